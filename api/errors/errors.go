@@ -157,6 +157,21 @@ var ErrFetchingLatestNonceCannotIncludeFields = errors.New("fetching latest nonc
 // ErrFetchingNonceGapsCannotIncludeFields signals that an error happened when trying to fetch nonce gaps
 var ErrFetchingNonceGapsCannotIncludeFields = errors.New("fetching nonce gaps cannot include fields")
 
+// ErrEmptySenderToGetPoolDetails signals that an error happened when trying to fetch the pool details for a sender
+var ErrEmptySenderToGetPoolDetails = errors.New("empty sender to get pool details")
+
+// ErrFetchingPoolDetailsCannotIncludeFields signals that an error happened when trying to fetch the pool details for a sender
+var ErrFetchingPoolDetailsCannotIncludeFields = errors.New("fetching pool details cannot include fields")
+
+// ErrFetchingPoolRejectionsCannotIncludeFields signals that an error happened when trying to fetch the pool rejections
+var ErrFetchingPoolRejectionsCannotIncludeFields = errors.New("fetching pool rejections cannot include fields")
+
+// ErrFetchingPoolSelectionCannotIncludeSender signals that an error happened when trying to fetch the pool selection
+var ErrFetchingPoolSelectionCannotIncludeSender = errors.New("fetching pool selection cannot include sender")
+
+// ErrTooManyTxPoolQueryOptions signals that more than one of the mutually exclusive transactions pool options were provided
+var ErrTooManyTxPoolQueryOptions = errors.New("only one of last-nonce, nonce-gaps, details, selection and rejections can be provided")
+
 // ErrInvalidFields signals that invalid fields were provided
 var ErrInvalidFields = errors.New("invalid fields")
 
@@ -172,6 +187,18 @@ var ErrInvalidRole = errors.New("invalid role")
 // ErrIsDataTrieMigrated signals that an error occurred while trying to verify the migration status of the data trie
 var ErrIsDataTrieMigrated = errors.New("could not verify the migration status of the data trie")
 
+// ErrGetTransactionsForAddress signals that an error occurred while getting the transactions of an address
+var ErrGetTransactionsForAddress = errors.New("error getting the transactions of the address")
+
+// ErrInvalidPageSize signals that an invalid page size has been provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrFilterEvents signals that an error occurred while filtering the events
+var ErrFilterEvents = errors.New("error filtering the events")
+
+// ErrHandleSubscriptions signals that an error occurred while handling the websocket subscriptions
+var ErrHandleSubscriptions = errors.New("error handling the websocket subscriptions")
+
 // ErrGetEligibleManagedKeys signals that an error occurred while getting the eligible managed keys
 var ErrGetEligibleManagedKeys = errors.New("error getting the eligible managed keys")
 
@@ -183,3 +210,18 @@ var ErrGetWaitingEpochsLeftForPublicKey = errors.New("error getting the waiting
 
 // ErrRecursiveRelayedTxIsNotAllowed signals that recursive relayed tx is not allowed
 var ErrRecursiveRelayedTxIsNotAllowed = errors.New("recursive relayed tx is not allowed")
+
+// ErrNoQueriesProvided signals that no queries were provided in a request
+var ErrNoQueriesProvided = errors.New("no queries provided")
+
+// ErrTooManyQueries signals that too many queries were provided in a request
+var ErrTooManyQueries = errors.New("too many queries provided")
+
+// ErrGetStateDiff signals that an error occurred while computing the diff between two states
+var ErrGetStateDiff = errors.New("error getting the state diff")
+
+// ErrInvalidStateDiffBoundary signals that a compared state was not given by exactly one of its root hash or block nonce
+var ErrInvalidStateDiffBoundary = errors.New("each compared state should be given either by root hash or by block nonce")
+
+// ErrGetTriesStatistics signals that an error occurred while computing the tries statistics
+var ErrGetTriesStatistics = errors.New("error getting the tries statistics")
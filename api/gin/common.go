@@ -57,6 +57,21 @@ func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
 	return false
 }
 
+func isOpenAPIRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
+	openAPIConfig, ok := routesConfig.APIPackages["openapi"]
+	if !ok {
+		return false
+	}
+
+	for _, cfg := range openAPIConfig.Routes {
+		if cfg.Name == openAPIRoute && cfg.Open {
+			return true
+		}
+	}
+
+	return false
+}
+
 func registerValidators() error {
 	validators := []validatorInput{
 		{
@@ -53,3 +53,22 @@ func TestCommon_isLogRouteEnabled(t *testing.T) {
 	require.True(t, isLogRouteEnabled(routesConfig))
 	require.False(t, isLogRouteEnabled(config.ApiRoutesConfig{}))
 }
+
+func TestCommon_isOpenAPIRouteEnabled(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"openapi": {
+				Routes: []config.RouteConfig{
+					{Name: "/openapi.json", Open: true},
+				},
+			},
+		},
+	}
+	require.True(t, isOpenAPIRouteEnabled(routesConfig))
+	require.False(t, isOpenAPIRouteEnabled(config.ApiRoutesConfig{}))
+
+	routesConfig.APIPackages["openapi"].Routes[0].Open = false
+	require.False(t, isOpenAPIRouteEnabled(routesConfig))
+}
@@ -2,6 +2,7 @@ package gin
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"sync"
@@ -15,6 +16,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/openapi"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/facade"
@@ -24,7 +26,12 @@ import (
 
 var log = logger.GetOrCreate("api/gin")
 
-const prometheusMetricsRoute = "/debug/metrics/prometheus"
+const (
+	prometheusMetricsRoute = "/debug/metrics/prometheus"
+	openAPIRoute           = "/openapi.json"
+	openAPITitle           = "MultiversX node API"
+	openAPIVersion         = "1.0.0"
+)
 
 // ArgsNewWebServer holds the arguments needed to create a new instance of webServer
 type ArgsNewWebServer struct {
@@ -126,6 +133,11 @@ func (ws *webServer) StartHttpServer() error {
 
 	ws.registerRoutes(engine)
 
+	err = ws.registerOpenAPIRoute(engine)
+	if err != nil {
+		return err
+	}
+
 	server := &http.Server{Addr: ws.facade.RestApiInterface(), Handler: engine}
 	log.Debug("creating gin web sever", "interface", ws.facade.RestApiInterface())
 	ws.httpServer, err = NewHttpServer(server)
@@ -168,6 +180,33 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["internal"] = internalBlockGroup
 
+	logsGroup, err := groups.NewLogsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["logs"] = logsGroup
+
+	subscriptionsGroup, err := groups.NewSubscriptionsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["subscriptions"] = subscriptionsGroup
+
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(ws.facade, ws.apiConfig.JsonRpc)
+	if err != nil {
+		return err
+	}
+	groupsMap["jsonrpc"] = jsonRpcGroup
+
+	// the GraphQL group validates its query limits, so it is created only when its route is enabled
+	if groups.IsEndpointOpen("graphql", "/query", ws.apiConfig) {
+		graphQLGroup, errCreate := groups.NewGraphQLGroup(ws.facade, ws.apiConfig.GraphQL)
+		if errCreate != nil {
+			return errCreate
+		}
+		groupsMap["graphql"] = graphQLGroup
+	}
+
 	hardforkGroup, err := groups.NewHardforkGroup(ws.facade)
 	if err != nil {
 		return err
@@ -192,6 +231,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["proof"] = proofGroup
 
+	stateGroup, err := groups.NewStateGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["state"] = stateGroup
+
 	transactionGroup, err := groups.NewTransactionGroup(ws.facade)
 	if err != nil {
 		return err
@@ -236,6 +281,35 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 	}
 }
 
+// registerOpenAPIRoute serves the OpenAPI specification built from the registered groups. The specification is built
+// once, as the enabled routes can not change while the server is running
+func (ws *webServer) registerOpenAPIRoute(ginRouter *gin.Engine) error {
+	if !isOpenAPIRouteEnabled(ws.apiConfig) {
+		return nil
+	}
+
+	specification, err := openapi.BuildSpecification(openapi.ArgsSpecification{
+		Groups:    ws.groups,
+		ApiConfig: ws.apiConfig,
+		Title:     openAPITitle,
+		Version:   openAPIVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	specificationBytes, err := json.Marshal(specification)
+	if err != nil {
+		return err
+	}
+
+	ginRouter.GET(openAPIRoute, func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json; charset=utf-8", specificationBytes)
+	})
+
+	return nil
+}
+
 func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, error) {
 	middlewares := make([]shared.MiddlewareProcessor, 0)
 
@@ -244,22 +318,47 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 		middlewares = append(middlewares, responseLoggerMiddleware)
 	}
 
+	ipResolver, err := middleware.NewClientIPResolver(ws.apiConfig.RateLimiting.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
+	var ctx context.Context
+	ctx, ws.cancelFunc = context.WithCancel(context.Background())
+
 	if ws.antiFloodConfig.WebServerAntifloodEnabled {
-		sourceLimiter, err := middleware.NewSourceThrottler(ws.antiFloodConfig.SameSourceRequests)
-		if err != nil {
-			return nil, err
+		sourceLimiter, errCreate := middleware.NewSourceThrottler(ws.antiFloodConfig.SameSourceRequests, ipResolver)
+		if errCreate != nil {
+			return nil, errCreate
 		}
 
-		var ctx context.Context
-		ctx, ws.cancelFunc = context.WithCancel(context.Background())
-
-		go ws.sourceLimiterReset(ctx, sourceLimiter)
+		sourceResetInterval := time.Second * time.Duration(ws.antiFloodConfig.SameSourceResetIntervalInSec)
+		go ws.limiterReset(ctx, sourceLimiter, sourceResetInterval, "WS source limiter")
 
 		middlewares = append(middlewares, sourceLimiter)
+	}
 
-		globalLimiter, err := middleware.NewGlobalThrottler(ws.antiFloodConfig.SimultaneousRequests)
-		if err != nil {
-			return nil, err
+	if ws.apiConfig.RateLimiting.Enabled {
+		rateLimiter, errCreate := middleware.NewRateLimiter(middleware.ArgsRateLimiter{
+			Config:     ws.apiConfig.RateLimiting,
+			IPResolver: ipResolver,
+		})
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		cleanupInterval := time.Second * time.Duration(ws.apiConfig.RateLimiting.IdleSourcesCleanupInSeconds)
+		if cleanupInterval > 0 {
+			go ws.limiterReset(ctx, rateLimiter, cleanupInterval, "WS rate limiter")
+		}
+
+		middlewares = append(middlewares, rateLimiter)
+	}
+
+	if ws.antiFloodConfig.WebServerAntifloodEnabled {
+		globalLimiter, errCreate := middleware.NewGlobalThrottler(ws.antiFloodConfig.SimultaneousRequests)
+		if errCreate != nil {
+			return nil, errCreate
 		}
 
 		middlewares = append(middlewares, globalLimiter)
@@ -268,15 +367,14 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 	return middlewares, nil
 }
 
-func (ws *webServer) sourceLimiterReset(ctx context.Context, reset resetHandler) {
-	betweenResetDuration := time.Second * time.Duration(ws.antiFloodConfig.SameSourceResetIntervalInSec)
+func (ws *webServer) limiterReset(ctx context.Context, reset resetHandler, betweenResetDuration time.Duration, name string) {
 	for {
 		select {
 		case <-time.After(betweenResetDuration):
-			log.Trace("calling reset on WS source limiter")
+			log.Trace("calling reset", "limiter", name)
 			reset.Reset()
 		case <-ctx.Done():
-			log.Debug("closing nodeFacade.sourceLimiterReset go routine")
+			log.Debug("closing webServer.limiterReset go routine", "limiter", name)
 			return
 		}
 	}
@@ -1,16 +1,20 @@
 package gin
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/openapi"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/facade"
@@ -105,6 +109,28 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 		err := ws.StartHttpServer()
 		require.Equal(t, middleware.ErrInvalidMaxNumRequests, err)
 	})
+	t.Run("createMiddlewareLimiters returns error due to middleware.NewClientIPResolver error", func(t *testing.T) {
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.RateLimiting.TrustedProxies = []string{"not an IP"}
+		ws, _ := NewGinWebServerHandler(args)
+		require.NotNil(t, ws)
+
+		err := ws.StartHttpServer()
+		require.True(t, errors.Is(err, middleware.ErrInvalidTrustedProxy))
+	})
+	t.Run("createMiddlewareLimiters returns error due to middleware.NewRateLimiter error", func(t *testing.T) {
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.RateLimiting = config.ApiRateLimitingConfig{
+			Enabled:           true,
+			RequestsPerSecond: 0,
+			Burst:             10,
+		}
+		ws, _ := NewGinWebServerHandler(args)
+		require.NotNil(t, ws)
+
+		err := ws.StartHttpServer()
+		require.True(t, errors.Is(err, middleware.ErrInvalidRequestsPerSecond))
+	})
 	t.Run("should work", func(t *testing.T) {
 		ws, _ := NewGinWebServerHandler(createMockArgsNewWebServer())
 		require.NotNil(t, ws)
@@ -177,6 +203,52 @@ func TestWebServer_UpdateFacade(t *testing.T) {
 	})
 }
 
+func TestWebServer_RegisterOpenAPIRoute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled route should not register", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _ := NewGinWebServerHandler(createMockArgsNewWebServer())
+		require.Nil(t, ws.createGroups())
+
+		engine := gin.New()
+		err := ws.registerOpenAPIRoute(engine)
+		require.Nil(t, err)
+
+		resp := httptest.NewRecorder()
+		engine.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, openAPIRoute, nil))
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+	t.Run("should serve the specification of the enabled routes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.APIPackages["openapi"] = config.APIPackageConfig{
+			Routes: []config.RouteConfig{{Name: openAPIRoute, Open: true}},
+		}
+		args.ApiConfig.APIPackages["node"] = config.APIPackageConfig{
+			Routes: []config.RouteConfig{{Name: "/status", Open: true}},
+		}
+		ws, _ := NewGinWebServerHandler(args)
+		require.Nil(t, ws.createGroups())
+
+		engine := gin.New()
+		err := ws.registerOpenAPIRoute(engine)
+		require.Nil(t, err)
+
+		resp := httptest.NewRecorder()
+		engine.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, openAPIRoute, nil))
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		doc := &openapi.Document{}
+		require.Nil(t, json.Unmarshal(resp.Body.Bytes(), doc))
+		assert.Equal(t, openAPITitle, doc.Info.Title)
+		assert.Len(t, doc.Paths, 1)
+		assert.NotNil(t, doc.Paths["/node/status"]["get"])
+	})
+}
+
 func TestWebServer_CloseWithDisabledServerShouldNotPanic(t *testing.T) {
 	t.Parallel()
 
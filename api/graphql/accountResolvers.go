@@ -0,0 +1,251 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared/logging"
+)
+
+// accountResolver resolves the fields of an account. The account is fetched when the first field other than the
+// address is resolved
+type accountResolver struct {
+	address string
+}
+
+func newAccountResolver(address string) *accountResolver {
+	if len(address) == 0 {
+		return nil
+	}
+
+	return &accountResolver{address: address}
+}
+
+func (ar *accountResolver) fetch(ctx context.Context) (*api.AccountResponse, error) {
+	state := requestStateFromContext(ctx)
+	value, err := state.fetchOnce("account:"+ar.address, costAccount, func() (interface{}, error) {
+		start := time.Now()
+		account, _, err := state.facade.GetAccount(ar.address, api.AccountQueryOptions{})
+		logging.LogAPIActionDurationIfNeeded(start, "GraphQL query: GetAccount")
+		if err != nil {
+			return nil, newInternalError(apiErrors.ErrCouldNotGetAccount, err)
+		}
+
+		return &account, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value.(*api.AccountResponse), nil
+}
+
+// Address returns the address of the account
+func (ar *accountResolver) Address() string {
+	return ar.address
+}
+
+// Nonce returns the nonce of the account
+func (ar *accountResolver) Nonce(ctx context.Context) (Uint64, error) {
+	account, err := ar.fetch(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return Uint64(account.Nonce), nil
+}
+
+// Balance returns the balance of the account
+func (ar *accountResolver) Balance(ctx context.Context) (string, error) {
+	account, err := ar.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return account.Balance, nil
+}
+
+// Username returns the username of the account
+func (ar *accountResolver) Username(ctx context.Context) (string, error) {
+	account, err := ar.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return account.Username, nil
+}
+
+// CodeHash returns the base64 encoded code hash of the account
+func (ar *accountResolver) CodeHash(ctx context.Context) (string, error) {
+	account, err := ar.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(account.CodeHash), nil
+}
+
+// RootHash returns the base64 encoded root hash of the account data trie
+func (ar *accountResolver) RootHash(ctx context.Context) (string, error) {
+	account, err := ar.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(account.RootHash), nil
+}
+
+// OwnerAddress returns the owner address of the account
+func (ar *accountResolver) OwnerAddress(ctx context.Context) (string, error) {
+	account, err := ar.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return account.OwnerAddress, nil
+}
+
+// DeveloperReward returns the developer reward of the account
+func (ar *accountResolver) DeveloperReward(ctx context.Context) (string, error) {
+	account, err := ar.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return account.DeveloperReward, nil
+}
+
+type esdtArgs struct {
+	TokenIdentifier string
+	Nonce           *Uint64
+}
+
+// Esdt returns the ESDT token held by the account
+func (ar *accountResolver) Esdt(ctx context.Context, args esdtArgs) (*esdtTokenResolver, error) {
+	if len(args.TokenIdentifier) == 0 {
+		return nil, newRequestError(apiErrors.ErrGetESDTNFTData, apiErrors.ErrEmptyTokenIdentifier)
+	}
+
+	nonce := uint64(0)
+	if args.Nonce != nil {
+		nonce = uint64(*args.Nonce)
+	}
+
+	state := requestStateFromContext(ctx)
+	key := fmt.Sprintf("esdt:%s:%s:%d", ar.address, args.TokenIdentifier, nonce)
+	value, err := state.fetchOnce(key, costESDTToken, func() (interface{}, error) {
+		start := time.Now()
+		esdtData, _, err := state.facade.GetESDTData(ar.address, args.TokenIdentifier, nonce, api.AccountQueryOptions{})
+		logging.LogAPIActionDurationIfNeeded(start, "GraphQL query: GetESDTData")
+		if err != nil {
+			return nil, newInternalError(apiErrors.ErrGetESDTNFTData, err)
+		}
+
+		return esdtData, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &esdtTokenResolver{
+		tokenIdentifier: args.TokenIdentifier,
+		token:           value.(*esdt.ESDigitalToken),
+	}, nil
+}
+
+type esdtTokenResolver struct {
+	tokenIdentifier string
+	token           *esdt.ESDigitalToken
+}
+
+// TokenIdentifier returns the identifier of the token
+func (er *esdtTokenResolver) TokenIdentifier() string {
+	return er.tokenIdentifier
+}
+
+// Type returns the type of the token. The type is empty for the non fungible tokens created before it was persisted
+func (er *esdtTokenResolver) Type() string {
+	isNotFungible := er.token.TokenMetaData != nil && er.token.TokenMetaData.Nonce != 0
+	tokenTypeNotSet := isNotFungible && core.ESDTType(er.token.Type) == core.NonFungible
+	if tokenTypeNotSet {
+		return ""
+	}
+
+	return core.ESDTType(er.token.Type).String()
+}
+
+// Balance returns the balance of the token
+func (er *esdtTokenResolver) Balance() string {
+	if er.token.Value == nil {
+		return "0"
+	}
+
+	return er.token.Value.String()
+}
+
+// Nonce returns the nonce of the token
+func (er *esdtTokenResolver) Nonce() Uint64 {
+	if er.token.TokenMetaData == nil {
+		return 0
+	}
+
+	return Uint64(er.token.TokenMetaData.Nonce)
+}
+
+// Properties returns the hex encoded properties of the token
+func (er *esdtTokenResolver) Properties() string {
+	return hex.EncodeToString(er.token.Properties)
+}
+
+// Name returns the name of the token
+func (er *esdtTokenResolver) Name() string {
+	if er.token.TokenMetaData == nil {
+		return ""
+	}
+
+	return string(er.token.TokenMetaData.Name)
+}
+
+// Creator returns the creator of the token
+func (er *esdtTokenResolver) Creator() string {
+	if er.token.TokenMetaData == nil {
+		return ""
+	}
+
+	return string(er.token.TokenMetaData.Creator)
+}
+
+// Royalties returns the royalties of the token
+func (er *esdtTokenResolver) Royalties() string {
+	if er.token.TokenMetaData == nil {
+		return "0"
+	}
+
+	return big.NewInt(int64(er.token.TokenMetaData.Royalties)).String()
+}
+
+// Attributes returns the base64 encoded attributes of the token
+func (er *esdtTokenResolver) Attributes() string {
+	if er.token.TokenMetaData == nil {
+		return ""
+	}
+
+	return base64.StdEncoding.EncodeToString(er.token.TokenMetaData.Attributes)
+}
+
+// Uris returns the base64 encoded URIs of the token
+func (er *esdtTokenResolver) Uris() []string {
+	if er.token.TokenMetaData == nil {
+		return make([]string, 0)
+	}
+
+	return encodeToBase64(er.token.TokenMetaData.URIs)
+}
@@ -0,0 +1,209 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared/logging"
+)
+
+// blockResolver resolves the fields of a block fetched without transactions. The block is fetched again, with its
+// transactions and logs, only if the transactions of a miniblock are selected
+type blockResolver struct {
+	block *api.Block
+}
+
+func fetchBlockByNonce(ctx context.Context, nonce uint64) (*blockResolver, error) {
+	state := requestStateFromContext(ctx)
+	value, err := state.fetchOnce(fmt.Sprintf("block:nonce:%d", nonce), costBlock, func() (interface{}, error) {
+		start := time.Now()
+		block, err := state.facade.GetBlockByNonce(nonce, api.BlockQueryOptions{})
+		logging.LogAPIActionDurationIfNeeded(start, "GraphQL query: GetBlockByNonce")
+		if err != nil {
+			return nil, newInternalError(apiErrors.ErrGetBlock, err)
+		}
+
+		return block, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockResolver{block: value.(*api.Block)}, nil
+}
+
+func fetchBlockByHash(ctx context.Context, hash string) (*blockResolver, error) {
+	if len(hash) == 0 {
+		return nil, newRequestError(apiErrors.ErrGetBlock, apiErrors.ErrValidationEmptyBlockHash)
+	}
+
+	state := requestStateFromContext(ctx)
+	value, err := state.fetchOnce("block:hash:"+hash, costBlock, func() (interface{}, error) {
+		start := time.Now()
+		block, err := state.facade.GetBlockByHash(hash, api.BlockQueryOptions{})
+		logging.LogAPIActionDurationIfNeeded(start, "GraphQL query: GetBlockByHash")
+		if err != nil {
+			return nil, newInternalError(apiErrors.ErrGetBlock, err)
+		}
+
+		return block, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockResolver{block: value.(*api.Block)}, nil
+}
+
+func (br *blockResolver) fetchWithTransactions(ctx context.Context) (*api.Block, error) {
+	state := requestStateFromContext(ctx)
+	value, err := state.fetchOnce("block:full:"+br.block.Hash, costBlockWithTransactions, func() (interface{}, error) {
+		options := api.BlockQueryOptions{
+			WithTransactions: true,
+			WithLogs:         true,
+		}
+
+		start := time.Now()
+		block, err := state.facade.GetBlockByHash(br.block.Hash, options)
+		logging.LogAPIActionDurationIfNeeded(start, "GraphQL query: GetBlockByHash")
+		if err != nil {
+			return nil, newInternalError(apiErrors.ErrGetBlock, err)
+		}
+
+		return block, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value.(*api.Block), nil
+}
+
+// Nonce returns the nonce of the block
+func (br *blockResolver) Nonce() Uint64 {
+	return Uint64(br.block.Nonce)
+}
+
+// Round returns the round of the block
+func (br *blockResolver) Round() Uint64 {
+	return Uint64(br.block.Round)
+}
+
+// Epoch returns the epoch of the block
+func (br *blockResolver) Epoch() Uint64 {
+	return Uint64(br.block.Epoch)
+}
+
+// Shard returns the shard of the block
+func (br *blockResolver) Shard() Uint64 {
+	return Uint64(br.block.Shard)
+}
+
+// Hash returns the hash of the block
+func (br *blockResolver) Hash() string {
+	return br.block.Hash
+}
+
+// PrevBlockHash returns the hash of the previous block
+func (br *blockResolver) PrevBlockHash() string {
+	return br.block.PrevBlockHash
+}
+
+// StateRootHash returns the state root hash of the block
+func (br *blockResolver) StateRootHash() string {
+	return br.block.StateRootHash
+}
+
+// NumTxs returns the number of transactions in block
+func (br *blockResolver) NumTxs() Uint64 {
+	return Uint64(br.block.NumTxs)
+}
+
+// Timestamp returns the timestamp of the block
+func (br *blockResolver) Timestamp() Uint64 {
+	return Uint64(br.block.Timestamp)
+}
+
+// AccumulatedFees returns the fees accumulated in block
+func (br *blockResolver) AccumulatedFees() string {
+	return br.block.AccumulatedFees
+}
+
+// DeveloperFees returns the developer fees accumulated in block
+func (br *blockResolver) DeveloperFees() string {
+	return br.block.DeveloperFees
+}
+
+// Status returns the status of the block
+func (br *blockResolver) Status() string {
+	return br.block.Status
+}
+
+// MiniBlocks returns the miniblocks of the block
+func (br *blockResolver) MiniBlocks() []*miniBlockResolver {
+	miniBlocks := make([]*miniBlockResolver, 0, len(br.block.MiniBlocks))
+	for _, miniBlock := range br.block.MiniBlocks {
+		miniBlocks = append(miniBlocks, &miniBlockResolver{
+			miniBlock: miniBlock,
+			block:     br,
+		})
+	}
+
+	return miniBlocks
+}
+
+type miniBlockResolver struct {
+	miniBlock *api.MiniBlock
+	block     *blockResolver
+}
+
+// Hash returns the hash of the miniblock
+func (mr *miniBlockResolver) Hash() string {
+	return mr.miniBlock.Hash
+}
+
+// Type returns the type of the miniblock
+func (mr *miniBlockResolver) Type() string {
+	return mr.miniBlock.Type
+}
+
+// ProcessingType returns the processing type of the miniblock
+func (mr *miniBlockResolver) ProcessingType() string {
+	return mr.miniBlock.ProcessingType
+}
+
+// SourceShard returns the source shard of the miniblock
+func (mr *miniBlockResolver) SourceShard() Uint64 {
+	return Uint64(mr.miniBlock.SourceShard)
+}
+
+// DestinationShard returns the destination shard of the miniblock
+func (mr *miniBlockResolver) DestinationShard() Uint64 {
+	return Uint64(mr.miniBlock.DestinationShard)
+}
+
+// Transactions returns the transactions of the miniblock
+func (mr *miniBlockResolver) Transactions(ctx context.Context) ([]*transactionResolver, error) {
+	block, err := mr.block.fetchWithTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, miniBlock := range block.MiniBlocks {
+		if miniBlock.Hash != mr.miniBlock.Hash {
+			continue
+		}
+
+		txs := make([]*transactionResolver, 0, len(miniBlock.Transactions))
+		for _, tx := range miniBlock.Transactions {
+			txs = append(txs, &transactionResolver{tx: tx})
+		}
+
+		return txs, nil
+	}
+
+	return make([]*transactionResolver, 0), nil
+}
@@ -0,0 +1,70 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+// ErrInvalidMaxQueryLength signals that an invalid maximum query length has been provided
+var ErrInvalidMaxQueryLength = errors.New("invalid maximum query length")
+
+// ErrInvalidMaxQueryDepth signals that an invalid maximum query depth has been provided
+var ErrInvalidMaxQueryDepth = errors.New("invalid maximum query depth")
+
+// ErrInvalidMaxQueryCost signals that an invalid maximum query cost has been provided
+var ErrInvalidMaxQueryCost = errors.New("invalid maximum query cost")
+
+// ErrNilFacade signals that a nil facade has been provided
+var ErrNilFacade = errors.New("nil facade")
+
+// ErrQueryTooLong signals that the query document exceeds the maximum length
+var ErrQueryTooLong = errors.New("query too long")
+
+// ErrQueryCostExceeded signals that resolving a field would exceed the maximum query cost
+var ErrQueryCostExceeded = errors.New("maximum query cost exceeded")
+
+// ErrInvalidBlockIdentifier signals that none or both of the block nonce and hash have been provided
+var ErrInvalidBlockIdentifier = errors.New("exactly one of nonce or hash should be provided")
+
+// ErrInvalidUint64 signals that a value could not be decoded as an Uint64 scalar
+var ErrInvalidUint64 = errors.New("invalid Uint64 value")
+
+// resolverError is the error returned by the resolvers. The return code the REST API would have responded with for
+// the same failure is reported in the extensions of the GraphQL error
+type resolverError struct {
+	err  error
+	code shared.ReturnCode
+}
+
+func newRequestError(err error, innerErr error) *resolverError {
+	return &resolverError{
+		err:  fmt.Errorf("%s: %w", err.Error(), innerErr),
+		code: shared.ReturnCodeRequestError,
+	}
+}
+
+func newInternalError(err error, innerErr error) *resolverError {
+	return &resolverError{
+		err:  fmt.Errorf("%s: %w", err.Error(), innerErr),
+		code: shared.ReturnCodeInternalError,
+	}
+}
+
+// Error returns the error message
+func (re *resolverError) Error() string {
+	return re.err.Error()
+}
+
+// Unwrap returns the wrapped error
+func (re *resolverError) Unwrap() error {
+	return re.err
+}
+
+// Extensions returns the extensions of the GraphQL error
+func (re *resolverError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code": re.code,
+	}
+}
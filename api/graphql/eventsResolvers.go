@@ -0,0 +1,178 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared/logging"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	defaultEventsPageSize = 20
+	maxEventsPageSize     = 100
+)
+
+type eventsFilterInput struct {
+	Address    *string
+	Identifier *string
+	Topics     *[]string
+	FromBlock  *Uint64
+	ToBlock    *Uint64
+	Cursor     *Uint64
+	Size       *int32
+}
+
+func (input *eventsFilterInput) toApiRequest() (*common.EventsFilterApiRequest, error) {
+	filter := &common.EventsFilterApiRequest{
+		Size: defaultEventsPageSize,
+	}
+	if input.Address != nil {
+		filter.Address = *input.Address
+	}
+	if input.Identifier != nil {
+		filter.Identifier = *input.Identifier
+	}
+	if input.FromBlock != nil {
+		filter.FromBlock = uint64(*input.FromBlock)
+	}
+	if input.ToBlock != nil {
+		filter.ToBlock = uint64(*input.ToBlock)
+	}
+	if input.Cursor != nil {
+		filter.Cursor = uint64(*input.Cursor)
+	}
+	if input.Size != nil {
+		filter.Size = int(*input.Size)
+	}
+	if filter.Size < 1 || filter.Size > maxEventsPageSize {
+		return nil, fmt.Errorf("%w, it should be between 1 and %d", apiErrors.ErrInvalidPageSize, maxEventsPageSize)
+	}
+
+	if input.Topics != nil {
+		filter.Topics = make([][]byte, 0, len(*input.Topics))
+		for _, topic := range *input.Topics {
+			decoded, err := base64.StdEncoding.DecodeString(topic)
+			if err != nil {
+				return nil, err
+			}
+
+			filter.Topics = append(filter.Topics, decoded)
+		}
+	}
+
+	return filter, nil
+}
+
+type eventsPageResolver struct {
+	response *common.FilteredEventsApiResponse
+}
+
+func fetchEventsPage(ctx context.Context, input eventsFilterInput) (*eventsPageResolver, error) {
+	filter, err := input.toApiRequest()
+	if err != nil {
+		return nil, newRequestError(apiErrors.ErrFilterEvents, err)
+	}
+
+	state := requestStateFromContext(ctx)
+	err = state.consume(costEventsPage)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	response, err := state.facade.FilterEvents(filter)
+	logging.LogAPIActionDurationIfNeeded(start, "GraphQL query: FilterEvents")
+	if err != nil {
+		return nil, newInternalError(apiErrors.ErrFilterEvents, err)
+	}
+
+	return &eventsPageResolver{response: response}, nil
+}
+
+// Events returns the events of the page
+func (pr *eventsPageResolver) Events() []*filteredEventResolver {
+	events := make([]*filteredEventResolver, 0, len(pr.response.Events))
+	for _, event := range pr.response.Events {
+		events = append(events, &filteredEventResolver{event: event})
+	}
+
+	return events
+}
+
+// NextCursor returns the cursor of the next page, or 0 if this is the last page
+func (pr *eventsPageResolver) NextCursor() Uint64 {
+	return Uint64(pr.response.NextCursor)
+}
+
+type filteredEventResolver struct {
+	event *common.FilteredEventApiResponse
+}
+
+// Address returns the address which generated the event
+func (fr *filteredEventResolver) Address() string {
+	return fr.event.Address
+}
+
+// Account returns the account which generated the event
+func (fr *filteredEventResolver) Account() *accountResolver {
+	return newAccountResolver(fr.event.Address)
+}
+
+// Identifier returns the identifier of the event
+func (fr *filteredEventResolver) Identifier() string {
+	return fr.event.Identifier
+}
+
+// Topics returns the base64 encoded topics of the event
+func (fr *filteredEventResolver) Topics() []string {
+	return encodeToBase64(fr.event.Topics)
+}
+
+// Data returns the base64 encoded data of the event
+func (fr *filteredEventResolver) Data() string {
+	return base64.StdEncoding.EncodeToString(fr.event.Data)
+}
+
+// EventIndex returns the index of the event in the logs of its transaction
+func (fr *filteredEventResolver) EventIndex() Uint64 {
+	return Uint64(fr.event.EventIndex)
+}
+
+// TxHash returns the hash of the transaction which generated the event
+func (fr *filteredEventResolver) TxHash() string {
+	return fr.event.TxHash
+}
+
+// Transaction returns the transaction which generated the event
+func (fr *filteredEventResolver) Transaction(ctx context.Context) (*transactionResolver, error) {
+	return fetchTransaction(ctx, fr.event.TxHash)
+}
+
+// Epoch returns the epoch of the event
+func (fr *filteredEventResolver) Epoch() Uint64 {
+	return Uint64(fr.event.Epoch)
+}
+
+// Round returns the round of the event
+func (fr *filteredEventResolver) Round() Uint64 {
+	return Uint64(fr.event.Round)
+}
+
+// BlockNonce returns the nonce of the block holding the event
+func (fr *filteredEventResolver) BlockNonce() Uint64 {
+	return Uint64(fr.event.BlockNonce)
+}
+
+// BlockHash returns the hash of the block holding the event
+func (fr *filteredEventResolver) BlockHash() string {
+	return fr.event.BlockHash
+}
+
+// Block returns the block holding the event
+func (fr *filteredEventResolver) Block(ctx context.Context) (*blockResolver, error) {
+	return fetchBlockByHash(ctx, fr.event.BlockHash)
+}
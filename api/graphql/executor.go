@@ -0,0 +1,101 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/graph-gophers/graphql-go"
+	gqlErrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("api/graphql")
+
+// ArgsExecutor holds the arguments needed for creating a GraphQL executor
+type ArgsExecutor struct {
+	MaxQueryLengthInBytes uint32
+	MaxQueryDepth         uint32
+	MaxQueryCost          uint32
+}
+
+// Request is the body of a GraphQL request
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Response is the body of a GraphQL response
+type Response = graphql.Response
+
+type executor struct {
+	schema         *graphql.Schema
+	maxQueryLength int
+	maxQueryCost   uint32
+}
+
+// NewExecutor parses the schema and creates an executor resolving the GraphQL queries on the node facade
+func NewExecutor(args ArgsExecutor) (*executor, error) {
+	if args.MaxQueryLengthInBytes == 0 {
+		return nil, ErrInvalidMaxQueryLength
+	}
+	if args.MaxQueryDepth == 0 {
+		return nil, ErrInvalidMaxQueryDepth
+	}
+	if args.MaxQueryCost == 0 {
+		return nil, ErrInvalidMaxQueryCost
+	}
+
+	schema, err := graphql.ParseSchema(
+		schemaDefinition,
+		&queryResolver{},
+		graphql.MaxDepth(int(args.MaxQueryDepth)),
+		graphql.Logger(&panicLogger{}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &executor{
+		schema:         schema,
+		maxQueryLength: int(args.MaxQueryLengthInBytes),
+		maxQueryCost:   args.MaxQueryCost,
+	}, nil
+}
+
+// Execute resolves the request on the provided facade. The validation and the resolving errors are reported in the
+// errors field of the response, next to the data that could be resolved
+func (e *executor) Execute(ctx context.Context, facade FacadeHandler, request *Request) *Response {
+	if check.IfNil(facade) {
+		return NewErrorResponse(ErrNilFacade)
+	}
+	if len(request.Query) > e.maxQueryLength {
+		return NewErrorResponse(fmt.Errorf("%w: %d bytes, maximum is %d", ErrQueryTooLong, len(request.Query), e.maxQueryLength))
+	}
+
+	state := newRequestState(facade, e.maxQueryCost)
+
+	return e.schema.Exec(contextWithRequestState(ctx, state), request.Query, request.OperationName, request.Variables)
+}
+
+// panicLogger logs the panics recovered while executing a query, such as the ones caused by integer literals out of
+// the 32 bits range, which the GraphQL library reports as errors in the response
+type panicLogger struct{}
+
+// LogPanic logs the recovered panic value
+func (pl *panicLogger) LogPanic(_ context.Context, value interface{}) {
+	log.Debug("graphql: recovered panic while executing query", "value", value)
+}
+
+// NewErrorResponse creates a response holding only the provided error
+func NewErrorResponse(err error) *Response {
+	return &Response{
+		Errors: []*gqlErrors.QueryError{gqlErrors.Errorf("%v", err)},
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *executor) IsInterfaceNil() bool {
+	return e == nil
+}
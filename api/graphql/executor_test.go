@@ -0,0 +1,339 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/api/graphql"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsExecutor() graphql.ArgsExecutor {
+	return graphql.ArgsExecutor{
+		MaxQueryLengthInBytes: 4096,
+		MaxQueryDepth:         10,
+		MaxQueryCost:          100,
+	}
+}
+
+func createTestBlock(withTransactions bool) *api.Block {
+	miniBlock := &api.MiniBlock{
+		Hash:             "mbHash",
+		Type:             "TxBlock",
+		SourceShard:      0,
+		DestinationShard: 1,
+	}
+	if withTransactions {
+		miniBlock.Transactions = []*transaction.ApiTransactionResult{
+			{
+				Hash:     "txHash",
+				Nonce:    3,
+				Sender:   "erd1sender",
+				Receiver: "erd1receiver",
+				Logs: &transaction.ApiLogs{
+					Address: "erd1receiver",
+					Events: []*transaction.Events{
+						{Address: "erd1receiver", Identifier: "transfer", Topics: [][]byte{[]byte("topic")}},
+					},
+				},
+			},
+		}
+	}
+
+	return &api.Block{
+		Nonce:      7,
+		Hash:       "blockHash",
+		Shard:      4294967295,
+		MiniBlocks: []*api.MiniBlock{miniBlock},
+	}
+}
+
+func executeQuery(t *testing.T, args graphql.ArgsExecutor, facade graphql.FacadeHandler, query string, variables map[string]interface{}) (map[string]interface{}, *graphql.Response) {
+	executor, err := graphql.NewExecutor(args)
+	require.Nil(t, err)
+
+	response := executor.Execute(context.Background(), facade, &graphql.Request{Query: query, Variables: variables})
+	data := make(map[string]interface{})
+	if len(response.Data) > 0 {
+		require.Nil(t, json.Unmarshal(response.Data, &data))
+	}
+
+	return data, response
+}
+
+func TestNewExecutor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max query length should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExecutor()
+		args.MaxQueryLengthInBytes = 0
+		executor, err := graphql.NewExecutor(args)
+		require.True(t, check.IfNil(executor))
+		require.Equal(t, graphql.ErrInvalidMaxQueryLength, err)
+	})
+	t.Run("invalid max query depth should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExecutor()
+		args.MaxQueryDepth = 0
+		executor, err := graphql.NewExecutor(args)
+		require.True(t, check.IfNil(executor))
+		require.Equal(t, graphql.ErrInvalidMaxQueryDepth, err)
+	})
+	t.Run("invalid max query cost should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExecutor()
+		args.MaxQueryCost = 0
+		executor, err := graphql.NewExecutor(args)
+		require.True(t, check.IfNil(executor))
+		require.Equal(t, graphql.ErrInvalidMaxQueryCost, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		executor, err := graphql.NewExecutor(createMockArgsExecutor())
+		require.False(t, check.IfNil(executor))
+		require.Nil(t, err)
+	})
+}
+
+func TestExecutor_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, response := executeQuery(t, createMockArgsExecutor(), nil, "{ block(nonce: 1) { hash } }", nil)
+		require.Len(t, response.Errors, 1)
+		require.Contains(t, response.Errors[0].Message, graphql.ErrNilFacade.Error())
+	})
+	t.Run("too long query should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExecutor()
+		args.MaxQueryLengthInBytes = 10
+		_, response := executeQuery(t, args, &mock.FacadeStub{}, "{ block(nonce: 1) { hash } }", nil)
+		require.Len(t, response.Errors, 1)
+		require.Contains(t, response.Errors[0].Message, graphql.ErrQueryTooLong.Error())
+	})
+	t.Run("too deep query should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExecutor()
+		args.MaxQueryDepth = 2
+		_, response := executeQuery(t, args, &mock.FacadeStub{}, "{ block(nonce: 1) { miniBlocks { transactions { hash } } } }", nil)
+		require.NotEmpty(t, response.Errors)
+	})
+	t.Run("invalid block identifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		data, response := executeQuery(t, createMockArgsExecutor(), &mock.FacadeStub{}, `{ block(nonce: 1, hash: "aa") { hash } }`, nil)
+		require.Len(t, response.Errors, 1)
+		require.Contains(t, response.Errors[0].Message, graphql.ErrInvalidBlockIdentifier.Error())
+		require.Equal(t, shared.ReturnCodeRequestError, response.Errors[0].Extensions["code"])
+		require.Nil(t, data["block"])
+	})
+	t.Run("facade error should be reported as internal issue", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return nil, expectedErr
+			},
+		}
+		_, response := executeQuery(t, createMockArgsExecutor(), facade, `{ transaction(hash: "aa") { hash } }`, nil)
+		require.Len(t, response.Errors, 1)
+		require.Contains(t, response.Errors[0].Message, expectedErr.Error())
+		require.Equal(t, shared.ReturnCodeInternalError, response.Errors[0].Extensions["code"])
+	})
+	t.Run("should resolve the selected nested fields fetching each entity once", func(t *testing.T) {
+		t.Parallel()
+
+		numGetBlockByNonce := uint32(0)
+		numGetBlockByHash := uint32(0)
+		numGetAccount := uint32(0)
+		facade := &mock.FacadeStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				atomic.AddUint32(&numGetBlockByNonce, 1)
+				require.Equal(t, uint64(7), nonce)
+				require.False(t, options.WithTransactions)
+				return createTestBlock(false), nil
+			},
+			GetBlockByHashCalled: func(hash string, options api.BlockQueryOptions) (*api.Block, error) {
+				atomic.AddUint32(&numGetBlockByHash, 1)
+				require.Equal(t, "blockHash", hash)
+				require.True(t, options.WithTransactions)
+				require.True(t, options.WithLogs)
+				return createTestBlock(true), nil
+			},
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				atomic.AddUint32(&numGetAccount, 1)
+				require.Equal(t, "erd1receiver", address)
+				return api.AccountResponse{Address: address, Balance: "1000", Nonce: 2}, api.BlockInfo{}, nil
+			},
+		}
+
+		query := `query($nonce: Uint64!) {
+			block(nonce: $nonce) {
+				nonce
+				shard
+				miniBlocks {
+					hash
+					transactions {
+						hash
+						receiverAccount { balance }
+						logs { events { identifier topics account { nonce balance } } }
+					}
+				}
+			}
+		}`
+		data, response := executeQuery(t, createMockArgsExecutor(), facade, query, map[string]interface{}{"nonce": "7"})
+		require.Empty(t, response.Errors)
+
+		block := data["block"].(map[string]interface{})
+		require.Equal(t, "7", block["nonce"])
+		require.Equal(t, "4294967295", block["shard"])
+		miniBlock := block["miniBlocks"].([]interface{})[0].(map[string]interface{})
+		require.Equal(t, "mbHash", miniBlock["hash"])
+		tx := miniBlock["transactions"].([]interface{})[0].(map[string]interface{})
+		require.Equal(t, "txHash", tx["hash"])
+		require.Equal(t, "1000", tx["receiverAccount"].(map[string]interface{})["balance"])
+		event := tx["logs"].(map[string]interface{})["events"].([]interface{})[0].(map[string]interface{})
+		require.Equal(t, "transfer", event["identifier"])
+		require.Equal(t, []interface{}{"dG9waWM="}, event["topics"])
+		require.Equal(t, "2", event["account"].(map[string]interface{})["nonce"])
+
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numGetBlockByNonce))
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numGetBlockByHash))
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numGetAccount))
+	})
+	t.Run("should not fetch the transactions if not selected", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByHashCalled: func(hash string, options api.BlockQueryOptions) (*api.Block, error) {
+				require.False(t, options.WithTransactions)
+				return createTestBlock(false), nil
+			},
+		}
+
+		data, response := executeQuery(t, createMockArgsExecutor(), facade, `{ block(hash: "blockHash") { miniBlocks { type } } }`, nil)
+		require.Empty(t, response.Errors)
+		miniBlock := data["block"].(map[string]interface{})["miniBlocks"].([]interface{})[0].(map[string]interface{})
+		require.Equal(t, "TxBlock", miniBlock["type"])
+	})
+	t.Run("exceeding the query cost should error", func(t *testing.T) {
+		t.Parallel()
+
+		numGetAccount := uint32(0)
+		facade := &mock.FacadeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				atomic.AddUint32(&numGetAccount, 1)
+				return api.AccountResponse{Balance: "1"}, api.BlockInfo{}, nil
+			},
+		}
+
+		args := createMockArgsExecutor()
+		args.MaxQueryCost = 2
+		query := `{
+			a: account(address: "erd1a") { balance }
+			b: account(address: "erd1b") { balance }
+			c: account(address: "erd1c") { balance }
+		}`
+		data, response := executeQuery(t, args, facade, query, nil)
+		require.Len(t, response.Errors, 1)
+		require.Contains(t, response.Errors[0].Message, graphql.ErrQueryCostExceeded.Error())
+		require.Equal(t, uint32(2), atomic.LoadUint32(&numGetAccount))
+
+		numResolved := 0
+		for _, account := range data {
+			if account != nil {
+				numResolved++
+			}
+		}
+		require.Equal(t, 2, numResolved)
+	})
+	t.Run("should resolve the ESDT tokens of an account", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetESDTDataCalled: func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error) {
+				require.Equal(t, "erd1a", address)
+				require.Equal(t, "NFT-abcdef", key)
+				require.Equal(t, uint64(5), nonce)
+				return &esdt.ESDigitalToken{
+					Type:  1,
+					Value: big.NewInt(1),
+					TokenMetaData: &esdt.MetaData{
+						Nonce: 5,
+						Name:  []byte("name"),
+						URIs:  [][]byte{[]byte("uri")},
+					},
+				}, api.BlockInfo{}, nil
+			},
+		}
+
+		query := `{ account(address: "erd1a") { address esdt(tokenIdentifier: "NFT-abcdef", nonce: 5) { balance nonce name uris } } }`
+		data, response := executeQuery(t, createMockArgsExecutor(), facade, query, nil)
+		require.Empty(t, response.Errors)
+		account := data["account"].(map[string]interface{})
+		require.Equal(t, "erd1a", account["address"])
+		token := account["esdt"].(map[string]interface{})
+		require.Equal(t, "1", token["balance"])
+		require.Equal(t, "5", token["nonce"])
+		require.Equal(t, "name", token["name"])
+		require.Equal(t, []interface{}{"dXJp"}, token["uris"])
+	})
+	t.Run("should resolve the events and their transactions", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			FilterEventsCalled: func(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error) {
+				require.Equal(t, "transfer", filter.Identifier)
+				require.Equal(t, [][]byte{[]byte("topic")}, filter.Topics)
+				require.Equal(t, 20, filter.Size)
+				return &common.FilteredEventsApiResponse{
+					Events: []*common.FilteredEventApiResponse{
+						{Identifier: "transfer", TxHash: "txHash"},
+						{Identifier: "transfer", TxHash: "txHash"},
+					},
+					NextCursor: 11,
+				}, nil
+			},
+			GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				require.True(t, withResults)
+				return &transaction.ApiTransactionResult{Hash: hash, Nonce: 4}, nil
+			},
+		}
+
+		query := `{ events(filter: { identifier: "transfer", topics: ["dG9waWM="] }) { nextCursor events { txHash transaction { nonce } } } }`
+		data, response := executeQuery(t, createMockArgsExecutor(), facade, query, nil)
+		require.Empty(t, response.Errors)
+		page := data["events"].(map[string]interface{})
+		require.Equal(t, "11", page["nextCursor"])
+		require.Len(t, page["events"], 2)
+	})
+	t.Run("invalid events page size should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, response := executeQuery(t, createMockArgsExecutor(), &mock.FacadeStub{}, `{ events(filter: { size: 1000 }) { nextCursor } }`, nil)
+		require.Len(t, response.Errors, 1)
+		require.True(t, strings.Contains(response.Errors[0].Message, "invalid page size"))
+	})
+}
@@ -0,0 +1,62 @@
+package graphql
+
+import (
+	"context"
+
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+)
+
+// queryResolver is the root resolver of the schema. It holds no state, the facade and the query budget being read
+// from the context of each request
+type queryResolver struct{}
+
+type blockArgs struct {
+	Nonce *Uint64
+	Hash  *string
+}
+
+type transactionArgs struct {
+	Hash string
+}
+
+type accountArgs struct {
+	Address string
+}
+
+type eventsArgs struct {
+	Filter eventsFilterInput
+}
+
+// Block returns the block with the provided nonce or hash
+func (qr *queryResolver) Block(ctx context.Context, args blockArgs) (*blockResolver, error) {
+	hasNonce := args.Nonce != nil
+	hasHash := args.Hash != nil
+	if hasNonce == hasHash {
+		return nil, newRequestError(apiErrors.ErrGetBlock, ErrInvalidBlockIdentifier)
+	}
+
+	if hasNonce {
+		return fetchBlockByNonce(ctx, uint64(*args.Nonce))
+	}
+
+	return fetchBlockByHash(ctx, *args.Hash)
+}
+
+// Transaction returns the transaction with the provided hash
+func (qr *queryResolver) Transaction(ctx context.Context, args transactionArgs) (*transactionResolver, error) {
+	return fetchTransaction(ctx, args.Hash)
+}
+
+// Account returns the account with the provided address
+func (qr *queryResolver) Account(args accountArgs) (*accountResolver, error) {
+	if len(args.Address) == 0 {
+		return nil, newRequestError(apiErrors.ErrCouldNotGetAccount, apiErrors.ErrEmptyAddress)
+	}
+
+	return newAccountResolver(args.Address), nil
+}
+
+// Events returns a page of the events matching the filter
+func (qr *queryResolver) Events(ctx context.Context, args eventsArgs) (*eventsPageResolver, error) {
+	return fetchEventsPage(ctx, args.Filter)
+}
@@ -0,0 +1,94 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+// The costs consumed from the query budget by each facade call
+const (
+	costAccount               = 1
+	costESDTToken             = 1
+	costTransaction           = 1
+	costBlock                 = 1
+	costBlockWithTransactions = 10
+	costEventsPage            = 10
+)
+
+type requestStateKey struct{}
+
+type fetchResult struct {
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+// requestState holds the facade, the remaining cost and the already fetched entities of a query. The fields of a
+// query are resolved concurrently, so the same entity requested by several fields is fetched and paid for only once
+type requestState struct {
+	facade        FacadeHandler
+	maxCost       uint32
+	mutCost       sync.Mutex
+	remainingCost uint32
+	mutFetched    sync.Mutex
+	fetched       map[string]*fetchResult
+}
+
+func newRequestState(facade FacadeHandler, maxCost uint32) *requestState {
+	return &requestState{
+		facade:        facade,
+		maxCost:       maxCost,
+		remainingCost: maxCost,
+		fetched:       make(map[string]*fetchResult),
+	}
+}
+
+func contextWithRequestState(ctx context.Context, state *requestState) context.Context {
+	return context.WithValue(ctx, requestStateKey{}, state)
+}
+
+func requestStateFromContext(ctx context.Context) *requestState {
+	return ctx.Value(requestStateKey{}).(*requestState)
+}
+
+func (rs *requestState) consume(cost uint32) error {
+	rs.mutCost.Lock()
+	defer rs.mutCost.Unlock()
+
+	if cost > rs.remainingCost {
+		return &resolverError{
+			err:  fmt.Errorf("%w, maximum is %d", ErrQueryCostExceeded, rs.maxCost),
+			code: shared.ReturnCodeRequestError,
+		}
+	}
+
+	rs.remainingCost -= cost
+
+	return nil
+}
+
+// fetchOnce calls the fetch function only for the first request of the provided key, consuming the provided cost.
+// The following requests of the same key receive the stored result
+func (rs *requestState) fetchOnce(key string, cost uint32, fetch func() (interface{}, error)) (interface{}, error) {
+	rs.mutFetched.Lock()
+	result, found := rs.fetched[key]
+	if !found {
+		result = &fetchResult{}
+		rs.fetched[key] = result
+	}
+	rs.mutFetched.Unlock()
+
+	result.once.Do(func() {
+		result.err = rs.consume(cost)
+		if result.err != nil {
+			return
+		}
+
+		result.value, result.err = fetch()
+	})
+
+	return result.value, result.err
+}
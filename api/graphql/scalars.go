@@ -0,0 +1,58 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const uint64ScalarName = "Uint64"
+
+// Uint64 is the custom scalar used for nonces, rounds, shard IDs and gas values. It is serialized as a decimal string,
+// as the JSON numbers are not safe above 2^53, and accepts as input either a decimal string or an integer
+type Uint64 uint64
+
+// ImplementsGraphQLType returns true for the name of the scalar in the schema
+func (u Uint64) ImplementsGraphQLType(name string) bool {
+	return name == uint64ScalarName
+}
+
+// UnmarshalGraphQL decodes the value of an argument or of a variable
+func (u *Uint64) UnmarshalGraphQL(input interface{}) error {
+	switch value := input.(type) {
+	case string:
+		return u.setFromString(value)
+	case json.Number:
+		return u.setFromString(value.String())
+	case int32:
+		if value < 0 {
+			return fmt.Errorf("%w: %d", ErrInvalidUint64, value)
+		}
+		*u = Uint64(value)
+		return nil
+	case float64:
+		if value < 0 || value != float64(uint64(value)) {
+			return fmt.Errorf("%w: %v", ErrInvalidUint64, value)
+		}
+		*u = Uint64(value)
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported type %T", ErrInvalidUint64, input)
+	}
+}
+
+func (u *Uint64) setFromString(value string) error {
+	decoded, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidUint64, value)
+	}
+
+	*u = Uint64(decoded)
+
+	return nil
+}
+
+// MarshalJSON encodes the value as a decimal string
+func (u Uint64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatUint(uint64(u), 10))
+}
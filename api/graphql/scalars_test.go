@@ -0,0 +1,49 @@
+package graphql_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/api/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUint64_UnmarshalGraphQL(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid inputs should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidInputs := []interface{}{"-1", "abc", int32(-1), float64(1.5), float64(-2), true}
+		for _, input := range invalidInputs {
+			value := graphql.Uint64(0)
+			err := value.UnmarshalGraphQL(input)
+			require.True(t, errors.Is(err, graphql.ErrInvalidUint64), "input %v", input)
+		}
+	})
+	t.Run("valid inputs should work", func(t *testing.T) {
+		t.Parallel()
+
+		validInputs := map[interface{}]uint64{
+			"18446744073709551615":    18446744073709551615,
+			json.Number("4294967295"): 4294967295,
+			int32(37):                 37,
+			float64(9007199254740991): 9007199254740991,
+		}
+		for input, expected := range validInputs {
+			value := graphql.Uint64(0)
+			err := value.UnmarshalGraphQL(input)
+			require.Nil(t, err)
+			require.Equal(t, graphql.Uint64(expected), value)
+		}
+	})
+}
+
+func TestUint64_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	buff, err := json.Marshal(graphql.Uint64(18446744073709551615))
+	require.Nil(t, err)
+	require.Equal(t, `"18446744073709551615"`, string(buff))
+}
@@ -0,0 +1,166 @@
+package graphql
+
+// schemaDefinition describes the entities served by the GraphQL endpoint. The binary fields are base64 encoded, as
+// they are in the JSON responses of the REST API
+const schemaDefinition = `
+schema {
+	query: Query
+}
+
+"Unsigned 64 bit integer, serialized as a decimal string. Accepts a decimal string or an integer as input"
+scalar Uint64
+
+type Query {
+	"Returns the block with the provided nonce or hash. Exactly one of the arguments should be provided"
+	block(nonce: Uint64, hash: String): Block
+	"Returns the transaction with the provided hash, together with its results and logs"
+	transaction(hash: String!): Transaction
+	"Returns the account with the provided bech32 address"
+	account(address: String!): Account
+	"Returns a page of the events matching the filter"
+	events(filter: EventsFilter!): EventsPage!
+}
+
+type Block {
+	nonce: Uint64!
+	round: Uint64!
+	epoch: Uint64!
+	shard: Uint64!
+	hash: String!
+	prevBlockHash: String!
+	stateRootHash: String!
+	numTxs: Uint64!
+	timestamp: Uint64!
+	accumulatedFees: String!
+	developerFees: String!
+	status: String!
+	miniBlocks: [MiniBlock!]!
+}
+
+type MiniBlock {
+	hash: String!
+	type: String!
+	processingType: String!
+	sourceShard: Uint64!
+	destinationShard: Uint64!
+	"The transactions of the miniblock, fetched together with their logs"
+	transactions: [Transaction!]!
+}
+
+type Transaction {
+	hash: String!
+	type: String!
+	nonce: Uint64!
+	round: Uint64!
+	epoch: Uint64!
+	value: String!
+	sender: String!
+	receiver: String!
+	senderAccount: Account
+	receiverAccount: Account
+	gasPrice: Uint64!
+	gasLimit: Uint64!
+	gasUsed: Uint64!
+	fee: String!
+	data: String!
+	signature: String!
+	function: String!
+	sourceShard: Uint64!
+	destinationShard: Uint64!
+	miniBlockHash: String!
+	blockNonce: Uint64!
+	blockHash: String!
+	timestamp: Uint64!
+	status: String!
+	smartContractResults: [SmartContractResult!]!
+	logs: Logs
+}
+
+type SmartContractResult {
+	hash: String!
+	nonce: Uint64!
+	value: String!
+	sender: String!
+	receiver: String!
+	senderAccount: Account
+	receiverAccount: Account
+	data: String!
+	prevTxHash: String!
+	originalTxHash: String!
+	returnMessage: String!
+	logs: Logs
+}
+
+type Logs {
+	address: String!
+	account: Account
+	events: [Event!]!
+}
+
+type Event {
+	address: String!
+	account: Account
+	identifier: String!
+	topics: [String!]!
+	data: String!
+}
+
+type Account {
+	address: String!
+	nonce: Uint64!
+	balance: String!
+	username: String!
+	codeHash: String!
+	rootHash: String!
+	ownerAddress: String!
+	developerReward: String!
+	"Returns the ESDT token held by the account. The nonce should be provided for non fungible tokens"
+	esdt(tokenIdentifier: String!, nonce: Uint64): ESDTToken
+}
+
+type ESDTToken {
+	tokenIdentifier: String!
+	type: String!
+	balance: String!
+	nonce: Uint64!
+	properties: String!
+	name: String!
+	creator: String!
+	royalties: String!
+	attributes: String!
+	uris: [String!]!
+}
+
+input EventsFilter {
+	address: String
+	identifier: String
+	"Base64 encoded topics, matched in order"
+	topics: [String!]
+	fromBlock: Uint64
+	toBlock: Uint64
+	cursor: Uint64
+	"Number of events in page, between 1 and 100. Defaults to 20"
+	size: Int
+}
+
+type EventsPage {
+	events: [FilteredEvent!]!
+	nextCursor: Uint64!
+}
+
+type FilteredEvent {
+	address: String!
+	account: Account
+	identifier: String!
+	topics: [String!]!
+	data: String!
+	eventIndex: Uint64!
+	txHash: String!
+	transaction: Transaction
+	epoch: Uint64!
+	round: Uint64!
+	blockNonce: Uint64!
+	blockHash: String!
+	block: Block
+}
+`
@@ -0,0 +1,311 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared/logging"
+)
+
+type transactionResolver struct {
+	tx *transaction.ApiTransactionResult
+}
+
+func fetchTransaction(ctx context.Context, hash string) (*transactionResolver, error) {
+	if len(hash) == 0 {
+		return nil, newRequestError(apiErrors.ErrGetTransaction, apiErrors.ErrValidationEmptyTxHash)
+	}
+
+	state := requestStateFromContext(ctx)
+	value, err := state.fetchOnce("transaction:"+hash, costTransaction, func() (interface{}, error) {
+		start := time.Now()
+		tx, err := state.facade.GetTransaction(hash, true)
+		logging.LogAPIActionDurationIfNeeded(start, "GraphQL query: GetTransaction")
+		if err != nil {
+			return nil, newInternalError(apiErrors.ErrGetTransaction, err)
+		}
+
+		return tx, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &transactionResolver{tx: value.(*transaction.ApiTransactionResult)}, nil
+}
+
+// Hash returns the hash of the transaction
+func (tr *transactionResolver) Hash() string {
+	return tr.tx.Hash
+}
+
+// Type returns the type of the transaction
+func (tr *transactionResolver) Type() string {
+	return tr.tx.Type
+}
+
+// Nonce returns the nonce of the transaction
+func (tr *transactionResolver) Nonce() Uint64 {
+	return Uint64(tr.tx.Nonce)
+}
+
+// Round returns the round of the transaction
+func (tr *transactionResolver) Round() Uint64 {
+	return Uint64(tr.tx.Round)
+}
+
+// Epoch returns the epoch of the transaction
+func (tr *transactionResolver) Epoch() Uint64 {
+	return Uint64(tr.tx.Epoch)
+}
+
+// Value returns the value of the transaction
+func (tr *transactionResolver) Value() string {
+	return tr.tx.Value
+}
+
+// Sender returns the address of the sender
+func (tr *transactionResolver) Sender() string {
+	return tr.tx.Sender
+}
+
+// Receiver returns the address of the receiver
+func (tr *transactionResolver) Receiver() string {
+	return tr.tx.Receiver
+}
+
+// SenderAccount returns the account of the sender
+func (tr *transactionResolver) SenderAccount() *accountResolver {
+	return newAccountResolver(tr.tx.Sender)
+}
+
+// ReceiverAccount returns the account of the receiver
+func (tr *transactionResolver) ReceiverAccount() *accountResolver {
+	return newAccountResolver(tr.tx.Receiver)
+}
+
+// GasPrice returns the gas price of the transaction
+func (tr *transactionResolver) GasPrice() Uint64 {
+	return Uint64(tr.tx.GasPrice)
+}
+
+// GasLimit returns the gas limit of the transaction
+func (tr *transactionResolver) GasLimit() Uint64 {
+	return Uint64(tr.tx.GasLimit)
+}
+
+// GasUsed returns the gas used by the transaction
+func (tr *transactionResolver) GasUsed() Uint64 {
+	return Uint64(tr.tx.GasUsed)
+}
+
+// Fee returns the fee of the transaction
+func (tr *transactionResolver) Fee() string {
+	return tr.tx.Fee
+}
+
+// Data returns the base64 encoded data field of the transaction
+func (tr *transactionResolver) Data() string {
+	return base64.StdEncoding.EncodeToString(tr.tx.Data)
+}
+
+// Signature returns the signature of the transaction
+func (tr *transactionResolver) Signature() string {
+	return tr.tx.Signature
+}
+
+// Function returns the function called by the transaction
+func (tr *transactionResolver) Function() string {
+	return tr.tx.Function
+}
+
+// SourceShard returns the source shard of the transaction
+func (tr *transactionResolver) SourceShard() Uint64 {
+	return Uint64(tr.tx.SourceShard)
+}
+
+// DestinationShard returns the destination shard of the transaction
+func (tr *transactionResolver) DestinationShard() Uint64 {
+	return Uint64(tr.tx.DestinationShard)
+}
+
+// MiniBlockHash returns the hash of the miniblock holding the transaction
+func (tr *transactionResolver) MiniBlockHash() string {
+	return tr.tx.MiniBlockHash
+}
+
+// BlockNonce returns the nonce of the block holding the transaction
+func (tr *transactionResolver) BlockNonce() Uint64 {
+	return Uint64(tr.tx.BlockNonce)
+}
+
+// BlockHash returns the hash of the block holding the transaction
+func (tr *transactionResolver) BlockHash() string {
+	return tr.tx.BlockHash
+}
+
+// Timestamp returns the timestamp of the transaction
+func (tr *transactionResolver) Timestamp() Uint64 {
+	return Uint64(tr.tx.Timestamp)
+}
+
+// Status returns the status of the transaction
+func (tr *transactionResolver) Status() string {
+	return string(tr.tx.Status)
+}
+
+// SmartContractResults returns the results of the transaction
+func (tr *transactionResolver) SmartContractResults() []*smartContractResultResolver {
+	results := make([]*smartContractResultResolver, 0, len(tr.tx.SmartContractResults))
+	for _, scr := range tr.tx.SmartContractResults {
+		results = append(results, &smartContractResultResolver{scr: scr})
+	}
+
+	return results
+}
+
+// Logs returns the logs of the transaction
+func (tr *transactionResolver) Logs() *logsResolver {
+	return newLogsResolver(tr.tx.Logs)
+}
+
+type smartContractResultResolver struct {
+	scr *transaction.ApiSmartContractResult
+}
+
+// Hash returns the hash of the smart contract result
+func (sr *smartContractResultResolver) Hash() string {
+	return sr.scr.Hash
+}
+
+// Nonce returns the nonce of the smart contract result
+func (sr *smartContractResultResolver) Nonce() Uint64 {
+	return Uint64(sr.scr.Nonce)
+}
+
+// Value returns the value of the smart contract result
+func (sr *smartContractResultResolver) Value() string {
+	if sr.scr.Value == nil {
+		return "0"
+	}
+
+	return sr.scr.Value.String()
+}
+
+// Sender returns the address of the sender
+func (sr *smartContractResultResolver) Sender() string {
+	return sr.scr.SndAddr
+}
+
+// Receiver returns the address of the receiver
+func (sr *smartContractResultResolver) Receiver() string {
+	return sr.scr.RcvAddr
+}
+
+// SenderAccount returns the account of the sender
+func (sr *smartContractResultResolver) SenderAccount() *accountResolver {
+	return newAccountResolver(sr.scr.SndAddr)
+}
+
+// ReceiverAccount returns the account of the receiver
+func (sr *smartContractResultResolver) ReceiverAccount() *accountResolver {
+	return newAccountResolver(sr.scr.RcvAddr)
+}
+
+// Data returns the data field of the smart contract result
+func (sr *smartContractResultResolver) Data() string {
+	return sr.scr.Data
+}
+
+// PrevTxHash returns the hash of the transaction which generated the smart contract result
+func (sr *smartContractResultResolver) PrevTxHash() string {
+	return sr.scr.PrevTxHash
+}
+
+// OriginalTxHash returns the hash of the original transaction
+func (sr *smartContractResultResolver) OriginalTxHash() string {
+	return sr.scr.OriginalTxHash
+}
+
+// ReturnMessage returns the return message of the smart contract result
+func (sr *smartContractResultResolver) ReturnMessage() string {
+	return sr.scr.ReturnMessage
+}
+
+// Logs returns the logs of the smart contract result
+func (sr *smartContractResultResolver) Logs() *logsResolver {
+	return newLogsResolver(sr.scr.Logs)
+}
+
+type logsResolver struct {
+	logs *transaction.ApiLogs
+}
+
+func newLogsResolver(logs *transaction.ApiLogs) *logsResolver {
+	if logs == nil {
+		return nil
+	}
+
+	return &logsResolver{logs: logs}
+}
+
+// Address returns the address which generated the logs
+func (lr *logsResolver) Address() string {
+	return lr.logs.Address
+}
+
+// Account returns the account which generated the logs
+func (lr *logsResolver) Account() *accountResolver {
+	return newAccountResolver(lr.logs.Address)
+}
+
+// Events returns the events of the logs
+func (lr *logsResolver) Events() []*eventResolver {
+	events := make([]*eventResolver, 0, len(lr.logs.Events))
+	for _, event := range lr.logs.Events {
+		events = append(events, &eventResolver{event: event})
+	}
+
+	return events
+}
+
+type eventResolver struct {
+	event *transaction.Events
+}
+
+// Address returns the address which generated the event
+func (er *eventResolver) Address() string {
+	return er.event.Address
+}
+
+// Account returns the account which generated the event
+func (er *eventResolver) Account() *accountResolver {
+	return newAccountResolver(er.event.Address)
+}
+
+// Identifier returns the identifier of the event
+func (er *eventResolver) Identifier() string {
+	return er.event.Identifier
+}
+
+// Topics returns the base64 encoded topics of the event
+func (er *eventResolver) Topics() []string {
+	return encodeToBase64(er.event.Topics)
+}
+
+// Data returns the base64 encoded data of the event
+func (er *eventResolver) Data() string {
+	return base64.StdEncoding.EncodeToString(er.event.Data)
+}
+
+func encodeToBase64(values [][]byte) []string {
+	encoded := make([]string, 0, len(values))
+	for _, value := range values {
+		encoded = append(encoded, base64.StdEncoding.EncodeToString(value))
+	}
+
+	return encoded
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 const (
@@ -32,6 +33,7 @@ const (
 	getRegisteredNFTsPath          = "/:address/registered-nfts"
 	getESDTNFTDataPath             = "/:address/nft/:tokenIdentifier/nonce/:nonce"
 	getGuardianData                = "/:address/guardian-data"
+	getTransactionsPath            = "/:address/transactions"
 	urlParamOnFinalBlock           = "onFinalBlock"
 	urlParamOnStartOfEpoch         = "onStartOfEpoch"
 	urlParamBlockNonce             = "blockNonce"
@@ -39,6 +41,11 @@ const (
 	urlParamBlockRootHash          = "blockRootHash"
 	urlParamHintEpoch              = "hintEpoch"
 	urlParamWithKeys               = "withKeys"
+	urlParamCursor                 = "cursor"
+	urlParamSize                   = "size"
+
+	defaultTransactionsPageSize = 20
+	maxTransactionsPageSize     = 100
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
@@ -57,6 +64,7 @@ type addressFacadeHandler interface {
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
 	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
+	GetTransactionsForAddress(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -103,76 +111,167 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Path:    getAccountPath,
 			Method:  http.MethodGet,
 			Handler: ag.getAccount,
+			Documentation: shared.EndpointDocumentation{
+				Summary: "returns the state of an account",
+				QueryParameters: append([]shared.QueryParameter{
+					{Name: urlParamWithKeys, Type: shared.QueryParameterBoolean, Description: "include the key-value pairs of the account"},
+				}, accountQueryParameters...),
+				ResponseData: gin.H{"account": &api.AccountResponse{}, "blockInfo": &api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getAccountsPath,
 			Method:  http.MethodPost,
 			Handler: ag.getAccounts,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the state of multiple accounts",
+				QueryParameters: accountQueryParameters,
+				RequestBody:     []string{},
+				ResponseData:    gin.H{"accounts": map[string]*api.AccountResponse{}, "blockInfo": &api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getBalancePath,
 			Method:  http.MethodGet,
 			Handler: ag.getBalance,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the balance of an account",
+				QueryParameters: accountQueryParameters,
+				ResponseData:    gin.H{"balance": "", "blockInfo": &api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getUsernamePath,
 			Method:  http.MethodGet,
 			Handler: ag.getUsername,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the username of an account",
+				QueryParameters: accountQueryParameters,
+				ResponseData:    gin.H{"username": "", "blockInfo": &api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getCodeHashPath,
 			Method:  http.MethodGet,
 			Handler: ag.getCodeHash,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the code hash of an account",
+				QueryParameters: accountQueryParameters,
+				ResponseData:    gin.H{"codeHash": []byte{}, "blockInfo": &api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getKeyPath,
 			Method:  http.MethodGet,
 			Handler: ag.getValueForKey,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the value stored under a key of an account",
+				QueryParameters: accountQueryParameters,
+				ResponseData:    gin.H{"value": "", "blockInfo": &api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getKeysPath,
 			Method:  http.MethodGet,
 			Handler: ag.getKeyValuePairs,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns all the key-value pairs of an account",
+				QueryParameters: accountQueryParameters,
+				ResponseData:    gin.H{"pairs": map[string]string{}, "blockInfo": &api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTBalancePath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTBalance,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the balance of a fungible token of an account",
+				QueryParameters: accountQueryParameters,
+				ResponseData:    gin.H{"tokenData": &esdtTokenData{}, "blockInfo": &api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTNFTDataPath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTNFTData,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the data of a token nonce held by an account",
+				QueryParameters: accountQueryParameters,
+				ResponseData:    gin.H{"tokenData": &ESDTNFTTokenData{}, "blockInfo": &api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTTokensPath,
 			Method:  http.MethodGet,
 			Handler: ag.getAllESDTData,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns all the tokens held by an account",
+				QueryParameters: accountQueryParameters,
+				ResponseData:    gin.H{"esdts": map[string]*ESDTNFTTokenData{}, "blockInfo": &api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getRegisteredNFTsPath,
 			Method:  http.MethodGet,
 			Handler: ag.getNFTTokenIDsRegisteredByAddress,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the non fungible tokens registered by an account",
+				QueryParameters: accountQueryParameters,
+				ResponseData:    gin.H{"tokens": []string{}, "blockInfo": &api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTTokensWithRolePath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTTokensWithRole,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the tokens for which an account has the provided role",
+				QueryParameters: accountQueryParameters,
+				ResponseData:    gin.H{"tokens": []string{}, "blockInfo": &api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTsRolesPath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTsRoles,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the roles of an account for each token",
+				QueryParameters: accountQueryParameters,
+				ResponseData:    gin.H{"roles": map[string][]string{}, "blockInfo": &api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getGuardianData,
 			Method:  http.MethodGet,
 			Handler: ag.getGuardianData,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the guardian data of an account",
+				QueryParameters: accountQueryParameters,
+				ResponseData:    gin.H{"guardianData": &api.GuardianData{}, "blockInfo": &api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getDataTrieMigrationStatusPath,
 			Method:  http.MethodGet,
 			Handler: ag.isDataTrieMigrated,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns whether the data trie of an account is migrated",
+				QueryParameters: accountQueryParameters,
+				ResponseData:    gin.H{"isMigrated": false},
+			},
+		},
+		{
+			Path:    getTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getTransactions,
+			Documentation: shared.EndpointDocumentation{
+				Summary: "returns a page of the transactions of an account",
+				QueryParameters: []shared.QueryParameter{
+					{Name: urlParamCursor, Type: shared.QueryParameterInteger, Description: "the cursor returned with the previous page"},
+					{Name: urlParamSize, Type: shared.QueryParameterInteger, Description: "the maximum number of transactions of the page"},
+				},
+				ResponseData: gin.H{"transactions": &common.AddressTransactionsApiResponse{}},
+			},
 		},
 	}
 	ag.endpoints = endpoints
@@ -483,6 +582,29 @@ func (ag *addressGroup) isDataTrieMigrated(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"isMigrated": isMigrated})
 }
 
+// getTransactions returns a page of the transactions, smart contract results and rewards touching the given address
+func (ag *addressGroup) getTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetTransactionsForAddress, errors.ErrEmptyAddress)
+		return
+	}
+
+	cursor, size, err := extractTransactionsPageParams(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetTransactionsForAddress, err)
+		return
+	}
+
+	transactions, err := ag.getFacade().GetTransactionsForAddress(addr, cursor, size)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetTransactionsForAddress, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"transactions": transactions})
+}
+
 func buildTokenDataApiResponse(tokenIdentifier string, esdtData *esdt.ESDigitalToken) *ESDTNFTTokenData {
 	tokenData := &ESDTNFTTokenData{
 		TokenIdentifier: tokenIdentifier,
@@ -539,6 +661,26 @@ func extractBaseParams(c *gin.Context) (string, api.AccountQueryOptions, error)
 	return addr, options, nil
 }
 
+func extractTransactionsPageParams(c *gin.Context) (uint64, int, error) {
+	cursor, err := parseUint64UrlParam(c, urlParamCursor)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, urlParamCursor)
+	}
+
+	size, err := parseUint32UrlParam(c, urlParamSize)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, urlParamSize)
+	}
+	if !size.HasValue {
+		return cursor.Value, defaultTransactionsPageSize, nil
+	}
+	if size.Value == 0 || size.Value > maxTransactionsPageSize {
+		return 0, 0, fmt.Errorf("%w, it should be between 1 and %d", errors.ErrInvalidPageSize, maxTransactionsPageSize)
+	}
+
+	return cursor.Value, int(size.Value), nil
+}
+
 func extractGetESDTBalanceParams(c *gin.Context) (string, string, api.AccountQueryOptions, error) {
 	addr, options, err := extractBaseParams(c)
 	if err != nil {
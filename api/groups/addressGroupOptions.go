@@ -7,8 +7,19 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	customErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
 )
 
+// accountQueryParameters documents the URL query parameters parsed by extractAccountQueryOptions
+var accountQueryParameters = []shared.QueryParameter{
+	{Name: urlParamOnFinalBlock, Type: shared.QueryParameterBoolean, Description: "query the state of the final block"},
+	{Name: urlParamOnStartOfEpoch, Type: shared.QueryParameterInteger, Description: "query the state at the start of the provided epoch"},
+	{Name: urlParamBlockNonce, Type: shared.QueryParameterInteger, Description: "query the state of the block with the provided nonce"},
+	{Name: urlParamBlockHash, Type: shared.QueryParameterString, Description: "query the state of the block with the provided hex encoded hash"},
+	{Name: urlParamBlockRootHash, Type: shared.QueryParameterString, Description: "query the state of the provided hex encoded root hash"},
+	{Name: urlParamHintEpoch, Type: shared.QueryParameterInteger, Description: "the epoch of the provided blockRootHash, if known"},
+}
+
 func extractAccountQueryOptions(c *gin.Context) (api.AccountQueryOptions, error) {
 	options, err := parseAccountQueryOptions(c)
 	if err != nil {
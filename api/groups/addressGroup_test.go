@@ -15,10 +15,12 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -36,6 +38,14 @@ type accountResponse struct {
 	} `json:"account"`
 }
 
+type addressTransactionsResponse struct {
+	Data struct {
+		Transactions common.AddressTransactionsApiResponse `json:"transactions"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 type valueForKeyResponseData struct {
 	Value string `json:"value"`
 }
@@ -986,6 +996,87 @@ func TestAddressGroup_getAllESDTData(t *testing.T) {
 	})
 }
 
+func TestAddressGroup_getTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error",
+		testErrorScenario("/address//transactions", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetTransactionsForAddress, apiErrors.ErrEmptyAddress)))
+	t.Run("invalid cursor should error",
+		testErrorScenario("/address/erd1alice/transactions?cursor=not-uint64", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetTransactionsForAddress, apiErrors.ErrBadUrlParams)))
+	t.Run("invalid size should error",
+		testErrorScenario("/address/erd1alice/transactions?size=not-uint32", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetTransactionsForAddress, apiErrors.ErrBadUrlParams)))
+	t.Run("zero size should error",
+		testErrorScenario("/address/erd1alice/transactions?size=0", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetTransactionsForAddress, apiErrors.ErrInvalidPageSize)))
+	t.Run("size too large should error",
+		testErrorScenario("/address/erd1alice/transactions?size=101", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetTransactionsForAddress, apiErrors.ErrInvalidPageSize)))
+	t.Run("with node fail should err", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsForAddressCalled: func(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testAddressGroup(
+			t,
+			facade,
+			"/address/erd1alice/transactions",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrGetTransactionsForAddress, expectedErr),
+		)
+	})
+	t.Run("should use the default size", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsForAddressCalled: func(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error) {
+				assert.Equal(t, "erd1alice", address)
+				assert.Equal(t, uint64(0), cursor)
+				assert.Equal(t, 20, size)
+				return &common.AddressTransactionsApiResponse{}, nil
+			},
+		}
+
+		response := &addressTransactionsResponse{}
+		loadAddressGroupResponse(t, facade, "/address/erd1alice/transactions", "GET", nil, response)
+		assert.Empty(t, response.Data.Transactions.Transactions)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsForAddressCalled: func(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error) {
+				assert.Equal(t, "erd1alice", address)
+				assert.Equal(t, uint64(37), cursor)
+				assert.Equal(t, 2, size)
+				return &common.AddressTransactionsApiResponse{
+					Transactions: []*transaction.ApiTransactionResult{
+						{Hash: "aa"},
+						{Hash: "bb"},
+					},
+					NumTransactions: 40,
+					NextCursor:      35,
+				}, nil
+			},
+		}
+
+		response := &addressTransactionsResponse{}
+		loadAddressGroupResponse(t, facade, "/address/erd1alice/transactions?cursor=37&size=2", "GET", nil, response)
+		require.Equal(t, 2, len(response.Data.Transactions.Transactions))
+		assert.Equal(t, "aa", response.Data.Transactions.Transactions[0].Hash)
+		assert.Equal(t, "bb", response.Data.Transactions.Transactions[1].Hash)
+		assert.Equal(t, uint64(40), response.Data.Transactions.NumTransactions)
+		assert.Equal(t, uint64(35), response.Data.Transactions.NextCursor)
+	})
+}
+
 func TestAddressGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -1151,6 +1242,7 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
 					{Name: "/:address/is-data-trie-migrated", Open: true},
+					{Name: "/:address/transactions", Open: true},
 				},
 			},
 		},
@@ -73,22 +73,24 @@ func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig config.Ap
 	splitPath := strings.Split(basePath, "/")
 	basePath = splitPath[len(splitPath)-1]
 
-	group, ok := apiConfig.APIPackages[basePath]
+	return endpointProperties{
+		isOpen: IsEndpointOpen(basePath, path, apiConfig),
+	}
+}
+
+// IsEndpointOpen returns true if the endpoint with the provided path of the provided group is enabled in the API
+// routes configuration
+func IsEndpointOpen(groupName string, path string, apiConfig config.ApiRoutesConfig) bool {
+	group, ok := apiConfig.APIPackages[groupName]
 	if !ok {
-		return endpointProperties{
-			isOpen: false,
-		}
+		return false
 	}
 
 	for _, route := range group.Routes {
 		if route.Name == path {
-			return endpointProperties{
-				isOpen: route.Open,
-			}
+			return route.Open
 		}
 	}
 
-	return endpointProperties{
-		isOpen: false,
-	}
+	return false
 }
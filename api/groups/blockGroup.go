@@ -28,6 +28,17 @@ const (
 	urlParamWithLogs          = "withLogs"
 )
 
+// blockQueryParameters documents the URL query parameters parsed by parseBlockQueryOptions
+var blockQueryParameters = []shared.QueryParameter{
+	{Name: urlParamWithTxs, Type: shared.QueryParameterBoolean, Description: "include the transactions of the block"},
+	{Name: urlParamWithLogs, Type: shared.QueryParameterBoolean, Description: "include the logs of the transactions, if withTxs is set"},
+}
+
+// alteredAccountsQueryParameters documents the URL query parameters of the altered accounts endpoints
+var alteredAccountsQueryParameters = []shared.QueryParameter{
+	{Name: urlParamTokensFilter, Type: shared.QueryParameterString, Description: "the tokens to include for each account, or * for all of them"},
+}
+
 // blockFacadeHandler defines the methods to be implemented by a facade for handling block requests
 type blockFacadeHandler interface {
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
@@ -59,26 +70,51 @@ func NewBlockGroup(facade blockFacadeHandler) (*blockGroup, error) {
 			Path:    getBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByNonce,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the block with the provided nonce",
+				QueryParameters: blockQueryParameters,
+				ResponseData:    gin.H{"block": &api.Block{}},
+			},
 		},
 		{
 			Path:    getBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByHash,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the block with the provided hash",
+				QueryParameters: blockQueryParameters,
+				ResponseData:    gin.H{"block": &api.Block{}},
+			},
 		},
 		{
 			Path:    getBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByRound,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the block of the provided round",
+				QueryParameters: blockQueryParameters,
+				ResponseData:    gin.H{"block": &api.Block{}},
+			},
 		},
 		{
 			Path:    getAlteredAccountsByNonce,
 			Method:  http.MethodGet,
 			Handler: bg.getAlteredAccountsByNonce,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the accounts altered by the block with the provided nonce",
+				QueryParameters: alteredAccountsQueryParameters,
+				ResponseData:    gin.H{"accounts": []*alteredAccount.AlteredAccount{}},
+			},
 		},
 		{
 			Path:    getAlteredAccountsByHash,
 			Method:  http.MethodGet,
 			Handler: bg.getAlteredAccountsByHash,
+			Documentation: shared.EndpointDocumentation{
+				Summary:         "returns the accounts altered by the block with the provided hash",
+				QueryParameters: alteredAccountsQueryParameters,
+				ResponseData:    gin.H{"accounts": []*alteredAccount.AlteredAccount{}},
+			},
 		},
 	}
 	bg.endpoints = endpoints
@@ -0,0 +1,134 @@
+package groups
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/graphql"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+const (
+	graphQLQueryPath = "/query"
+
+	graphQLMaxRequestInBytes = 1024 * 1024
+)
+
+// graphQLFacadeHandler defines the methods to be implemented by a facade for handling GraphQL queries
+type graphQLFacadeHandler interface {
+	GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetESDTData(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	FilterEvents(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error)
+	IsInterfaceNil() bool
+}
+
+// graphQLExecutor defines the executor used by the group for resolving the queries
+type graphQLExecutor interface {
+	Execute(ctx context.Context, facade graphql.FacadeHandler, request *graphql.Request) *graphql.Response
+	IsInterfaceNil() bool
+}
+
+type graphQLGroup struct {
+	*baseGroup
+	facade    graphQLFacadeHandler
+	mutFacade sync.RWMutex
+	executor  graphQLExecutor
+}
+
+// NewGraphQLGroup returns a new instance of graphQLGroup, resolving GraphQL queries on the facade
+func NewGraphQLGroup(facade graphQLFacadeHandler, graphQLConfig config.ApiGraphQLConfig) (*graphQLGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for graphql group", errors.ErrNilFacadeHandler)
+	}
+
+	executor, err := graphql.NewExecutor(graphql.ArgsExecutor{
+		MaxQueryLengthInBytes: graphQLConfig.MaxQueryLengthInBytes,
+		MaxQueryDepth:         graphQLConfig.MaxQueryDepth,
+		MaxQueryCost:          graphQLConfig.MaxQueryCost,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gg := &graphQLGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+		executor:  executor,
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    graphQLQueryPath,
+			Method:  http.MethodPost,
+			Handler: gg.query,
+			Documentation: shared.EndpointDocumentation{
+				Summary:     "resolves a GraphQL query on blocks, transactions, accounts and events",
+				RequestBody: &graphql.Request{},
+			},
+		},
+	}
+	gg.endpoints = endpoints
+
+	return gg, nil
+}
+
+// query resolves the GraphQL query received in the request body. As the GraphQL protocol requires, the response holds
+// the resolved data next to the errors, with status 200, while malformed requests are answered with status 400
+func (gg *graphQLGroup) query(c *gin.Context) {
+	request := &graphql.Request{}
+	decoder := json.NewDecoder(io.LimitReader(c.Request.Body, graphQLMaxRequestInBytes))
+	decoder.UseNumber()
+	err := decoder.Decode(request)
+	if err != nil {
+		err = fmt.Errorf("%s: %w", errors.ErrInvalidJSONRequest.Error(), err)
+		c.JSON(http.StatusBadRequest, graphql.NewErrorResponse(err))
+		return
+	}
+
+	response := gg.executor.Execute(c.Request.Context(), gg.getFacade(), request)
+	c.JSON(http.StatusOK, response)
+}
+
+func (gg *graphQLGroup) getFacade() graphQLFacadeHandler {
+	gg.mutFacade.RLock()
+	defer gg.mutFacade.RUnlock()
+
+	return gg.facade
+}
+
+// UpdateFacade will update the facade
+func (gg *graphQLGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(graphQLFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	gg.mutFacade.Lock()
+	gg.facade = castFacade
+	gg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gg *graphQLGroup) IsInterfaceNil() bool {
+	return gg == nil
+}
@@ -0,0 +1,156 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/graphql"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type graphQLTestResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func getGraphQLRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"graphql": {
+				Routes: []config.RouteConfig{
+					{Name: "/query", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func getGraphQLConfig() config.ApiGraphQLConfig {
+	return config.ApiGraphQLConfig{
+		MaxQueryLengthInBytes: 4096,
+		MaxQueryDepth:         10,
+		MaxQueryCost:          100,
+	}
+}
+
+func doGraphQLRequest(t *testing.T, gg shared.GroupHandler, body string) (int, graphQLTestResponse) {
+	ws := startWebServer(gg, "graphql", getGraphQLRoutesConfig())
+
+	req, _ := http.NewRequest(http.MethodPost, "/graphql/query", bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := graphQLTestResponse{}
+	loadResponse(resp.Body, &response)
+
+	return resp.Code, response
+}
+
+func TestNewGraphQLGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		gg, err := groups.NewGraphQLGroup(nil, getGraphQLConfig())
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, gg)
+	})
+	t.Run("invalid config", func(t *testing.T) {
+		cfg := getGraphQLConfig()
+		cfg.MaxQueryCost = 0
+		gg, err := groups.NewGraphQLGroup(&mock.FacadeStub{}, cfg)
+		require.Equal(t, graphql.ErrInvalidMaxQueryCost, err)
+		require.Nil(t, gg)
+	})
+	t.Run("should work", func(t *testing.T) {
+		gg, err := groups.NewGraphQLGroup(&mock.FacadeStub{}, getGraphQLConfig())
+		require.NoError(t, err)
+		require.NotNil(t, gg)
+	})
+}
+
+func TestGraphQLGroup_query(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request body should error", func(t *testing.T) {
+		t.Parallel()
+
+		gg, _ := groups.NewGraphQLGroup(&mock.FacadeStub{}, getGraphQLConfig())
+		code, response := doGraphQLRequest(t, gg, "not a json")
+		assert.Equal(t, http.StatusBadRequest, code)
+		require.Len(t, response.Errors, 1)
+		assert.Contains(t, response.Errors[0].Message, apiErrors.ErrInvalidJSONRequest.Error())
+	})
+	t.Run("invalid query should respond with errors", func(t *testing.T) {
+		t.Parallel()
+
+		gg, _ := groups.NewGraphQLGroup(&mock.FacadeStub{}, getGraphQLConfig())
+		code, response := doGraphQLRequest(t, gg, `{"query":"{ block(nonce: 1) { unknownField } }"}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.NotEmpty(t, response.Errors)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				return &api.Block{Nonce: nonce, Hash: "hash"}, nil
+			},
+		}
+		gg, _ := groups.NewGraphQLGroup(facade, getGraphQLConfig())
+		body := `{"query":"query($n: Uint64!) { block(nonce: $n) { nonce hash } }","variables":{"n":12}}`
+		code, response := doGraphQLRequest(t, gg, body)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Empty(t, response.Errors)
+		assert.JSONEq(t, `{"block":{"nonce":"12","hash":"hash"}}`, string(response.Data))
+	})
+}
+
+func TestGraphQLGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		gg, _ := groups.NewGraphQLGroup(&mock.FacadeStub{}, getGraphQLConfig())
+		err := gg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		gg, _ := groups.NewGraphQLGroup(&mock.FacadeStub{}, getGraphQLConfig())
+		err := gg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		gg, _ := groups.NewGraphQLGroup(&mock.FacadeStub{}, getGraphQLConfig())
+		newFacade := &mock.FacadeStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				return &api.Block{Nonce: 42}, nil
+			},
+		}
+		require.Nil(t, gg.UpdateFacade(newFacade))
+
+		_, response := doGraphQLRequest(t, gg, `{"query":"{ block(nonce: 1) { nonce } }"}`)
+		assert.JSONEq(t, `{"block":{"nonce":"42"}}`, string(response.Data))
+	})
+}
+
+func TestGraphQLGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	gg, _ := groups.NewGraphQLGroup(nil, getGraphQLConfig())
+	require.True(t, gg.IsInterfaceNil())
+
+	gg, _ = groups.NewGraphQLGroup(&mock.FacadeStub{}, getGraphQLConfig())
+	require.False(t, gg.IsInterfaceNil())
+}
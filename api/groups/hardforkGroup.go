@@ -46,6 +46,11 @@ func NewHardforkGroup(facade hardforkFacadeHandler) (*hardforkGroup, error) {
 			Path:    triggerPath,
 			Method:  http.MethodPost,
 			Handler: hg.triggerHandler,
+			Documentation: shared.EndpointDocumentation{
+				Summary:      "triggers a hardfork at the provided epoch",
+				RequestBody:  &HardforkRequest{},
+				ResponseData: gin.H{"status": ""},
+			},
 		},
 	}
 	hg.endpoints = endpoints
@@ -1,6 +1,7 @@
 package groups
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -8,12 +9,16 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/api/shared/logging"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/triesStatistics"
 )
 
 const (
@@ -34,6 +39,14 @@ const (
 	getJSONShardBlockByRoundPath          = "/json/shardblock/by-round/:round"
 	getRawMiniBlockByHashPath             = "/raw/miniblock/by-hash/:hash/epoch/:epoch"
 	getJSONMiniBlockByHashPath            = "/json/miniblock/by-hash/:hash/epoch/:epoch"
+	getJSONAccountStatePath               = "/json/account-state/:address"
+	getJSONAccountsStatesPath             = "/json/account-states"
+	getTriesStatisticsPath                = "/trie-statistics"
+	getTriesStatisticsEndpoint            = "/internal/trie-statistics"
+
+	urlParamRootHash             = "rootHash"
+	urlParamTop                  = "top"
+	defaultTriesStatisticsNumTop = 10
 )
 
 // internalBlockFacadeHandler defines the methods to be implemented by a facade for handling block requests
@@ -47,6 +60,10 @@ type internalBlockFacadeHandler interface {
 	GetInternalMiniBlockByHash(format common.ApiOutputFormat, hash string, epoch uint32) (interface{}, error)
 	GetInternalStartOfEpochMetaBlock(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
 	GetInternalStartOfEpochValidatorsInfo(epoch uint32) ([]*state.ShardValidatorInfo, error)
+	GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetAccounts(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error)
+	GetTriesStatistics(rootHash string, numTopAccounts int) (*triesStatistics.Report, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
 
@@ -153,6 +170,31 @@ func NewInternalBlockGroup(facade internalBlockFacadeHandler) (*internalBlockGro
 			Method:  http.MethodGet,
 			Handler: ib.getJSONStartOfEpochValidatorsInfo,
 		},
+		{
+			Path:    getJSONAccountStatePath,
+			Method:  http.MethodGet,
+			Handler: ib.getJSONAccountState,
+		},
+		{
+			Path:    getJSONAccountsStatesPath,
+			Method:  http.MethodPost,
+			Handler: ib.getJSONAccountsStates,
+		},
+		{
+			Path:    getTriesStatisticsPath,
+			Method:  http.MethodGet,
+			Handler: ib.getTriesStatistics,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTriesStatisticsEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+			Documentation: shared.EndpointDocumentation{
+				Summary:      "computes the statistics of the accounts trie and of the data tries, together with the accounts holding the largest data tries",
+				ResponseData: gin.H{"statistics": &triesStatistics.Report{}},
+			},
+		},
 	}
 	ib.endpoints = endpoints
 
@@ -476,6 +518,107 @@ func (ib *internalBlockGroup) getJSONStartOfEpochValidatorsInfo(c *gin.Context)
 	shared.RespondWith(c, http.StatusOK, gin.H{"validators": validatorsInfo}, "", shared.ReturnCodeSuccess)
 }
 
+// getJSONAccountState returns the state of the provided address, in the format accepted by the chain simulator
+func (ib *internalBlockGroup) getJSONAccountState(c *gin.Context) {
+	addr, options, err := extractBaseParams(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrCouldNotGetAccount, err)
+		return
+	}
+
+	options.WithKeys = true
+
+	start := time.Now()
+	accountResponse, blockInfo, err := ib.getFacade().GetAccount(addr, options)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetAccount with keys")
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrCouldNotGetAccount, err)
+		return
+	}
+
+	accountResponse.Address = addr
+	addressState := common.NewAddressStateFromAccountResponse(&accountResponse)
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"state": addressState, "blockInfo": blockInfo}, "", shared.ReturnCodeSuccess)
+}
+
+// getJSONAccountsStates returns the states of the provided addresses, in the format accepted by the chain simulator
+func (ib *internalBlockGroup) getJSONAccountsStates(c *gin.Context) {
+	var addresses []string
+	err := c.ShouldBindJSON(&addresses)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	options, err := extractAccountQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrCouldNotGetAccount, err)
+		return
+	}
+
+	options.WithKeys = true
+
+	start := time.Now()
+	accountsResponse, blockInfo, err := ib.getFacade().GetAccounts(addresses, options)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetAccounts with keys")
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrCouldNotGetAccount, err)
+		return
+	}
+
+	addressesStates := make([]*common.AddressState, 0, len(addresses))
+	for _, addr := range addresses {
+		accountResponse, found := accountsResponse[addr]
+		if !found {
+			accountResponse = newEmptyAccountResponse()
+		}
+
+		accountResponse.Address = addr
+		addressesStates = append(addressesStates, common.NewAddressStateFromAccountResponse(accountResponse))
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"states": addressesStates, "blockInfo": blockInfo}, "", shared.ReturnCodeSuccess)
+}
+
+// getTriesStatistics computes the statistics of the tries of the requested state, the current one by default
+func (ib *internalBlockGroup) getTriesStatistics(c *gin.Context) {
+	rootHash, err := parseHexBytesUrlParam(c, urlParamRootHash)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetTriesStatistics, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, urlParamRootHash))
+		return
+	}
+
+	top, err := parseUint32UrlParam(c, urlParamTop)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetTriesStatistics, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, urlParamTop))
+		return
+	}
+	numTopAccounts := defaultTriesStatisticsNumTop
+	if top.HasValue {
+		numTopAccounts = int(top.Value)
+	}
+
+	start := time.Now()
+	report, err := ib.getFacade().GetTriesStatistics(hex.EncodeToString(rootHash), numTopAccounts)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTriesStatistics")
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetTriesStatistics, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"statistics": report})
+}
+
+// newEmptyAccountResponse returns the response of an account that does not exist, so that the addresses missing from
+// the facade response are still explicitly returned
+func newEmptyAccountResponse() *api.AccountResponse {
+	return &api.AccountResponse{
+		Balance:         "0",
+		DeveloperReward: "0",
+	}
+}
+
 func (ib *internalBlockGroup) getFacade() internalBlockFacadeHandler {
 	ib.mutFacade.RLock()
 	defer ib.mutFacade.RUnlock()
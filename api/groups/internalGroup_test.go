@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/groups"
@@ -17,6 +18,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/triesStatistics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -79,6 +81,30 @@ type internalValidatorsInfoResponse struct {
 	Code  string `json:"code"`
 }
 
+type internalAccountStateResponse struct {
+	Data struct {
+		State *common.AddressState `json:"state"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type internalAccountsStatesResponse struct {
+	Data struct {
+		States []*common.AddressState `json:"states"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type triesStatisticsResponse struct {
+	Data struct {
+		Statistics *triesStatistics.Report `json:"statistics"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 var (
 	expectedRawBlockOutput = bytes.Repeat([]byte("1"), 10)
 	expectedMetaBlock      = block.MetaBlock{
@@ -981,6 +1007,242 @@ func TestInternalBlockGroup_getJSONStartOfEpochValidatorsInfo(t *testing.T) {
 	})
 }
 
+func TestInternalBlockGroup_getJSONAccountState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid query options should error",
+		testInternalGroupErrorScenario("/internal/json/account-state/erd1alice?blockNonce=not-uint", nil,
+			formatExpectedErr(apiErrors.ErrCouldNotGetAccount, apiErrors.ErrBadUrlParams)))
+	t.Run("facade error should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				return api.AccountResponse{}, api.BlockInfo{}, expectedErr
+			},
+		}
+
+		testInternalGroup(
+			t,
+			facade,
+			"/internal/json/account-state/erd1alice",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrCouldNotGetAccount, expectedErr),
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				require.True(t, options.WithKeys)
+				return api.AccountResponse{
+					Nonce:           37,
+					Balance:         "1000",
+					Code:            "0061736d",
+					CodeHash:        []byte("code hash"),
+					CodeMetadata:    []byte{5, 0},
+					DeveloperReward: "10",
+					OwnerAddress:    "erd1bob",
+					Pairs:           map[string]string{"6b6579": "76616c7565"},
+				}, api.BlockInfo{}, nil
+			},
+		}
+
+		response := &internalAccountStateResponse{}
+		loadInternalBlockGroupResponse(
+			t,
+			facade,
+			"/internal/json/account-state/erd1alice",
+			"GET",
+			nil,
+			response,
+		)
+
+		nonce := uint64(37)
+		expectedState := &common.AddressState{
+			Address:          "erd1alice",
+			Nonce:            &nonce,
+			Balance:          "1000",
+			Code:             "0061736d",
+			CodeMetadata:     "BQA=",
+			CodeHash:         "Y29kZSBoYXNo",
+			DeveloperRewards: "10",
+			Owner:            "erd1bob",
+			Pairs:            map[string]string{"6b6579": "76616c7565"},
+		}
+		assert.Equal(t, expectedState, response.Data.State)
+	})
+}
+
+func TestInternalBlockGroup_getJSONAccountsStates(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := &internalAccountsStatesResponse{}
+		loadInternalBlockGroupResponseWithCode(
+			t,
+			&mock.FacadeStub{},
+			"/internal/json/account-states",
+			bytes.NewBuffer([]byte("invalid")),
+			http.StatusBadRequest,
+			response,
+		)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountsCalled: func(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error) {
+				return nil, api.BlockInfo{}, expectedErr
+			},
+		}
+
+		response := &internalAccountsStatesResponse{}
+		loadInternalBlockGroupResponseWithCode(
+			t,
+			facade,
+			"/internal/json/account-states",
+			bytes.NewBuffer([]byte(`["erd1alice"]`)),
+			http.StatusInternalServerError,
+			response,
+		)
+		assert.True(t, strings.Contains(response.Error, formatExpectedErr(apiErrors.ErrCouldNotGetAccount, expectedErr)))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountsCalled: func(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error) {
+				require.True(t, options.WithKeys)
+				return map[string]*api.AccountResponse{
+					"erd1alice": {Balance: "1"},
+					"erd1bob":   {Balance: "2"},
+				}, api.BlockInfo{}, nil
+			},
+		}
+
+		response := &internalAccountsStatesResponse{}
+		loadInternalBlockGroupResponse(
+			t,
+			facade,
+			"/internal/json/account-states",
+			"POST",
+			bytes.NewBuffer([]byte(`["erd1bob", "erd1alice"]`)),
+			response,
+		)
+
+		require.Len(t, response.Data.States, 2)
+		assert.Equal(t, "erd1bob", response.Data.States[0].Address)
+		assert.Equal(t, "2", response.Data.States[0].Balance)
+		assert.Equal(t, "erd1alice", response.Data.States[1].Address)
+		assert.Equal(t, "1", response.Data.States[1].Balance)
+	})
+	t.Run("missing address should be returned as empty account", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountsCalled: func(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error) {
+				return map[string]*api.AccountResponse{
+					"erd1alice": {Balance: "1"},
+				}, api.BlockInfo{}, nil
+			},
+		}
+
+		response := &internalAccountsStatesResponse{}
+		loadInternalBlockGroupResponse(
+			t,
+			facade,
+			"/internal/json/account-states",
+			"POST",
+			bytes.NewBuffer([]byte(`["erd1bob", "erd1alice"]`)),
+			response,
+		)
+
+		require.Len(t, response.Data.States, 2)
+		assert.Equal(t, "erd1bob", response.Data.States[0].Address)
+		assert.Equal(t, "0", response.Data.States[0].Balance)
+		assert.Equal(t, uint64(0), *response.Data.States[0].Nonce)
+		assert.Equal(t, "erd1alice", response.Data.States[1].Address)
+		assert.Equal(t, "1", response.Data.States[1].Balance)
+	})
+}
+
+func TestInternalBlockGroup_getTriesStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error",
+		testInternalGroupErrorScenario("/internal/trie-statistics?rootHash=not hex", nil,
+			formatExpectedErr(apiErrors.ErrGetTriesStatistics, apiErrors.ErrBadUrlParams)))
+	t.Run("invalid top should error",
+		testInternalGroupErrorScenario("/internal/trie-statistics?top=-1", nil,
+			formatExpectedErr(apiErrors.ErrGetTriesStatistics, apiErrors.ErrBadUrlParams)))
+	t.Run("facade error should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTriesStatisticsCalled: func(rootHash string, numTopAccounts int) (*triesStatistics.Report, error) {
+				return nil, expectedErr
+			},
+		}
+
+		testInternalGroup(
+			t,
+			facade,
+			"/internal/trie-statistics",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrGetTriesStatistics, expectedErr),
+		)
+	})
+	t.Run("should work with the default parameters", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTriesStatisticsCalled: func(rootHash string, numTopAccounts int) (*triesStatistics.Report, error) {
+				assert.Empty(t, rootHash)
+				assert.Equal(t, 10, numTopAccounts)
+
+				return &triesStatistics.Report{}, nil
+			},
+		}
+
+		response := &triesStatisticsResponse{}
+		loadInternalBlockGroupResponse(t, facade, "/internal/trie-statistics", "GET", nil, response)
+		assert.Empty(t, response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedReport := &triesStatistics.Report{
+			RootHash:    "aabb",
+			NumAccounts: 2,
+			IsComplete:  true,
+			TriesByType: map[common.TrieType]*triesStatistics.TrieTypeStatistics{
+				common.DataTrie: {NumTries: 1, NumLeaves: 4, OldVersionLeavesFraction: 0.25},
+			},
+			TopAccountsByDataTrieSize: []*triesStatistics.AccountStatistics{{Address: "erd1alice", DataTrieSize: 100}},
+			TopAccountsByNumLeaves:    []*triesStatistics.AccountStatistics{{Address: "erd1alice", NumLeaves: 4}},
+		}
+		facade := &mock.FacadeStub{
+			GetTriesStatisticsCalled: func(rootHash string, numTopAccounts int) (*triesStatistics.Report, error) {
+				assert.Equal(t, "aabb", rootHash)
+				assert.Equal(t, 1, numTopAccounts)
+
+				return expectedReport, nil
+			},
+		}
+
+		response := &triesStatisticsResponse{}
+		loadInternalBlockGroupResponse(t, facade, "/internal/trie-statistics?rootHash=aabb&top=1", "GET", nil, response)
+		assert.Equal(t, expectedReport, response.Data.Statistics)
+	})
+}
+
 func TestInternalBlockGroup_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -1086,6 +1348,28 @@ func loadInternalBlockGroupResponse(
 	loadResponse(resp.Body, destination)
 }
 
+func loadInternalBlockGroupResponseWithCode(
+	t *testing.T,
+	facade shared.FacadeHandler,
+	url string,
+	body io.Reader,
+	expectedRespCode int,
+	destination interface{},
+) {
+	blockGroup, err := groups.NewInternalBlockGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(blockGroup, "internal", getInternalBlockRoutesConfig())
+
+	req, _ := http.NewRequest("POST", url, body)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, expectedRespCode, resp.Code)
+
+	loadResponse(resp.Body, destination)
+}
+
 func testInternalGroupErrorScenario(url string, body io.Reader, expectedErr string) func(t *testing.T) {
 	return func(t *testing.T) {
 		t.Parallel()
@@ -1146,6 +1430,9 @@ func getInternalBlockRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/json/shardblock/by-round/:round", Open: true},
 					{Name: "/json/miniblock/by-hash/:hash/epoch/:epoch", Open: true},
 					{Name: "/json/startofepoch/validators/by-epoch/:epoch", Open: true},
+					{Name: "/json/account-state/:address", Open: true},
+					{Name: "/json/account-states", Open: true},
+					{Name: "/trie-statistics", Open: true},
 				},
 			},
 		},
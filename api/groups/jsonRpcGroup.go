@@ -0,0 +1,518 @@
+package groups
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/jsonrpc"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/api/shared/logging"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+const (
+	jsonRpcHttpPath      = "/http"
+	jsonRpcWebSocketPath = "/ws"
+
+	jsonRpcMaxBatchSize      = 100
+	jsonRpcMaxMessageInBytes = 4 * 1024 * 1024
+
+	webSocketAnyOrigin = "*"
+
+	rpcMethodGetAccount      = "mvx_getAccount"
+	rpcMethodSendTransaction = "mvx_sendTransaction"
+	rpcMethodGetTransaction  = "mvx_getTransaction"
+	rpcMethodGetBlockByNonce = "mvx_getBlockByNonce"
+	rpcMethodGetBlockByHash  = "mvx_getBlockByHash"
+	rpcMethodQueryVm         = "mvx_queryVm"
+
+	// the REST routes whose rate limiting costs are charged for the calls of the equivalent methods
+	rateLimitRouteGetAccount      = "/address" + getAccountPath
+	rateLimitRouteSendTransaction = "/transaction" + sendTransactionPath
+	rateLimitRouteGetTransaction  = "/transaction" + getTransactionPath
+	rateLimitRouteGetBlockByNonce = "/block" + getBlockByNoncePath
+	rateLimitRouteGetBlockByHash  = "/block" + getBlockByHashPath
+	rateLimitRouteQueryVm         = "/vm-values" + queryPath
+)
+
+// jsonRpcFacadeHandler defines the methods to be implemented by a facade for handling JSON-RPC requests
+type jsonRpcFacadeHandler interface {
+	GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
+	ValidateTransaction(tx *transaction.Transaction) error
+	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+// jsonRpcServer defines the JSON-RPC server used by the group
+type jsonRpcServer interface {
+	HandleMessage(message []byte, charger shared.RateLimitCharger) []byte
+	IsInterfaceNil() bool
+}
+
+type jsonRpcGroup struct {
+	*baseGroup
+	facade                        jsonRpcFacadeHandler
+	mutFacade                     sync.RWMutex
+	server                        jsonRpcServer
+	upgrader                      websocket.Upgrader
+	webSocketMaxRequestsPerSecond uint32
+}
+
+type rpcGetAccountParams struct {
+	Address      string  `json:"address"`
+	OnFinalBlock bool    `json:"onFinalBlock"`
+	BlockNonce   *uint64 `json:"blockNonce"`
+	BlockHash    string  `json:"blockHash"`
+	WithKeys     bool    `json:"withKeys"`
+}
+
+type rpcSendTransactionParams struct {
+	Transaction transaction.FrontendTransaction `json:"transaction"`
+}
+
+type rpcGetTransactionParams struct {
+	Hash        string `json:"hash"`
+	WithResults bool   `json:"withResults"`
+}
+
+type rpcGetBlockByNonceParams struct {
+	Nonce    *uint64 `json:"nonce"`
+	WithTxs  bool    `json:"withTxs"`
+	WithLogs bool    `json:"withLogs"`
+}
+
+type rpcGetBlockByHashParams struct {
+	Hash     string `json:"hash"`
+	WithTxs  bool   `json:"withTxs"`
+	WithLogs bool   `json:"withLogs"`
+}
+
+type rpcQueryVmParams struct {
+	Query VMValueRequest `json:"query"`
+}
+
+// NewJsonRpcGroup returns a new instance of jsonRpcGroup, serving JSON-RPC 2.0 requests over HTTP and websocket
+func NewJsonRpcGroup(facade jsonRpcFacadeHandler, jsonRpcConfig config.ApiJsonRpcConfig) (*jsonRpcGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for json-rpc group", errors.ErrNilFacadeHandler)
+	}
+
+	jg := &jsonRpcGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+		upgrader: websocket.Upgrader{
+			CheckOrigin: newWebSocketOriginChecker(jsonRpcConfig.WebSocketAllowedOrigins),
+		},
+		webSocketMaxRequestsPerSecond: jsonRpcConfig.WebSocketMaxRequestsPerSecond,
+	}
+
+	server, err := jsonrpc.NewServer(jsonrpc.ArgsServer{
+		Methods: map[string]jsonrpc.Method{
+			rpcMethodGetAccount: {
+				Handler:        jg.getAccount,
+				RateLimitRoute: rateLimitRouteGetAccount,
+			},
+			rpcMethodSendTransaction: {
+				Handler:        jg.sendTransaction,
+				ThrottlerName:  sendTransactionEndpoint,
+				RateLimitRoute: rateLimitRouteSendTransaction,
+			},
+			rpcMethodGetTransaction: {
+				Handler:        jg.getTransaction,
+				ThrottlerName:  getTransactionEndpoint,
+				RateLimitRoute: rateLimitRouteGetTransaction,
+			},
+			rpcMethodGetBlockByNonce: {
+				Handler:        jg.getBlockByNonce,
+				RateLimitRoute: rateLimitRouteGetBlockByNonce,
+			},
+			rpcMethodGetBlockByHash: {
+				Handler:        jg.getBlockByHash,
+				RateLimitRoute: rateLimitRouteGetBlockByHash,
+			},
+			rpcMethodQueryVm: {
+				Handler:        jg.queryVm,
+				RateLimitRoute: rateLimitRouteQueryVm,
+			},
+		},
+		ThrottlerProvider: jg,
+		MaxBatchSize:      jsonRpcMaxBatchSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	jg.server = server
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    jsonRpcHttpPath,
+			Method:  http.MethodPost,
+			Handler: jg.serveHttp,
+			Documentation: shared.EndpointDocumentation{
+				Summary:     "serves JSON-RPC 2.0 requests, single or batched",
+				RequestBody: &jsonrpc.Request{},
+			},
+		},
+		{
+			Path:    jsonRpcWebSocketPath,
+			Method:  http.MethodGet,
+			Handler: jg.serveWebSocket,
+		},
+	}
+	jg.endpoints = endpoints
+
+	return jg, nil
+}
+
+// serveHttp handles a JSON-RPC message received in the request body. The JSON-RPC errors are reported in the
+// response body, with status 200, as the protocol requires. Each request of a batch is rate limited on its own
+func (jg *jsonRpcGroup) serveHttp(c *gin.Context) {
+	message, err := io.ReadAll(io.LimitReader(c.Request.Body, jsonRpcMaxMessageInBytes))
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrInvalidJSONRequest, err)
+		return
+	}
+
+	response := jg.server.HandleMessage(message, getRateLimitCharger(c))
+	if len(response) == 0 {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/json; charset=utf-8", response)
+}
+
+// serveWebSocket upgrades the connection to a websocket and handles each received text message as a JSON-RPC message.
+// The requests of the messages are charged against the rate limiting bucket of the client that opened the connection,
+// or against the per connection limit if the rate limiting is disabled
+func (jg *jsonRpcGroup) serveWebSocket(c *gin.Context) {
+	charger := getRateLimitCharger(c)
+	if check.IfNil(charger) && jg.webSocketMaxRequestsPerSecond > 0 {
+		charger = &webSocketConnectionCharger{
+			maxRequestsPerSecond: jg.webSocketMaxRequestsPerSecond,
+		}
+	}
+
+	conn, err := jg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("jsonRpcGroup: cannot upgrade connection", "error", err.Error())
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	conn.SetReadLimit(jsonRpcMaxMessageInBytes)
+	for {
+		messageType, message, errRead := conn.ReadMessage()
+		if errRead != nil {
+			log.Trace("jsonRpcGroup: websocket connection closed", "error", errRead.Error())
+			return
+		}
+		if messageType != websocket.TextMessage {
+			continue
+		}
+
+		response := jg.server.HandleMessage(message, charger)
+		if len(response) == 0 {
+			continue
+		}
+
+		errWrite := conn.WriteMessage(websocket.TextMessage, response)
+		if errWrite != nil {
+			log.Debug("jsonRpcGroup: cannot write on websocket connection", "error", errWrite.Error())
+			return
+		}
+	}
+}
+
+func (jg *jsonRpcGroup) getAccount(rawParams json.RawMessage) (interface{}, *jsonrpc.Error) {
+	params := rpcGetAccountParams{}
+	rpcErr := jsonrpc.UnmarshalParams(rawParams, &params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(params.Address) == 0 {
+		return nil, validationError(errors.ErrCouldNotGetAccount, errors.ErrEmptyAddress)
+	}
+
+	blockHash, err := hex.DecodeString(params.BlockHash)
+	if err != nil {
+		return nil, validationError(errors.ErrCouldNotGetAccount, err)
+	}
+
+	options := api.AccountQueryOptions{
+		OnFinalBlock: params.OnFinalBlock,
+		BlockHash:    blockHash,
+		WithKeys:     params.WithKeys,
+	}
+	if params.BlockNonce != nil {
+		options.BlockNonce = core.OptionalUint64{Value: *params.BlockNonce, HasValue: true}
+	}
+	err = checkAccountQueryOptions(options)
+	if err != nil {
+		return nil, validationError(errors.ErrCouldNotGetAccount, err)
+	}
+
+	start := time.Now()
+	accountResponse, blockInfo, err := jg.getFacade().GetAccount(params.Address, options)
+	logging.LogAPIActionDurationIfNeeded(start, "JSON-RPC call: GetAccount")
+	if err != nil {
+		return nil, internalError(errors.ErrCouldNotGetAccount, err)
+	}
+
+	accountResponse.Address = params.Address
+	return gin.H{"account": accountResponse, "blockInfo": blockInfo}, nil
+}
+
+func (jg *jsonRpcGroup) sendTransaction(rawParams json.RawMessage) (interface{}, *jsonrpc.Error) {
+	params := rpcSendTransactionParams{}
+	rpcErr := jsonrpc.UnmarshalParams(rawParams, &params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	facade := jg.getFacade()
+	start := time.Now()
+	tx, txHash, err := facade.CreateTransaction(newArgsCreateTransaction(&params.Transaction))
+	logging.LogAPIActionDurationIfNeeded(start, "JSON-RPC call: CreateTransaction")
+	if err != nil {
+		return nil, validationError(errors.ErrTxGenerationFailed, err)
+	}
+
+	start = time.Now()
+	err = facade.ValidateTransaction(tx)
+	logging.LogAPIActionDurationIfNeeded(start, "JSON-RPC call: ValidateTransaction")
+	if err != nil {
+		return nil, validationError(errors.ErrTxGenerationFailed, err)
+	}
+
+	start = time.Now()
+	_, err = facade.SendBulkTransactions([]*transaction.Transaction{tx})
+	logging.LogAPIActionDurationIfNeeded(start, "JSON-RPC call: SendBulkTransactions")
+	if err != nil {
+		return nil, jsonrpc.NewServerError(err.Error())
+	}
+
+	return gin.H{"txHash": hex.EncodeToString(txHash)}, nil
+}
+
+func (jg *jsonRpcGroup) getTransaction(rawParams json.RawMessage) (interface{}, *jsonrpc.Error) {
+	params := rpcGetTransactionParams{}
+	rpcErr := jsonrpc.UnmarshalParams(rawParams, &params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(params.Hash) == 0 {
+		return nil, validationError(errors.ErrValidation, errors.ErrValidationEmptyTxHash)
+	}
+
+	start := time.Now()
+	tx, err := jg.getFacade().GetTransaction(params.Hash, params.WithResults)
+	logging.LogAPIActionDurationIfNeeded(start, "JSON-RPC call: GetTransaction")
+	if err != nil {
+		return nil, internalError(errors.ErrGetTransaction, err)
+	}
+
+	return gin.H{"transaction": tx}, nil
+}
+
+func (jg *jsonRpcGroup) getBlockByNonce(rawParams json.RawMessage) (interface{}, *jsonrpc.Error) {
+	params := rpcGetBlockByNonceParams{}
+	rpcErr := jsonrpc.UnmarshalParams(rawParams, &params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if params.Nonce == nil {
+		return nil, validationError(errors.ErrGetBlock, errors.ErrInvalidBlockNonce)
+	}
+
+	options := api.BlockQueryOptions{WithTransactions: params.WithTxs, WithLogs: params.WithLogs}
+	start := time.Now()
+	block, err := jg.getFacade().GetBlockByNonce(*params.Nonce, options)
+	logging.LogAPIActionDurationIfNeeded(start, "JSON-RPC call: GetBlockByNonce")
+	if err != nil {
+		return nil, internalError(errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func (jg *jsonRpcGroup) getBlockByHash(rawParams json.RawMessage) (interface{}, *jsonrpc.Error) {
+	params := rpcGetBlockByHashParams{}
+	rpcErr := jsonrpc.UnmarshalParams(rawParams, &params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(params.Hash) == 0 {
+		return nil, validationError(errors.ErrGetBlock, errors.ErrValidationEmptyBlockHash)
+	}
+
+	options := api.BlockQueryOptions{WithTransactions: params.WithTxs, WithLogs: params.WithLogs}
+	start := time.Now()
+	block, err := jg.getFacade().GetBlockByHash(params.Hash, options)
+	logging.LogAPIActionDurationIfNeeded(start, "JSON-RPC call: GetBlockByHash")
+	if err != nil {
+		return nil, internalError(errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func (jg *jsonRpcGroup) queryVm(rawParams json.RawMessage) (interface{}, *jsonrpc.Error) {
+	params := rpcQueryVmParams{}
+	rpcErr := jsonrpc.UnmarshalParams(rawParams, &params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	facade := jg.getFacade()
+	query, err := createSCQuery(facade, &params.Query)
+	if err != nil {
+		return nil, validationError(errors.ErrQueryError, err)
+	}
+
+	start := time.Now()
+	vmOutput, blockInfo, err := facade.ExecuteSCQuery(query)
+	logging.LogAPIActionDurationIfNeeded(start, "JSON-RPC call: ExecuteSCQuery")
+	if err != nil {
+		return nil, validationError(errors.ErrQueryError, err)
+	}
+
+	return gin.H{"data": vmOutput, "blockInfo": blockInfo}, nil
+}
+
+// getRateLimitCharger returns the rate limit charger of the request, or nil if the rate limiting is disabled
+func getRateLimitCharger(c *gin.Context) shared.RateLimitCharger {
+	value, exists := c.Get(shared.RateLimitChargerContextKey)
+	if !exists {
+		return nil
+	}
+
+	charger, _ := value.(shared.RateLimitCharger)
+	return charger
+}
+
+// newWebSocketOriginChecker returns the function checking the origin of the websocket upgrade requests. The requests
+// without an Origin header do not come from browsers, so they are always allowed
+func newWebSocketOriginChecker(allowedOrigins []string) func(r *http.Request) bool {
+	if len(allowedOrigins) == 0 {
+		// the upgrader will only allow the requests from the same origin
+		return nil
+	}
+
+	allowed := make(map[string]struct{}, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		if origin == webSocketAnyOrigin {
+			return func(_ *http.Request) bool {
+				return true
+			}
+		}
+
+		allowed[strings.ToLower(origin)] = struct{}{}
+	}
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if len(origin) == 0 {
+			return true
+		}
+
+		_, found := allowed[strings.ToLower(origin)]
+		return found
+	}
+}
+
+// webSocketConnectionCharger limits the number of requests a websocket connection can send each second, when the
+// rate limiting is disabled. It is not concurrent safe, being used only by the connection handler
+type webSocketConnectionCharger struct {
+	maxRequestsPerSecond uint32
+	windowStart          time.Time
+	numRequests          uint32
+}
+
+// Charge counts the request in the current second, returning ErrTooManyRequests if the limit was reached
+func (charger *webSocketConnectionCharger) Charge(_ string) error {
+	now := time.Now()
+	if now.Sub(charger.windowStart) >= time.Second {
+		charger.windowStart = now
+		charger.numRequests = 0
+	}
+
+	if charger.numRequests >= charger.maxRequestsPerSecond {
+		return fmt.Errorf("%w for websocket connection", errors.ErrTooManyRequests)
+	}
+	charger.numRequests++
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (charger *webSocketConnectionCharger) IsInterfaceNil() bool {
+	return charger == nil
+}
+
+func validationError(err error, innerErr error) *jsonrpc.Error {
+	return jsonrpc.NewInvalidParamsError(fmt.Sprintf("%s: %s", err.Error(), innerErr.Error()))
+}
+
+func internalError(err error, innerErr error) *jsonrpc.Error {
+	return jsonrpc.NewServerError(fmt.Sprintf("%s: %s", err.Error(), innerErr.Error()))
+}
+
+// GetThrottlerForEndpoint returns the endpoint throttler of the current facade, shared with the REST routes
+func (jg *jsonRpcGroup) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
+	return jg.getFacade().GetThrottlerForEndpoint(endpoint)
+}
+
+func (jg *jsonRpcGroup) getFacade() jsonRpcFacadeHandler {
+	jg.mutFacade.RLock()
+	defer jg.mutFacade.RUnlock()
+
+	return jg.facade
+}
+
+// UpdateFacade will update the facade
+func (jg *jsonRpcGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(jsonRpcFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	jg.mutFacade.Lock()
+	jg.facade = castFacade
+	jg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jg *jsonRpcGroup) IsInterfaceNil() bool {
+	return jg == nil
+}
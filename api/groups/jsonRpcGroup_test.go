@@ -0,0 +1,599 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/jsonrpc"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type jsonRpcTestResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *jsonrpc.Error  `json:"error"`
+}
+
+func getJsonRpcRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"jsonrpc": {
+				Routes: []config.RouteConfig{
+					{Name: "/http", Open: true},
+					{Name: "/ws", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func doJsonRpcRequest(t *testing.T, facade *mock.FacadeStub, message string) *httptest.ResponseRecorder {
+	jg, err := groups.NewJsonRpcGroup(facade, config.ApiJsonRpcConfig{})
+	require.Nil(t, err)
+	ws := startWebServer(jg, "jsonrpc", getJsonRpcRoutesConfig())
+
+	req, _ := http.NewRequest(http.MethodPost, "/jsonrpc/http", bytes.NewBufferString(message))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func doSingleJsonRpcRequest(t *testing.T, facade *mock.FacadeStub, message string) jsonRpcTestResponse {
+	resp := doJsonRpcRequest(t, facade, message)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	response := jsonRpcTestResponse{}
+	loadResponse(resp.Body, &response)
+
+	return response
+}
+
+func TestNewJsonRpcGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		jg, err := groups.NewJsonRpcGroup(nil, config.ApiJsonRpcConfig{})
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, jg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		jg, err := groups.NewJsonRpcGroup(&mock.FacadeStub{}, config.ApiJsonRpcConfig{})
+		require.NoError(t, err)
+		require.NotNil(t, jg)
+	})
+}
+
+func TestJsonRpcGroup_serveHttp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("notification should respond with no content", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doJsonRpcRequest(t, &mock.FacadeStub{}, `{"jsonrpc":"2.0","method":"mvx_getBlockByNonce","params":[1]}`)
+		assert.Equal(t, http.StatusNoContent, resp.Code)
+		assert.Empty(t, resp.Body.Bytes())
+	})
+	t.Run("batch should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				return &api.Block{Nonce: nonce}, nil
+			},
+		}
+		message := `[
+			{"jsonrpc":"2.0","id":1,"method":"mvx_getBlockByNonce","params":[1]},
+			{"jsonrpc":"2.0","id":2,"method":"mvx_getBlockByNonce","params":{"nonce":2}},
+			{"jsonrpc":"2.0","id":3,"method":"mvx_unknown"}
+		]`
+		resp := doJsonRpcRequest(t, facade, message)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		responses := make([]jsonRpcTestResponse, 0)
+		loadResponse(resp.Body, &responses)
+		require.Len(t, responses, 3)
+		assert.Contains(t, string(responses[0].Result), `"nonce":1`)
+		assert.Contains(t, string(responses[1].Result), `"nonce":2`)
+		assert.Equal(t, jsonrpc.CodeMethodNotFound, responses[2].Error.Code)
+	})
+}
+
+func TestJsonRpcGroup_getAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, &mock.FacadeStub{}, `{"jsonrpc":"2.0","id":1,"method":"mvx_getAccount","params":{}}`)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, jsonrpc.CodeInvalidParams, response.Error.Code)
+		assert.Contains(t, response.Error.Message, apiErrors.ErrEmptyAddress.Error())
+	})
+	t.Run("incompatible options should error", func(t *testing.T) {
+		t.Parallel()
+
+		message := `{"jsonrpc":"2.0","id":1,"method":"mvx_getAccount","params":{"address":"erd1","onFinalBlock":true,"blockNonce":3}}`
+		response := doSingleJsonRpcRequest(t, &mock.FacadeStub{}, message)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, jsonrpc.CodeInvalidParams, response.Error.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				return api.AccountResponse{}, api.BlockInfo{}, errors.New("expected error")
+			},
+		}
+		response := doSingleJsonRpcRequest(t, facade, `{"jsonrpc":"2.0","id":1,"method":"mvx_getAccount","params":["erd1"]}`)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, jsonrpc.CodeServerError, response.Error.Code)
+		assert.Equal(t, "internal_issue", response.Error.Data)
+		assert.Contains(t, response.Error.Message, "expected error")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				assert.Equal(t, core.OptionalUint64{Value: 5, HasValue: true}, options.BlockNonce)
+				assert.True(t, options.WithKeys)
+				return api.AccountResponse{Balance: "100"}, api.BlockInfo{Nonce: 5}, nil
+			},
+		}
+		message := `{"jsonrpc":"2.0","id":"x","method":"mvx_getAccount","params":{"address":"erd1","blockNonce":5,"withKeys":true}}`
+		response := doSingleJsonRpcRequest(t, facade, message)
+		require.Nil(t, response.Error)
+		assert.Equal(t, `"x"`, string(response.ID))
+
+		result := struct {
+			Account   api.AccountResponse `json:"account"`
+			BlockInfo api.BlockInfo       `json:"blockInfo"`
+		}{}
+		require.Nil(t, json.Unmarshal(response.Result, &result))
+		assert.Equal(t, "erd1", result.Account.Address)
+		assert.Equal(t, "100", result.Account.Balance)
+		assert.Equal(t, uint64(5), result.BlockInfo.Nonce)
+	})
+}
+
+func TestJsonRpcGroup_sendTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create transaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error) {
+				return nil, nil, errors.New("expected error")
+			},
+		}
+		response := doSingleJsonRpcRequest(t, facade, `{"jsonrpc":"2.0","id":1,"method":"mvx_sendTransaction","params":[{"nonce":1}]}`)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, jsonrpc.CodeInvalidParams, response.Error.Code)
+		assert.Contains(t, response.Error.Message, apiErrors.ErrTxGenerationFailed.Error())
+	})
+	t.Run("throttled endpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				assert.Equal(t, "/transaction/send", endpoint)
+				return &mock.ThrottlerStub{
+					CanProcessCalled: func() bool {
+						return false
+					},
+				}, true
+			},
+		}
+		response := doSingleJsonRpcRequest(t, facade, `{"jsonrpc":"2.0","id":1,"method":"mvx_sendTransaction","params":[{"nonce":1}]}`)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, jsonrpc.CodeLimitExceeded, response.Error.Code)
+		assert.Equal(t, "system_busy", response.Error.Data)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sentTxs := 0
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error) {
+				assert.Equal(t, uint64(7), txArgs.Nonce)
+				assert.Equal(t, "erd1receiver", txArgs.Receiver)
+				return &transaction.Transaction{Nonce: txArgs.Nonce}, []byte("hash"), nil
+			},
+			SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+				sentTxs += len(txs)
+				return uint64(len(txs)), nil
+			},
+		}
+		message := `{"jsonrpc":"2.0","id":1,"method":"mvx_sendTransaction","params":{"transaction":{"nonce":7,"receiver":"erd1receiver"}}}`
+		response := doSingleJsonRpcRequest(t, facade, message)
+		require.Nil(t, response.Error)
+		assert.JSONEq(t, `{"txHash":"68617368"}`, string(response.Result))
+		assert.Equal(t, 1, sentTxs)
+	})
+}
+
+func TestJsonRpcGroup_getTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, &mock.FacadeStub{}, `{"jsonrpc":"2.0","id":1,"method":"mvx_getTransaction","params":[""]}`)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, jsonrpc.CodeInvalidParams, response.Error.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				assert.True(t, withResults)
+				return &transaction.ApiTransactionResult{Hash: hash}, nil
+			},
+		}
+		response := doSingleJsonRpcRequest(t, facade, `{"jsonrpc":"2.0","id":1,"method":"mvx_getTransaction","params":["aabb", true]}`)
+		require.Nil(t, response.Error)
+		assert.Contains(t, string(response.Result), `"hash":"aabb"`)
+	})
+}
+
+func TestJsonRpcGroup_getBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, &mock.FacadeStub{}, `{"jsonrpc":"2.0","id":1,"method":"mvx_getBlockByNonce","params":{"withTxs":true}}`)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, jsonrpc.CodeInvalidParams, response.Error.Code)
+	})
+	t.Run("block by hash facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByHashCalled: func(hash string, options api.BlockQueryOptions) (*api.Block, error) {
+				return nil, errors.New("expected error")
+			},
+		}
+		response := doSingleJsonRpcRequest(t, facade, `{"jsonrpc":"2.0","id":1,"method":"mvx_getBlockByHash","params":["aa"]}`)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, jsonrpc.CodeServerError, response.Error.Code)
+	})
+	t.Run("block by hash should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByHashCalled: func(hash string, options api.BlockQueryOptions) (*api.Block, error) {
+				assert.True(t, options.WithLogs)
+				return &api.Block{Hash: hash}, nil
+			},
+		}
+		response := doSingleJsonRpcRequest(t, facade, `{"jsonrpc":"2.0","id":1,"method":"mvx_getBlockByHash","params":{"hash":"aa","withLogs":true}}`)
+		require.Nil(t, response.Error)
+		assert.Contains(t, string(response.Result), `"hash":"aa"`)
+	})
+}
+
+func TestJsonRpcGroup_queryVm(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid argument should error", func(t *testing.T) {
+		t.Parallel()
+
+		message := `{"jsonrpc":"2.0","id":1,"method":"mvx_queryVm","params":[{"scAddress":"aa","funcName":"get","args":["zz"]}]}`
+		response := doSingleJsonRpcRequest(t, &mock.FacadeStub{}, message)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, jsonrpc.CodeInvalidParams, response.Error.Code)
+		assert.Equal(t, "bad_request", response.Error.Data)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+				assert.Equal(t, []byte{0xaa}, query.ScAddress)
+				assert.Equal(t, "get", query.FuncName)
+				assert.Equal(t, [][]byte{{0x01}}, query.Arguments)
+				return &vm.VMOutputApi{ReturnCode: "ok"}, api.BlockInfo{Nonce: 3}, nil
+			},
+		}
+		message := `{"jsonrpc":"2.0","id":1,"method":"mvx_queryVm","params":{"query":{"scAddress":"aa","funcName":"get","args":["01"]}}}`
+		response := doSingleJsonRpcRequest(t, facade, message)
+		require.Nil(t, response.Error)
+		assert.Contains(t, string(response.Result), `"returnCode":"ok"`)
+		assert.Contains(t, string(response.Result), `"nonce":3`)
+	})
+}
+
+func TestJsonRpcGroup_serveWebSocket(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+			return &api.Block{Nonce: nonce}, nil
+		},
+	}
+	jg, _ := groups.NewJsonRpcGroup(facade, config.ApiJsonRpcConfig{})
+	ws := startWebServer(jg, "jsonrpc", getJsonRpcRoutesConfig())
+	server := httptest.NewServer(ws)
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/jsonrpc/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Nil(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// the notification is not answered, so the first response belongs to the second request
+	require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","method":"mvx_getBlockByNonce","params":[1]}`)))
+	require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":2,"method":"mvx_getBlockByNonce","params":[2]}`)))
+
+	response := jsonRpcTestResponse{}
+	require.Nil(t, conn.ReadJSON(&response))
+	assert.Equal(t, "2", string(response.ID))
+	assert.Contains(t, string(response.Result), `"nonce":2`)
+}
+
+func startJsonRpcWebSocketServer(t *testing.T, facade *mock.FacadeStub, jsonRpcConfig config.ApiJsonRpcConfig) (*httptest.Server, string) {
+	jg, err := groups.NewJsonRpcGroup(facade, jsonRpcConfig)
+	require.Nil(t, err)
+	server := httptest.NewServer(startWebServer(jg, "jsonrpc", getJsonRpcRoutesConfig()))
+
+	return server, "ws" + strings.TrimPrefix(server.URL, "http") + "/jsonrpc/ws"
+}
+
+func TestJsonRpcGroup_serveWebSocketShouldCheckTheOrigin(t *testing.T) {
+	t.Parallel()
+
+	dialWithOrigin := func(url string, origin string) error {
+		header := http.Header{}
+		if len(origin) > 0 {
+			header.Set("Origin", origin)
+		}
+
+		conn, _, err := websocket.DefaultDialer.Dial(url, header)
+		if err == nil {
+			_ = conn.Close()
+		}
+
+		return err
+	}
+
+	t.Run("no allowed origins should only allow the same origin", func(t *testing.T) {
+		t.Parallel()
+
+		server, url := startJsonRpcWebSocketServer(t, &mock.FacadeStub{}, config.ApiJsonRpcConfig{})
+		defer server.Close()
+
+		assert.Nil(t, dialWithOrigin(url, ""))
+		assert.Nil(t, dialWithOrigin(url, server.URL))
+		assert.NotNil(t, dialWithOrigin(url, "https://other.com"))
+	})
+	t.Run("allowed origins should only allow the configured ones", func(t *testing.T) {
+		t.Parallel()
+
+		server, url := startJsonRpcWebSocketServer(t, &mock.FacadeStub{}, config.ApiJsonRpcConfig{
+			WebSocketAllowedOrigins: []string{"https://Allowed.com"},
+		})
+		defer server.Close()
+
+		assert.Nil(t, dialWithOrigin(url, ""))
+		assert.Nil(t, dialWithOrigin(url, "https://allowed.com"))
+		assert.NotNil(t, dialWithOrigin(url, server.URL))
+		assert.NotNil(t, dialWithOrigin(url, "https://other.com"))
+	})
+	t.Run("any origin should allow all", func(t *testing.T) {
+		t.Parallel()
+
+		server, url := startJsonRpcWebSocketServer(t, &mock.FacadeStub{}, config.ApiJsonRpcConfig{
+			WebSocketAllowedOrigins: []string{"https://allowed.com", "*"},
+		})
+		defer server.Close()
+
+		assert.Nil(t, dialWithOrigin(url, "https://other.com"))
+	})
+}
+
+func TestJsonRpcGroup_serveWebSocketShouldLimitTheRequestsOfEachConnection(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+			return &api.Block{Nonce: nonce}, nil
+		},
+	}
+	server, url := startJsonRpcWebSocketServer(t, facade, config.ApiJsonRpcConfig{
+		WebSocketMaxRequestsPerSecond: 2,
+	})
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		require.Nil(t, err)
+
+		message := `[
+			{"jsonrpc":"2.0","id":1,"method":"mvx_getBlockByNonce","params":[1]},
+			{"jsonrpc":"2.0","id":2,"method":"mvx_getBlockByNonce","params":[2]},
+			{"jsonrpc":"2.0","id":3,"method":"mvx_getBlockByNonce","params":[3]}
+		]`
+		require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(message)))
+
+		// each connection has its own limit
+		responses := make([]jsonRpcTestResponse, 0)
+		require.Nil(t, conn.ReadJSON(&responses))
+		require.Len(t, responses, 3)
+		assert.Nil(t, responses[0].Error)
+		assert.Nil(t, responses[1].Error)
+		require.NotNil(t, responses[2].Error)
+		assert.Equal(t, jsonrpc.CodeLimitExceeded, responses[2].Error.Code)
+
+		_ = conn.Close()
+	}
+}
+
+type jsonRpcChargerStub struct {
+	mut           sync.Mutex
+	chargedRoutes []string
+	maxCharges    int
+}
+
+func (stub *jsonRpcChargerStub) Charge(route string) error {
+	stub.mut.Lock()
+	defer stub.mut.Unlock()
+
+	if len(stub.chargedRoutes) >= stub.maxCharges {
+		return errors.New("too many requests")
+	}
+
+	stub.chargedRoutes = append(stub.chargedRoutes, route)
+	return nil
+}
+
+func (stub *jsonRpcChargerStub) getChargedRoutes() []string {
+	stub.mut.Lock()
+	defer stub.mut.Unlock()
+
+	return append([]string{}, stub.chargedRoutes...)
+}
+
+func (stub *jsonRpcChargerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func startJsonRpcWebServerWithCharger(t *testing.T, facade *mock.FacadeStub, charger shared.RateLimitCharger) *gin.Engine {
+	jg, err := groups.NewJsonRpcGroup(facade, config.ApiJsonRpcConfig{})
+	require.Nil(t, err)
+
+	ws := gin.New()
+	ws.Use(func(c *gin.Context) {
+		c.Set(shared.RateLimitChargerContextKey, charger)
+	})
+	jg.RegisterRoutes(ws.Group("jsonrpc"), getJsonRpcRoutesConfig())
+
+	return ws
+}
+
+func TestJsonRpcGroup_ShouldChargeEachCall(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+			return &api.Block{Nonce: nonce}, nil
+		},
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+			return &vm.VMOutputApi{ReturnCode: "ok"}, api.BlockInfo{}, nil
+		},
+	}
+
+	t.Run("each request of a batch should be charged", func(t *testing.T) {
+		t.Parallel()
+
+		charger := &jsonRpcChargerStub{maxCharges: 2}
+		ws := startJsonRpcWebServerWithCharger(t, facade, charger)
+
+		message := `[
+			{"jsonrpc":"2.0","id":1,"method":"mvx_queryVm","params":{"query":{"scAddress":"aa","funcName":"get"}}},
+			{"jsonrpc":"2.0","id":2,"method":"mvx_getBlockByNonce","params":[1]},
+			{"jsonrpc":"2.0","id":3,"method":"mvx_getBlockByNonce","params":[2]}
+		]`
+		req, _ := http.NewRequest(http.MethodPost, "/jsonrpc/http", bytes.NewBufferString(message))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		responses := make([]jsonRpcTestResponse, 0)
+		loadResponse(resp.Body, &responses)
+		require.Len(t, responses, 3)
+		assert.Nil(t, responses[0].Error)
+		assert.Nil(t, responses[1].Error)
+		require.NotNil(t, responses[2].Error)
+		assert.Equal(t, jsonrpc.CodeLimitExceeded, responses[2].Error.Code)
+		assert.Equal(t, []string{"/vm-values/query", "/block/by-nonce/:nonce"}, charger.getChargedRoutes())
+	})
+	t.Run("each websocket message should be charged", func(t *testing.T) {
+		t.Parallel()
+
+		charger := &jsonRpcChargerStub{maxCharges: 1}
+		server := httptest.NewServer(startJsonRpcWebServerWithCharger(t, facade, charger))
+		defer server.Close()
+
+		url := "ws" + strings.TrimPrefix(server.URL, "http") + "/jsonrpc/ws"
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		require.Nil(t, err)
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		for nonce := 1; nonce <= 2; nonce++ {
+			message := fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"mvx_getBlockByNonce","params":[%d]}`, nonce, nonce)
+			require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(message)))
+		}
+
+		response := jsonRpcTestResponse{}
+		require.Nil(t, conn.ReadJSON(&response))
+		assert.Nil(t, response.Error)
+		response = jsonRpcTestResponse{}
+		require.Nil(t, conn.ReadJSON(&response))
+		require.NotNil(t, response.Error)
+		assert.Equal(t, jsonrpc.CodeLimitExceeded, response.Error.Code)
+		assert.Equal(t, []string{"/block/by-nonce/:nonce"}, charger.getChargedRoutes())
+	})
+}
+
+func TestJsonRpcGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		jg, _ := groups.NewJsonRpcGroup(&mock.FacadeStub{}, config.ApiJsonRpcConfig{})
+		err := jg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		jg, _ := groups.NewJsonRpcGroup(&mock.FacadeStub{}, config.ApiJsonRpcConfig{})
+		err := jg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		jg, _ := groups.NewJsonRpcGroup(&mock.FacadeStub{}, config.ApiJsonRpcConfig{})
+		newFacade := &mock.FacadeStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				return &api.Block{Nonce: 42}, nil
+			},
+		}
+		require.Nil(t, jg.UpdateFacade(newFacade))
+
+		ws := startWebServer(jg, "jsonrpc", getJsonRpcRoutesConfig())
+		req, _ := http.NewRequest(http.MethodPost, "/jsonrpc/http", bytes.NewBufferString(`{"jsonrpc":"2.0","id":1,"method":"mvx_getBlockByNonce","params":[1]}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Contains(t, resp.Body.String(), `"nonce":42`)
+	})
+}
@@ -0,0 +1,111 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	filterLogsPath = "/filter"
+
+	defaultEventsPageSize = 20
+	maxEventsPageSize     = 100
+)
+
+// logsFacadeHandler defines the methods to be implemented by a facade for handling logs requests
+type logsFacadeHandler interface {
+	FilterEvents(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error)
+	IsInterfaceNil() bool
+}
+
+type logsGroup struct {
+	*baseGroup
+	facade    logsFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewLogsGroup returns a new instance of logsGroup
+func NewLogsGroup(facade logsFacadeHandler) (*logsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for logs group", errors.ErrNilFacadeHandler)
+	}
+
+	lg := &logsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    filterLogsPath,
+			Method:  http.MethodPost,
+			Handler: lg.filterEvents,
+		},
+	}
+	lg.endpoints = endpoints
+
+	return lg, nil
+}
+
+// filterEvents will receive a filter from the client and will return a page of the events matching it
+func (lg *logsGroup) filterEvents(c *gin.Context) {
+	filter := &common.EventsFilterApiRequest{}
+	err := c.ShouldBindJSON(filter)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	if filter.Size == 0 {
+		filter.Size = defaultEventsPageSize
+	}
+	if filter.Size < 0 || filter.Size > maxEventsPageSize {
+		err = fmt.Errorf("%w, it should be between 1 and %d", errors.ErrInvalidPageSize, maxEventsPageSize)
+		shared.RespondWithValidationError(c, errors.ErrFilterEvents, err)
+		return
+	}
+
+	response, err := lg.getFacade().FilterEvents(filter)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrFilterEvents, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"events": response.Events, "nextCursor": response.NextCursor})
+}
+
+func (lg *logsGroup) getFacade() logsFacadeHandler {
+	lg.mutFacade.RLock()
+	defer lg.mutFacade.RUnlock()
+
+	return lg.facade
+}
+
+// UpdateFacade will update the facade
+func (lg *logsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(logsFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	lg.mutFacade.Lock()
+	lg.facade = castFacade
+	lg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lg *logsGroup) IsInterfaceNil() bool {
+	return lg == nil
+}
@@ -0,0 +1,211 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type filteredEventsResponseData struct {
+	Events     []*common.FilteredEventApiResponse `json:"events"`
+	NextCursor uint64                             `json:"nextCursor"`
+}
+
+type filteredEventsResponse struct {
+	Data  filteredEventsResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
+func TestNewLogsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		lg, err := groups.NewLogsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, lg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		lg, err := groups.NewLogsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, lg)
+	})
+}
+
+func TestLogsGroup_filterEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("bad request should error", func(t *testing.T) {
+		t.Parallel()
+
+		lg, _ := groups.NewLogsGroup(&mock.FacadeStub{})
+		ws := startWebServer(lg, "logs", getLogsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/logs/filter", bytes.NewBuffer([]byte("invalid bytes")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("invalid size should error", func(t *testing.T) {
+		t.Parallel()
+
+		lg, _ := groups.NewLogsGroup(&mock.FacadeStub{})
+		ws := startWebServer(lg, "logs", getLogsRoutesConfig())
+
+		filterBytes, _ := json.Marshal(&common.EventsFilterApiRequest{Identifier: "swap", Size: 101})
+		req, _ := http.NewRequest("POST", "/logs/filter", bytes.NewBuffer(filterBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrFilterEvents.Error()))
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidPageSize.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			FilterEventsCalled: func(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		lg, _ := groups.NewLogsGroup(facade)
+		ws := startWebServer(lg, "logs", getLogsRoutesConfig())
+
+		filterBytes, _ := json.Marshal(&common.EventsFilterApiRequest{Identifier: "swap"})
+		req, _ := http.NewRequest("POST", "/logs/filter", bytes.NewBuffer(filterBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrFilterEvents.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedEvents := []*common.FilteredEventApiResponse{
+			{
+				Address:    "erd1pair",
+				Identifier: "swap",
+				Topics:     [][]byte{[]byte("WEGLD"), []byte("alice")},
+				TxHash:     "aa",
+				BlockNonce: 5,
+				BlockHash:  "bb",
+			},
+		}
+		facade := &mock.FacadeStub{
+			FilterEventsCalled: func(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error) {
+				assert.Equal(t, &common.EventsFilterApiRequest{
+					Address:   "erd1pair",
+					Topics:    [][]byte{nil, []byte("alice")},
+					FromBlock: 3,
+					ToBlock:   7,
+					Cursor:    2,
+					Size:      20,
+				}, filter)
+
+				return &common.FilteredEventsApiResponse{
+					Events:     expectedEvents,
+					NextCursor: 9,
+				}, nil
+			},
+		}
+		lg, _ := groups.NewLogsGroup(facade)
+		ws := startWebServer(lg, "logs", getLogsRoutesConfig())
+
+		body := `{"address":"erd1pair","topics":[null,"YWxpY2U="],"fromBlock":3,"toBlock":7,"cursor":2}`
+		req, _ := http.NewRequest("POST", "/logs/filter", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := filteredEventsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedEvents, response.Data.Events)
+		assert.Equal(t, uint64(9), response.Data.NextCursor)
+	})
+}
+
+func TestLogsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		lg, _ := groups.NewLogsGroup(&mock.FacadeStub{})
+		err := lg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		lg, _ := groups.NewLogsGroup(&mock.FacadeStub{})
+		err := lg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		lg, _ := groups.NewLogsGroup(&mock.FacadeStub{})
+		newFacade := &mock.FacadeStub{
+			FilterEventsCalled: func(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		err := lg.UpdateFacade(newFacade)
+		require.NoError(t, err)
+
+		ws := startWebServer(lg, "logs", getLogsRoutesConfig())
+		filterBytes, _ := json.Marshal(&common.EventsFilterApiRequest{Identifier: "swap"})
+		req, _ := http.NewRequest("POST", "/logs/filter", bytes.NewBuffer(filterBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+}
+
+func TestLogsGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	lg, _ := groups.NewLogsGroup(nil)
+	require.True(t, lg.IsInterfaceNil())
+
+	lg, _ = groups.NewLogsGroup(&mock.FacadeStub{})
+	require.False(t, lg.IsInterfaceNil())
+}
+
+func getLogsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"logs": {
+				Routes: []config.RouteConfig{
+					{Name: "/filter", Open: true},
+				},
+			},
+		},
+	}
+}
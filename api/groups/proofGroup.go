@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/proofBundle"
 )
 
 const (
@@ -20,10 +21,14 @@ const (
 	getProofEndpoint                = "/proof/root-hash/:roothash/address/:address"
 	getProofDataTrieEndpoint        = "/proof/root-hash/:roothash/address/:address/key/:key"
 	verifyProofEndpoint             = "/proof/verify"
+	getProofBundleEndpoint          = "/proof/bundle/address/:address"
+	getProofBundleDataTrieEndpoint  = "/proof/bundle/address/:address/key/:key"
 	getProofCurrentRootHashPath     = "/address/:address"
 	getProofPath                    = "/root-hash/:roothash/address/:address"
 	getProofDataTriePath            = "/root-hash/:roothash/address/:address/key/:key"
 	verifyProofPath                 = "/verify"
+	getProofBundlePath              = "/bundle/address/:address"
+	getProofBundleDataTriePath      = "/bundle/address/:address/key/:key"
 )
 
 // proofFacadeHandler defines the methods to be implemented by a facade for proof requests
@@ -31,6 +36,7 @@ type proofFacadeHandler interface {
 	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
+	GetProofBundle(address string, key string) (*proofBundle.Bundle, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
@@ -58,6 +64,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    getProofPath,
 			Method:  http.MethodGet,
 			Handler: pg.getProof,
+			Documentation: shared.EndpointDocumentation{
+				Summary:      "returns the Merkle proof of an account for the provided root hash",
+				ResponseData: gin.H{"proof": []string{}, "value": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofEndpoint, facade),
@@ -69,6 +79,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    getProofDataTriePath,
 			Method:  http.MethodGet,
 			Handler: pg.getProofDataTrie,
+			Documentation: shared.EndpointDocumentation{
+				Summary:      "returns the Merkle proofs of an account and of a key of its data trie for the provided root hash",
+				ResponseData: gin.H{"proofs": map[string][]string{}, "value": "", "dataTrieRootHash": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofDataTrieEndpoint, facade),
@@ -80,6 +94,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    getProofCurrentRootHashPath,
 			Method:  http.MethodGet,
 			Handler: pg.getProofCurrentRootHash,
+			Documentation: shared.EndpointDocumentation{
+				Summary:      "returns the Merkle proof of an account for the current root hash",
+				ResponseData: gin.H{"proof": []string{}, "value": "", "rootHash": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofCurrentRootHashEndpoint, facade),
@@ -91,6 +109,11 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    verifyProofPath,
 			Method:  http.MethodPost,
 			Handler: pg.verifyProof,
+			Documentation: shared.EndpointDocumentation{
+				Summary:      "verifies the Merkle proof of an account",
+				RequestBody:  &VerifyProofRequest{},
+				ResponseData: gin.H{"ok": false},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyProofEndpoint, facade),
@@ -98,6 +121,36 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 				},
 			},
 		},
+		{
+			Path:    getProofBundlePath,
+			Method:  http.MethodGet,
+			Handler: pg.getProofBundle,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofBundleEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+			Documentation: shared.EndpointDocumentation{
+				Summary:      "returns the account proof bundled with the signed header committing to the proven state",
+				ResponseData: gin.H{"bundle": &proofBundle.Bundle{}},
+			},
+		},
+		{
+			Path:    getProofBundleDataTriePath,
+			Method:  http.MethodGet,
+			Handler: pg.getProofBundle,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofBundleDataTrieEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+			Documentation: shared.EndpointDocumentation{
+				Summary:      "returns the account and data trie key proofs bundled with the signed header committing to the proven state",
+				ResponseData: gin.H{"bundle": &proofBundle.Bundle{}},
+			},
+		},
 	}
 	pg.endpoints = endpoints
 
@@ -238,6 +291,24 @@ func (pg *proofGroup) verifyProof(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"ok": proofOk})
 }
 
+// getProofBundle will receive an address and an optional data trie key from the client, and it will return the Merkle
+// proofs bundled with the current header and its aggregated signature, which can be verified without trusting the node
+func (pg *proofGroup) getProofBundle(c *gin.Context) {
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyAddress)
+		return
+	}
+
+	bundle, err := pg.getFacade().GetProofBundle(address, c.Param("key"))
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"bundle": bundle})
+}
+
 func (pg *proofGroup) getFacade() proofFacadeHandler {
 	pg.mutFacade.RLock()
 	defer pg.mutFacade.RUnlock()
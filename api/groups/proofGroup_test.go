@@ -17,6 +17,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/state/proofBundle"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -379,6 +380,105 @@ func TestVerifyProof(t *testing.T) {
 	assert.True(t, isValid)
 }
 
+func TestGetProofBundle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetProofBundleCalled: func(address string, key string) (*proofBundle.Bundle, error) {
+				return nil, fmt.Errorf("GetProofBundle error")
+			},
+		}
+
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/proof/bundle/address/addr", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	})
+	t.Run("account bundle should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetProofBundleCalled: func(address string, key string) (*proofBundle.Bundle, error) {
+				assert.Equal(t, "addr", address)
+				assert.Empty(t, key)
+				return &proofBundle.Bundle{
+					ShardID:      1,
+					HeaderHash:   "aa",
+					AccountProof: []string{"bb", "cc"},
+				}, nil
+			},
+		}
+
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/proof/bundle/address/addr", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type bundleResponse struct {
+			Data struct {
+				Bundle proofBundle.Bundle `json:"bundle"`
+			} `json:"data"`
+			Code shared.ReturnCode `json:"code"`
+		}
+		response := bundleResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+		assert.Equal(t, uint32(1), response.Data.Bundle.ShardID)
+		assert.Equal(t, "aa", response.Data.Bundle.HeaderHash)
+		assert.Equal(t, []string{"bb", "cc"}, response.Data.Bundle.AccountProof)
+	})
+	t.Run("data trie bundle should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetProofBundleCalled: func(address string, key string) (*proofBundle.Bundle, error) {
+				assert.Equal(t, "addr", address)
+				assert.Equal(t, "6b6579", key)
+				return &proofBundle.Bundle{
+					Key:           key,
+					DataTrieProof: []string{"dd"},
+				}, nil
+			},
+		}
+
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/proof/bundle/address/addr/key/6b6579", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+		responseMap, ok := response.Data.(map[string]interface{})
+		require.True(t, ok)
+		bundle, ok := responseMap["bundle"].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, "6b6579", bundle["key"])
+		assert.Equal(t, []interface{}{"dd"}, bundle["dataTrieProof"])
+	})
+}
+
 func TestProofGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -476,6 +576,8 @@ func getProofRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/root-hash/:roothash/address/:address/key/:key", Open: true},
 					{Name: "/address/:address", Open: true},
 					{Name: "/verify", Open: true},
+					{Name: "/bundle/address/:address", Open: true},
+					{Name: "/bundle/address/:address/key/:key", Open: true},
 				},
 			},
 		},
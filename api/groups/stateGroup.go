@@ -0,0 +1,171 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/state/stateDiff"
+)
+
+const (
+	getStateDiffEndpoint = "/state/diff"
+	getStateDiffPath     = "/diff"
+
+	urlParamFromRootHash   = "fromRootHash"
+	urlParamToRootHash     = "toRootHash"
+	urlParamFromBlockNonce = "fromBlockNonce"
+	urlParamToBlockNonce   = "toBlockNonce"
+	urlParamStartAddress   = "startAddress"
+
+	defaultStateDiffPageSize = 20
+)
+
+// stateFacadeHandler defines the methods to be implemented by a facade for handling state requests
+type stateFacadeHandler interface {
+	GetStateDiff(request stateDiff.Request) (*stateDiff.StateDiff, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+type stateGroup struct {
+	*baseGroup
+	facade    stateFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewStateGroup returns a new instance of stateGroup
+func NewStateGroup(facade stateFacadeHandler) (*stateGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for state group", errors.ErrNilFacadeHandler)
+	}
+
+	sg := &stateGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    getStateDiffPath,
+			Method:  http.MethodGet,
+			Handler: sg.getStateDiff,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getStateDiffEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+			Documentation: shared.EndpointDocumentation{
+				Summary:      "returns a page of the accounts which differ between two states, given by root hash or by block nonce",
+				ResponseData: gin.H{"diff": &stateDiff.StateDiff{}},
+			},
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// getStateDiff returns a page of the accounts which differ between the two requested states
+func (sg *stateGroup) getStateDiff(c *gin.Context) {
+	request, err := extractStateDiffRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetStateDiff, err)
+		return
+	}
+
+	diff, err := sg.getFacade().GetStateDiff(request)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetStateDiff, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"diff": diff})
+}
+
+func extractStateDiffRequest(c *gin.Context) (stateDiff.Request, error) {
+	fromRootHash, fromBlockNonce, err := extractStateDiffBoundary(c, urlParamFromRootHash, urlParamFromBlockNonce)
+	if err != nil {
+		return stateDiff.Request{}, err
+	}
+	toRootHash, toBlockNonce, err := extractStateDiffBoundary(c, urlParamToRootHash, urlParamToBlockNonce)
+	if err != nil {
+		return stateDiff.Request{}, err
+	}
+
+	size, err := parseUint32UrlParam(c, urlParamSize)
+	if err != nil {
+		return stateDiff.Request{}, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, urlParamSize)
+	}
+	maxAccounts := defaultStateDiffPageSize
+	if size.HasValue {
+		maxAccounts = int(size.Value)
+	}
+	if maxAccounts == 0 {
+		return stateDiff.Request{}, errors.ErrInvalidPageSize
+	}
+
+	return stateDiff.Request{
+		FromRootHash:   fromRootHash,
+		ToRootHash:     toRootHash,
+		FromBlockNonce: fromBlockNonce,
+		ToBlockNonce:   toBlockNonce,
+		StartAddress:   c.Request.URL.Query().Get(urlParamStartAddress),
+		MaxAccounts:    maxAccounts,
+	}, nil
+}
+
+func extractStateDiffBoundary(c *gin.Context, rootHashParam string, blockNonceParam string) (string, core.OptionalUint64, error) {
+	rootHash, err := parseHexBytesUrlParam(c, rootHashParam)
+	if err != nil {
+		return "", core.OptionalUint64{}, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, rootHashParam)
+	}
+
+	blockNonce, err := parseUint64UrlParam(c, blockNonceParam)
+	if err != nil {
+		return "", core.OptionalUint64{}, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, blockNonceParam)
+	}
+
+	hasRootHash := len(rootHash) > 0
+	if hasRootHash == blockNonce.HasValue {
+		return "", core.OptionalUint64{}, fmt.Errorf("%w: %s or %s", errors.ErrInvalidStateDiffBoundary, rootHashParam, blockNonceParam)
+	}
+
+	return c.Request.URL.Query().Get(rootHashParam), blockNonce, nil
+}
+
+func (sg *stateGroup) getFacade() stateFacadeHandler {
+	sg.mutFacade.RLock()
+	defer sg.mutFacade.RUnlock()
+
+	return sg.facade
+}
+
+// UpdateFacade will update the facade
+func (sg *stateGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(stateFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	sg.mutFacade.Lock()
+	sg.facade = castFacade
+	sg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *stateGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
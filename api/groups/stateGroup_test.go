@@ -0,0 +1,233 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/state/stateDiff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stateDiffResponseData struct {
+	Diff *stateDiff.StateDiff `json:"diff"`
+}
+
+type stateDiffResponse struct {
+	Data  stateDiffResponseData `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
+func TestNewStateGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		sg, err := groups.NewStateGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, sg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		sg, err := groups.NewStateGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, sg)
+	})
+}
+
+func TestStateGroup_getStateDiff(t *testing.T) {
+	t.Parallel()
+
+	testInvalidRequest := func(url string, expectedErr error) func(t *testing.T) {
+		return func(t *testing.T) {
+			t.Parallel()
+
+			facade := &mock.FacadeStub{
+				GetStateDiffCalled: func(request stateDiff.Request) (*stateDiff.StateDiff, error) {
+					assert.Fail(t, "should have not been called")
+					return nil, nil
+				},
+			}
+			sg, _ := groups.NewStateGroup(facade)
+			ws := startWebServer(sg, "state", getStateRoutesConfig())
+
+			req, _ := http.NewRequest("GET", url, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := shared.GenericAPIResponse{}
+			loadResponse(resp.Body, &response)
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStateDiff.Error()))
+			assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+		}
+	}
+
+	t.Run("missing old state should error",
+		testInvalidRequest("/state/diff?toRootHash=aabb", apiErrors.ErrInvalidStateDiffBoundary))
+	t.Run("old state given twice should error",
+		testInvalidRequest("/state/diff?fromRootHash=aabb&fromBlockNonce=2&toBlockNonce=3", apiErrors.ErrInvalidStateDiffBoundary))
+	t.Run("missing new state should error",
+		testInvalidRequest("/state/diff?fromRootHash=aabb", apiErrors.ErrInvalidStateDiffBoundary))
+	t.Run("invalid root hash should error",
+		testInvalidRequest("/state/diff?fromRootHash=not hex&toRootHash=aabb", apiErrors.ErrBadUrlParams))
+	t.Run("invalid block nonce should error",
+		testInvalidRequest("/state/diff?fromBlockNonce=-1&toRootHash=aabb", apiErrors.ErrBadUrlParams))
+	t.Run("invalid size should error",
+		testInvalidRequest("/state/diff?fromBlockNonce=2&toBlockNonce=3&size=abc", apiErrors.ErrBadUrlParams))
+	t.Run("zero size should error",
+		testInvalidRequest("/state/diff?fromBlockNonce=2&toBlockNonce=3&size=0", apiErrors.ErrInvalidPageSize))
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetStateDiffCalled: func(request stateDiff.Request) (*stateDiff.StateDiff, error) {
+				return nil, expectedErr
+			},
+		}
+		sg, _ := groups.NewStateGroup(facade)
+		ws := startWebServer(sg, "state", getStateRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/state/diff?fromBlockNonce=2&toBlockNonce=3", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStateDiff.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work with the default page size", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetStateDiffCalled: func(request stateDiff.Request) (*stateDiff.StateDiff, error) {
+				assert.Equal(t, stateDiff.Request{
+					FromRootHash: "aabb",
+					ToBlockNonce: core.OptionalUint64{Value: 7, HasValue: true},
+					MaxAccounts:  20,
+				}, request)
+
+				return &stateDiff.StateDiff{}, nil
+			},
+		}
+		sg, _ := groups.NewStateGroup(facade)
+		ws := startWebServer(sg, "state", getStateRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/state/diff?fromRootHash=aabb&toBlockNonce=7", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedDiff := &stateDiff.StateDiff{
+			FromRootHash: "aabb",
+			ToRootHash:   "ccdd",
+			Accounts: []*stateDiff.AccountDiff{
+				{
+					Address:       "erd1alice",
+					Change:        stateDiff.Modified,
+					ChangedFields: []string{stateDiff.BalanceField},
+					Old:           &stateDiff.AccountState{Nonce: 1, Balance: "10", DeveloperReward: "0"},
+					New:           &stateDiff.AccountState{Nonce: 1, Balance: "20", DeveloperReward: "0"},
+				},
+			},
+			NextAddress: "erd1alice",
+		}
+		facade := &mock.FacadeStub{
+			GetStateDiffCalled: func(request stateDiff.Request) (*stateDiff.StateDiff, error) {
+				assert.Equal(t, stateDiff.Request{
+					FromRootHash: "aabb",
+					ToRootHash:   "ccdd",
+					StartAddress: "erd1bob",
+					MaxAccounts:  1,
+				}, request)
+
+				return expectedDiff, nil
+			},
+		}
+		sg, _ := groups.NewStateGroup(facade)
+		ws := startWebServer(sg, "state", getStateRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/state/diff?fromRootHash=aabb&toRootHash=ccdd&startAddress=erd1bob&size=1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := stateDiffResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedDiff, response.Data.Diff)
+	})
+}
+
+func TestStateGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewStateGroup(&mock.FacadeStub{})
+		err := sg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewStateGroup(&mock.FacadeStub{})
+		err := sg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewStateGroup(&mock.FacadeStub{})
+		newFacade := &mock.FacadeStub{
+			GetStateDiffCalled: func(request stateDiff.Request) (*stateDiff.StateDiff, error) {
+				return nil, expectedErr
+			},
+		}
+		err := sg.UpdateFacade(newFacade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sg, "state", getStateRoutesConfig())
+		req, _ := http.NewRequest("GET", "/state/diff?fromBlockNonce=2&toBlockNonce=3", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+}
+
+func TestStateGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	sg, _ := groups.NewStateGroup(nil)
+	require.True(t, sg.IsInterfaceNil())
+
+	sg, _ = groups.NewStateGroup(&mock.FacadeStub{})
+	require.False(t, sg.IsInterfaceNil())
+}
+
+func getStateRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"state": {
+				Routes: []config.RouteConfig{
+					{Name: "/diff", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	subscriptionsWebSocketPath = "/ws"
+
+	// a close frame payload is limited to 125 bytes, out of which 2 hold the close code
+	maxCloseReasonLength = 123
+)
+
+// subscriptionsFacadeHandler defines the methods to be implemented by a facade for handling websocket subscriptions
+type subscriptionsFacadeHandler interface {
+	HandleSubscriptionsConnection(conn common.WebSocketConnection) error
+	IsInterfaceNil() bool
+}
+
+type subscriptionsGroup struct {
+	*baseGroup
+	facade    subscriptionsFacadeHandler
+	mutFacade sync.RWMutex
+	upgrader  websocket.Upgrader
+}
+
+// NewSubscriptionsGroup returns a new instance of subscriptionsGroup
+func NewSubscriptionsGroup(facade subscriptionsFacadeHandler) (*subscriptionsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for subscriptions group", errors.ErrNilFacadeHandler)
+	}
+
+	sg := &subscriptionsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				return true
+			},
+		},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    subscriptionsWebSocketPath,
+			Method:  http.MethodGet,
+			Handler: sg.serveWebSocket,
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// serveWebSocket will upgrade the connection to a websocket and will serve the client's subscriptions on it.
+// If the subscriptions cannot be served, the connection is closed with the error as reason
+func (sg *subscriptionsGroup) serveWebSocket(c *gin.Context) {
+	conn, err := sg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("subscriptionsGroup: cannot upgrade connection", "error", err.Error())
+		return
+	}
+
+	err = sg.getFacade().HandleSubscriptionsConnection(conn)
+	if err != nil {
+		reason := fmt.Sprintf("%s: %s", errors.ErrHandleSubscriptions.Error(), err.Error())
+		if len(reason) > maxCloseReasonLength {
+			reason = reason[:maxCloseReasonLength]
+		}
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, reason))
+		_ = conn.Close()
+	}
+}
+
+func (sg *subscriptionsGroup) getFacade() subscriptionsFacadeHandler {
+	sg.mutFacade.RLock()
+	defer sg.mutFacade.RUnlock()
+
+	return sg.facade
+}
+
+// UpdateFacade will update the facade
+func (sg *subscriptionsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(subscriptionsFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	sg.mutFacade.Lock()
+	sg.facade = castFacade
+	sg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *subscriptionsGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
@@ -0,0 +1,173 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSubscriptionsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		sg, err := groups.NewSubscriptionsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, sg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		sg, err := groups.NewSubscriptionsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, sg)
+	})
+}
+
+func TestSubscriptionsGroup_serveWebSocket(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not a websocket request should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			HandleSubscriptionsConnectionCalled: func(conn common.WebSocketConnection) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		sg, _ := groups.NewSubscriptionsGroup(facade)
+		ws := startWebServer(sg, "subscriptions", getSubscriptionsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/subscriptions/ws", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("facade error should close the connection", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			HandleSubscriptionsConnectionCalled: func(conn common.WebSocketConnection) error {
+				return expectedErr
+			},
+		}
+		sg, _ := groups.NewSubscriptionsGroup(facade)
+		conn := dialSubscriptionsWebSocket(t, sg)
+
+		_, _, err := conn.ReadMessage()
+		closeErr, ok := err.(*websocket.CloseError)
+		require.True(t, ok)
+		assert.Equal(t, websocket.CloseTryAgainLater, closeErr.Code)
+		assert.True(t, strings.Contains(closeErr.Text, apiErrors.ErrHandleSubscriptions.Error()))
+		assert.True(t, strings.Contains(closeErr.Text, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			HandleSubscriptionsConnectionCalled: func(conn common.WebSocketConnection) error {
+				messageType, message, err := conn.ReadMessage()
+				if err != nil {
+					return err
+				}
+
+				return conn.WriteMessage(messageType, append([]byte("echo "), message...))
+			},
+		}
+		sg, _ := groups.NewSubscriptionsGroup(facade)
+		conn := dialSubscriptionsWebSocket(t, sg)
+
+		err := conn.WriteMessage(websocket.TextMessage, []byte("subscribe"))
+		require.NoError(t, err)
+
+		_, message, err := conn.ReadMessage()
+		require.NoError(t, err)
+		assert.Equal(t, "echo subscribe", string(message))
+	})
+}
+
+func TestSubscriptionsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewSubscriptionsGroup(&mock.FacadeStub{})
+		err := sg.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewSubscriptionsGroup(&mock.FacadeStub{})
+		err := sg.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sg, _ := groups.NewSubscriptionsGroup(&mock.FacadeStub{})
+		newFacade := &mock.FacadeStub{
+			HandleSubscriptionsConnectionCalled: func(conn common.WebSocketConnection) error {
+				return expectedErr
+			},
+		}
+		err := sg.UpdateFacade(newFacade)
+		require.NoError(t, err)
+
+		conn := dialSubscriptionsWebSocket(t, sg)
+		_, _, err = conn.ReadMessage()
+		closeErr, ok := err.(*websocket.CloseError)
+		require.True(t, ok)
+		assert.True(t, strings.Contains(closeErr.Text, expectedErr.Error()))
+	})
+}
+
+func TestSubscriptionsGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	sg, _ := groups.NewSubscriptionsGroup(nil)
+	require.True(t, sg.IsInterfaceNil())
+
+	sg, _ = groups.NewSubscriptionsGroup(&mock.FacadeStub{})
+	require.False(t, sg.IsInterfaceNil())
+}
+
+func dialSubscriptionsWebSocket(t *testing.T, sg shared.GroupHandler) *websocket.Conn {
+	ws := startWebServer(sg, "subscriptions", getSubscriptionsRoutesConfig())
+	server := httptest.NewServer(ws)
+	t.Cleanup(server.Close)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscriptions/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func getSubscriptionsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"subscriptions": {
+				Routes: []config.RouteConfig{
+					{Name: "/ws", Open: true},
+				},
+			},
+		},
+	}
+}
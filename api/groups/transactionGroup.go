@@ -41,7 +41,11 @@ const (
 	queryParamFields         = "fields"
 	queryParamLastNonce      = "last-nonce"
 	queryParamNonceGaps      = "nonce-gaps"
+	queryParamDetails        = "details"
+	queryParamSelection      = "selection"
+	queryParamRejections     = "rejections"
 	queryParameterScrHash    = "scrHash"
+	queryParamTrace          = "trace"
 )
 
 // transactionFacadeHandler defines the methods to be implemented by a facade for transaction requests
@@ -50,13 +54,16 @@ type transactionFacadeHandler interface {
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetSCRsByTxHash(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolSenderDetails(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error)
+	GetTransactionsPoolSelection(fields string) (*common.TransactionsPoolSelectionApiResponse, error)
+	GetTransactionsPoolRejections(sender string) (*common.TransactionsPoolRejectionsApiResponse, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
@@ -85,6 +92,11 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    sendTransactionPath,
 			Method:  http.MethodPost,
 			Handler: tg.sendTransaction,
+			Documentation: shared.EndpointDocumentation{
+				Summary:      "sends a transaction to the network",
+				RequestBody:  &transaction.FrontendTransaction{},
+				ResponseData: gin.H{"txHash": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(sendTransactionEndpoint, facade),
@@ -96,6 +108,15 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    simulateTransactionPath,
 			Method:  http.MethodPost,
 			Handler: tg.simulateTransaction,
+			Documentation: shared.EndpointDocumentation{
+				Summary: "simulates the execution of a transaction",
+				QueryParameters: []shared.QueryParameter{
+					{Name: queryParamCheckSignature, Type: shared.QueryParameterBoolean, Description: "verify the signature of the transaction, true by default"},
+					{Name: queryParamTrace, Type: shared.QueryParameterBoolean, Description: "include the execution trace"},
+				},
+				RequestBody:  &transaction.FrontendTransaction{},
+				ResponseData: gin.H{"result": &txSimData.SimulationResultsWithVMOutput{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateTransactionEndpoint, facade),
@@ -107,11 +128,28 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    costPath,
 			Method:  http.MethodPost,
 			Handler: tg.computeTransactionGasLimit,
+			Documentation: shared.EndpointDocumentation{
+				Summary:      "computes the gas limit needed by a transaction",
+				RequestBody:  &transaction.FrontendTransaction{},
+				ResponseData: &transaction.CostResponse{},
+			},
 		},
 		{
 			Path:    getTransactionsPool,
 			Method:  http.MethodGet,
 			Handler: tg.getTransactionsPool,
+			Documentation: shared.EndpointDocumentation{
+				Summary: "returns the transactions of the pool, the content of the response depends on the query parameters",
+				QueryParameters: []shared.QueryParameter{
+					{Name: queryParamSender, Type: shared.QueryParameterString, Description: "return only the transactions of the provided sender"},
+					{Name: queryParamFields, Type: shared.QueryParameterString, Description: "comma separated list of the transaction fields to return, or * for all of them"},
+					{Name: queryParamLastNonce, Type: shared.QueryParameterBoolean, Description: "return the last nonce of the sender in the pool"},
+					{Name: queryParamNonceGaps, Type: shared.QueryParameterBoolean, Description: "return the nonce gaps of the sender in the pool"},
+					{Name: queryParamDetails, Type: shared.QueryParameterBoolean, Description: "return the details of the sender in the pool"},
+					{Name: queryParamSelection, Type: shared.QueryParameterBoolean, Description: "return the transactions that would be selected for the next block"},
+					{Name: queryParamRejections, Type: shared.QueryParameterBoolean, Description: "return the transactions rejected from the pool"},
+				},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionPath, facade),
@@ -123,6 +161,11 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    sendMultiplePath,
 			Method:  http.MethodPost,
 			Handler: tg.sendMultipleTransactions,
+			Documentation: shared.EndpointDocumentation{
+				Summary:      "sends multiple transactions to the network",
+				RequestBody:  []*transaction.FrontendTransaction{},
+				ResponseData: gin.H{"txsSent": uint64(0), "txsHashes": map[int]string{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(sendMultipleTransactionsEndpoint, facade),
@@ -134,6 +177,13 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    getTransactionPath,
 			Method:  http.MethodGet,
 			Handler: tg.getTransaction,
+			Documentation: shared.EndpointDocumentation{
+				Summary: "returns a transaction by its hash",
+				QueryParameters: []shared.QueryParameter{
+					{Name: queryParamWithResults, Type: shared.QueryParameterBoolean, Description: "include the smart contract results and the logs of the transaction"},
+				},
+				ResponseData: gin.H{"transaction": &transaction.ApiTransactionResult{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionEndpoint, facade),
@@ -145,6 +195,13 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    getScrsByTxHashPath,
 			Method:  http.MethodGet,
 			Handler: tg.getScrsByTxHash,
+			Documentation: shared.EndpointDocumentation{
+				Summary: "returns the smart contract results generated by a transaction",
+				QueryParameters: []shared.QueryParameter{
+					{Name: queryParameterScrHash, Type: shared.QueryParameterString, Description: "the hash of a smart contract result of the transaction", Required: true},
+				},
+				ResponseData: gin.H{"scrs": []*transaction.ApiSmartContractResult{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getScrsByTxHashEndpoint, facade),
@@ -197,6 +254,19 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
+	withTrace, err := getQueryParameterBool(c, queryParamTrace)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	tx, txHash, err := tg.createTransaction(&ftx)
 	if err != nil {
 		c.JSON(
@@ -226,7 +296,7 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	}
 
 	start = time.Now()
-	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx)
+	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx, withTrace)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionExecution")
 	if err != nil {
 		c.JSON(
@@ -549,7 +619,7 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 // getTransactionsPool returns the transactions details in the pool
 func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 	// extract and validate query parameters
-	sender, fields, lastNonce, nonceGaps, err := tg.extractQueryParameters(c)
+	params, err := tg.extractQueryParameters(c)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -562,7 +632,7 @@ func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 		return
 	}
 
-	err = validateQuery(sender, fields, lastNonce, nonceGaps)
+	err = validateQuery(params)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -575,39 +645,85 @@ func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 		return
 	}
 
+	if params.selection {
+		tg.getTransactionsPoolSelection(params.fields, c)
+		return
+	}
+
+	if params.rejections {
+		tg.getTransactionsPoolRejections(params.sender, c)
+		return
+	}
+
 	// if no sender was provided, the fields for all transactions from pool should be returned in response
-	if sender == "" {
-		tg.getTxPool(fields, c)
+	if params.sender == "" {
+		tg.getTxPool(params.fields, c)
+		return
+	}
+
+	if params.lastNonce {
+		tg.getLastPoolNonceForSender(params.sender, c)
 		return
 	}
 
-	if lastNonce {
-		tg.getLastPoolNonceForSender(sender, c)
+	if params.nonceGaps {
+		tg.getTransactionsPoolNonceGapsForSender(params.sender, c)
 		return
 	}
 
-	if nonceGaps {
-		tg.getTransactionsPoolNonceGapsForSender(sender, c)
+	if params.details {
+		tg.getTransactionsPoolSenderDetails(params.sender, c)
 		return
 	}
 
-	tg.getTxPoolForSender(sender, fields, c)
+	tg.getTxPoolForSender(params.sender, params.fields, c)
+}
+
+type txPoolQueryParameters struct {
+	sender     string
+	fields     string
+	lastNonce  bool
+	nonceGaps  bool
+	details    bool
+	selection  bool
+	rejections bool
 }
 
-func (tg *transactionGroup) extractQueryParameters(c *gin.Context) (string, string, bool, bool, error) {
-	senderAddress := getQueryParameterSender(c)
-	fields := getQueryParameterFields(c)
-	lastNonce, err := getQueryParameterLastNonce(c)
+func (tg *transactionGroup) extractQueryParameters(c *gin.Context) (*txPoolQueryParameters, error) {
+	lastNonce, err := getQueryParameterBool(c, queryParamLastNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceGaps, err := getQueryParameterBool(c, queryParamNonceGaps)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := getQueryParameterBool(c, queryParamDetails)
 	if err != nil {
-		return "", "", false, false, err
+		return nil, err
 	}
 
-	nonceGaps, err := getQueryParameterNonceGaps(c)
+	selection, err := getQueryParameterBool(c, queryParamSelection)
 	if err != nil {
-		return "", "", false, false, err
+		return nil, err
 	}
 
-	return senderAddress, fields, lastNonce, nonceGaps, nil
+	rejections, err := getQueryParameterBool(c, queryParamRejections)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txPoolQueryParameters{
+		sender:     getQueryParameterSender(c),
+		fields:     getQueryParameterFields(c),
+		lastNonce:  lastNonce,
+		nonceGaps:  nonceGaps,
+		details:    details,
+		selection:  selection,
+		rejections: rejections,
+	}, nil
 }
 
 // getTxPool returns the fields for all txs in pool
@@ -718,8 +834,97 @@ func (tg *transactionGroup) getTransactionsPoolNonceGapsForSender(sender string,
 	)
 }
 
+// getTransactionsPoolSenderDetails returns the transactions in pool for sender, checked against the selection rules
+func (tg *transactionGroup) getTransactionsPoolSenderDetails(sender string, c *gin.Context) {
+	start := time.Now()
+	details, err := tg.getFacade().GetTransactionsPoolSenderDetails(sender)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPoolSenderDetails")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"details": details},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionsPoolSelection returns the transactions in pool that would be picked by the next selection
+func (tg *transactionGroup) getTransactionsPoolSelection(fields string, c *gin.Context) {
+	start := time.Now()
+	selection, err := tg.getFacade().GetTransactionsPoolSelection(fields)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPoolSelection")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"selection": selection},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionsPoolRejections returns the transactions recently evicted from pool or rejected at selection
+func (tg *transactionGroup) getTransactionsPoolRejections(sender string, c *gin.Context) {
+	start := time.Now()
+	rejections, err := tg.getFacade().GetTransactionsPoolRejections(sender)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPoolRejections")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"rejections": rejections},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 func (tg *transactionGroup) createTransaction(receivedTx *transaction.FrontendTransaction) (*transaction.Transaction, []byte, error) {
-	txArgs := &external.ArgsCreateTransaction{
+	start := time.Now()
+	tx, txHash, err := tg.getFacade().CreateTransaction(newArgsCreateTransaction(receivedTx))
+	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
+
+	return tx, txHash, err
+}
+
+func newArgsCreateTransaction(receivedTx *transaction.FrontendTransaction) *external.ArgsCreateTransaction {
+	return &external.ArgsCreateTransaction{
 		Nonce:            receivedTx.Nonce,
 		Value:            receivedTx.Value,
 		Receiver:         receivedTx.Receiver,
@@ -736,41 +941,68 @@ func (tg *transactionGroup) createTransaction(receivedTx *transaction.FrontendTr
 		Guardian:         receivedTx.GuardianAddr,
 		GuardianSigHex:   receivedTx.GuardianSignature,
 	}
-	start := time.Now()
-	tx, txHash, err := tg.getFacade().CreateTransaction(txArgs)
-	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
-
-	return tx, txHash, err
 }
 
-func validateQuery(sender, fields string, lastNonce, nonceGaps bool) error {
-	if fields != "" && lastNonce {
+func validateQuery(params *txPoolQueryParameters) error {
+	// last-nonce and nonce-gaps were historically accepted together, the former taking precedence
+	if countTrue(params.lastNonce || params.nonceGaps, params.details, params.selection, params.rejections) > 1 {
+		return errors.ErrTooManyTxPoolQueryOptions
+	}
+
+	if params.fields != "" && params.lastNonce {
 		return errors.ErrFetchingLatestNonceCannotIncludeFields
 	}
 
-	if fields != "" && nonceGaps {
+	if params.fields != "" && params.nonceGaps {
 		return errors.ErrFetchingNonceGapsCannotIncludeFields
 	}
 
-	if sender == "" && lastNonce {
+	if params.fields != "" && params.details {
+		return errors.ErrFetchingPoolDetailsCannotIncludeFields
+	}
+
+	if params.fields != "" && params.rejections {
+		return errors.ErrFetchingPoolRejectionsCannotIncludeFields
+	}
+
+	if params.sender == "" && params.lastNonce {
 		return errors.ErrEmptySenderToGetLatestNonce
 	}
 
-	if sender == "" && nonceGaps {
+	if params.sender == "" && params.nonceGaps {
 		return errors.ErrEmptySenderToGetNonceGaps
 	}
 
-	if fields == "*" {
+	if params.sender == "" && params.details {
+		return errors.ErrEmptySenderToGetPoolDetails
+	}
+
+	if params.sender != "" && params.selection {
+		return errors.ErrFetchingPoolSelectionCannotIncludeSender
+	}
+
+	if params.fields == "*" {
 		return nil
 	}
 
-	if fields != "" {
-		return validateFields(fields)
+	if params.fields != "" {
+		return validateFields(params.fields)
 	}
 
 	return nil
 }
 
+func countTrue(flags ...bool) int {
+	count := 0
+	for _, flag := range flags {
+		if flag {
+			count++
+		}
+	}
+
+	return count
+}
+
 func validateFields(fields string) error {
 	for _, c := range fields {
 		if c == ',' {
@@ -815,22 +1047,13 @@ func getQueryParameterFields(c *gin.Context) string {
 	return fieldsStr
 }
 
-func getQueryParameterLastNonce(c *gin.Context) (bool, error) {
-	lastNonceStr := c.Request.URL.Query().Get(queryParamLastNonce)
-	if lastNonceStr == "" {
-		return false, nil
-	}
-
-	return strconv.ParseBool(lastNonceStr)
-}
-
-func getQueryParameterNonceGaps(c *gin.Context) (bool, error) {
-	nonceGapsStr := c.Request.URL.Query().Get(queryParamNonceGaps)
-	if nonceGapsStr == "" {
+func getQueryParameterBool(c *gin.Context, param string) (bool, error) {
+	valueStr := c.Request.URL.Query().Get(param)
+	if valueStr == "" {
 		return false, nil
 	}
 
-	return strconv.ParseBool(nonceGapsStr)
+	return strconv.ParseBool(valueStr)
 }
 
 func (tg *transactionGroup) getFacade() transactionFacadeHandler {
@@ -129,6 +129,36 @@ type txPoolNonceGapsForSenderResponse struct {
 	Code  string                               `json:"code"`
 }
 
+type txPoolSenderDetailsResponseData struct {
+	Details common.TransactionsPoolSenderDetailsApiResponse `json:"details"`
+}
+
+type txPoolSenderDetailsResponse struct {
+	Data  txPoolSenderDetailsResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
+type txPoolSelectionResponseData struct {
+	Selection common.TransactionsPoolSelectionApiResponse `json:"selection"`
+}
+
+type txPoolSelectionResponse struct {
+	Data  txPoolSelectionResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string                      `json:"code"`
+}
+
+type txPoolRejectionsResponseData struct {
+	Rejections common.TransactionsPoolRejectionsApiResponse `json:"rejections"`
+}
+
+type txPoolRejectionsResponse struct {
+	Data  txPoolRejectionsResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
 var (
 	sender      = "sender"
 	receiver    = "receiver"
@@ -639,6 +669,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/simulate", &dataTx.FrontendTransaction{}))
 	t.Run("invalid param transaction should error", testTransactionGroupErrorScenario("/transaction/simulate", "POST", jsonTxStr, http.StatusBadRequest, apiErrors.ErrValidation))
 	t.Run("invalid param checkSignature should error", testTransactionGroupErrorScenario("/transaction/simulate?checkSignature=not-bool", "POST", &dataTx.FrontendTransaction{}, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("invalid param trace should error", testTransactionGroupErrorScenario("/transaction/simulate?trace=not-bool", "POST", &dataTx.FrontendTransaction{}, http.StatusBadRequest, apiErrors.ErrValidation))
 	t.Run("CreateTransaction error should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -671,7 +702,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
 				return expectedErr
 			},
-			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 				require.Fail(t, "should have not been called")
 				return nil, nil
 			},
@@ -696,7 +727,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
 				return nil
 			},
-			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 				return nil, expectedErr
 			},
 		}
@@ -716,7 +747,8 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 		processTxWasCalled := false
 
 		facade := &mock.FacadeStub{
-			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.False(t, withTrace)
 				processTxWasCalled = true
 				return &txSimData.SimulationResultsWithVMOutput{
 					SimulationResults: dataTx.SimulationResults{
@@ -760,6 +792,54 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 		assert.True(t, processTxWasCalled)
 		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
 	})
+	t.Run("should work with trace", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.True(t, withTrace)
+				return &txSimData.SimulationResultsWithVMOutput{
+					SimulationResults: dataTx.SimulationResults{
+						Status: "success",
+					},
+					Trace: &txSimData.ExecutionStep{
+						Type:     "transaction",
+						Function: "add",
+						Calls: []*txSimData.ExecutionStep{
+							{
+								Type:     "scCall",
+								Function: "add",
+							},
+						},
+					},
+				}, nil
+			},
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return nil
+			},
+		}
+
+		jsonBytes, _ := json.Marshal(dataTx.FrontendTransaction{})
+
+		response := &simulateTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/simulate?trace=true",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+
+		result := response.Data.(map[string]interface{})["result"].(map[string]interface{})
+		trace := result["trace"].(map[string]interface{})
+		assert.Equal(t, "transaction", trace["type"])
+		assert.Len(t, trace["calls"], 1)
+	})
 }
 
 func TestTransactionGroup_getTransactionsPool(t *testing.T) {
@@ -772,6 +852,15 @@ func TestTransactionGroup_getTransactionsPool(t *testing.T) {
 	t.Run("empty sender, requesting nonce gaps", testTxPoolWithInvalidQuery("?nonce-gaps=true", apiErrors.ErrEmptySenderToGetNonceGaps))
 	t.Run("fields + latest nonce", testTxPoolWithInvalidQuery("?fields=sender,receiver&last-nonce=true", apiErrors.ErrFetchingLatestNonceCannotIncludeFields))
 	t.Run("fields + nonce gaps", testTxPoolWithInvalidQuery("?fields=sender,receiver&nonce-gaps=true", apiErrors.ErrFetchingNonceGapsCannotIncludeFields))
+	t.Run("invalid details param should error", testTransactionGroupErrorScenario("/transaction/pool?details=not-bool", "GET", nil, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("invalid selection param should error", testTransactionGroupErrorScenario("/transaction/pool?selection=not-bool", "GET", nil, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("invalid rejections param should error", testTransactionGroupErrorScenario("/transaction/pool?rejections=not-bool", "GET", nil, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("empty sender, requesting details", testTxPoolWithInvalidQuery("?details=true", apiErrors.ErrEmptySenderToGetPoolDetails))
+	t.Run("fields + details", testTxPoolWithInvalidQuery("?by-sender=sender&fields=sender&details=true", apiErrors.ErrFetchingPoolDetailsCannotIncludeFields))
+	t.Run("fields + rejections", testTxPoolWithInvalidQuery("?fields=sender&rejections=true", apiErrors.ErrFetchingPoolRejectionsCannotIncludeFields))
+	t.Run("sender + selection", testTxPoolWithInvalidQuery("?by-sender=sender&selection=true", apiErrors.ErrFetchingPoolSelectionCannotIncludeSender))
+	t.Run("selection + rejections", testTxPoolWithInvalidQuery("?selection=true&rejections=true", apiErrors.ErrTooManyTxPoolQueryOptions))
+	t.Run("nonce gaps + details", testTxPoolWithInvalidQuery("?by-sender=sender&nonce-gaps=true&details=true", apiErrors.ErrTooManyTxPoolQueryOptions))
 	t.Run("fields has spaces", testTxPoolWithInvalidQuery("?fields=sender ,receiver", apiErrors.ErrInvalidFields))
 	t.Run("fields has numbers", testTxPoolWithInvalidQuery("?fields=sender1", apiErrors.ErrInvalidFields))
 	t.Run("fields + wild card", testTxPoolWithInvalidQuery("?fields=sender,receiver,*", apiErrors.ErrInvalidFields))
@@ -980,6 +1069,167 @@ func TestTransactionGroup_getTransactionsPool(t *testing.T) {
 		assert.Empty(t, response.Error)
 		assert.Equal(t, *expectedNonceGaps, response.Data.NonceGaps)
 	})
+	t.Run("GetTransactionsPoolSenderDetails error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolSenderDetailsCalled: func(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool?by-sender=sender&details=true",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("GetTransactionsPoolSelection error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolSelectionCalled: func(fields string) (*common.TransactionsPoolSelectionApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool?selection=true",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("GetTransactionsPoolRejections error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolRejectionsCalled: func(sender string) (*common.TransactionsPoolRejectionsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool?rejections=true",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work for sender details", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSender := "sender"
+		expectedDetails := &common.TransactionsPoolSenderDetailsApiResponse{
+			Sender:          expectedSender,
+			AccountNonce:    5,
+			AccountBalance:  "1000",
+			NumTransactions: 1,
+			TotalFee:        "50",
+			Transactions: []common.PoolTransactionDetailsApiResponse{
+				{
+					Hash:       "txhash1",
+					Nonce:      7,
+					Selectable: false,
+					Reason:     "nonce gap",
+				},
+			},
+			Rejections: []common.RejectedTransactionApiResponse{},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolSenderDetailsCalled: func(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+				assert.Equal(t, expectedSender, sender)
+				return expectedDetails, nil
+			},
+		}
+
+		response := &txPoolSenderDetailsResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/pool?by-sender="+expectedSender+"&details=true",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedDetails, response.Data.Details)
+	})
+	t.Run("should work for selection", func(t *testing.T) {
+		t.Parallel()
+
+		providedFields := "hash,nonce"
+		expectedSelection := &common.TransactionsPoolSelectionApiResponse{
+			Transactions: []common.Transaction{
+				{
+					TxFields: map[string]interface{}{
+						"hash":  "txhash1",
+						"nonce": float64(3),
+					},
+				},
+			},
+			TotalGasLimit: 50000,
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolSelectionCalled: func(fields string) (*common.TransactionsPoolSelectionApiResponse, error) {
+				assert.Equal(t, providedFields, fields)
+				return expectedSelection, nil
+			},
+		}
+
+		response := &txPoolSelectionResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/pool?selection=true&fields="+providedFields,
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedSelection, response.Data.Selection)
+	})
+	t.Run("should work for rejections", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSender := "sender"
+		expectedRejections := &common.TransactionsPoolRejectionsApiResponse{
+			Rejections: []common.RejectedTransactionApiResponse{
+				{
+					Hash:      "txhash1",
+					Sender:    expectedSender,
+					Nonce:     4,
+					Reason:    "insufficient balance",
+					Timestamp: 1700000000,
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolRejectionsCalled: func(sender string) (*common.TransactionsPoolRejectionsApiResponse, error) {
+				assert.Equal(t, expectedSender, sender)
+				return expectedRejections, nil
+			},
+		}
+
+		response := &txPoolRejectionsResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/pool?by-sender="+expectedSender+"&rejections=true",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedRejections, response.Data.Rejections)
+	})
 }
 
 func testTxPoolWithInvalidQuery(query string, expectedErr error) func(t *testing.T) {
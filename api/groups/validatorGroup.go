@@ -47,11 +47,19 @@ func NewValidatorGroup(facade validatorFacadeHandler) (*validatorGroup, error) {
 			Path:    statisticsPath,
 			Method:  http.MethodGet,
 			Handler: ng.statistics,
+			Documentation: shared.EndpointDocumentation{
+				Summary:      "returns the rating and the signing statistics of the validators",
+				ResponseData: gin.H{"statistics": map[string]*validator.ValidatorStatistics{}},
+			},
 		},
 		{
 			Path:    auctionPath,
 			Method:  http.MethodGet,
 			Handler: ng.auction,
+			Documentation: shared.EndpointDocumentation{
+				Summary:      "returns the validators of the auction list",
+				ResponseData: gin.H{"auctionList": []*common.AuctionListValidatorAPIResponse{}},
+			},
 		},
 	}
 	ng.endpoints = endpoints
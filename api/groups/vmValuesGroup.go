@@ -8,12 +8,12 @@ import (
 	"sync"
 
 	"github.com/gin-gonic/gin"
-	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	apiData "github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
@@ -23,11 +23,16 @@ const (
 	stringPath = "/string"
 	intPath    = "/int"
 	queryPath  = "/query"
+
+	queryMultiplePath = "/query-multiple"
+
+	maxNumQueriesInMultipleRequest = 100
 )
 
 // vmValuesFacadeHandler defines the methods to be implemented by a facade for vm-values requests
 type vmValuesFacadeHandler interface {
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, apiData.BlockInfo, error)
+	ExecuteSCQueries([]*process.SCQuery) ([]*common.SCQueryApiResult, apiData.BlockInfo, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
@@ -54,21 +59,46 @@ func NewVmValuesGroup(facade vmValuesFacadeHandler) (*vmValuesGroup, error) {
 			Path:    hexPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getHex,
+			Documentation: shared.EndpointDocumentation{
+				Summary:     "returns the first value returned by a smart contract view function, hex encoded",
+				RequestBody: &VMValueRequest{},
+			},
 		},
 		{
 			Path:    stringPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getString,
+			Documentation: shared.EndpointDocumentation{
+				Summary:     "returns the first value returned by a smart contract view function, as string",
+				RequestBody: &VMValueRequest{},
+			},
 		},
 		{
 			Path:    intPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getInt,
+			Documentation: shared.EndpointDocumentation{
+				Summary:     "returns the first value returned by a smart contract view function, as integer",
+				RequestBody: &VMValueRequest{},
+			},
 		},
 		{
 			Path:    queryPath,
 			Method:  http.MethodPost,
 			Handler: vvg.executeQuery,
+			Documentation: shared.EndpointDocumentation{
+				Summary:     "executes a smart contract view function",
+				RequestBody: &VMValueRequest{},
+			},
+		},
+		{
+			Path:    queryMultiplePath,
+			Method:  http.MethodPost,
+			Handler: vvg.executeMultipleQueries,
+			Documentation: shared.EndpointDocumentation{
+				Summary:     "executes multiple smart contract view functions against the same state",
+				RequestBody: &VMValuesMultipleRequest{},
+			},
 		},
 	}
 	vvg.endpoints = endpoints
@@ -87,6 +117,12 @@ type VMValueRequest struct {
 	ShouldBeSynced bool     `json:"shouldBeSynced"`
 }
 
+// VMValuesMultipleRequest represents the structure of a request holding multiple queries to be executed against the same state
+type VMValuesMultipleRequest struct {
+	Queries        []*VMValueRequest `json:"queries"`
+	ShouldBeSynced bool              `json:"shouldBeSynced"`
+}
+
 // getHex returns the data as bytes, hex-encoded
 func (vvg *vmValuesGroup) getHex(context *gin.Context) {
 	vvg.doGetVMValue(context, vm.AsHex)
@@ -141,7 +177,7 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 		return nil, "", apiData.BlockInfo{}, err
 	}
 
-	command.BlockNonce, command.BlockHash, err = extractBlockCoordinates(context)
+	err = addBlockCoordinatesToQuery(context, command)
 	if err != nil {
 		return nil, "", apiData.BlockInfo{}, err
 	}
@@ -159,22 +195,118 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 	return vmOutputApi, vmExecErrMsg, blockInfo, nil
 }
 
-func extractBlockCoordinates(context *gin.Context) (core.OptionalUint64, []byte, error) {
+// executeMultipleQueries executes all the provided queries against the same state
+func (vvg *vmValuesGroup) executeMultipleQueries(context *gin.Context) {
+	request := VMValuesMultipleRequest{}
+	err := context.ShouldBindJSON(&request)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeMultipleQueries", errors.ErrInvalidJSONRequest)
+		return
+	}
+
+	queries, err := vvg.createSCQueries(context, &request)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeMultipleQueries", err)
+		return
+	}
+
+	results, blockInfo, err := vvg.getFacade().ExecuteSCQueries(queries)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeMultipleQueries", err)
+		return
+	}
+
+	context.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"results": results, "blockInfo": blockInfo},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (vvg *vmValuesGroup) createSCQueries(context *gin.Context, request *VMValuesMultipleRequest) ([]*process.SCQuery, error) {
+	if len(request.Queries) == 0 {
+		return nil, errors.ErrNoQueriesProvided
+	}
+	if len(request.Queries) > maxNumQueriesInMultipleRequest {
+		return nil, fmt.Errorf("%w, provided %d, maximum %d", errors.ErrTooManyQueries, len(request.Queries), maxNumQueriesInMultipleRequest)
+	}
+
+	queries := make([]*process.SCQuery, 0, len(request.Queries))
+	for i, queryRequest := range request.Queries {
+		if queryRequest == nil {
+			return nil, fmt.Errorf("%w at index %d", errors.ErrInvalidJSONRequest, i)
+		}
+
+		query, err := vvg.createSCQuery(queryRequest)
+		if err != nil {
+			return nil, fmt.Errorf("query at index %d: %w", i, err)
+		}
+
+		err = addBlockCoordinatesToQuery(context, query)
+		if err != nil {
+			return nil, err
+		}
+
+		// all the queries are executed against the same state, so the sync requirement is defined once per request
+		query.ShouldBeSynced = request.ShouldBeSynced
+		queries = append(queries, query)
+	}
+
+	return queries, nil
+}
+
+func addBlockCoordinatesToQuery(context *gin.Context, query *process.SCQuery) error {
 	blockNonce, err := parseUint64UrlParam(context, urlParamBlockNonce)
 	if err != nil {
-		return core.OptionalUint64{}, nil, fmt.Errorf("%w for block nonce", err)
+		return fmt.Errorf("%w for block nonce", err)
 	}
 
 	blockHash, err := parseHexBytesUrlParam(context, urlParamBlockHash)
 	if err != nil {
-		return core.OptionalUint64{}, nil, fmt.Errorf("%w for block hash", err)
+		return fmt.Errorf("%w for block hash", err)
 	}
 
-	return blockNonce, blockHash, nil
+	blockRootHash, err := parseHexBytesUrlParam(context, urlParamBlockRootHash)
+	if err != nil {
+		return fmt.Errorf("%w for block root hash", err)
+	}
+
+	hintEpoch, err := parseUint32UrlParam(context, urlParamHintEpoch)
+	if err != nil {
+		return fmt.Errorf("%w for hint epoch", err)
+	}
+
+	err = checkAccountQueryOptions(apiData.AccountQueryOptions{
+		BlockNonce:    blockNonce,
+		BlockHash:     blockHash,
+		BlockRootHash: blockRootHash,
+		HintEpoch:     hintEpoch,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+
+	query.BlockNonce = blockNonce
+	query.BlockHash = blockHash
+	query.BlockRootHash = blockRootHash
+	query.HintEpoch = hintEpoch
+
+	return nil
 }
 
 func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQuery, error) {
-	decodedAddress, err := vvg.getFacade().DecodeAddressPubkey(request.ScAddress)
+	return createSCQuery(vvg.getFacade(), request)
+}
+
+type addressDecoder interface {
+	DecodeAddressPubkey(pk string) ([]byte, error)
+}
+
+func createSCQuery(decoder addressDecoder, request *VMValueRequest) (*process.SCQuery, error) {
+	decodedAddress, err := decoder.DecodeAddressPubkey(request.ScAddress)
 	if err != nil {
 		return nil, fmt.Errorf("'%s' is not a valid address: %s", request.ScAddress, err.Error())
 	}
@@ -199,7 +331,7 @@ func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQue
 	}
 
 	if len(request.CallerAddr) > 0 {
-		callerAddress, errDecodeCaller := vvg.getFacade().DecodeAddressPubkey(request.CallerAddr)
+		callerAddress, errDecodeCaller := decoder.DecodeAddressPubkey(request.CallerAddr)
 		if errDecodeCaller != nil {
 			return nil, errDecodeCaller
 		}
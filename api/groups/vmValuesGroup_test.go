@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -46,6 +47,11 @@ type simpleResponse struct {
 	Error string `json:"error"`
 }
 
+type multipleQueriesResponse struct {
+	Results   []*common.SCQueryApiResult `json:"results"`
+	BlockInfo api.BlockInfo              `json:"blockInfo"`
+}
+
 type vmOutputResponse struct {
 	Data      *vmcommon.VMOutput `json:"data"`
 	BlockInfo api.BlockInfo      `json:"blockInfo"`
@@ -146,6 +152,10 @@ func TestQuery(t *testing.T) {
 
 	t.Run("invalid block nonce should error", testQueryShouldError("/vm-values/query?blockNonce=invalid_nonce"))
 	t.Run("invalid block hash should error", testQueryShouldError("/vm-values/query?blockHash=invalid_nonce"))
+	t.Run("invalid block root hash should error", testQueryShouldError("/vm-values/query?blockRootHash=invalid_root_hash"))
+	t.Run("invalid hint epoch should error", testQueryShouldError("/vm-values/query?blockRootHash=aaaa&hintEpoch=invalid_epoch"))
+	t.Run("multiple block coordinates should error", testQueryShouldError("/vm-values/query?blockNonce=10&blockRootHash=aaaa"))
+	t.Run("hint epoch without block root hash should error", testQueryShouldError("/vm-values/query?blockNonce=10&hintEpoch=2"))
 	t.Run("should work - block nonce", func(t *testing.T) {
 		t.Parallel()
 
@@ -179,6 +189,26 @@ func TestQuery(t *testing.T) {
 		url := fmt.Sprintf("/vm-values/query?blockHash=%s", hex.EncodeToString(providedBlockHash))
 		testQueryShouldWork(t, url, &facade)
 	})
+	t.Run("should work - block root hash and hint epoch", func(t *testing.T) {
+		t.Parallel()
+
+		providedBlockRootHash := []byte("provided root hash")
+		providedHintEpoch := core.OptionalUint32{
+			Value:    7,
+			HasValue: true,
+		}
+		facade := mock.FacadeStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+				require.Equal(t, providedBlockRootHash, query.BlockRootHash)
+				require.Equal(t, providedHintEpoch, query.HintEpoch)
+				return &vm.VMOutputApi{
+					ReturnData: [][]byte{big.NewInt(42).Bytes()},
+				}, api.BlockInfo{}, nil
+			},
+		}
+		url := fmt.Sprintf("/vm-values/query?blockRootHash=%s&hintEpoch=%d", hex.EncodeToString(providedBlockRootHash), providedHintEpoch.Value)
+		testQueryShouldWork(t, url, &facade)
+	})
 	t.Run("should work - no block coordinates", func(t *testing.T) {
 		t.Parallel()
 
@@ -211,6 +241,169 @@ func TestQuery(t *testing.T) {
 	})
 }
 
+func TestQueryMultiple(t *testing.T) {
+	t.Parallel()
+
+	validQuery := &groups.VMValueRequest{
+		ScAddress: dummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	t.Run("invalid json should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := &shared.GenericAPIResponse{}
+		statusCode := doPostMultiple(t, &mock.FacadeStub{}, "/vm-values/query-multiple", []byte("invalid"), response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrInvalidJSONRequest.Error())
+	})
+	t.Run("no queries should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := &shared.GenericAPIResponse{}
+		request := groups.VMValuesMultipleRequest{}
+		statusCode := doPostMultiple(t, &mock.FacadeStub{}, "/vm-values/query-multiple", request, response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrNoQueriesProvided.Error())
+	})
+	t.Run("too many queries should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := &shared.GenericAPIResponse{}
+		request := groups.VMValuesMultipleRequest{
+			Queries: make([]*groups.VMValueRequest, 101),
+		}
+		for i := range request.Queries {
+			request.Queries[i] = validQuery
+		}
+		statusCode := doPostMultiple(t, &mock.FacadeStub{}, "/vm-values/query-multiple", request, response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrTooManyQueries.Error())
+	})
+	t.Run("invalid query should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := &shared.GenericAPIResponse{}
+		request := groups.VMValuesMultipleRequest{
+			Queries: []*groups.VMValueRequest{
+				validQuery,
+				{
+					ScAddress: dummyScAddress,
+					FuncName:  "function",
+					Args:      []string{"not hex"},
+				},
+			},
+		}
+		statusCode := doPostMultiple(t, &mock.FacadeStub{}, "/vm-values/query-multiple", request, response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, "query at index 1")
+	})
+	t.Run("invalid block coordinates should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := &shared.GenericAPIResponse{}
+		request := groups.VMValuesMultipleRequest{
+			Queries: []*groups.VMValueRequest{validQuery},
+		}
+		statusCode := doPostMultiple(t, &mock.FacadeStub{}, "/vm-values/query-multiple?blockNonce=1&blockHash=aaaa", request, response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*common.SCQueryApiResult, api.BlockInfo, error) {
+				return nil, api.BlockInfo{}, expectedErr
+			},
+		}
+		response := &shared.GenericAPIResponse{}
+		request := groups.VMValuesMultipleRequest{
+			Queries: []*groups.VMValueRequest{validQuery},
+		}
+		statusCode := doPostMultiple(t, facade, "/vm-values/query-multiple", request, response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedBlockNonce := uint64(37)
+		providedBlockInfo := api.BlockInfo{
+			Nonce:    providedBlockNonce,
+			Hash:     "provided hash",
+			RootHash: "provided root hash",
+		}
+		providedResults := []*common.SCQueryApiResult{
+			{
+				Data: &vm.VMOutputApi{
+					ReturnData: [][]byte{big.NewInt(42).Bytes()},
+				},
+			},
+			{
+				Error: "execution error",
+			},
+		}
+		facade := &mock.FacadeStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*common.SCQueryApiResult, api.BlockInfo, error) {
+				require.Equal(t, 2, len(queries))
+				for _, query := range queries {
+					require.Equal(t, providedBlockNonce, query.BlockNonce.Value)
+					require.True(t, query.ShouldBeSynced)
+				}
+				require.Equal(t, "function", queries[0].FuncName)
+				require.Equal(t, "other function", queries[1].FuncName)
+
+				return providedResults, providedBlockInfo, nil
+			},
+		}
+		request := groups.VMValuesMultipleRequest{
+			Queries: []*groups.VMValueRequest{
+				validQuery,
+				{
+					ScAddress: dummyScAddress,
+					FuncName:  "other function",
+				},
+			},
+			ShouldBeSynced: true,
+		}
+
+		apiResponse := &shared.GenericAPIResponse{}
+		url := fmt.Sprintf("/vm-values/query-multiple?blockNonce=%d", providedBlockNonce)
+		statusCode := doPostMultiple(t, facade, url, request, apiResponse)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Empty(t, apiResponse.Error)
+
+		response := multipleQueriesResponse{}
+		responseDataBytes, _ := json.Marshal(apiResponse.Data)
+		_ = json.Unmarshal(responseDataBytes, &response)
+		require.Equal(t, providedResults, response.Results)
+		require.Equal(t, providedBlockInfo, response.BlockInfo)
+	})
+}
+
+func doPostMultiple(t *testing.T, facade *mock.FacadeStub, url string, request interface{}, response *shared.GenericAPIResponse) int {
+	requestAsBytes, ok := request.([]byte)
+	if !ok {
+		requestAsBytes, _ = json.Marshal(request)
+	}
+
+	group, err := groups.NewVmValuesGroup(facade)
+	require.NoError(t, err)
+
+	server := startWebServer(group, "vm-values", getVmValuesRoutesConfig())
+
+	httpRequest, _ := http.NewRequest("POST", url, bytes.NewBuffer(requestAsBytes))
+
+	responseRecorder := httptest.NewRecorder()
+	server.ServeHTTP(responseRecorder, httpRequest)
+	loadResponse(responseRecorder.Body, response)
+
+	return responseRecorder.Code
+}
+
 func testQueryShouldWork(t *testing.T, url string, facade shared.FacadeHandler) {
 	request := groups.VMValueRequest{
 		ScAddress: dummyScAddress,
@@ -581,6 +774,7 @@ func getVmValuesRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/string", Open: true},
 					{Name: "/int", Open: true},
 					{Name: "/query", Open: true},
+					{Name: "/query-multiple", Open: true},
 				},
 			},
 		},
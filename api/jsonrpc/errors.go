@@ -0,0 +1,82 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+// The error codes defined by the JSON-RPC 2.0 specification
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+)
+
+// The implementation defined error codes, mapped on the return codes of the REST API
+const (
+	CodeServerError   = -32000
+	CodeLimitExceeded = -32005
+)
+
+// ErrNilThrottlerProvider signals that a nil throttler provider has been provided
+var ErrNilThrottlerProvider = errors.New("nil throttler provider")
+
+// ErrEmptyMethodName signals that an empty method name has been provided
+var ErrEmptyMethodName = errors.New("empty method name")
+
+// ErrNilMethodHandler signals that a nil method handler has been provided
+var ErrNilMethodHandler = errors.New("nil method handler")
+
+// ErrInvalidMaxBatchSize signals that an invalid maximum batch size has been provided
+var ErrInvalidMaxBatchSize = errors.New("invalid maximum batch size")
+
+// Error is the error object of a JSON-RPC response. The data field holds the return code the REST API would have
+// responded with for the same failure
+type Error struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// Error returns the error message
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+// NewInvalidParamsError creates the error returned when the method parameters are not valid
+func NewInvalidParamsError(message string) *Error {
+	return &Error{
+		Code:    CodeInvalidParams,
+		Message: message,
+		Data:    shared.ReturnCodeRequestError,
+	}
+}
+
+// NewServerError creates the error returned when the method call failed
+func NewServerError(message string) *Error {
+	return &Error{
+		Code:    CodeServerError,
+		Message: message,
+		Data:    shared.ReturnCodeInternalError,
+	}
+}
+
+// NewLimitExceededError creates the error returned when the method call was throttled
+func NewLimitExceededError(message string) *Error {
+	return &Error{
+		Code:    CodeLimitExceeded,
+		Message: message,
+		Data:    shared.ReturnCodeSystemBusy,
+	}
+}
+
+func newError(code int, message string) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+	}
+}
@@ -0,0 +1,70 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// UnmarshalParams decodes the method parameters in the provided struct pointer. The parameters can be provided
+// either by name, as an object, or by position, as an array holding the values in the order of the struct fields.
+// Missing parameters keep their zero values
+func UnmarshalParams(params json.RawMessage, target interface{}) *Error {
+	trimmed := bytes.TrimSpace(params)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+
+	if trimmed[0] != '[' {
+		decoder := json.NewDecoder(bytes.NewReader(trimmed))
+		decoder.DisallowUnknownFields()
+		err := decoder.Decode(target)
+		if err != nil {
+			return NewInvalidParamsError(err.Error())
+		}
+
+		return nil
+	}
+
+	var positional []json.RawMessage
+	err := json.Unmarshal(trimmed, &positional)
+	if err != nil {
+		return NewInvalidParamsError(err.Error())
+	}
+
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return newError(CodeInternalError, "positional parameters need a struct target")
+	}
+
+	fields := paramFields(value.Elem())
+	if len(positional) > len(fields) {
+		return NewInvalidParamsError(fmt.Sprintf("too many parameters: expected at most %d, got %d", len(fields), len(positional)))
+	}
+
+	for i, rawValue := range positional {
+		err = json.Unmarshal(rawValue, fields[i].Addr().Interface())
+		if err != nil {
+			return NewInvalidParamsError(fmt.Sprintf("parameter %d: %s", i, err.Error()))
+		}
+	}
+
+	return nil
+}
+
+func paramFields(value reflect.Value) []reflect.Value {
+	fields := make([]reflect.Value, 0, value.NumField())
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+
+		fields = append(fields, value.Field(i))
+	}
+
+	return fields
+}
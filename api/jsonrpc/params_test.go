@@ -0,0 +1,83 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testParams struct {
+	Hash        string  `json:"hash"`
+	Nonce       *uint64 `json:"nonce"`
+	WithResults bool    `json:"withResults"`
+	Ignored     string  `json:"-"`
+	unexported  bool
+}
+
+func TestUnmarshalParams(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing params should keep the zero values", func(t *testing.T) {
+		t.Parallel()
+
+		params := testParams{}
+		require.Nil(t, UnmarshalParams(nil, &params))
+		require.Nil(t, UnmarshalParams(json.RawMessage(" null "), &params))
+		assert.Equal(t, testParams{}, params)
+	})
+	t.Run("named params should work", func(t *testing.T) {
+		t.Parallel()
+
+		params := testParams{}
+		rpcErr := UnmarshalParams(json.RawMessage(`{"hash":"aa","nonce":7}`), &params)
+		require.Nil(t, rpcErr)
+		assert.Equal(t, "aa", params.Hash)
+		assert.Equal(t, uint64(7), *params.Nonce)
+		assert.False(t, params.WithResults)
+	})
+	t.Run("unknown named param should error", func(t *testing.T) {
+		t.Parallel()
+
+		params := testParams{}
+		rpcErr := UnmarshalParams(json.RawMessage(`{"hash":"aa","other":1}`), &params)
+		require.NotNil(t, rpcErr)
+		assert.Equal(t, CodeInvalidParams, rpcErr.Code)
+	})
+	t.Run("positional params should work", func(t *testing.T) {
+		t.Parallel()
+
+		params := testParams{}
+		rpcErr := UnmarshalParams(json.RawMessage(`["aa", 7, true]`), &params)
+		require.Nil(t, rpcErr)
+		assert.Equal(t, "aa", params.Hash)
+		assert.Equal(t, uint64(7), *params.Nonce)
+		assert.True(t, params.WithResults)
+	})
+	t.Run("too many positional params should error", func(t *testing.T) {
+		t.Parallel()
+
+		params := testParams{}
+		rpcErr := UnmarshalParams(json.RawMessage(`["aa", 7, true, "x"]`), &params)
+		require.NotNil(t, rpcErr)
+		assert.Equal(t, CodeInvalidParams, rpcErr.Code)
+	})
+	t.Run("positional param of wrong type should error", func(t *testing.T) {
+		t.Parallel()
+
+		params := testParams{}
+		rpcErr := UnmarshalParams(json.RawMessage(`[7]`), &params)
+		require.NotNil(t, rpcErr)
+		assert.Equal(t, CodeInvalidParams, rpcErr.Code)
+		assert.Contains(t, rpcErr.Message, "parameter 0")
+	})
+	t.Run("positional params on a non struct target should error", func(t *testing.T) {
+		t.Parallel()
+
+		var params []string
+		rpcErr := UnmarshalParams(json.RawMessage(`["aa"]`), &params)
+		require.NotNil(t, rpcErr)
+		assert.Equal(t, CodeInternalError, rpcErr.Code)
+	})
+}
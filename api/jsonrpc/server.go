@@ -0,0 +1,205 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("api/jsonrpc")
+
+// ThrottlerProvider returns the endpoint throttlers shared with the REST API
+type ThrottlerProvider interface {
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+// ArgsServer holds the arguments needed for creating a JSON-RPC server
+type ArgsServer struct {
+	Methods           map[string]Method
+	ThrottlerProvider ThrottlerProvider
+	MaxBatchSize      int
+}
+
+type server struct {
+	methods           map[string]Method
+	throttlerProvider ThrottlerProvider
+	maxBatchSize      int
+}
+
+// NewServer creates a transport agnostic JSON-RPC 2.0 server, dispatching the single and the batch requests to the
+// registered methods
+func NewServer(args ArgsServer) (*server, error) {
+	if check.IfNil(args.ThrottlerProvider) {
+		return nil, ErrNilThrottlerProvider
+	}
+	if args.MaxBatchSize < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMaxBatchSize, args.MaxBatchSize)
+	}
+
+	methods := make(map[string]Method, len(args.Methods))
+	for name, method := range args.Methods {
+		if len(name) == 0 {
+			return nil, ErrEmptyMethodName
+		}
+		if method.Handler == nil {
+			return nil, fmt.Errorf("%w for method %s", ErrNilMethodHandler, name)
+		}
+
+		methods[name] = method
+	}
+
+	return &server{
+		methods:           methods,
+		throttlerProvider: args.ThrottlerProvider,
+		maxBatchSize:      args.MaxBatchSize,
+	}, nil
+}
+
+// HandleMessage processes a JSON-RPC message, holding either a single request or a batch of requests, and returns
+// the encoded response. It returns nil if there is nothing to respond, as for the notifications. Each request is
+// charged through the provided rate limit charger, if any, with the cost of the REST route of its method
+func (s *server) HandleMessage(message []byte, charger shared.RateLimitCharger) []byte {
+	trimmed := bytes.TrimSpace(message)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		return s.handleBatch(trimmed, charger)
+	}
+
+	var request Request
+	err := json.Unmarshal(trimmed, &request)
+	if err != nil {
+		return encodeResponse(errorResponse(nil, newError(CodeParseError, err.Error())))
+	}
+
+	response := s.handleRequest(&request, charger)
+	if response == nil {
+		return nil
+	}
+
+	return encodeResponse(response)
+}
+
+func (s *server) handleBatch(message []byte, charger shared.RateLimitCharger) []byte {
+	var rawRequests []json.RawMessage
+	err := json.Unmarshal(message, &rawRequests)
+	if err != nil {
+		return encodeResponse(errorResponse(nil, newError(CodeParseError, err.Error())))
+	}
+	if len(rawRequests) == 0 {
+		return encodeResponse(errorResponse(nil, newError(CodeInvalidRequest, "empty batch")))
+	}
+	if len(rawRequests) > s.maxBatchSize {
+		message := fmt.Sprintf("batch too large: maximum %d requests, got %d", s.maxBatchSize, len(rawRequests))
+		return encodeResponse(errorResponse(nil, newError(CodeInvalidRequest, message)))
+	}
+
+	responses := make([]*Response, 0, len(rawRequests))
+	for _, rawRequest := range rawRequests {
+		var request Request
+		err = json.Unmarshal(rawRequest, &request)
+		if err != nil {
+			responses = append(responses, errorResponse(nil, newError(CodeInvalidRequest, err.Error())))
+			continue
+		}
+
+		response := s.handleRequest(&request, charger)
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+
+	if len(responses) == 0 {
+		return nil
+	}
+
+	return encodeResponse(responses)
+}
+
+func (s *server) handleRequest(request *Request, charger shared.RateLimitCharger) *Response {
+	isNotification := len(request.ID) == 0
+	if request.JSONRPC != Version || len(request.Method) == 0 {
+		// invalid requests are answered even if they have no id, as they can not be trusted to be notifications
+		return errorResponse(request.ID, newError(CodeInvalidRequest, "invalid JSON-RPC 2.0 request"))
+	}
+
+	result, rpcErr := s.callMethod(request, charger)
+	if isNotification {
+		return nil
+	}
+	if rpcErr != nil {
+		return errorResponse(request.ID, rpcErr)
+	}
+
+	resultBytes, err := json.Marshal(result)
+	if err != nil {
+		return errorResponse(request.ID, newError(CodeInternalError, err.Error()))
+	}
+
+	return &Response{
+		JSONRPC: Version,
+		ID:      request.ID,
+		Result:  resultBytes,
+	}
+}
+
+func (s *server) callMethod(request *Request, charger shared.RateLimitCharger) (interface{}, *Error) {
+	// the unknown methods are charged as well, with the default cost
+	method, found := s.methods[request.Method]
+	if !check.IfNil(charger) {
+		err := charger.Charge(method.RateLimitRoute)
+		if err != nil {
+			return nil, NewLimitExceededError(fmt.Sprintf("%s for method %s", err.Error(), request.Method))
+		}
+	}
+
+	if !found {
+		return nil, newError(CodeMethodNotFound, fmt.Sprintf("method %s not found", request.Method))
+	}
+
+	if len(method.ThrottlerName) > 0 {
+		throttler, hasThrottler := s.throttlerProvider.GetThrottlerForEndpoint(method.ThrottlerName)
+		if hasThrottler {
+			if !throttler.CanProcess() {
+				return nil, NewLimitExceededError(fmt.Sprintf("%s for method %s", errors.ErrTooManyRequests.Error(), request.Method))
+			}
+
+			throttler.StartProcessing()
+			defer throttler.EndProcessing()
+		}
+	}
+
+	return method.Handler(request.Params)
+}
+
+func errorResponse(id json.RawMessage, rpcErr *Error) *Response {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+
+	return &Response{
+		JSONRPC: Version,
+		ID:      id,
+		Error:   rpcErr,
+	}
+}
+
+func encodeResponse(response interface{}) []byte {
+	responseBytes, err := json.Marshal(response)
+	if err != nil {
+		log.Warn("jsonrpc server: cannot encode response", "error", err)
+		return nil
+	}
+
+	return responseBytes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *server) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,289 @@
+package jsonrpc_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/jsonrpc"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type echoParams struct {
+	Value string `json:"value"`
+}
+
+func createMockArgsServer() jsonrpc.ArgsServer {
+	return jsonrpc.ArgsServer{
+		Methods: map[string]jsonrpc.Method{
+			"echo": {
+				Handler: func(params json.RawMessage) (interface{}, *jsonrpc.Error) {
+					echo := echoParams{}
+					rpcErr := jsonrpc.UnmarshalParams(params, &echo)
+					if rpcErr != nil {
+						return nil, rpcErr
+					}
+
+					return echo.Value, nil
+				},
+				ThrottlerName:  "/echo",
+				RateLimitRoute: "/echo-route",
+			},
+			"null": {
+				Handler: func(params json.RawMessage) (interface{}, *jsonrpc.Error) {
+					return nil, nil
+				},
+			},
+			"fail": {
+				Handler: func(params json.RawMessage) (interface{}, *jsonrpc.Error) {
+					return nil, jsonrpc.NewServerError("failure")
+				},
+			},
+		},
+		ThrottlerProvider: &mock.FacadeStub{},
+		MaxBatchSize:      3,
+	}
+}
+
+func decodeResponse(t *testing.T, message []byte) map[string]interface{} {
+	response := make(map[string]interface{})
+	require.Nil(t, json.Unmarshal(message, &response))
+
+	return response
+}
+
+func decodeBatchResponse(t *testing.T, message []byte) []map[string]interface{} {
+	responses := make([]map[string]interface{}, 0)
+	require.Nil(t, json.Unmarshal(message, &responses))
+
+	return responses
+}
+
+func errorCode(response map[string]interface{}) int {
+	rpcErr, ok := response["error"].(map[string]interface{})
+	if !ok {
+		return 0
+	}
+
+	return int(rpcErr["code"].(float64))
+}
+
+func TestNewServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil throttler provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsServer()
+		args.ThrottlerProvider = nil
+		s, err := jsonrpc.NewServer(args)
+		assert.True(t, check.IfNil(s))
+		assert.Equal(t, jsonrpc.ErrNilThrottlerProvider, err)
+	})
+	t.Run("invalid max batch size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsServer()
+		args.MaxBatchSize = 0
+		s, err := jsonrpc.NewServer(args)
+		assert.True(t, check.IfNil(s))
+		assert.True(t, errors.Is(err, jsonrpc.ErrInvalidMaxBatchSize))
+	})
+	t.Run("empty method name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsServer()
+		args.Methods[""] = args.Methods["echo"]
+		s, err := jsonrpc.NewServer(args)
+		assert.True(t, check.IfNil(s))
+		assert.Equal(t, jsonrpc.ErrEmptyMethodName, err)
+	})
+	t.Run("nil method handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsServer()
+		args.Methods["nil"] = jsonrpc.Method{}
+		s, err := jsonrpc.NewServer(args)
+		assert.True(t, check.IfNil(s))
+		assert.True(t, errors.Is(err, jsonrpc.ErrNilMethodHandler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := jsonrpc.NewServer(createMockArgsServer())
+		assert.False(t, check.IfNil(s))
+		assert.Nil(t, err)
+	})
+}
+
+func TestServer_HandleMessage(t *testing.T) {
+	t.Parallel()
+
+	s, err := jsonrpc.NewServer(createMockArgsServer())
+	require.Nil(t, err)
+
+	t.Run("parse error", func(t *testing.T) {
+		t.Parallel()
+
+		response := decodeResponse(t, s.HandleMessage([]byte(`{"jsonrpc":`), nil))
+		assert.Equal(t, jsonrpc.CodeParseError, errorCode(response))
+		assert.Nil(t, response["id"])
+	})
+	t.Run("invalid version", func(t *testing.T) {
+		t.Parallel()
+
+		response := decodeResponse(t, s.HandleMessage([]byte(`{"jsonrpc":"1.0","id":1,"method":"echo"}`), nil))
+		assert.Equal(t, jsonrpc.CodeInvalidRequest, errorCode(response))
+		assert.Equal(t, float64(1), response["id"])
+	})
+	t.Run("method not found", func(t *testing.T) {
+		t.Parallel()
+
+		response := decodeResponse(t, s.HandleMessage([]byte(`{"jsonrpc":"2.0","id":"a","method":"missing"}`), nil))
+		assert.Equal(t, jsonrpc.CodeMethodNotFound, errorCode(response))
+		assert.Equal(t, "a", response["id"])
+	})
+	t.Run("method error should carry the REST return code", func(t *testing.T) {
+		t.Parallel()
+
+		response := decodeResponse(t, s.HandleMessage([]byte(`{"jsonrpc":"2.0","id":1,"method":"fail"}`), nil))
+		assert.Equal(t, jsonrpc.CodeServerError, errorCode(response))
+		assert.Equal(t, string(shared.ReturnCodeInternalError), response["error"].(map[string]interface{})["data"])
+		_, hasResult := response["result"]
+		assert.False(t, hasResult)
+	})
+	t.Run("successful call", func(t *testing.T) {
+		t.Parallel()
+
+		response := decodeResponse(t, s.HandleMessage([]byte(`{"jsonrpc":"2.0","id":1,"method":"echo","params":["hello"]}`), nil))
+		assert.Equal(t, "2.0", response["jsonrpc"])
+		assert.Equal(t, "hello", response["result"])
+		_, hasError := response["error"]
+		assert.False(t, hasError)
+	})
+	t.Run("null result should be present in the response", func(t *testing.T) {
+		t.Parallel()
+
+		response := decodeResponse(t, s.HandleMessage([]byte(`{"jsonrpc":"2.0","id":1,"method":"null"}`), nil))
+		result, hasResult := response["result"]
+		assert.True(t, hasResult)
+		assert.Nil(t, result)
+	})
+	t.Run("notification should not be answered", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, s.HandleMessage([]byte(`{"jsonrpc":"2.0","method":"echo","params":{"value":"x"}}`), nil))
+		assert.Nil(t, s.HandleMessage([]byte(`{"jsonrpc":"2.0","method":"fail"}`), nil))
+	})
+	t.Run("empty batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := decodeResponse(t, s.HandleMessage([]byte(`[]`), nil))
+		assert.Equal(t, jsonrpc.CodeInvalidRequest, errorCode(response))
+	})
+	t.Run("too large batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		request := `{"jsonrpc":"2.0","id":1,"method":"null"}`
+		response := decodeResponse(t, s.HandleMessage([]byte("["+request+","+request+","+request+","+request+"]"), nil))
+		assert.Equal(t, jsonrpc.CodeInvalidRequest, errorCode(response))
+	})
+	t.Run("batch should answer each request, except the notifications", func(t *testing.T) {
+		t.Parallel()
+
+		message := `[
+			{"jsonrpc":"2.0","id":1,"method":"echo","params":{"value":"a"}},
+			{"jsonrpc":"2.0","method":"echo","params":{"value":"b"}},
+			1
+		]`
+		responses := decodeBatchResponse(t, s.HandleMessage([]byte(message), nil))
+		require.Len(t, responses, 2)
+		assert.Equal(t, "a", responses[0]["result"])
+		assert.Equal(t, jsonrpc.CodeInvalidRequest, errorCode(responses[1]))
+	})
+	t.Run("batch of notifications should not be answered", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, s.HandleMessage([]byte(`[{"jsonrpc":"2.0","method":"null"}]`), nil))
+	})
+}
+
+func TestServer_HandleMessageShouldApplyTheEndpointThrottlers(t *testing.T) {
+	t.Parallel()
+
+	canProcess := false
+	throttler := &mock.ThrottlerStub{
+		CanProcessCalled: func() bool {
+			return canProcess
+		},
+	}
+	args := createMockArgsServer()
+	args.ThrottlerProvider = &mock.FacadeStub{
+		GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+			return throttler, endpoint == "/echo"
+		},
+	}
+	s, err := jsonrpc.NewServer(args)
+	require.Nil(t, err)
+
+	response := decodeResponse(t, s.HandleMessage([]byte(`{"jsonrpc":"2.0","id":1,"method":"echo"}`), nil))
+	assert.Equal(t, jsonrpc.CodeLimitExceeded, errorCode(response))
+	assert.Equal(t, string(shared.ReturnCodeSystemBusy), response["error"].(map[string]interface{})["data"])
+	assert.False(t, throttler.StartWasCalled)
+
+	response = decodeResponse(t, s.HandleMessage([]byte(`{"jsonrpc":"2.0","id":1,"method":"null"}`), nil))
+	assert.Equal(t, 0, errorCode(response))
+
+	canProcess = true
+	response = decodeResponse(t, s.HandleMessage([]byte(`{"jsonrpc":"2.0","id":1,"method":"echo"}`), nil))
+	assert.Equal(t, 0, errorCode(response))
+	assert.True(t, throttler.StartWasCalled)
+	assert.True(t, throttler.EndWasCalled)
+}
+
+type rateLimitChargerStub struct {
+	chargedRoutes []string
+	maxCharges    int
+}
+
+func (stub *rateLimitChargerStub) Charge(route string) error {
+	if len(stub.chargedRoutes) >= stub.maxCharges {
+		return errors.New("too many requests")
+	}
+
+	stub.chargedRoutes = append(stub.chargedRoutes, route)
+	return nil
+}
+
+func (stub *rateLimitChargerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestServer_HandleMessageShouldChargeEachRequest(t *testing.T) {
+	t.Parallel()
+
+	s, err := jsonrpc.NewServer(createMockArgsServer())
+	require.Nil(t, err)
+
+	charger := &rateLimitChargerStub{maxCharges: 3}
+	message := `[
+		{"jsonrpc":"2.0","id":1,"method":"echo","params":{"value":"a"}},
+		{"jsonrpc":"2.0","id":2,"method":"missing"},
+		{"jsonrpc":"2.0","method":"null"}
+	]`
+	responses := decodeBatchResponse(t, s.HandleMessage([]byte(message), charger))
+	require.Len(t, responses, 2)
+	assert.Equal(t, "a", responses[0]["result"])
+	assert.Equal(t, jsonrpc.CodeMethodNotFound, errorCode(responses[1]))
+	assert.Equal(t, []string{"/echo-route", "", ""}, charger.chargedRoutes)
+
+	response := decodeResponse(t, s.HandleMessage([]byte(`{"jsonrpc":"2.0","id":1,"method":"echo","params":{"value":"b"}}`), charger))
+	assert.Equal(t, jsonrpc.CodeLimitExceeded, errorCode(response))
+	assert.Equal(t, string(shared.ReturnCodeSystemBusy), response["error"].(map[string]interface{})["data"])
+	assert.Equal(t, 3, len(charger.chargedRoutes))
+}
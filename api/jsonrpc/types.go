@@ -0,0 +1,33 @@
+package jsonrpc
+
+import "encoding/json"
+
+// Version is the only JSON-RPC protocol version supported
+const Version = "2.0"
+
+// Request is a JSON-RPC request. A request without an id is a notification and receives no response
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// Response is a JSON-RPC response, holding either the result or the error of a request
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// MethodHandler executes a method call with the provided raw parameters
+type MethodHandler func(params json.RawMessage) (interface{}, *Error)
+
+// Method holds the handler of a method, the name of the endpoint throttler applied on its calls, if any, and the
+// REST route whose rate limiting cost is charged for each call
+type Method struct {
+	Handler        MethodHandler
+	ThrottlerName  string
+	RateLimitRoute string
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+const forwardedForHeader = "X-Forwarded-For"
+
+type clientIPResolver struct {
+	trustedProxies []*net.IPNet
+}
+
+// NewClientIPResolver creates a component that finds the IP of the client that originated a request. The
+// X-Forwarded-For header is taken into account only for requests received from one of the trusted proxies, which can
+// be provided either as IPs or as CIDR ranges
+func NewClientIPResolver(trustedProxies []string) (*clientIPResolver, error) {
+	resolver := &clientIPResolver{
+		trustedProxies: make([]*net.IPNet, 0, len(trustedProxies)),
+	}
+
+	for _, proxy := range trustedProxies {
+		ipNet, err := parseTrustedProxy(proxy)
+		if err != nil {
+			return nil, err
+		}
+
+		resolver.trustedProxies = append(resolver.trustedProxies, ipNet)
+	}
+
+	return resolver, nil
+}
+
+func parseTrustedProxy(proxy string) (*net.IPNet, error) {
+	proxy = strings.TrimSpace(proxy)
+	if strings.Contains(proxy, "/") {
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTrustedProxy, proxy)
+		}
+
+		return ipNet, nil
+	}
+
+	ip := net.ParseIP(proxy)
+	if ip == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTrustedProxy, proxy)
+	}
+
+	bits := 8 * net.IPv6len
+	if ip.To4() != nil {
+		ip = ip.To4()
+		bits = 8 * net.IPv4len
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+// ClientIP returns the IP of the client that originated the request. If the request was received from a trusted
+// proxy, the X-Forwarded-For addresses are walked from right to left and the first one that is not a trusted proxy
+// is returned
+func (resolver *clientIPResolver) ClientIP(request *http.Request) (string, error) {
+	remoteAddr, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return "", err
+	}
+
+	if !resolver.isTrustedProxy(remoteAddr) {
+		return remoteAddr, nil
+	}
+
+	forwardedFor := make([]string, 0)
+	for _, headerValue := range request.Header.Values(forwardedForHeader) {
+		forwardedFor = append(forwardedFor, strings.Split(headerValue, ",")...)
+	}
+
+	clientIP := remoteAddr
+	for i := len(forwardedFor) - 1; i >= 0; i-- {
+		address := strings.TrimSpace(forwardedFor[i])
+		if net.ParseIP(address) == nil {
+			// an invalid entry can not be trusted, so the last valid hop is considered the client
+			break
+		}
+
+		clientIP = address
+		if !resolver.isTrustedProxy(address) {
+			break
+		}
+	}
+
+	return clientIP, nil
+}
+
+func (resolver *clientIPResolver) isTrustedProxy(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, ipNet := range resolver.trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (resolver *clientIPResolver) IsInterfaceNil() bool {
+	return resolver == nil
+}
@@ -0,0 +1,107 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRequest(remoteAddr string, forwardedFor ...string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/node/status", nil)
+	req.RemoteAddr = remoteAddr
+	for _, value := range forwardedFor {
+		req.Header.Add("X-Forwarded-For", value)
+	}
+
+	return req
+}
+
+func TestNewClientIPResolver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid IP should error", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, err := middleware.NewClientIPResolver([]string{"10.0.0.1", "10.0.0.256"})
+		assert.True(t, check.IfNil(resolver))
+		assert.True(t, errors.Is(err, middleware.ErrInvalidTrustedProxy))
+	})
+	t.Run("invalid CIDR should error", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, err := middleware.NewClientIPResolver([]string{"10.0.0.0/33"})
+		assert.True(t, check.IfNil(resolver))
+		assert.True(t, errors.Is(err, middleware.ErrInvalidTrustedProxy))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, err := middleware.NewClientIPResolver([]string{"10.0.0.1", "192.168.0.0/16", "::1", "fd00::/8"})
+		assert.False(t, check.IfNil(resolver))
+		assert.Nil(t, err)
+	})
+}
+
+func TestClientIPResolver_ClientIP(t *testing.T) {
+	t.Parallel()
+
+	resolver, err := middleware.NewClientIPResolver([]string{"10.0.0.1", "192.168.0.0/16"})
+	require.Nil(t, err)
+
+	t.Run("invalid remote address should error", func(t *testing.T) {
+		t.Parallel()
+
+		clientIP, errResolve := resolver.ClientIP(createRequest("10.0.0.1"))
+		assert.NotNil(t, errResolve)
+		assert.Empty(t, clientIP)
+	})
+	t.Run("untrusted remote address should ignore the forwarded header", func(t *testing.T) {
+		t.Parallel()
+
+		clientIP, errResolve := resolver.ClientIP(createRequest("1.2.3.4:1000", "5.6.7.8"))
+		assert.Nil(t, errResolve)
+		assert.Equal(t, "1.2.3.4", clientIP)
+	})
+	t.Run("trusted proxy without forwarded header should return the proxy", func(t *testing.T) {
+		t.Parallel()
+
+		clientIP, errResolve := resolver.ClientIP(createRequest("10.0.0.1:1000"))
+		assert.Nil(t, errResolve)
+		assert.Equal(t, "10.0.0.1", clientIP)
+	})
+	t.Run("trusted proxy should return the first untrusted address from the right", func(t *testing.T) {
+		t.Parallel()
+
+		// the first address was set by the client, so it can not be trusted
+		clientIP, errResolve := resolver.ClientIP(createRequest("10.0.0.1:1000", "9.9.9.9, 5.6.7.8", "192.168.1.1"))
+		assert.Nil(t, errResolve)
+		assert.Equal(t, "5.6.7.8", clientIP)
+	})
+	t.Run("only trusted proxies should return the leftmost one", func(t *testing.T) {
+		t.Parallel()
+
+		clientIP, errResolve := resolver.ClientIP(createRequest("10.0.0.1:1000", "192.168.1.2, 192.168.1.1"))
+		assert.Nil(t, errResolve)
+		assert.Equal(t, "192.168.1.2", clientIP)
+	})
+	t.Run("invalid forwarded entry should stop at the last valid hop", func(t *testing.T) {
+		t.Parallel()
+
+		clientIP, errResolve := resolver.ClientIP(createRequest("10.0.0.1:1000", "5.6.7.8, not-an-ip, 192.168.1.1"))
+		assert.Nil(t, errResolve)
+		assert.Equal(t, "192.168.1.1", clientIP)
+	})
+	t.Run("IPv6 remote address should work", func(t *testing.T) {
+		t.Parallel()
+
+		clientIP, errResolve := resolver.ClientIP(createRequest("[2001:db8::1]:1000"))
+		assert.Nil(t, errResolve)
+		assert.Equal(t, "2001:db8::1", clientIP)
+	})
+}
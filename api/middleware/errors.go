@@ -7,3 +7,27 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrNilClientIPResolver signals that a nil client IP resolver has been provided
+var ErrNilClientIPResolver = errors.New("nil client IP resolver")
+
+// ErrInvalidTrustedProxy signals that a trusted proxy is neither a valid IP nor a valid CIDR
+var ErrInvalidTrustedProxy = errors.New("invalid trusted proxy")
+
+// ErrInvalidRequestsPerSecond signals that an invalid number of requests per second has been provided
+var ErrInvalidRequestsPerSecond = errors.New("invalid requests per second value")
+
+// ErrInvalidBurst signals that an invalid burst value has been provided
+var ErrInvalidBurst = errors.New("invalid burst value")
+
+// ErrEmptyAPIKeyHeader signals that API keys were configured without the header holding them
+var ErrEmptyAPIKeyHeader = errors.New("empty API key header")
+
+// ErrEmptyAPIKey signals that an empty API key has been provided
+var ErrEmptyAPIKey = errors.New("empty API key")
+
+// ErrDuplicatedAPIKey signals that the same API key was configured more than once
+var ErrDuplicatedAPIKey = errors.New("duplicated API key")
+
+// ErrInvalidRouteCost signals that a route cost is zero or higher than the capacity of a bucket
+var ErrInvalidRouteCost = errors.New("invalid route cost")
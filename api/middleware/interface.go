@@ -0,0 +1,9 @@
+package middleware
+
+import "net/http"
+
+// ClientIPResolver defines the component able to find the IP of the client that originated a request
+type ClientIPResolver interface {
+	ClientIP(request *http.Request) (string, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,270 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+const (
+	defaultRouteCost = uint32(1)
+
+	rateLimitLimitHeader     = "RateLimit-Limit"
+	rateLimitRemainingHeader = "RateLimit-Remaining"
+	rateLimitResetHeader     = "RateLimit-Reset"
+	retryAfterHeader         = "Retry-After"
+
+	ipSourcePrefix  = "ip:"
+	keySourcePrefix = "key:"
+)
+
+type bucketLimits struct {
+	requestsPerSecond uint32
+	burst             uint32
+}
+
+// ArgsRateLimiter holds the arguments needed for creating a new rate limiter
+type ArgsRateLimiter struct {
+	Config     config.ApiRateLimitingConfig
+	IPResolver ClientIPResolver
+}
+
+type rateLimiter struct {
+	ipResolver   ClientIPResolver
+	apiKeyHeader string
+	ipLimits     bucketLimits
+	apiKeyLimits map[string]bucketLimits
+	routeCosts   map[string]uint32
+	getTimeFunc  func() time.Time
+
+	mutBuckets sync.Mutex
+	buckets    map[string]*tokenBucket
+}
+
+// NewRateLimiter creates a middleware that limits the requests of each source with a token bucket. A source is the
+// API key of the request, if it is a configured one, or the client IP otherwise. Each request consumes the number of
+// tokens configured for its route. The handlers of the requests carrying several calls (e.g. JSON-RPC batches) charge
+// each call through the shared.RateLimitCharger set in the request context
+func NewRateLimiter(args ArgsRateLimiter) (*rateLimiter, error) {
+	err := checkArgsRateLimiter(args)
+	if err != nil {
+		return nil, err
+	}
+
+	rl := &rateLimiter{
+		ipResolver:   args.IPResolver,
+		apiKeyHeader: args.Config.APIKeyHeader,
+		ipLimits: bucketLimits{
+			requestsPerSecond: args.Config.RequestsPerSecond,
+			burst:             args.Config.Burst,
+		},
+		apiKeyLimits: make(map[string]bucketLimits, len(args.Config.APIKeys)),
+		routeCosts:   make(map[string]uint32, len(args.Config.RouteCosts)),
+		getTimeFunc:  time.Now,
+		buckets:      make(map[string]*tokenBucket),
+	}
+
+	for _, apiKey := range args.Config.APIKeys {
+		rl.apiKeyLimits[apiKey.Key] = bucketLimits{
+			requestsPerSecond: apiKey.RequestsPerSecond,
+			burst:             apiKey.Burst,
+		}
+	}
+	for _, routeCost := range args.Config.RouteCosts {
+		rl.routeCosts[routeCost.Route] = routeCost.Cost
+	}
+
+	return rl, nil
+}
+
+func checkArgsRateLimiter(args ArgsRateLimiter) error {
+	if check.IfNil(args.IPResolver) {
+		return ErrNilClientIPResolver
+	}
+
+	cfg := args.Config
+	err := checkBucketLimits(cfg.RequestsPerSecond, cfg.Burst, "client IP")
+	if err != nil {
+		return err
+	}
+
+	minBurst := cfg.Burst
+	if len(cfg.APIKeys) > 0 && len(cfg.APIKeyHeader) == 0 {
+		return ErrEmptyAPIKeyHeader
+	}
+
+	apiKeys := make(map[string]struct{}, len(cfg.APIKeys))
+	for index, apiKey := range cfg.APIKeys {
+		if len(apiKey.Key) == 0 {
+			return fmt.Errorf("%w at index %d", ErrEmptyAPIKey, index)
+		}
+		_, exists := apiKeys[apiKey.Key]
+		if exists {
+			return fmt.Errorf("%w at index %d", ErrDuplicatedAPIKey, index)
+		}
+		apiKeys[apiKey.Key] = struct{}{}
+
+		err = checkBucketLimits(apiKey.RequestsPerSecond, apiKey.Burst, fmt.Sprintf("API key at index %d", index))
+		if err != nil {
+			return err
+		}
+		if apiKey.Burst < minBurst {
+			minBurst = apiKey.Burst
+		}
+	}
+
+	for _, routeCost := range cfg.RouteCosts {
+		// a request costing more than a bucket capacity could never be served
+		if routeCost.Cost == 0 || routeCost.Cost > minBurst {
+			return fmt.Errorf("%w for route %s: cost %d, minimum burst %d", ErrInvalidRouteCost, routeCost.Route, routeCost.Cost, minBurst)
+		}
+	}
+
+	return nil
+}
+
+func checkBucketLimits(requestsPerSecond uint32, burst uint32, source string) error {
+	if requestsPerSecond == 0 {
+		return fmt.Errorf("%w for %s", ErrInvalidRequestsPerSecond, source)
+	}
+	if burst == 0 {
+		return fmt.Errorf("%w for %s", ErrInvalidBurst, source)
+	}
+
+	return nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (rl *rateLimiter) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		source, limits, err := rl.resolveSource(c.Request)
+		if err != nil {
+			c.AbortWithStatusJSON(
+				http.StatusInternalServerError,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: err.Error(),
+					Code:  shared.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+
+		cost := rl.routeCost(c.FullPath())
+		state := rl.take(source, limits, cost)
+		setRateLimitHeaders(c, state)
+
+		if !state.allowed {
+			c.Header(retryAfterHeader, formatSeconds(state.retryAfter))
+			c.AbortWithStatusJSON(
+				http.StatusTooManyRequests,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s for source %s", ErrTooManyRequests.Error(), displaySource(source)),
+					Code:  shared.ReturnCodeSystemBusy,
+				},
+			)
+			return
+		}
+
+		c.Set(shared.RateLimitChargerContextKey, &requestCharger{
+			rateLimiter: rl,
+			context:     c,
+			source:      source,
+			limits:      limits,
+			prepaid:     cost,
+		})
+
+		c.Next()
+	}
+}
+
+func setRateLimitHeaders(c *gin.Context, state bucketState) {
+	c.Header(rateLimitLimitHeader, strconv.FormatUint(state.limit, 10))
+	c.Header(rateLimitRemainingHeader, strconv.FormatUint(state.remaining, 10))
+	c.Header(rateLimitResetHeader, formatSeconds(state.resetAfter))
+}
+
+// resolveSource returns the bucket key and the limits of the request source. Requests carrying an unknown API key
+// are limited by their client IP
+func (rl *rateLimiter) resolveSource(request *http.Request) (string, bucketLimits, error) {
+	if len(rl.apiKeyHeader) > 0 {
+		apiKey := request.Header.Get(rl.apiKeyHeader)
+		limits, found := rl.apiKeyLimits[apiKey]
+		if len(apiKey) > 0 && found {
+			return keySourcePrefix + apiKey, limits, nil
+		}
+	}
+
+	clientIP, err := rl.ipResolver.ClientIP(request)
+	if err != nil {
+		return "", bucketLimits{}, err
+	}
+
+	return ipSourcePrefix + clientIP, rl.ipLimits, nil
+}
+
+func (rl *rateLimiter) routeCost(route string) uint32 {
+	cost, found := rl.routeCosts[route]
+	if !found {
+		return defaultRouteCost
+	}
+
+	return cost
+}
+
+func (rl *rateLimiter) take(source string, limits bucketLimits, cost uint32) bucketState {
+	now := rl.getTimeFunc()
+
+	rl.mutBuckets.Lock()
+	defer rl.mutBuckets.Unlock()
+
+	bucket, found := rl.buckets[source]
+	if !found {
+		bucket = newTokenBucket(limits.requestsPerSecond, limits.burst, now)
+		rl.buckets[source] = bucket
+	}
+
+	return bucket.take(cost, now)
+}
+
+// Reset removes the buckets of the sources that did not send requests since their buckets were completely refilled
+func (rl *rateLimiter) Reset() {
+	now := rl.getTimeFunc()
+
+	rl.mutBuckets.Lock()
+	defer rl.mutBuckets.Unlock()
+
+	for source, bucket := range rl.buckets {
+		if bucket.isFull(now) {
+			delete(rl.buckets, source)
+		}
+	}
+}
+
+// formatSeconds rounds up the provided duration to whole seconds, as required by the rate limit headers
+func formatSeconds(duration time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(duration.Seconds())), 10)
+}
+
+// displaySource hides the API keys from the error messages
+func displaySource(source string) string {
+	if len(source) > len(keySourcePrefix) && source[:len(keySourcePrefix)] == keySourcePrefix {
+		return "API key"
+	}
+
+	return "address " + source[len(ipSourcePrefix):]
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rl *rateLimiter) IsInterfaceNil() bool {
+	return rl == nil
+}
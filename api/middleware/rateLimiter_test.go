@@ -0,0 +1,353 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsRateLimiter() ArgsRateLimiter {
+	ipResolver, _ := NewClientIPResolver([]string{"10.0.0.1"})
+
+	return ArgsRateLimiter{
+		Config: config.ApiRateLimitingConfig{
+			Enabled:           true,
+			RequestsPerSecond: 1,
+			Burst:             4,
+			APIKeyHeader:      "X-Api-Key",
+			APIKeys: []config.ApiKeyRateLimitConfig{
+				{Key: "premium", RequestsPerSecond: 10, Burst: 10},
+			},
+			RouteCosts: []config.ApiRouteCostConfig{
+				{Route: "/vm-values/query", Cost: 3},
+			},
+		},
+		IPResolver: ipResolver,
+	}
+}
+
+type testTime struct {
+	mut sync.Mutex
+	now time.Time
+}
+
+func (tt *testTime) get() time.Time {
+	tt.mut.Lock()
+	defer tt.mut.Unlock()
+
+	return tt.now
+}
+
+func (tt *testTime) advance(duration time.Duration) {
+	tt.mut.Lock()
+	tt.now = tt.now.Add(duration)
+	tt.mut.Unlock()
+}
+
+func startNodeServerRateLimiter(t *testing.T, args ArgsRateLimiter) (*gin.Engine, *rateLimiter, *testTime) {
+	rl, err := NewRateLimiter(args)
+	require.Nil(t, err)
+
+	tt := &testTime{now: time.Unix(1000, 0)}
+	rl.getTimeFunc = tt.get
+
+	handler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{})
+	}
+
+	ws := gin.New()
+	ws.Use(rl.MiddlewareHandlerFunc())
+	ws.GET("/node/status", handler)
+	ws.POST("/vm-values/query", handler)
+
+	return ws, rl, tt
+}
+
+func doRateLimitedRequest(ws *gin.Engine, method string, path string, remoteAddr string, headers map[string]string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, nil)
+	req.RemoteAddr = remoteAddr
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil IP resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRateLimiter()
+		args.IPResolver = nil
+		rl, err := NewRateLimiter(args)
+		assert.True(t, check.IfNil(rl))
+		assert.Equal(t, ErrNilClientIPResolver, err)
+	})
+	t.Run("invalid requests per second should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRateLimiter()
+		args.Config.RequestsPerSecond = 0
+		rl, err := NewRateLimiter(args)
+		assert.True(t, check.IfNil(rl))
+		assert.True(t, errors.Is(err, ErrInvalidRequestsPerSecond))
+	})
+	t.Run("invalid burst should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRateLimiter()
+		args.Config.Burst = 0
+		rl, err := NewRateLimiter(args)
+		assert.True(t, check.IfNil(rl))
+		assert.True(t, errors.Is(err, ErrInvalidBurst))
+	})
+	t.Run("empty API key header should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRateLimiter()
+		args.Config.APIKeyHeader = ""
+		rl, err := NewRateLimiter(args)
+		assert.True(t, check.IfNil(rl))
+		assert.Equal(t, ErrEmptyAPIKeyHeader, err)
+	})
+	t.Run("empty API key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRateLimiter()
+		args.Config.APIKeys[0].Key = ""
+		rl, err := NewRateLimiter(args)
+		assert.True(t, check.IfNil(rl))
+		assert.True(t, errors.Is(err, ErrEmptyAPIKey))
+	})
+	t.Run("duplicated API key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRateLimiter()
+		args.Config.APIKeys = append(args.Config.APIKeys, args.Config.APIKeys[0])
+		rl, err := NewRateLimiter(args)
+		assert.True(t, check.IfNil(rl))
+		assert.True(t, errors.Is(err, ErrDuplicatedAPIKey))
+	})
+	t.Run("invalid API key burst should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRateLimiter()
+		args.Config.APIKeys[0].Burst = 0
+		rl, err := NewRateLimiter(args)
+		assert.True(t, check.IfNil(rl))
+		assert.True(t, errors.Is(err, ErrInvalidBurst))
+	})
+	t.Run("zero route cost should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRateLimiter()
+		args.Config.RouteCosts[0].Cost = 0
+		rl, err := NewRateLimiter(args)
+		assert.True(t, check.IfNil(rl))
+		assert.True(t, errors.Is(err, ErrInvalidRouteCost))
+	})
+	t.Run("route cost higher than a burst should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRateLimiter()
+		args.Config.RouteCosts[0].Cost = 5
+		rl, err := NewRateLimiter(args)
+		assert.True(t, check.IfNil(rl))
+		assert.True(t, errors.Is(err, ErrInvalidRouteCost))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rl, err := NewRateLimiter(createMockArgsRateLimiter())
+		assert.False(t, check.IfNil(rl))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRateLimiter_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should set the rate limit headers and reject when the bucket is empty", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _, tt := startNodeServerRateLimiter(t, createMockArgsRateLimiter())
+
+		resp := doRateLimitedRequest(ws, http.MethodPost, "/vm-values/query", "1.2.3.4:1000", nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "4", resp.Header().Get(rateLimitLimitHeader))
+		assert.Equal(t, "1", resp.Header().Get(rateLimitRemainingHeader))
+		assert.Equal(t, "3", resp.Header().Get(rateLimitResetHeader))
+
+		resp = doRateLimitedRequest(ws, http.MethodGet, "/node/status", "1.2.3.4:1000", nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "0", resp.Header().Get(rateLimitRemainingHeader))
+
+		resp = doRateLimitedRequest(ws, http.MethodPost, "/vm-values/query", "1.2.3.4:1000", nil)
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		assert.Equal(t, "3", resp.Header().Get(retryAfterHeader))
+		response := shared.GenericAPIResponse{}
+		require.Nil(t, json.Unmarshal(resp.Body.Bytes(), &response))
+		assert.Equal(t, shared.ReturnCodeSystemBusy, response.Code)
+		assert.Contains(t, response.Error, ErrTooManyRequests.Error())
+		assert.Contains(t, response.Error, "1.2.3.4")
+
+		// other sources are not affected
+		resp = doRateLimitedRequest(ws, http.MethodGet, "/node/status", "5.6.7.8:1000", nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		tt.advance(time.Second)
+		resp = doRateLimitedRequest(ws, http.MethodGet, "/node/status", "1.2.3.4:1000", nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("should limit by the forwarded client IP behind a trusted proxy", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _, _ := startNodeServerRateLimiter(t, createMockArgsRateLimiter())
+
+		for i := 0; i < 4; i++ {
+			resp := doRateLimitedRequest(ws, http.MethodGet, "/node/status", "10.0.0.1:1000", map[string]string{"X-Forwarded-For": "1.2.3.4"})
+			assert.Equal(t, http.StatusOK, resp.Code)
+		}
+
+		resp := doRateLimitedRequest(ws, http.MethodGet, "/node/status", "10.0.0.1:1000", map[string]string{"X-Forwarded-For": "1.2.3.4"})
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+		resp = doRateLimitedRequest(ws, http.MethodGet, "/node/status", "10.0.0.1:1000", map[string]string{"X-Forwarded-For": "5.6.7.8"})
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("known API key should use its own limits and unknown ones should fall back to the client IP", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _, _ := startNodeServerRateLimiter(t, createMockArgsRateLimiter())
+
+		for i := 0; i < 10; i++ {
+			resp := doRateLimitedRequest(ws, http.MethodGet, "/node/status", "1.2.3.4:1000", map[string]string{"X-Api-Key": "premium"})
+			assert.Equal(t, http.StatusOK, resp.Code)
+			assert.Equal(t, "10", resp.Header().Get(rateLimitLimitHeader))
+		}
+
+		resp := doRateLimitedRequest(ws, http.MethodGet, "/node/status", "1.2.3.4:1000", map[string]string{"X-Api-Key": "premium"})
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		response := shared.GenericAPIResponse{}
+		require.Nil(t, json.Unmarshal(resp.Body.Bytes(), &response))
+		assert.NotContains(t, response.Error, "premium")
+
+		resp = doRateLimitedRequest(ws, http.MethodGet, "/node/status", "1.2.3.4:1000", map[string]string{"X-Api-Key": "unknown"})
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "4", resp.Header().Get(rateLimitLimitHeader))
+	})
+	t.Run("unresolvable client IP should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _, _ := startNodeServerRateLimiter(t, createMockArgsRateLimiter())
+
+		resp := doRateLimitedRequest(ws, http.MethodGet, "/node/status", "invalid", nil)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+}
+
+func TestRateLimiter_ResetShouldRemoveIdleSources(t *testing.T) {
+	t.Parallel()
+
+	ws, rl, tt := startNodeServerRateLimiter(t, createMockArgsRateLimiter())
+
+	_ = doRateLimitedRequest(ws, http.MethodGet, "/node/status", "1.2.3.4:1000", nil)
+	tt.advance(500 * time.Millisecond)
+	_ = doRateLimitedRequest(ws, http.MethodGet, "/node/status", "5.6.7.8:1000", nil)
+	tt.advance(600 * time.Millisecond)
+
+	rl.Reset()
+
+	rl.mutBuckets.Lock()
+	defer rl.mutBuckets.Unlock()
+
+	assert.Len(t, rl.buckets, 1)
+	_, found := rl.buckets[ipSourcePrefix+"5.6.7.8"]
+	assert.True(t, found)
+}
+
+func TestRateLimiter_RequestChargerShouldChargeEachCall(t *testing.T) {
+	t.Parallel()
+
+	rl, err := NewRateLimiter(createMockArgsRateLimiter())
+	require.Nil(t, err)
+	tt := &testTime{now: time.Unix(1000, 0)}
+	rl.getTimeFunc = tt.get
+
+	chargeErrors := make([]error, 0)
+	ws := gin.New()
+	ws.Use(rl.MiddlewareHandlerFunc())
+	ws.POST("/batch", func(c *gin.Context) {
+		value, exists := c.Get(shared.RateLimitChargerContextKey)
+		require.True(t, exists)
+		charger := value.(shared.RateLimitCharger)
+
+		for i := 0; i < 2; i++ {
+			chargeErrors = append(chargeErrors, charger.Charge("/vm-values/query"))
+		}
+		c.JSON(http.StatusOK, gin.H{})
+	})
+
+	// the request pays 1 token, used by the first call, which pays 2 more; the second call needs 3 of the remaining 1
+	resp := doRateLimitedRequest(ws, http.MethodPost, "/batch", "1.2.3.4:5", nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 2, len(chargeErrors))
+	assert.Nil(t, chargeErrors[0])
+	assert.True(t, errors.Is(chargeErrors[1], ErrTooManyRequests))
+	assert.Equal(t, "1", resp.Header().Get(rateLimitRemainingHeader))
+
+	// the calls are charged against the same bucket as the requests
+	resp = doRateLimitedRequest(ws, http.MethodPost, "/batch", "1.2.3.4:5", nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	resp = doRateLimitedRequest(ws, http.MethodPost, "/batch", "1.2.3.4:5", nil)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
+
+func TestRateLimiter_RequestChargerShouldNotSetHeadersAfterTheResponseStarted(t *testing.T) {
+	t.Parallel()
+
+	rl, err := NewRateLimiter(createMockArgsRateLimiter())
+	require.Nil(t, err)
+	tt := &testTime{now: time.Unix(1000, 0)}
+	rl.getTimeFunc = tt.get
+
+	var chargeErr error
+	remainingBeforeCharge, remainingAfterCharge := "", ""
+	ws := gin.New()
+	ws.Use(rl.MiddlewareHandlerFunc())
+	ws.GET("/ws", func(c *gin.Context) {
+		value, exists := c.Get(shared.RateLimitChargerContextKey)
+		require.True(t, exists)
+		charger := value.(shared.RateLimitCharger)
+
+		// the response is started, as it is when the connection is upgraded to websocket
+		c.Status(http.StatusOK)
+		c.Writer.WriteHeaderNow()
+
+		remainingBeforeCharge = c.Writer.Header().Get(rateLimitRemainingHeader)
+		chargeErr = charger.Charge("/vm-values/query")
+		remainingAfterCharge = c.Writer.Header().Get(rateLimitRemainingHeader)
+	})
+
+	resp := doRateLimitedRequest(ws, http.MethodGet, "/ws", "1.2.3.4:5", nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Nil(t, chargeErr)
+	assert.Equal(t, "3", remainingBeforeCharge)
+	assert.Equal(t, remainingBeforeCharge, remainingAfterCharge)
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requestCharger charges the calls carried by a request against the bucket of the request source. The tokens already
+// consumed for the route of the request itself are used by the first calls, so that a request carrying a single call
+// costs as much as the equivalent REST request. It is not concurrent safe, being used only by the request handler
+type requestCharger struct {
+	rateLimiter *rateLimiter
+	context     *gin.Context
+	source      string
+	limits      bucketLimits
+	prepaid     uint32
+}
+
+// Charge consumes the tokens configured for the provided route, returning ErrTooManyRequests if the source does
+// not have enough tokens
+func (charger *requestCharger) Charge(route string) error {
+	cost := charger.rateLimiter.routeCost(route)
+	if charger.prepaid >= cost {
+		charger.prepaid -= cost
+		return nil
+	}
+
+	state := charger.rateLimiter.take(charger.source, charger.limits, cost-charger.prepaid)
+	// the headers can not be sent anymore once the response was started, as it is for the connections upgraded to
+	// websocket, which are charged for each received message
+	if !charger.context.Writer.Written() {
+		setRateLimitHeaders(charger.context, state)
+	}
+	if !state.allowed {
+		return fmt.Errorf("%w for source %s, retry after %s seconds",
+			ErrTooManyRequests, displaySource(charger.source), formatSeconds(state.retryAfter))
+	}
+
+	charger.prepaid = 0
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (charger *requestCharger) IsInterfaceNil() bool {
+	return charger == nil
+}
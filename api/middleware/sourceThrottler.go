@@ -2,11 +2,11 @@ package middleware
 
 import (
 	"fmt"
-	"net"
 	"net/http"
 	"sync"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/api/shared"
 )
 
@@ -15,25 +15,30 @@ type sourceThrottler struct {
 	mutRequests    sync.Mutex
 	sourceRequests map[string]uint32
 	maxNumRequests uint32
+	ipResolver     ClientIPResolver
 }
 
 // NewSourceThrottler creates a new instance of a sourceThrottler
-func NewSourceThrottler(maxNumRequests uint32) (*sourceThrottler, error) {
+func NewSourceThrottler(maxNumRequests uint32, ipResolver ClientIPResolver) (*sourceThrottler, error) {
 	if maxNumRequests == 0 {
 		return nil, ErrInvalidMaxNumRequests
 	}
+	if check.IfNil(ipResolver) {
+		return nil, ErrNilClientIPResolver
+	}
 
 	return &sourceThrottler{
 		mutRequests:    sync.Mutex{},
 		sourceRequests: make(map[string]uint32),
 		maxNumRequests: maxNumRequests,
+		ipResolver:     ipResolver,
 	}, nil
 }
 
 // MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
 func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		remoteAddr, _, err := net.SplitHostPort(c.Request.RemoteAddr)
+		remoteAddr, err := st.ipResolver.ClientIP(c.Request)
 		if err != nil {
 			c.AbortWithStatusJSON(
 				http.StatusInternalServerError,
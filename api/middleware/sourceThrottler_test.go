@@ -21,7 +21,8 @@ type reseter interface {
 func startNodeServerSourceThrottler(handler func(c *gin.Context), maxConnections uint32) (*gin.Engine, reseter) {
 	ws := gin.New()
 	ws.Use(cors.Default())
-	sourceThrottler, _ := middleware.NewSourceThrottler(maxConnections)
+	ipResolver, _ := middleware.NewClientIPResolver(nil)
+	sourceThrottler, _ := middleware.NewSourceThrottler(maxConnections, ipResolver)
 	ws.Use(sourceThrottler.MiddlewareHandlerFunc())
 	ginAddressRoutes := ws.Group("/address")
 
@@ -33,16 +34,27 @@ func startNodeServerSourceThrottler(handler func(c *gin.Context), maxConnections
 func TestNewSourceThrottler_InvalidValueShouldErr(t *testing.T) {
 	t.Parallel()
 
-	st, err := middleware.NewSourceThrottler(0)
+	ipResolver, _ := middleware.NewClientIPResolver(nil)
+	st, err := middleware.NewSourceThrottler(0, ipResolver)
 
 	assert.True(t, check.IfNil(st))
 	assert.Equal(t, middleware.ErrInvalidMaxNumRequests, err)
 }
 
+func TestNewSourceThrottler_NilIPResolverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	st, err := middleware.NewSourceThrottler(1, nil)
+
+	assert.True(t, check.IfNil(st))
+	assert.Equal(t, middleware.ErrNilClientIPResolver, err)
+}
+
 func TestNewSourceThrottler(t *testing.T) {
 	t.Parallel()
 
-	st, err := middleware.NewSourceThrottler(1)
+	ipResolver, _ := middleware.NewClientIPResolver(nil)
+	st, err := middleware.NewSourceThrottler(1, ipResolver)
 
 	assert.False(t, check.IfNil(st))
 	assert.Nil(t, err)
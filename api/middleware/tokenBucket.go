@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"math"
+	"time"
+)
+
+// tokenBucket holds the tokens available to a source. The bucket is refilled continuously with refillRate tokens
+// per second, up to its capacity. It is not concurrent safe
+type tokenBucket struct {
+	capacity   float64
+	refillRate float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+// bucketState holds the state of a bucket after a take attempt, used to populate the rate limit response headers
+type bucketState struct {
+	allowed    bool
+	limit      uint64
+	remaining  uint64
+	resetAfter time.Duration
+	retryAfter time.Duration
+}
+
+func newTokenBucket(requestsPerSecond uint32, burst uint32, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		capacity:   float64(burst),
+		refillRate: float64(requestsPerSecond),
+		tokens:     float64(burst),
+		lastRefill: now,
+	}
+}
+
+func (tb *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(tb.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	tb.tokens = math.Min(tb.capacity, tb.tokens+elapsed*tb.refillRate)
+	tb.lastRefill = now
+}
+
+// take consumes the provided number of tokens, if available
+func (tb *tokenBucket) take(cost uint32, now time.Time) bucketState {
+	tb.refill(now)
+
+	state := bucketState{
+		limit: uint64(tb.capacity),
+	}
+
+	if tb.tokens >= float64(cost) {
+		tb.tokens -= float64(cost)
+		state.allowed = true
+	} else {
+		state.retryAfter = tb.durationUntil(float64(cost))
+	}
+
+	state.remaining = uint64(math.Floor(tb.tokens))
+	state.resetAfter = tb.durationUntil(tb.capacity)
+
+	return state
+}
+
+func (tb *tokenBucket) durationUntil(tokens float64) time.Duration {
+	missing := tokens - tb.tokens
+	if missing <= 0 {
+		return 0
+	}
+
+	return time.Duration(missing / tb.refillRate * float64(time.Second))
+}
+
+// isFull returns true if the bucket was not used since it was completely refilled
+func (tb *tokenBucket) isFull(now time.Time) bool {
+	tb.refill(now)
+
+	return tb.tokens >= tb.capacity
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucket_Take(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	tb := newTokenBucket(2, 4, now)
+
+	state := tb.take(3, now)
+	assert.True(t, state.allowed)
+	assert.Equal(t, uint64(4), state.limit)
+	assert.Equal(t, uint64(1), state.remaining)
+	assert.Equal(t, 1500*time.Millisecond, state.resetAfter)
+	assert.Equal(t, time.Duration(0), state.retryAfter)
+
+	state = tb.take(2, now)
+	assert.False(t, state.allowed)
+	assert.Equal(t, uint64(1), state.remaining)
+	assert.Equal(t, 500*time.Millisecond, state.retryAfter)
+
+	state = tb.take(2, now.Add(500*time.Millisecond))
+	assert.True(t, state.allowed)
+	assert.Equal(t, uint64(0), state.remaining)
+	assert.Equal(t, 2*time.Second, state.resetAfter)
+}
+
+func TestTokenBucket_RefillShouldNotExceedCapacity(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	tb := newTokenBucket(10, 5, now)
+
+	tb.take(5, now)
+	assert.False(t, tb.isFull(now.Add(100*time.Millisecond)))
+	assert.True(t, tb.isFull(now.Add(time.Hour)))
+
+	state := tb.take(1, now.Add(time.Hour))
+	assert.True(t, state.allowed)
+	assert.Equal(t, uint64(4), state.remaining)
+}
@@ -18,6 +18,9 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/proofBundle"
+	"github.com/multiversx/mx-chain-go/state/stateDiff"
+	"github.com/multiversx/mx-chain-go/state/triesStatistics"
 )
 
 // FacadeStub is the mock implementation of a node router handler
@@ -35,6 +38,7 @@ type FacadeStub struct {
 	ValidateTransactionForSimulationHandler     func(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactionsHandler                 func(txs []*transaction.Transaction) (uint64, error)
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	ExecuteSCQueriesHandler                     func(queries []*process.SCQuery) ([]*common.SCQueryApiResult, api.BlockInfo, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler                  func() (map[string]*validator.ValidatorStatistics, error)
 	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
@@ -49,7 +53,7 @@ type FacadeStub struct {
 	GetUsernameCalled                           func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetCodeHashCalled                           func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
 	GetKeyValuePairsCalled                      func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
-	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
 	GetESDTDataCalled                           func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetAllESDTTokensCalled                      func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetESDTsWithRoleCalled                      func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
@@ -75,6 +79,9 @@ type FacadeStub struct {
 	GetProofCalled                              func(string, string) (*common.GetProofResponse, error)
 	GetProofCurrentRootHashCalled               func(string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                      func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	GetProofBundleCalled                        func(string, string) (*proofBundle.Bundle, error)
+	GetStateDiffCalled                          func(request stateDiff.Request) (*stateDiff.StateDiff, error)
+	GetTriesStatisticsCalled                    func(rootHash string, numTopAccounts int) (*triesStatistics.Report, error)
 	VerifyProofCalled                           func(string, string, [][]byte) (bool, error)
 	GetTokenSupplyCalled                        func(token string) (*api.ESDTSupply, error)
 	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string, error)
@@ -83,6 +90,12 @@ type FacadeStub struct {
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolSenderDetailsCalled      func(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error)
+	GetTransactionsPoolSelectionCalled          func(fields string) (*common.TransactionsPoolSelectionApiResponse, error)
+	GetTransactionsPoolRejectionsCalled         func(sender string) (*common.TransactionsPoolRejectionsApiResponse, error)
+	GetTransactionsForAddressCalled             func(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error)
+	FilterEventsCalled                          func(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error)
+	HandleSubscriptionsConnectionCalled         func(conn common.WebSocketConnection) error
 	GetGasConfigsCalled                         func() (map[string]map[string]uint64, error)
 	RestApiInterfaceCalled                      func() string
 	RestAPIServerDebugModeCalled                func() bool
@@ -145,6 +158,33 @@ func (f *FacadeStub) GetProofDataTrie(rootHash string, address string, key strin
 	return nil, nil, nil
 }
 
+// GetProofBundle -
+func (f *FacadeStub) GetProofBundle(address string, key string) (*proofBundle.Bundle, error) {
+	if f.GetProofBundleCalled != nil {
+		return f.GetProofBundleCalled(address, key)
+	}
+
+	return nil, nil
+}
+
+// GetStateDiff -
+func (f *FacadeStub) GetStateDiff(request stateDiff.Request) (*stateDiff.StateDiff, error) {
+	if f.GetStateDiffCalled != nil {
+		return f.GetStateDiffCalled(request)
+	}
+
+	return nil, nil
+}
+
+// GetTriesStatistics -
+func (f *FacadeStub) GetTriesStatistics(rootHash string, numTopAccounts int) (*triesStatistics.Report, error) {
+	if f.GetTriesStatisticsCalled != nil {
+		return f.GetTriesStatisticsCalled(rootHash, numTopAccounts)
+	}
+
+	return nil, nil
+}
+
 // VerifyProof -
 func (f *FacadeStub) VerifyProof(rootHash string, address string, proof [][]byte) (bool, error) {
 	if f.VerifyProofCalled != nil {
@@ -340,9 +380,9 @@ func (f *FacadeStub) GetTransaction(hash string, withResults bool) (*transaction
 }
 
 // SimulateTransactionExecution is the mock implementation of a handler's SimulateTransactionExecution method
-func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if f.SimulateTransactionExecutionHandler != nil {
-		return f.SimulateTransactionExecutionHandler(tx)
+		return f.SimulateTransactionExecutionHandler(tx, withTrace)
 	}
 
 	return nil, nil
@@ -402,6 +442,15 @@ func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, ap
 	return nil, api.BlockInfo{}, nil
 }
 
+// ExecuteSCQueries is a mock implementation.
+func (f *FacadeStub) ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryApiResult, api.BlockInfo, error) {
+	if f.ExecuteSCQueriesHandler != nil {
+		return f.ExecuteSCQueriesHandler(queries)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
 // StatusMetrics is the mock implementation for the StatusMetrics
 func (f *FacadeStub) StatusMetrics() external.StatusMetricsHandler {
 	if f.StatusMetricsHandler != nil {
@@ -652,6 +701,60 @@ func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*comm
 	return nil, nil
 }
 
+// GetTransactionsPoolSenderDetails -
+func (f *FacadeStub) GetTransactionsPoolSenderDetails(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+	if f.GetTransactionsPoolSenderDetailsCalled != nil {
+		return f.GetTransactionsPoolSenderDetailsCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolSelection -
+func (f *FacadeStub) GetTransactionsPoolSelection(fields string) (*common.TransactionsPoolSelectionApiResponse, error) {
+	if f.GetTransactionsPoolSelectionCalled != nil {
+		return f.GetTransactionsPoolSelectionCalled(fields)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolRejections -
+func (f *FacadeStub) GetTransactionsPoolRejections(sender string) (*common.TransactionsPoolRejectionsApiResponse, error) {
+	if f.GetTransactionsPoolRejectionsCalled != nil {
+		return f.GetTransactionsPoolRejectionsCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsForAddress -
+func (f *FacadeStub) GetTransactionsForAddress(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error) {
+	if f.GetTransactionsForAddressCalled != nil {
+		return f.GetTransactionsForAddressCalled(address, cursor, size)
+	}
+
+	return nil, nil
+}
+
+// FilterEvents -
+func (f *FacadeStub) FilterEvents(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error) {
+	if f.FilterEventsCalled != nil {
+		return f.FilterEventsCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// HandleSubscriptionsConnection -
+func (f *FacadeStub) HandleSubscriptionsConnection(conn common.WebSocketConnection) error {
+	if f.HandleSubscriptionsConnectionCalled != nil {
+		return f.HandleSubscriptionsConnectionCalled(conn)
+	}
+
+	return nil
+}
+
 // GetGasConfigs -
 func (f *FacadeStub) GetGasConfigs() (map[string]map[string]uint64, error) {
 	if f.GetGasConfigsCalled != nil {
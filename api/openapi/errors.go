@@ -0,0 +1,9 @@
+package openapi
+
+import "errors"
+
+// ErrNilGroupHandler signals that a nil group handler has been provided
+var ErrNilGroupHandler = errors.New("nil group handler")
+
+// ErrNilEndpointHandlerData signals that a nil endpoint handler data has been provided
+var ErrNilEndpointHandlerData = errors.New("nil endpoint handler data")
@@ -0,0 +1,211 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"math/big"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const componentsSchemasPrefix = "#/components/schemas/"
+
+var (
+	bigIntType          = reflect.TypeOf(big.Int{})
+	timeType            = reflect.TypeOf(time.Time{})
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	invalidNameCharsReg = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+)
+
+// schemaGenerator builds the schemas of the Go types, following their JSON encoding. Named structs are registered
+// as reusable components and referenced from the other schemas
+type schemaGenerator struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		components: make(map[string]*Schema),
+		names:      make(map[reflect.Type]string),
+	}
+}
+
+// schemaOfValue returns the schema of the provided value. Unlike the types, the values allow describing the dynamic
+// objects built with maps of interfaces, such as the data field of most of the API responses
+func (sg *schemaGenerator) schemaOfValue(value interface{}) *Schema {
+	return sg.schemaOfReflectValue(reflect.ValueOf(value))
+}
+
+func (sg *schemaGenerator) schemaOfReflectValue(value reflect.Value) *Schema {
+	if !value.IsValid() {
+		return &Schema{}
+	}
+
+	switch value.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if value.IsNil() {
+			return sg.schemaOfType(value.Type())
+		}
+		return sg.schemaOfReflectValue(value.Elem())
+	case reflect.Map:
+		isDynamicObject := value.Type().Key().Kind() == reflect.String && value.Type().Elem().Kind() == reflect.Interface
+		if !isDynamicObject || value.Len() == 0 {
+			return sg.schemaOfType(value.Type())
+		}
+
+		schema := &Schema{
+			Type:       "object",
+			Properties: make(map[string]*Schema, value.Len()),
+			Required:   make([]string, 0, value.Len()),
+		}
+		iter := value.MapRange()
+		for iter.Next() {
+			key := iter.Key().String()
+			schema.Properties[key] = sg.schemaOfReflectValue(iter.Value())
+			schema.Required = append(schema.Required, key)
+		}
+		sort.Strings(schema.Required)
+
+		return schema
+	default:
+		return sg.schemaOfType(value.Type())
+	}
+}
+
+func (sg *schemaGenerator) schemaOfType(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == bigIntType:
+		return &Schema{Type: "integer"}
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType):
+		// the custom JSON encoding can not be described
+		return &Schema{}
+	case t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType):
+		return &Schema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// byte slices are encoded as base64 strings
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: sg.schemaOfType(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: sg.schemaOfType(t.Elem())}
+	case reflect.Struct:
+		return sg.schemaOfStruct(t)
+	default:
+		return &Schema{}
+	}
+}
+
+func (sg *schemaGenerator) schemaOfStruct(t reflect.Type) *Schema {
+	if len(t.Name()) == 0 {
+		return sg.structProperties(t)
+	}
+
+	name, found := sg.names[t]
+	if !found {
+		name = sg.componentName(t)
+		sg.names[t] = name
+		// registered before generating the fields, so recursive types end up referencing themselves
+		sg.components[name] = &Schema{}
+		*sg.components[name] = *sg.structProperties(t)
+	}
+
+	return &Schema{Ref: componentsSchemasPrefix + name}
+}
+
+func (sg *schemaGenerator) componentName(t reflect.Type) string {
+	name := invalidNameCharsReg.ReplaceAllString(path.Base(t.PkgPath())+"."+t.Name(), "_")
+	_, exists := sg.components[name]
+	if !exists {
+		return name
+	}
+
+	return invalidNameCharsReg.ReplaceAllString(t.PkgPath()+"."+t.Name(), "_")
+}
+
+func (sg *schemaGenerator) structProperties(t reflect.Type) *Schema {
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+	sg.addStructFields(schema, t)
+	sort.Strings(schema.Required)
+
+	return schema
+}
+
+func (sg *schemaGenerator) addStructFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, options := parseJSONTag(tag)
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		if field.Anonymous && len(name) == 0 && fieldType.Kind() == reflect.Struct {
+			// the fields of the embedded structs are promoted in the JSON object
+			sg.addStructFields(schema, fieldType)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		fieldSchema := sg.schemaOfType(field.Type)
+		if options["string"] {
+			fieldSchema = &Schema{Type: "string"}
+		}
+
+		_, alreadyAdded := schema.Properties[name]
+		schema.Properties[name] = fieldSchema
+		if !options["omitempty"] && !alreadyAdded {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+func parseJSONTag(tag string) (string, map[string]bool) {
+	parts := strings.Split(tag, ",")
+	options := make(map[string]bool, len(parts)-1)
+	for _, option := range parts[1:] {
+		options[option] = true
+	}
+
+	return parts[0], options
+}
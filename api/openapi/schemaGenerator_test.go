@@ -0,0 +1,97 @@
+package openapi
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type embeddedTestStruct struct {
+	Embedded string `json:"embedded"`
+}
+
+type testStruct struct {
+	embeddedTestStruct
+	Name       string            `json:"name"`
+	Optional   uint64            `json:"optional,omitempty"`
+	Quoted     int64             `json:"quoted,string"`
+	Data       []byte            `json:"data"`
+	Value      *big.Int          `json:"value"`
+	Timestamp  time.Time         `json:"timestamp"`
+	Tags       []string          `json:"tags"`
+	Labels     map[string]uint32 `json:"labels"`
+	Child      *testStruct       `json:"child,omitempty"`
+	Any        interface{}       `json:"any"`
+	Ignored    string            `json:"-"`
+	NoTag      bool
+	unexported bool
+}
+
+func TestSchemaGenerator_SchemaOfStruct(t *testing.T) {
+	t.Parallel()
+
+	sg := newSchemaGenerator()
+	schema := sg.schemaOfValue(&testStruct{})
+	require.Equal(t, componentsSchemasPrefix+"openapi.testStruct", schema.Ref)
+
+	component := sg.components["openapi.testStruct"]
+	require.NotNil(t, component)
+	assert.Equal(t, "object", component.Type)
+	assert.Equal(t, &Schema{Type: "string"}, component.Properties["embedded"])
+	assert.Equal(t, &Schema{Type: "string"}, component.Properties["name"])
+	assert.Equal(t, &Schema{Type: "integer", Format: "int64"}, component.Properties["optional"])
+	assert.Equal(t, &Schema{Type: "string"}, component.Properties["quoted"])
+	assert.Equal(t, &Schema{Type: "string", Format: "byte"}, component.Properties["data"])
+	assert.Equal(t, &Schema{Type: "integer"}, component.Properties["value"])
+	assert.Equal(t, &Schema{Type: "string", Format: "date-time"}, component.Properties["timestamp"])
+	assert.Equal(t, &Schema{Type: "array", Items: &Schema{Type: "string"}}, component.Properties["tags"])
+	assert.Equal(t, &Schema{Type: "object", AdditionalProperties: &Schema{Type: "integer", Format: "int32"}}, component.Properties["labels"])
+	assert.Equal(t, &Schema{Ref: schema.Ref}, component.Properties["child"])
+	assert.Equal(t, &Schema{}, component.Properties["any"])
+	assert.Equal(t, &Schema{Type: "boolean"}, component.Properties["NoTag"])
+	assert.Len(t, component.Properties, 12)
+	assert.Equal(t, []string{"NoTag", "any", "data", "embedded", "labels", "name", "quoted", "tags", "timestamp", "value"}, component.Required)
+}
+
+func TestSchemaGenerator_SchemaOfDynamicObject(t *testing.T) {
+	t.Parallel()
+
+	sg := newSchemaGenerator()
+	schema := sg.schemaOfValue(map[string]interface{}{
+		"transaction": &transaction.FrontendTransaction{},
+		"count":       uint32(0),
+		"unknown":     nil,
+	})
+
+	assert.Equal(t, "object", schema.Type)
+	assert.Equal(t, []string{"count", "transaction", "unknown"}, schema.Required)
+	assert.Equal(t, &Schema{Type: "integer", Format: "int32"}, schema.Properties["count"])
+	assert.Equal(t, &Schema{Ref: componentsSchemasPrefix + "transaction.FrontendTransaction"}, schema.Properties["transaction"])
+	assert.Equal(t, &Schema{}, schema.Properties["unknown"])
+
+	txSchema := sg.components["transaction.FrontendTransaction"]
+	require.NotNil(t, txSchema)
+	assert.Equal(t, &Schema{Type: "string"}, txSchema.Properties["receiver"])
+	assert.Contains(t, txSchema.Required, "receiver")
+	assert.NotContains(t, txSchema.Required, "signature")
+}
+
+func TestSchemaGenerator_SameNameInDifferentPackages(t *testing.T) {
+	t.Parallel()
+
+	type Log struct {
+		Field string `json:"field"`
+	}
+
+	sg := newSchemaGenerator()
+	first := sg.schemaOfValue(transaction.Log{})
+	second := sg.schemaOfValue(Log{})
+
+	assert.Equal(t, componentsSchemasPrefix+"transaction.Log", first.Ref)
+	assert.NotEqual(t, first.Ref, second.Ref)
+	assert.Len(t, sg.components, 3)
+}
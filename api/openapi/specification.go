@@ -0,0 +1,196 @@
+package openapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+const (
+	openAPIVersion  = "3.0.3"
+	jsonContentType = "application/json"
+)
+
+// ArgsSpecification holds the arguments needed for building the OpenAPI specification of the node API
+type ArgsSpecification struct {
+	Groups    map[string]shared.GroupHandler
+	ApiConfig config.ApiRoutesConfig
+	Title     string
+	Version   string
+}
+
+// BuildSpecification builds the OpenAPI 3 document describing the endpoints registered by the provided groups.
+// Only the routes enabled in the API routes configuration are included
+func BuildSpecification(args ArgsSpecification) (*Document, error) {
+	generator := newSchemaGenerator()
+	genericResponseSchema := generator.schemaOfValue(shared.GenericAPIResponse{})
+
+	doc := &Document{
+		OpenAPI: openAPIVersion,
+		Info: Info{
+			Title:   args.Title,
+			Version: args.Version,
+		},
+		Paths: make(map[string]PathItem),
+		Tags:  make([]Tag, 0, len(args.Groups)),
+	}
+
+	groupNames := make([]string, 0, len(args.Groups))
+	for groupName := range args.Groups {
+		groupNames = append(groupNames, groupName)
+	}
+	sort.Strings(groupNames)
+
+	operationIDs := make(map[string]struct{})
+	for _, groupName := range groupNames {
+		groupHandler := args.Groups[groupName]
+		if check.IfNil(groupHandler) {
+			return nil, fmt.Errorf("%w for group %s", ErrNilGroupHandler, groupName)
+		}
+
+		numOperations := 0
+		for _, endpoint := range groupHandler.GetEndpoints() {
+			if endpoint == nil {
+				return nil, fmt.Errorf("%w in group %s", ErrNilEndpointHandlerData, groupName)
+			}
+			if !groups.IsEndpointOpen(groupName, endpoint.Path, args.ApiConfig) {
+				continue
+			}
+
+			fullPath, parameters := convertPath("/" + groupName + endpoint.Path)
+			pathItem, found := doc.Paths[fullPath]
+			if !found {
+				pathItem = make(PathItem)
+				doc.Paths[fullPath] = pathItem
+			}
+
+			operation := createOperation(generator, groupName, fullPath, endpoint, parameters, genericResponseSchema)
+			operation.OperationID = uniqueOperationID(operationIDs, endpoint.Method, fullPath)
+			pathItem[strings.ToLower(endpoint.Method)] = operation
+			numOperations++
+		}
+
+		if numOperations > 0 {
+			doc.Tags = append(doc.Tags, Tag{Name: groupName})
+		}
+	}
+
+	doc.Components = Components{
+		Schemas: generator.components,
+	}
+
+	return doc, nil
+}
+
+func createOperation(
+	generator *schemaGenerator,
+	groupName string,
+	fullPath string,
+	endpoint *shared.EndpointHandlerData,
+	parameters []Parameter,
+	genericResponseSchema *Schema,
+) *Operation {
+	for _, queryParameter := range endpoint.Documentation.QueryParameters {
+		parameters = append(parameters, Parameter{
+			Name:        queryParameter.Name,
+			In:          "query",
+			Description: queryParameter.Description,
+			Required:    queryParameter.Required,
+			Schema:      &Schema{Type: string(queryParameter.Type)},
+		})
+	}
+
+	operation := &Operation{
+		Summary:    endpoint.Documentation.Summary,
+		Tags:       []string{groupName},
+		Parameters: parameters,
+		Responses: map[string]*Response{
+			"400": jsonResponse("invalid request", genericResponseSchema),
+			"429": jsonResponse("too many requests", genericResponseSchema),
+			"500": jsonResponse("internal error", genericResponseSchema),
+		},
+	}
+	if len(operation.Summary) == 0 {
+		operation.Summary = fmt.Sprintf("%s %s", endpoint.Method, fullPath)
+	}
+
+	if endpoint.Documentation.RequestBody != nil {
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				jsonContentType: {Schema: generator.schemaOfValue(endpoint.Documentation.RequestBody)},
+			},
+		}
+	}
+
+	successSchema := genericResponseSchema
+	if endpoint.Documentation.ResponseData != nil {
+		successSchema = &Schema{
+			AllOf: []*Schema{
+				genericResponseSchema,
+				{
+					Type: "object",
+					Properties: map[string]*Schema{
+						"data": generator.schemaOfValue(endpoint.Documentation.ResponseData),
+					},
+				},
+			},
+		}
+	}
+	operation.Responses["200"] = jsonResponse("successful operation", successSchema)
+
+	return operation
+}
+
+func jsonResponse(description string, schema *Schema) *Response {
+	return &Response{
+		Description: description,
+		Content: map[string]*MediaType{
+			jsonContentType: {Schema: schema},
+		},
+	}
+}
+
+// convertPath converts the gin path parameters (:name and *name) to the OpenAPI format ({name})
+func convertPath(ginPath string) (string, []Parameter) {
+	segments := strings.Split(ginPath, "/")
+	parameters := make([]Parameter, 0)
+	for i, segment := range segments {
+		if len(segment) < 2 || (segment[0] != ':' && segment[0] != '*') {
+			continue
+		}
+
+		name := segment[1:]
+		segments[i] = "{" + name + "}"
+		parameters = append(parameters, Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+
+	return strings.Join(segments, "/"), parameters
+}
+
+func uniqueOperationID(operationIDs map[string]struct{}, method string, fullPath string) string {
+	replacer := strings.NewReplacer("{", "", "}", "", "/", "-", ".", "-")
+	base := strings.ToLower(method) + replacer.Replace(fullPath)
+
+	operationID := base
+	for i := 2; ; i++ {
+		_, exists := operationIDs[operationID]
+		if !exists {
+			break
+		}
+		operationID = fmt.Sprintf("%s-%d", base, i)
+	}
+	operationIDs[operationID] = struct{}{}
+
+	return operationID
+}
@@ -0,0 +1,190 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/openapi"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeGroups(t *testing.T) map[string]shared.GroupHandler {
+	facade := &mock.FacadeStub{}
+
+	addressGroup, err := groups.NewAddressGroup(facade)
+	require.Nil(t, err)
+	transactionGroup, err := groups.NewTransactionGroup(facade)
+	require.Nil(t, err)
+	blockGroup, err := groups.NewBlockGroup(facade)
+	require.Nil(t, err)
+
+	return map[string]shared.GroupHandler{
+		"address":     addressGroup,
+		"transaction": transactionGroup,
+		"block":       blockGroup,
+	}
+}
+
+func createRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"address": {
+				Routes: []config.RouteConfig{
+					{Name: "/:address", Open: true},
+					{Name: "/:address/key/:key", Open: true},
+					{Name: "/:address/balance", Open: false},
+				},
+			},
+			"transaction": {
+				Routes: []config.RouteConfig{
+					{Name: "/send", Open: true},
+					{Name: "/:txhash", Open: true},
+				},
+			},
+			"block": {
+				Routes: []config.RouteConfig{
+					{Name: "/by-nonce/:nonce", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildSpecification(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil group handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		doc, err := openapi.BuildSpecification(openapi.ArgsSpecification{
+			Groups: map[string]shared.GroupHandler{"address": nil},
+		})
+		assert.Nil(t, doc)
+		assert.True(t, errors.Is(err, openapi.ErrNilGroupHandler))
+	})
+	t.Run("nil endpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		groupHandler := &api.GroupHandlerStub{
+			GetEndpointsCalled: func() []*shared.EndpointHandlerData {
+				return []*shared.EndpointHandlerData{nil}
+			},
+		}
+		doc, err := openapi.BuildSpecification(openapi.ArgsSpecification{
+			Groups: map[string]shared.GroupHandler{"address": groupHandler},
+		})
+		assert.Nil(t, doc)
+		assert.True(t, errors.Is(err, openapi.ErrNilEndpointHandlerData))
+	})
+	t.Run("should include only the enabled routes", func(t *testing.T) {
+		t.Parallel()
+
+		doc, err := openapi.BuildSpecification(openapi.ArgsSpecification{
+			Groups:    createNodeGroups(t),
+			ApiConfig: createRoutesConfig(),
+			Title:     "title",
+			Version:   "v1",
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, "3.0.3", doc.OpenAPI)
+		assert.Equal(t, openapi.Info{Title: "title", Version: "v1"}, doc.Info)
+		assert.Equal(t, []openapi.Tag{{Name: "address"}, {Name: "block"}, {Name: "transaction"}}, doc.Tags)
+		require.Len(t, doc.Paths, 5)
+
+		accountOperation := doc.Paths["/address/{address}"]["get"]
+		require.NotNil(t, accountOperation)
+		assert.Equal(t, "get-address-address", accountOperation.OperationID)
+		assert.Equal(t, []string{"address"}, accountOperation.Tags)
+		assert.Nil(t, accountOperation.RequestBody)
+		require.Len(t, accountOperation.Parameters, 8)
+		assert.Equal(t, openapi.Parameter{Name: "address", In: "path", Required: true, Schema: &openapi.Schema{Type: "string"}}, accountOperation.Parameters[0])
+		assert.Equal(t, "withKeys", accountOperation.Parameters[1].Name)
+		assert.Equal(t, "query", accountOperation.Parameters[1].In)
+		assert.False(t, accountOperation.Parameters[1].Required)
+		assert.Equal(t, &openapi.Schema{Type: "boolean"}, accountOperation.Parameters[1].Schema)
+		assert.Equal(t, "onFinalBlock", accountOperation.Parameters[2].Name)
+		assert.Equal(t, "blockNonce", accountOperation.Parameters[4].Name)
+		assert.Equal(t, &openapi.Schema{Type: "integer"}, accountOperation.Parameters[4].Schema)
+		assert.Equal(t, "blockHash", accountOperation.Parameters[5].Name)
+		assert.Equal(t, &openapi.Schema{Type: "string"}, accountOperation.Parameters[5].Schema)
+
+		successSchema := accountOperation.Responses["200"].Content["application/json"].Schema
+		require.Len(t, successSchema.AllOf, 2)
+		assert.Equal(t, "#/components/schemas/shared.GenericAPIResponse", successSchema.AllOf[0].Ref)
+		dataSchema := successSchema.AllOf[1].Properties["data"]
+		assert.Equal(t, "#/components/schemas/api.AccountResponse", dataSchema.Properties["account"].Ref)
+		assert.Equal(t, "#/components/schemas/api.BlockInfo", dataSchema.Properties["blockInfo"].Ref)
+
+		keyOperation := doc.Paths["/address/{address}/key/{key}"]["get"]
+		require.NotNil(t, keyOperation)
+		assert.Len(t, keyOperation.Parameters, 8)
+		keyDataSchema := keyOperation.Responses["200"].Content["application/json"].Schema.AllOf[1].Properties["data"]
+		assert.Equal(t, "string", keyDataSchema.Properties["value"].Type)
+
+		transactionOperation := doc.Paths["/transaction/{txhash}"]["get"]
+		require.NotNil(t, transactionOperation)
+		require.Len(t, transactionOperation.Parameters, 2)
+		assert.Equal(t, "withResults", transactionOperation.Parameters[1].Name)
+
+		blockOperation := doc.Paths["/block/by-nonce/{nonce}"]["get"]
+		require.NotNil(t, blockOperation)
+		require.Len(t, blockOperation.Parameters, 3)
+		assert.Equal(t, "withTxs", blockOperation.Parameters[1].Name)
+		assert.Equal(t, "withLogs", blockOperation.Parameters[2].Name)
+		blockDataSchema := blockOperation.Responses["200"].Content["application/json"].Schema.AllOf[1].Properties["data"]
+		assert.Equal(t, "#/components/schemas/api.Block", blockDataSchema.Properties["block"].Ref)
+
+		sendOperation := doc.Paths["/transaction/send"]["post"]
+		require.NotNil(t, sendOperation)
+		require.NotNil(t, sendOperation.RequestBody)
+		assert.Equal(t, "#/components/schemas/transaction.FrontendTransaction", sendOperation.RequestBody.Content["application/json"].Schema.Ref)
+		for _, code := range []string{"200", "400", "429", "500"} {
+			assert.NotNil(t, sendOperation.Responses[code])
+		}
+
+		assert.NotNil(t, doc.Components.Schemas["transaction.FrontendTransaction"])
+		assert.NotNil(t, doc.Components.Schemas["api.AccountResponse"])
+		assert.NotNil(t, doc.Components.Schemas["api.Block"])
+		assert.NotNil(t, doc.Components.Schemas["shared.GenericAPIResponse"])
+
+		_, err = json.Marshal(doc)
+		assert.Nil(t, err)
+	})
+	t.Run("duplicated operation ids should be made unique", func(t *testing.T) {
+		t.Parallel()
+
+		groupHandler := &api.GroupHandlerStub{
+			GetEndpointsCalled: func() []*shared.EndpointHandlerData {
+				return []*shared.EndpointHandlerData{
+					{Path: "/a.b", Method: http.MethodGet},
+					{Path: "/a-b", Method: http.MethodGet},
+				}
+			},
+		}
+		routesConfig := config.ApiRoutesConfig{
+			APIPackages: map[string]config.APIPackageConfig{
+				"group": {Routes: []config.RouteConfig{{Name: "/a.b", Open: true}, {Name: "/a-b", Open: true}}},
+			},
+		}
+
+		doc, err := openapi.BuildSpecification(openapi.ArgsSpecification{
+			Groups:    map[string]shared.GroupHandler{"group": groupHandler},
+			ApiConfig: routesConfig,
+		})
+		require.Nil(t, err)
+
+		first := doc.Paths["/group/a.b"]["get"]
+		second := doc.Paths["/group/a-b"]["get"]
+		assert.NotEqual(t, first.OperationID, second.OperationID)
+		assert.Equal(t, "GET /group/a.b", first.Summary)
+	})
+}
@@ -19,6 +19,9 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/proofBundle"
+	"github.com/multiversx/mx-chain-go/state/stateDiff"
+	"github.com/multiversx/mx-chain-go/state/triesStatistics"
 )
 
 // HttpServerCloser defines the basic actions of starting and closing that a web server should be able to do
@@ -28,6 +31,13 @@ type HttpServerCloser interface {
 	IsInterfaceNil() bool
 }
 
+// RateLimitCharger charges the calls carried by a single API request (e.g. the requests of a JSON-RPC batch or the
+// messages received on a websocket connection) against the rate limiting bucket of the request source
+type RateLimitCharger interface {
+	Charge(route string) error
+	IsInterfaceNil() bool
+}
+
 // MiddlewareProcessor defines a processor used internally by the web server when processing requests
 type MiddlewareProcessor interface {
 	MiddlewareHandlerFunc() gin.HandlerFunc
@@ -57,6 +67,7 @@ type GroupHandler interface {
 		ws *gin.RouterGroup,
 		apiConfig config.ApiRoutesConfig,
 	)
+	GetEndpoints() []*EndpointHandlerData
 	IsInterfaceNil() bool
 }
 
@@ -104,19 +115,23 @@ type FacadeHandler interface {
 	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
+	GetProofBundle(address string, key string) (*proofBundle.Bundle, error)
+	GetStateDiff(request stateDiff.Request) (*stateDiff.StateDiff, error)
+	GetTriesStatistics(rootHash string, numTopAccounts int) (*triesStatistics.Report, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	ExecuteSCQueries([]*process.SCQuery) ([]*common.SCQueryApiResult, api.BlockInfo, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	RestApiInterface() string
 	RestAPIServerDebugMode() bool
@@ -128,6 +143,12 @@ type FacadeHandler interface {
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolSenderDetails(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error)
+	GetTransactionsPoolSelection(fields string) (*common.TransactionsPoolSelectionApiResponse, error)
+	GetTransactionsPoolRejections(sender string) (*common.TransactionsPoolRejectionsApiResponse, error)
+	GetTransactionsForAddress(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error)
+	FilterEvents(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error)
+	HandleSubscriptionsConnection(conn common.WebSocketConnection) error
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
 	GetManagedKeysCount() int
 	GetManagedKeys() []string
@@ -18,6 +18,10 @@ const (
 	After MiddlewarePosition = false
 )
 
+// RateLimitChargerContextKey is the key of the request context value holding the RateLimitCharger of the request,
+// set only if the rate limiting is enabled
+const RateLimitChargerContextKey = "rateLimitCharger"
+
 // AdditionalMiddleware holds the data needed for adding a middleware to an API endpoint
 type AdditionalMiddleware struct {
 	Middleware gin.HandlerFunc
@@ -30,6 +34,38 @@ type EndpointHandlerData struct {
 	Method                string
 	Handler               gin.HandlerFunc
 	AdditionalMiddlewares []AdditionalMiddleware
+	Documentation         EndpointDocumentation
+}
+
+// EndpointDocumentation holds the optional details of an endpoint used when generating the OpenAPI specification
+type EndpointDocumentation struct {
+	Summary string
+	// QueryParameters holds the URL query parameters accepted by the endpoint
+	QueryParameters []QueryParameter
+	// RequestBody holds a value of the type expected in the request body, if any
+	RequestBody interface{}
+	// ResponseData holds a value of the type returned in the data field of a successful response, if known
+	ResponseData interface{}
+}
+
+// QueryParameterType defines the type of the value of a URL query parameter
+type QueryParameterType string
+
+const (
+	// QueryParameterBoolean is the type of the query parameters holding true or false
+	QueryParameterBoolean QueryParameterType = "boolean"
+	// QueryParameterInteger is the type of the query parameters holding an unsigned integer
+	QueryParameterInteger QueryParameterType = "integer"
+	// QueryParameterString is the type of the query parameters holding a text or a hex encoded value
+	QueryParameterString QueryParameterType = "string"
+)
+
+// QueryParameter describes a URL query parameter of an endpoint
+type QueryParameter struct {
+	Name        string
+	Type        QueryParameterType
+	Description string
+	Required    bool
 }
 
 // GenericAPIResponse defines the structure of all responses on API endpoints
@@ -2,11 +2,15 @@
 
 generate() {
     generateForAssessmentTool
+    generateForDBConverter
     generateForKeyGenerator
     generateForLogViewer
     generateForNode
     generateForSeedNode
+    generateForStateArchiver
     generateForTermUi
+    generateForTrieChecker
+    generateForTrieStats
 }
 
 generateForAssessmentTool() {
@@ -23,6 +27,20 @@ $(code)
     echo "$HELP" > ./assessment/CLI.md
 }
 
+generateForDBConverter() {
+    HELP="
+# Database conversion Tool CLI
+
+The **Database conversion Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbconverter --help
+
+$(./dbconverter/dbconverter --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbconverter/CLI.md
+}
+
 generateForKeyGenerator() {
     HELP="
 # Keygenerator CLI
@@ -79,6 +97,20 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForStateArchiver() {
+    HELP="
+# State archive Tool CLI
+
+The **State archive Tool** exposes the following Command Line Interface:
+$(code)
+\$ statearchiver --help
+
+$(./statearchiver/statearchiver --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./statearchiver/CLI.md
+}
+
 generateForTermUi() {
     HELP="
 # MultiversX TermUI CLI
@@ -93,6 +125,34 @@ $(code)
     echo "$HELP" > ./termui/CLI.md
 }
 
+generateForTrieChecker() {
+    HELP="
+# Trie checker Tool CLI
+
+The **Trie checker Tool** exposes the following Command Line Interface:
+$(code)
+\$ triechecker --help
+
+$(./triechecker/triechecker --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./triechecker/CLI.md
+}
+
+generateForTrieStats() {
+    HELP="
+# Trie statistics Tool CLI
+
+The **Trie statistics Tool** exposes the following Command Line Interface:
+$(code)
+\$ triestats --help
+
+$(./triestats/triestats --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./triestats/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
@@ -0,0 +1,343 @@
+package converter
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage/pebbledb"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+const (
+	// read + write + execute for owner only
+	rwxOwner                = 0700
+	dbConfigFileName        = "config.toml"
+	levelDBCurrentFileName  = "CURRENT"
+	pebbleOptionsFilePrefix = "OPTIONS-"
+	batchDelaySeconds       = 2
+	numKeysBetweenProgress  = 1_000_000
+)
+
+var log = logger.GetOrCreate("dbconverter")
+
+// ArgsDBConverter holds the arguments needed to create a new database converter
+type ArgsDBConverter struct {
+	SourcePath      string
+	DestinationPath string
+	MaxBatchSize    int
+	MaxOpenFiles    int
+	Verify          bool
+}
+
+// ConversionStats holds the outcome of a conversion
+type ConversionStats struct {
+	NumDatabases   int
+	NumKeys        uint64
+	NumBytes       uint64
+	NumCopiedFiles int
+}
+
+type dbConverter struct {
+	sourcePath      string
+	destinationPath string
+	maxBatchSize    int
+	maxOpenFiles    int
+	verify          bool
+}
+
+// NewDBConverter creates a converter of the LevelDB databases found in a directory (e.g. the "db" directory of a node)
+// into Pebble databases, in a different directory, preserving the layout.
+func NewDBConverter(args ArgsDBConverter) (*dbConverter, error) {
+	if len(args.SourcePath) == 0 {
+		return nil, ErrEmptySourcePath
+	}
+	if len(args.DestinationPath) == 0 {
+		return nil, ErrEmptyDestinationPath
+	}
+	if args.MaxBatchSize < 1 {
+		return nil, ErrInvalidMaxBatchSize
+	}
+
+	sourcePath, err := filepath.Abs(args.SourcePath)
+	if err != nil {
+		return nil, err
+	}
+	destinationPath, err := filepath.Abs(args.DestinationPath)
+	if err != nil {
+		return nil, err
+	}
+	if isSameOrNestedPath(sourcePath, destinationPath) {
+		return nil, ErrSameSourceAndDestination
+	}
+
+	return &dbConverter{
+		sourcePath:      sourcePath,
+		destinationPath: destinationPath,
+		maxBatchSize:    args.MaxBatchSize,
+		maxOpenFiles:    args.MaxOpenFiles,
+		verify:          args.Verify,
+	}, nil
+}
+
+// Convert converts the LevelDB databases from the source directory into Pebble databases, in the destination directory.
+// The other files are copied as they are, except for the configuration files of the databases, which are updated
+// to the new database type. The source directory is only read.
+func (converter *dbConverter) Convert() (*ConversionStats, error) {
+	err := checkDestinationIsEmpty(converter.destinationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &ConversionStats{}
+	err = filepath.WalkDir(converter.sourcePath, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(converter.sourcePath, path)
+		if err != nil {
+			return err
+		}
+		destinationPath := filepath.Join(converter.destinationPath, relativePath)
+
+		if !entry.IsDir() {
+			stats.NumCopiedFiles++
+			return convertOrCopyFile(path, destinationPath)
+		}
+
+		if !isLevelDBDirectory(path) {
+			return os.MkdirAll(destinationPath, rwxOwner)
+		}
+
+		err = converter.convertDatabase(path, destinationPath, stats)
+		if err != nil {
+			return fmt.Errorf("%w while converting %s", err, path)
+		}
+
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (converter *dbConverter) convertDatabase(sourcePath string, destinationPath string, stats *ConversionStats) error {
+	log.Info("converting database", "source", sourcePath, "destination", destinationPath)
+
+	source, err := leveldb.OpenFile(sourcePath, &opt.Options{
+		ReadOnly:               true,
+		OpenFilesCacheCapacity: converter.maxOpenFiles,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = source.Close()
+	}()
+
+	destination, err := pebbledb.NewDB(destinationPath, batchDelaySeconds, converter.maxBatchSize, converter.maxOpenFiles)
+	if err != nil {
+		return err
+	}
+
+	numKeys := uint64(0)
+	iterator := source.NewIterator(nil, nil)
+	for iterator.Next() {
+		// the iterator reuses its buffers, while the batch of the destination holds the provided slices
+		key := cloneBytes(iterator.Key())
+		value := cloneBytes(iterator.Value())
+
+		err = destination.Put(key, value)
+		if err != nil {
+			iterator.Release()
+			_ = destination.Close()
+			return err
+		}
+
+		numKeys++
+		stats.NumBytes += uint64(len(key) + len(value))
+		if numKeys%numKeysBetweenProgress == 0 {
+			log.Info("converting database", "source", sourcePath, "num keys", numKeys)
+		}
+	}
+	iterator.Release()
+
+	err = iterator.Error()
+	if err != nil {
+		_ = destination.Close()
+		return err
+	}
+
+	// closing the destination commits the last batch
+	err = destination.Close()
+	if err != nil {
+		return err
+	}
+
+	if converter.verify {
+		err = converter.verifyNumKeys(destinationPath, numKeys)
+		if err != nil {
+			return err
+		}
+	}
+
+	stats.NumDatabases++
+	stats.NumKeys += numKeys
+	log.Info("converted database", "source", sourcePath, "num keys", numKeys)
+
+	return convertOrCopyFileIfExists(filepath.Join(sourcePath, dbConfigFileName), filepath.Join(destinationPath, dbConfigFileName))
+}
+
+func (converter *dbConverter) verifyNumKeys(path string, expectedNumKeys uint64) error {
+	db, err := pebbledb.NewDB(path, batchDelaySeconds, converter.maxBatchSize, converter.maxOpenFiles)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	numKeys := uint64(0)
+	db.RangeKeys(func(_ []byte, _ []byte) bool {
+		numKeys++
+		return true
+	})
+
+	if numKeys != expectedNumKeys {
+		return fmt.Errorf("%w: expected %d, found %d", errNumKeysMismatch, expectedNumKeys, numKeys)
+	}
+
+	return nil
+}
+
+// convertOrCopyFile updates the type of the configuration files of the databases, while the other files are copied
+func convertOrCopyFile(sourcePath string, destinationPath string) error {
+	if filepath.Base(sourcePath) != dbConfigFileName {
+		return copyFile(sourcePath, destinationPath)
+	}
+
+	dbConfig := &config.DBConfig{}
+	err := core.LoadTomlFile(dbConfig, sourcePath)
+	if err != nil || !isLevelDBType(dbConfig.Type) {
+		return copyFile(sourcePath, destinationPath)
+	}
+
+	dbConfig.Type = string(storageunit.PebbleDB)
+
+	return core.SaveTomlFile(dbConfig, destinationPath)
+}
+
+func convertOrCopyFileIfExists(sourcePath string, destinationPath string) error {
+	_, err := os.Stat(sourcePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return convertOrCopyFile(sourcePath, destinationPath)
+}
+
+func copyFile(sourcePath string, destinationPath string) error {
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = source.Close()
+	}()
+
+	err = os.MkdirAll(filepath.Dir(destinationPath), rwxOwner)
+	if err != nil {
+		return err
+	}
+
+	destination, err := os.Create(destinationPath)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(destination, source)
+	if err != nil {
+		_ = destination.Close()
+		return err
+	}
+
+	return destination.Close()
+}
+
+func isLevelDBType(dbType string) bool {
+	return dbType == string(storageunit.LvlDB) || dbType == string(storageunit.LvlDBSerial)
+}
+
+// isLevelDBDirectory returns true if the directory holds a LevelDB database. Pebble databases also have a "CURRENT"
+// file, but, unlike LevelDB ones, they have an "OPTIONS" file, as well.
+func isLevelDBDirectory(path string) bool {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return false
+	}
+
+	hasCurrentFile := false
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if strings.HasPrefix(entry.Name(), pebbleOptionsFilePrefix) {
+			return false
+		}
+		if entry.Name() == levelDBCurrentFileName {
+			hasCurrentFile = true
+		}
+	}
+
+	return hasCurrentFile
+}
+
+func checkDestinationIsEmpty(path string) error {
+	entries, err := os.ReadDir(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return ErrDestinationNotEmpty
+	}
+
+	return nil
+}
+
+func isSameOrNestedPath(parent string, path string) bool {
+	relativePath, err := filepath.Rel(parent, path)
+	if err != nil {
+		return false
+	}
+
+	return relativePath == "." || !strings.HasPrefix(relativePath, "..")
+}
+
+func cloneBytes(data []byte) []byte {
+	cloned := make([]byte, len(data))
+	copy(cloned, data)
+
+	return cloned
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (converter *dbConverter) IsInterfaceNil() bool {
+	return converter == nil
+}
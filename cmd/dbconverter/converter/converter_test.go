@@ -0,0 +1,207 @@
+package converter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/storage/pebbledb"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsDBConverter(t *testing.T) ArgsDBConverter {
+	return ArgsDBConverter{
+		SourcePath:      t.TempDir(),
+		DestinationPath: filepath.Join(t.TempDir(), "converted"),
+		MaxBatchSize:    7,
+		MaxOpenFiles:    10,
+		Verify:          true,
+	}
+}
+
+func createLevelDB(t *testing.T, path string, numKeys int) {
+	db, err := database.NewSerialDB(path, 2, 100, 10)
+	require.Nil(t, err)
+
+	for i := 0; i < numKeys; i++ {
+		err = db.Put([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i)))
+		require.Nil(t, err)
+	}
+
+	require.Nil(t, db.Close())
+}
+
+func saveDBConfig(t *testing.T, path string, dbType string) {
+	err := core.SaveTomlFile(&config.DBConfig{
+		Type:              dbType,
+		BatchDelaySeconds: 2,
+		MaxBatchSize:      100,
+		MaxOpenFiles:      10,
+	}, filepath.Join(path, dbConfigFileName))
+	require.Nil(t, err)
+}
+
+func requirePebbleDBContents(t *testing.T, path string, numKeys int) {
+	db, err := pebbledb.NewDB(path, 2, 100, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	numFound := 0
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		numFound++
+		return true
+	})
+	require.Equal(t, numKeys, numFound)
+
+	for i := 0; i < numKeys; i++ {
+		value, errGet := db.Get([]byte(fmt.Sprintf("key-%d", i)))
+		require.Nil(t, errGet)
+		require.Equal(t, []byte(fmt.Sprintf("value-%d", i)), value)
+	}
+}
+
+func requireDBType(t *testing.T, path string, dbType storageunit.DBType) {
+	dbConfig := &config.DBConfig{}
+	err := core.LoadTomlFile(dbConfig, filepath.Join(path, dbConfigFileName))
+	require.Nil(t, err)
+	require.Equal(t, string(dbType), dbConfig.Type)
+}
+
+func TestNewDBConverter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty source path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsDBConverter(t)
+		args.SourcePath = ""
+		dbConverter, err := NewDBConverter(args)
+		assert.Nil(t, dbConverter)
+		assert.Equal(t, ErrEmptySourcePath, err)
+	})
+	t.Run("empty destination path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsDBConverter(t)
+		args.DestinationPath = ""
+		dbConverter, err := NewDBConverter(args)
+		assert.Nil(t, dbConverter)
+		assert.Equal(t, ErrEmptyDestinationPath, err)
+	})
+	t.Run("invalid max batch size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsDBConverter(t)
+		args.MaxBatchSize = 0
+		dbConverter, err := NewDBConverter(args)
+		assert.Nil(t, dbConverter)
+		assert.Equal(t, ErrInvalidMaxBatchSize, err)
+	})
+	t.Run("destination inside source should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsDBConverter(t)
+		args.DestinationPath = filepath.Join(args.SourcePath, "converted")
+		dbConverter, err := NewDBConverter(args)
+		assert.Nil(t, dbConverter)
+		assert.Equal(t, ErrSameSourceAndDestination, err)
+
+		args.DestinationPath = args.SourcePath
+		dbConverter, err = NewDBConverter(args)
+		assert.Nil(t, dbConverter)
+		assert.Equal(t, ErrSameSourceAndDestination, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dbConverter, err := NewDBConverter(createArgsDBConverter(t))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(dbConverter))
+	})
+}
+
+func TestDbConverter_Convert(t *testing.T) {
+	t.Parallel()
+
+	t.Run("destination not empty should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsDBConverter(t)
+		require.Nil(t, os.MkdirAll(args.DestinationPath, rwxOwner))
+		require.Nil(t, os.WriteFile(filepath.Join(args.DestinationPath, "file"), []byte("data"), 0600))
+
+		dbConverter, _ := NewDBConverter(args)
+		stats, err := dbConverter.Convert()
+		assert.Nil(t, stats)
+		assert.Equal(t, ErrDestinationNotEmpty, err)
+	})
+	t.Run("should convert the databases and preserve the layout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsDBConverter(t)
+
+		// a simple storer, with the configuration inside the database directory
+		simpleStorerPath := filepath.Join(args.SourcePath, "Epoch_0", "Shard_0", "BlockHeaders")
+		createLevelDB(t, simpleStorerPath, 20)
+		saveDBConfig(t, simpleStorerPath, string(storageunit.LvlDBSerial))
+
+		// a sharded storer, with the configuration in the parent directory
+		shardedStorerPath := filepath.Join(args.SourcePath, "Epoch_0", "Shard_0", "AccountsTrie")
+		createLevelDB(t, filepath.Join(shardedStorerPath, "0"), 10)
+		createLevelDB(t, filepath.Join(shardedStorerPath, "1"), 0)
+		saveDBConfig(t, shardedStorerPath, string(storageunit.LvlDB))
+
+		// other files are copied
+		require.Nil(t, os.WriteFile(filepath.Join(args.SourcePath, "Epoch_0", "file.txt"), []byte("data"), 0600))
+
+		dbConverter, _ := NewDBConverter(args)
+		stats, err := dbConverter.Convert()
+		require.Nil(t, err)
+		require.Equal(t, 3, stats.NumDatabases)
+		require.Equal(t, uint64(30), stats.NumKeys)
+		require.Equal(t, 2, stats.NumCopiedFiles)
+
+		convertedSimpleStorerPath := filepath.Join(args.DestinationPath, "Epoch_0", "Shard_0", "BlockHeaders")
+		requirePebbleDBContents(t, convertedSimpleStorerPath, 20)
+		requireDBType(t, convertedSimpleStorerPath, storageunit.PebbleDB)
+
+		convertedShardedStorerPath := filepath.Join(args.DestinationPath, "Epoch_0", "Shard_0", "AccountsTrie")
+		requirePebbleDBContents(t, filepath.Join(convertedShardedStorerPath, "0"), 10)
+		requirePebbleDBContents(t, filepath.Join(convertedShardedStorerPath, "1"), 0)
+		requireDBType(t, convertedShardedStorerPath, storageunit.PebbleDB)
+
+		data, err := os.ReadFile(filepath.Join(args.DestinationPath, "Epoch_0", "file.txt"))
+		require.Nil(t, err)
+		require.Equal(t, []byte("data"), data)
+
+		// the source is left untouched
+		requireDBType(t, simpleStorerPath, storageunit.LvlDBSerial)
+		require.True(t, isLevelDBDirectory(simpleStorerPath))
+	})
+}
+
+func TestIsLevelDBDirectory(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, isLevelDBDirectory(filepath.Join(t.TempDir(), "missing")))
+	require.False(t, isLevelDBDirectory(t.TempDir()))
+
+	levelDBPath := t.TempDir()
+	createLevelDB(t, levelDBPath, 1)
+	require.True(t, isLevelDBDirectory(levelDBPath))
+
+	pebbleDBPath := t.TempDir()
+	db, err := pebbledb.NewDB(pebbleDBPath, 2, 100, 10)
+	require.Nil(t, err)
+	require.Nil(t, db.Close())
+	require.False(t, isLevelDBDirectory(pebbleDBPath))
+}
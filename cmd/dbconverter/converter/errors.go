@@ -0,0 +1,21 @@
+package converter
+
+import "errors"
+
+// ErrEmptySourcePath signals that an empty source path has been provided
+var ErrEmptySourcePath = errors.New("empty source path")
+
+// ErrEmptyDestinationPath signals that an empty destination path has been provided
+var ErrEmptyDestinationPath = errors.New("empty destination path")
+
+// ErrInvalidMaxBatchSize signals that an invalid max batch size has been provided
+var ErrInvalidMaxBatchSize = errors.New("invalid max batch size")
+
+// ErrDestinationNotEmpty signals that the destination directory already holds files
+var ErrDestinationNotEmpty = errors.New("destination directory is not empty")
+
+// ErrSameSourceAndDestination signals that the destination directory is the same as (or inside) the source one
+var ErrSameSourceAndDestination = errors.New("destination directory should not be inside the source directory")
+
+// errNumKeysMismatch signals that the converted database does not hold the same number of keys as the source one
+var errNumKeysMismatch = errors.New("number of keys mismatch after conversion")
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/cmd/dbconverter/converter"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	source           string
+	destination      string
+	maxBatchSize     int
+	maxOpenFiles     int
+	skipVerification bool
+	logLevel         string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// source defines a flag for the directory holding the LevelDB databases
+	source = cli.StringFlag{
+		Name:        "source",
+		Usage:       "The directory holding the LevelDB databases (e.g. the db directory of a stopped node). It is only read.",
+		Destination: &argsConfig.source,
+	}
+	// destination defines a flag for the directory where the Pebble databases are written
+	destination = cli.StringFlag{
+		Name:        "destination",
+		Usage:       "The (empty or missing) directory where the Pebble databases are written, preserving the layout of the source directory",
+		Destination: &argsConfig.destination,
+	}
+	// maxBatchSize defines a flag for the number of keys committed at once
+	maxBatchSize = cli.IntFlag{
+		Name:        "max-batch-size",
+		Usage:       "The number of keys committed at once in the Pebble databases",
+		Value:       10000,
+		Destination: &argsConfig.maxBatchSize,
+	}
+	// maxOpenFiles defines a flag for the maximum number of files opened by each database
+	maxOpenFiles = cli.IntFlag{
+		Name:        "max-open-files",
+		Usage:       "The maximum number of files opened by each database",
+		Value:       10,
+		Destination: &argsConfig.maxOpenFiles,
+	}
+	// skipVerification defines a flag for skipping the count of the keys of each converted database
+	skipVerification = cli.BoolFlag{
+		Name:        "skip-verification",
+		Usage:       "Boolean option that skips counting the keys of each converted database (which reads it once more)",
+		Destination: &argsConfig.skipVerification,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name:        "log-level",
+		Usage:       "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example, if set to *:INFO the logs for all packages will have the INFO level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("dbconverter")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Database conversion Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary converts the LevelDB databases of a (stopped) node into Pebble databases"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		source,
+		destination,
+		maxBatchSize,
+		maxOpenFiles,
+		skipVerification,
+		logLevel,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error converting databases", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	dbConverter, err := converter.NewDBConverter(converter.ArgsDBConverter{
+		SourcePath:      argsConfig.source,
+		DestinationPath: argsConfig.destination,
+		MaxBatchSize:    argsConfig.maxBatchSize,
+		MaxOpenFiles:    argsConfig.maxOpenFiles,
+		Verify:          !argsConfig.skipVerification,
+	})
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	stats, err := dbConverter.Convert()
+	if err != nil {
+		return err
+	}
+
+	log.Info("conversion finished",
+		"num databases", stats.NumDatabases,
+		"num keys", stats.NumKeys,
+		"size", fmt.Sprintf("%d bytes", stats.NumBytes),
+		"num copied files", stats.NumCopiedFiles,
+		"duration", time.Since(startTime),
+	)
+	log.Info("in order to use the converted databases, set the Type of the storers to PebbleDB in config.toml")
+
+	return nil
+}
@@ -331,6 +331,46 @@ var (
 		Name:  "import-db-save-epoch-root-hash",
 		Usage: "This flag, if set, will export the trie snapshots at every new epoch",
 	}
+	// outportReplay defines a flag that, if set, will make the node re-export the blocks from its local database to the outport drivers
+	outportReplay = cli.BoolFlag{
+		Name: "outport-replay",
+		Usage: "This flag, if set, will make the node push the blocks from its local database to the configured outport " +
+			"drivers and then exit. The blocks range is given by the outport-replay nonce or epoch flags",
+	}
+	// outportReplayStartNonce defines a flag for the first block nonce to be replayed
+	outportReplayStartNonce = cli.Uint64Flag{
+		Name:  "outport-replay-start-nonce",
+		Usage: "This flag specifies the nonce of the first block to be replayed. Can be used only if the outport-replay was set",
+		Value: 1,
+	}
+	// outportReplayEndNonce defines a flag for the last block nonce to be replayed
+	outportReplayEndNonce = cli.Uint64Flag{
+		Name:  "outport-replay-end-nonce",
+		Usage: "This flag specifies the nonce of the last block to be replayed. If not set, the replay continues up to the last block in storage",
+	}
+	// outportReplayStartEpoch defines a flag for the first epoch to be replayed
+	outportReplayStartEpoch = cli.Uint64Flag{
+		Name:  "outport-replay-start-epoch",
+		Usage: "This flag specifies the first epoch to be replayed. If set, the nonce flags are ignored",
+	}
+	// outportReplayEndEpoch defines a flag for the last epoch to be replayed
+	outportReplayEndEpoch = cli.Uint64Flag{
+		Name:  "outport-replay-end-epoch",
+		Usage: "This flag specifies the last epoch to be replayed. If set, the nonce flags are ignored",
+	}
+	// outportReplayMaxBlocksPerSecond defines a flag for the replay rate limit
+	outportReplayMaxBlocksPerSecond = cli.Uint64Flag{
+		Name:  "outport-replay-max-blocks-per-second",
+		Usage: "This flag specifies the maximum number of blocks pushed to the outport drivers each second. 0 means unlimited",
+		Value: 0,
+	}
+	// outportReplayCheckpointFile defines a flag for the file holding the replay progress
+	outportReplayCheckpointFile = cli.StringFlag{
+		Name: "outport-replay-checkpoint-file",
+		Usage: "This flag specifies the file in which the last replayed block is saved. If the file exists, the replay " +
+			"resumes from the block following the saved one",
+		Value: "",
+	}
 	// redundancyLevel defines a flag that specifies the level of redundancy used by the current instance for the node (-1 = disabled, 0 = main instance (default), 1 = first backup, 2 = second backup, etc.)
 	redundancyLevel = cli.Int64Flag{
 		Name:  "redundancy-level",
@@ -408,6 +448,15 @@ var (
 		Name:  "p2p-prometheus-metrics",
 		Usage: "Boolean option for enabling the /debug/metrics/prometheus route for p2p prometheus metrics",
 	}
+
+	// stateArchive defines a flag for the path of a state archive used instead of syncing the accounts trie from the network
+	stateArchive = cli.StringFlag{
+		Name: "state-archive",
+		Usage: "This flag specifies the path of a state archive, created with the statearchiver tool, holding the accounts state " +
+			"at the epoch start the node bootstraps from. The archive is verified and imported instead of syncing the accounts " +
+			"trie from the network. If the import fails, the node falls back to the network sync",
+		Value: "",
+	}
 )
 
 func getFlags() []cli.Flag {
@@ -455,6 +504,13 @@ func getFlags() []cli.Flag {
 		importDbDirectory,
 		importDbNoSigCheck,
 		importDbSaveEpochRootHash,
+		outportReplay,
+		outportReplayStartNonce,
+		outportReplayEndNonce,
+		outportReplayStartEpoch,
+		outportReplayEndEpoch,
+		outportReplayMaxBlocksPerSecond,
+		outportReplayCheckpointFile,
 		redundancyLevel,
 		fullArchive,
 		memBallast,
@@ -470,6 +526,7 @@ func getFlags() []cli.Flag {
 		operationMode,
 		repopulateTokensSupplies,
 		p2pPrometheusMetrics,
+		stateArchive,
 	}
 }
 
@@ -499,6 +556,7 @@ func getFlagsConfig(ctx *cli.Context, log logger.Logger) *config.ContextFlagsCon
 	flagsConfig.OperationMode = ctx.GlobalString(operationMode.Name)
 	flagsConfig.RepopulateTokensSupplies = ctx.GlobalBool(repopulateTokensSupplies.Name)
 	flagsConfig.P2PPrometheusMetricsEnabled = ctx.GlobalBool(p2pPrometheusMetrics.Name)
+	flagsConfig.StateArchivePath = ctx.GlobalString(stateArchive.Name)
 
 	if ctx.GlobalBool(noKey.Name) {
 		log.Warn("the provided -no-key option is deprecated and will soon be removed. To start a node without " +
@@ -553,6 +611,7 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 	}
 	cfgs.FlagsConfig = flagsConfig
 	cfgs.ImportDbConfig = importDBConfigs
+	cfgs.OutportReplayConfig = getOutportReplayConfig(ctx)
 	err := applyCompatibleConfigs(log, cfgs)
 	if err != nil {
 		return err
@@ -568,6 +627,27 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 	return nil
 }
 
+func getOutportReplayConfig(ctx *cli.Context) *config.OutportReplayConfig {
+	replayConfig := &config.OutportReplayConfig{
+		IsReplayMode:       ctx.GlobalBool(outportReplay.Name),
+		IsEpochRange:       ctx.IsSet(outportReplayStartEpoch.Name) || ctx.IsSet(outportReplayEndEpoch.Name),
+		StartNonce:         ctx.GlobalUint64(outportReplayStartNonce.Name),
+		EndNonce:           math.MaxUint64,
+		StartEpoch:         uint32(ctx.GlobalUint64(outportReplayStartEpoch.Name)),
+		EndEpoch:           math.MaxUint32,
+		MaxBlocksPerSecond: ctx.GlobalUint64(outportReplayMaxBlocksPerSecond.Name),
+		CheckpointFilePath: ctx.GlobalString(outportReplayCheckpointFile.Name),
+	}
+	if ctx.IsSet(outportReplayEndNonce.Name) {
+		replayConfig.EndNonce = ctx.GlobalUint64(outportReplayEndNonce.Name)
+	}
+	if ctx.IsSet(outportReplayEndEpoch.Name) {
+		replayConfig.EndEpoch = uint32(ctx.GlobalUint64(outportReplayEndEpoch.Name))
+	}
+
+	return replayConfig
+}
+
 func getWorkingDir(ctx *cli.Context, cliFlag cli.StringFlag, log logger.Logger) string {
 	var err error
 
@@ -612,6 +692,13 @@ func applyCompatibleConfigs(log logger.Logger, configs *config.Configs) error {
 		return fmt.Errorf("import-db-no-sig-check can only be used with the import-db flag")
 	}
 
+	if configs.OutportReplayConfig != nil && configs.OutportReplayConfig.IsReplayMode {
+		if isInImportDBMode {
+			return fmt.Errorf("outport-replay can not be used together with the import-db flag")
+		}
+		processConfigOutportReplayMode(log, configs)
+	}
+
 	if configs.PreferencesConfig.BlockProcessingCutoff.Enabled {
 		log.Debug("node is started by using the block processing cut-off - will disable the watchdog")
 		configs.FlagsConfig.DisableConsensusWatchdog = true
@@ -711,6 +798,23 @@ func processConfigImportDBMode(log logger.Logger, configs *config.Configs) error
 	return nil
 }
 
+func processConfigOutportReplayMode(log logger.Logger, configs *config.Configs) {
+	// the blocks are read from the local database, so the node must not bootstrap from the network
+	configs.GeneralConfig.GeneralSettings.StartInEpochEnabled = false
+
+	replayConfig := configs.OutportReplayConfig
+	log.Warn("the node is in outport replay mode! Will push the stored blocks to the outport drivers and then exit",
+		"GeneralSettings.StartInEpochEnabled", configs.GeneralConfig.GeneralSettings.StartInEpochEnabled,
+		"epoch range", replayConfig.IsEpochRange,
+		"start nonce", replayConfig.StartNonce,
+		"end nonce", replayConfig.EndNonce,
+		"start epoch", replayConfig.StartEpoch,
+		"end epoch", replayConfig.EndEpoch,
+		"max blocks per second", replayConfig.MaxBlocksPerSecond,
+		"checkpoint file", replayConfig.CheckpointFilePath,
+	)
+}
+
 func processConfigFullArchiveMode(log logger.Logger, configs *config.Configs) {
 	generalConfigs := configs.GeneralConfig
 
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/state/stateArchive"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const accountsTrieIdentifier = "AccountsTrie"
+
+type cfg struct {
+	dbPath   string
+	shard    string
+	epoch    uint
+	rootHash string
+	output   string
+	logLevel string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// dbPath defines a flag for the directory holding the databases of the node
+	dbPath = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "The directory holding the per epoch databases of a stopped node, including the chain ID (e.g. db/1)",
+		Destination: &argsConfig.dbPath,
+	}
+	// shard defines a flag for the shard of the exported state
+	shard = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "The shard of the exported state. Possible values: 0, 1, 2, ... or metachain",
+		Value:       "0",
+		Destination: &argsConfig.shard,
+	}
+	// epoch defines a flag for the epoch of the exported state
+	epoch = cli.UintFlag{
+		Name:        "epoch",
+		Usage:       "The epoch of the exported state. The accounts trie databases of this epoch and of the previous ones are searched",
+		Destination: &argsConfig.epoch,
+	}
+	// rootHash defines a flag for the root hash of the exported accounts trie
+	rootHash = cli.StringFlag{
+		Name:        "root-hash",
+		Usage:       "The hex encoded root hash of the exported accounts trie, usually the one of the epoch start block",
+		Destination: &argsConfig.rootHash,
+	}
+	// output defines a flag for the path of the written archive
+	output = cli.StringFlag{
+		Name:        "output",
+		Usage:       "The path of the written state archive",
+		Value:       "state.tar.gz",
+		Destination: &argsConfig.output,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name:        "log-level",
+		Usage:       "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example, if set to *:INFO the logs for all packages will have the INFO level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("statearchiver")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "State archive Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary exports the accounts state of a (stopped) node into a portable state archive, to be used with the --state-archive node flag"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		shard,
+		epoch,
+		rootHash,
+		output,
+		logLevel,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error exporting state", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	shardID, err := core.ConvertShardIDToUint32(argsConfig.shard)
+	if err != nil {
+		return err
+	}
+	if len(argsConfig.rootHash) == 0 {
+		return fmt.Errorf("the root-hash flag is required")
+	}
+	rootHashBytes, err := hex.DecodeString(argsConfig.rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the root hash", err)
+	}
+
+	storer, err := factory.NewEpochsStorer(factory.ArgsEpochsStorer{
+		DBPath:     argsConfig.dbPath,
+		ShardID:    shardID,
+		Epoch:      uint32(argsConfig.epoch),
+		Identifier: accountsTrieIdentifier,
+		ReadOnly:   true,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	// the node uses the same hasher and marshaller for the trie nodes on all the networks
+	exporter, err := stateArchive.NewStateExporter(stateArchive.ArgsStateExporter{
+		Marshaller: &marshal.GogoProtoMarshalizer{},
+		Hasher:     blake2b.NewBlake2b(),
+		Storer:     storer,
+	})
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	log.Info("exporting state", "root hash", argsConfig.rootHash, "shard", argsConfig.shard, "epoch", argsConfig.epoch,
+		"num databases", storer.NumDatabases())
+	manifest, err := exporter.Export(rootHashBytes, uint32(argsConfig.epoch), shardID, argsConfig.output)
+	if err != nil {
+		return err
+	}
+
+	log.Info("export finished",
+		"output", argsConfig.output,
+		"num trie nodes", manifest.NumTrieNodes,
+		"num data tries", manifest.NumDataTries,
+		"size", fmt.Sprintf("%d bytes", manifest.NodesSizeInBytes),
+		"checksum", manifest.NodesChecksum,
+		"duration", time.Since(startTime),
+	)
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package checker
+
+// NodeIssue describes a missing, corrupt or repaired trie node
+type NodeIssue struct {
+	TrieRootHash string `json:"trieRootHash"`
+	Address      string `json:"address,omitempty"`
+	Hash         string `json:"hash"`
+	Reason       string `json:"reason,omitempty"`
+}
+
+// DataTrieMismatch describes a data trie which does not belong to the account pointing to it
+type DataTrieMismatch struct {
+	Address             string `json:"address"`
+	RootHash            string `json:"rootHash"`
+	NumMismatchedLeaves uint64 `json:"numMismatchedLeaves"`
+	Reason              string `json:"reason"`
+}
+
+// Report holds the result of a state check. The issue lists are capped, while the counters are not
+type Report struct {
+	RootHash     string `json:"rootHash"`
+	IsConsistent bool   `json:"isConsistent"`
+	// IsMainTrieComplete is false if nodes of the accounts trie could not be reached, case in which the code entries
+	// are not checked, as the missing ones might be under the unreachable nodes
+	IsMainTrieComplete bool `json:"isMainTrieComplete"`
+
+	NumTrieNodes   uint64 `json:"numTrieNodes"`
+	NumAccounts    uint64 `json:"numAccounts"`
+	NumDataTries   uint64 `json:"numDataTries"`
+	NumCodeEntries uint64 `json:"numCodeEntries"`
+
+	NumMissingNodes        uint64 `json:"numMissingNodes"`
+	NumCorruptNodes        uint64 `json:"numCorruptNodes"`
+	NumRepairedNodes       uint64 `json:"numRepairedNodes"`
+	NumMissingCodeEntries  uint64 `json:"numMissingCodeEntries"`
+	NumDanglingCodeEntries uint64 `json:"numDanglingCodeEntries"`
+	NumDataTrieMismatches  uint64 `json:"numDataTrieMismatches"`
+	NumUnrecognizedLeaves  uint64 `json:"numUnrecognizedLeaves"`
+
+	MissingNodes        []NodeIssue        `json:"missingNodes"`
+	CorruptNodes        []NodeIssue        `json:"corruptNodes"`
+	RepairedNodes       []NodeIssue        `json:"repairedNodes"`
+	MissingCodeEntries  []string           `json:"missingCodeEntries"`
+	DanglingCodeEntries []string           `json:"danglingCodeEntries"`
+	DataTrieMismatches  []DataTrieMismatch `json:"dataTrieMismatches"`
+	UnrecognizedLeaves  []string           `json:"unrecognizedLeaves"`
+
+	maxReportedIssuesPerType int
+}
+
+func newReport(rootHash string, maxReportedIssuesPerType int) *Report {
+	return &Report{
+		RootHash:                 rootHash,
+		MissingNodes:             make([]NodeIssue, 0),
+		CorruptNodes:             make([]NodeIssue, 0),
+		RepairedNodes:            make([]NodeIssue, 0),
+		MissingCodeEntries:       make([]string, 0),
+		DanglingCodeEntries:      make([]string, 0),
+		DataTrieMismatches:       make([]DataTrieMismatch, 0),
+		UnrecognizedLeaves:       make([]string, 0),
+		maxReportedIssuesPerType: maxReportedIssuesPerType,
+	}
+}
+
+func (report *Report) canAdd(numReported int) bool {
+	return report.maxReportedIssuesPerType == 0 || numReported < report.maxReportedIssuesPerType
+}
+
+func (report *Report) addMissingNode(issue NodeIssue) {
+	report.NumMissingNodes++
+	if report.canAdd(len(report.MissingNodes)) {
+		report.MissingNodes = append(report.MissingNodes, issue)
+	}
+}
+
+func (report *Report) addCorruptNode(issue NodeIssue) {
+	report.NumCorruptNodes++
+	if report.canAdd(len(report.CorruptNodes)) {
+		report.CorruptNodes = append(report.CorruptNodes, issue)
+	}
+}
+
+func (report *Report) addRepairedNode(issue NodeIssue) {
+	report.NumRepairedNodes++
+	if report.canAdd(len(report.RepairedNodes)) {
+		report.RepairedNodes = append(report.RepairedNodes, issue)
+	}
+}
+
+func (report *Report) addMissingCodeEntry(codeHash string) {
+	report.NumMissingCodeEntries++
+	if report.canAdd(len(report.MissingCodeEntries)) {
+		report.MissingCodeEntries = append(report.MissingCodeEntries, codeHash)
+	}
+}
+
+func (report *Report) addDanglingCodeEntry(codeHash string) {
+	report.NumDanglingCodeEntries++
+	if report.canAdd(len(report.DanglingCodeEntries)) {
+		report.DanglingCodeEntries = append(report.DanglingCodeEntries, codeHash)
+	}
+}
+
+func (report *Report) addDataTrieMismatch(mismatch DataTrieMismatch) {
+	report.NumDataTrieMismatches++
+	if report.canAdd(len(report.DataTrieMismatches)) {
+		report.DataTrieMismatches = append(report.DataTrieMismatches, mismatch)
+	}
+}
+
+func (report *Report) addUnrecognizedLeaf(key string) {
+	report.NumUnrecognizedLeaves++
+	if report.canAdd(len(report.UnrecognizedLeaves)) {
+		report.UnrecognizedLeaves = append(report.UnrecognizedLeaves, key)
+	}
+}
+
+func (report *Report) computeIsConsistent() {
+	report.IsConsistent = report.NumMissingNodes == 0 &&
+		report.NumCorruptNodes == 0 &&
+		report.NumMissingCodeEntries == 0 &&
+		report.NumDanglingCodeEntries == 0 &&
+		report.NumDataTrieMismatches == 0 &&
+		report.NumUnrecognizedLeaves == 0
+}
@@ -0,0 +1,279 @@
+package checker
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/dataTrieValue"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/trie/statistics"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("triechecker/checker")
+
+const progressLogInterval = 10000
+
+// ArgsStateChecker is the DTO used to create a new state checker
+type ArgsStateChecker struct {
+	Storer                   common.BaseStorer
+	RepairSource             common.BaseStorer
+	Marshaller               marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	MaxReportedIssuesPerType int
+}
+
+type stateChecker struct {
+	trieChecker              trieChecker
+	marshaller               marshal.Marshalizer
+	hasher                   hashing.Hasher
+	maxReportedIssuesPerType int
+}
+
+type trieChecker interface {
+	CheckTrie(rootHash []byte, stats common.TrieStatisticsHandler, leafHandler trie.TrieLeafHandler) (*trie.TrieCheckResult, error)
+}
+
+// checkSession holds the state of a single check
+type checkSession struct {
+	report         *Report
+	statsCollector common.TriesStatisticsCollector
+	codeEntries    map[string]struct{}
+	referencedCode map[string]struct{}
+	dataTrieOwners map[string][]byte
+}
+
+// NewStateChecker creates a new state checker. The repair source is optional: if provided, the missing or corrupt
+// trie nodes are re-fetched from it and written in the checked storer
+func NewStateChecker(args ArgsStateChecker) (*stateChecker, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	tcc, err := trie.NewTrieConsistencyChecker(trie.ArgsTrieConsistencyChecker{
+		Storer:       args.Storer,
+		RepairSource: args.RepairSource,
+		Marshaller:   args.Marshaller,
+		Hasher:       args.Hasher,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateChecker{
+		trieChecker:              tcc,
+		marshaller:               args.Marshaller,
+		hasher:                   args.Hasher,
+		maxReportedIssuesPerType: args.MaxReportedIssuesPerType,
+	}, nil
+}
+
+// Check walks the accounts trie with the given root hash and the data trie of each account, reporting the missing and
+// corrupt trie nodes, the code entries missing or not referenced by any account and the data tries whose leaves do
+// not belong to the account pointing to them
+func (sc *stateChecker) Check(rootHash []byte) (*Report, error) {
+	if len(rootHash) == 0 {
+		return nil, ErrEmptyRootHash
+	}
+
+	session := &checkSession{
+		report:         newReport(hex.EncodeToString(rootHash), sc.maxReportedIssuesPerType),
+		statsCollector: statistics.NewTrieStatisticsCollector(),
+		codeEntries:    make(map[string]struct{}),
+		referencedCode: make(map[string]struct{}),
+		dataTrieOwners: make(map[string][]byte),
+	}
+
+	mainTrieStats := statistics.NewTrieStatistics()
+	result, err := sc.trieChecker.CheckTrie(rootHash, mainTrieStats, func(key []byte, value []byte, _ core.TrieNodeVersion) error {
+		return sc.checkMainTrieLeaf(session, key, value)
+	})
+	if err != nil {
+		return nil, err
+	}
+	session.statsCollector.Add(mainTrieStats, common.MainTrie)
+	session.addTrieCheckResult(result, rootHash, nil)
+
+	report := session.report
+	report.IsMainTrieComplete = result.IsConsistent()
+	if report.IsMainTrieComplete {
+		session.checkCodeEntries()
+	} else {
+		log.Warn("the accounts trie is incomplete, the code entries are not checked")
+	}
+
+	report.NumTrieNodes = session.statsCollector.GetNumNodes()
+	report.NumDataTries = uint64(len(session.dataTrieOwners))
+	report.NumCodeEntries = uint64(len(session.codeEntries))
+	report.computeIsConsistent()
+	session.statsCollector.Print()
+
+	return report, nil
+}
+
+func (sc *stateChecker) checkMainTrieLeaf(session *checkSession, key []byte, value []byte) error {
+	account := &accounts.UserAccountData{}
+	err := sc.marshaller.Unmarshal(account, value)
+	if err == nil && bytes.Equal(account.Address, key) {
+		return sc.checkAccount(session, account)
+	}
+
+	codeEntry := &state.CodeEntry{}
+	err = sc.marshaller.Unmarshal(codeEntry, value)
+	if err == nil && bytes.Equal(sc.hasher.Compute(string(codeEntry.Code)), key) {
+		session.codeEntries[string(key)] = struct{}{}
+		return nil
+	}
+
+	log.Debug("unrecognized accounts trie leaf", "key", key)
+	session.report.addUnrecognizedLeaf(hex.EncodeToString(key))
+
+	return nil
+}
+
+func (sc *stateChecker) checkAccount(session *checkSession, account *accounts.UserAccountData) error {
+	session.report.NumAccounts++
+	if session.report.NumAccounts%progressLogInterval == 0 {
+		log.Info("checking state", "num checked accounts", session.report.NumAccounts)
+	}
+
+	if len(account.CodeHash) > 0 {
+		session.referencedCode[string(account.CodeHash)] = struct{}{}
+	}
+	if common.IsEmptyTrie(account.RootHash) {
+		return nil
+	}
+
+	owner, isChecked := session.dataTrieOwners[string(account.RootHash)]
+	if isChecked {
+		// the data trie leaves hold the owner address, so a data trie can not belong to more accounts
+		session.report.addDataTrieMismatch(DataTrieMismatch{
+			Address:  hex.EncodeToString(account.Address),
+			RootHash: hex.EncodeToString(account.RootHash),
+			Reason:   fmt.Sprintf("data trie shared with account %s", hex.EncodeToString(owner)),
+		})
+		return nil
+	}
+	session.dataTrieOwners[string(account.RootHash)] = account.Address
+
+	return sc.checkDataTrie(session, account)
+}
+
+func (sc *stateChecker) checkDataTrie(session *checkSession, account *accounts.UserAccountData) error {
+	dataTrieStats := statistics.NewTrieStatistics()
+	dataTrieStats.AddAccountInfo(string(account.Address), account.RootHash)
+
+	numMismatchedLeaves := uint64(0)
+	result, err := sc.trieChecker.CheckTrie(account.RootHash, dataTrieStats, func(key []byte, value []byte, version core.TrieNodeVersion) error {
+		if !sc.isDataTrieLeafOwnedBy(account.Address, key, value, version) {
+			numMismatchedLeaves++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	session.statsCollector.Add(dataTrieStats, common.DataTrie)
+	session.addTrieCheckResult(result, account.RootHash, account.Address)
+
+	if numMismatchedLeaves > 0 {
+		session.report.addDataTrieMismatch(DataTrieMismatch{
+			Address:             hex.EncodeToString(account.Address),
+			RootHash:            hex.EncodeToString(account.RootHash),
+			NumMismatchedLeaves: numMismatchedLeaves,
+			Reason:              "data trie leaves not owned by the account",
+		})
+	}
+
+	return nil
+}
+
+// isDataTrieLeafOwnedBy checks the owner address stored in the leaf, in the format given by the leaf version
+func (sc *stateChecker) isDataTrieLeafOwnedBy(address []byte, key []byte, value []byte, version core.TrieNodeVersion) bool {
+	if version == core.AutoBalanceEnabled {
+		leafData := &dataTrieValue.TrieLeafData{}
+		err := sc.marshaller.Unmarshal(leafData, value)
+		if err != nil {
+			return false
+		}
+
+		return bytes.Equal(leafData.Address, address) && bytes.Equal(sc.hasher.Compute(string(leafData.Key)), key)
+	}
+
+	suffix := make([]byte, 0, len(key)+len(address))
+	suffix = append(suffix, key...)
+	suffix = append(suffix, address...)
+
+	return bytes.HasSuffix(value, suffix)
+}
+
+func (session *checkSession) addTrieCheckResult(result *trie.TrieCheckResult, trieRootHash []byte, address []byte) {
+	newIssue := func(hash []byte, reason string) NodeIssue {
+		issue := NodeIssue{
+			TrieRootHash: hex.EncodeToString(trieRootHash),
+			Hash:         hex.EncodeToString(hash),
+			Reason:       reason,
+		}
+		if len(address) > 0 {
+			issue.Address = hex.EncodeToString(address)
+		}
+
+		return issue
+	}
+
+	for _, missingNode := range result.MissingNodes {
+		log.Debug("missing trie node", "hash", missingNode.Hash, "trie", trieRootHash, "reason", missingNode.Reason)
+		session.report.addMissingNode(newIssue(missingNode.Hash, missingNode.Reason))
+	}
+	for _, corruptNode := range result.CorruptNodes {
+		log.Debug("corrupt trie node", "hash", corruptNode.Hash, "trie", trieRootHash, "reason", corruptNode.Reason)
+		session.report.addCorruptNode(newIssue(corruptNode.Hash, corruptNode.Reason))
+	}
+	for _, repairedNode := range result.RepairedNodes {
+		session.report.addRepairedNode(newIssue(repairedNode, ""))
+	}
+}
+
+func (session *checkSession) checkCodeEntries() {
+	for _, codeHash := range getSortedKeys(session.referencedCode) {
+		if _, found := session.codeEntries[codeHash]; !found {
+			session.report.addMissingCodeEntry(hex.EncodeToString([]byte(codeHash)))
+		}
+	}
+	for _, codeHash := range getSortedKeys(session.codeEntries) {
+		if _, found := session.referencedCode[codeHash]; !found {
+			session.report.addDanglingCodeEntry(hex.EncodeToString([]byte(codeHash)))
+		}
+	}
+}
+
+// getSortedKeys is used for a deterministic report
+func getSortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sc *stateChecker) IsInterfaceNil() bool {
+	return sc == nil
+}
@@ -0,0 +1,351 @@
+package checker
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/dataTrieValue"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshaller = &marshal.GogoProtoMarshalizer{}
+	testHasher     = blake2b.NewBlake2b()
+)
+
+const testNumDataTrieValues = 20
+
+type testStateBuilder struct {
+	t              *testing.T
+	storageManager common.StorageManager
+	accountsTrie   common.Trie
+	trieRootHashes [][]byte
+}
+
+func newTestStateBuilder(t *testing.T) *testStateBuilder {
+	args := storage.GetStorageManagerArgs()
+	args.Marshalizer = testMarshaller
+	args.Hasher = testHasher
+	storageManager, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	builder := &testStateBuilder{
+		t:              t,
+		storageManager: storageManager,
+	}
+	builder.accountsTrie = builder.newTrie()
+
+	return builder
+}
+
+func (builder *testStateBuilder) newTrie() common.Trie {
+	tr, err := trie.NewTrie(builder.storageManager, testMarshaller, testHasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(builder.t, err)
+
+	return tr
+}
+
+func (builder *testStateBuilder) addAccount(address []byte, codeHash []byte, dataTrieRootHash []byte) {
+	account := &accounts.UserAccountData{
+		Nonce:    1,
+		Balance:  big.NewInt(10),
+		CodeHash: codeHash,
+		RootHash: dataTrieRootHash,
+		Address:  address,
+	}
+	accountBytes, err := testMarshaller.Marshal(account)
+	require.Nil(builder.t, err)
+
+	builder.addLeaf(address, accountBytes)
+}
+
+func (builder *testStateBuilder) addCode(code []byte) []byte {
+	codeHash := testHasher.Compute(string(code))
+	codeEntryBytes, err := testMarshaller.Marshal(&state.CodeEntry{Code: code, NumReferences: 1})
+	require.Nil(builder.t, err)
+
+	builder.addLeaf(codeHash, codeEntryBytes)
+
+	return codeHash
+}
+
+func (builder *testStateBuilder) addLeaf(key []byte, value []byte) {
+	require.Nil(builder.t, builder.accountsTrie.Update(key, value))
+}
+
+// addDataTrie creates a data trie with the leaves in the format of the given version, owned by the given address
+func (builder *testStateBuilder) addDataTrie(ownerAddress []byte, version core.TrieNodeVersion) []byte {
+	dataTrie, ok := builder.newTrie().(state.DataTrie)
+	require.True(builder.t, ok)
+	for i := 0; i < testNumDataTrieValues; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		value := []byte(fmt.Sprintf("value%d", i))
+
+		if version == core.AutoBalanceEnabled {
+			leafData, err := testMarshaller.Marshal(&dataTrieValue.TrieLeafData{
+				Value:   value,
+				Key:     key,
+				Address: ownerAddress,
+			})
+			require.Nil(builder.t, err)
+			require.Nil(builder.t, dataTrie.UpdateWithVersion(testHasher.Compute(string(key)), leafData, core.AutoBalanceEnabled))
+			continue
+		}
+
+		legacyValue := append(append(value, key...), ownerAddress...)
+		require.Nil(builder.t, dataTrie.Update(key, legacyValue))
+	}
+
+	require.Nil(builder.t, dataTrie.Commit())
+	rootHash, err := dataTrie.RootHash()
+	require.Nil(builder.t, err)
+	builder.trieRootHashes = append(builder.trieRootHashes, rootHash)
+
+	return rootHash
+}
+
+// build commits the accounts trie and copies all the trie nodes in a new storer
+func (builder *testStateBuilder) build() (*testscommon.MemDbMock, []byte) {
+	require.Nil(builder.t, builder.accountsTrie.Commit())
+	rootHash, err := builder.accountsTrie.RootHash()
+	require.Nil(builder.t, err)
+
+	storer := testscommon.NewMemDbMock()
+	for _, trieRootHash := range append(builder.trieRootHashes, rootHash) {
+		err = trie.WalkTrieNodes(trieRootHash, builder.storageManager, testMarshaller, testHasher, func(hash []byte, encodedNode []byte, _ []byte) error {
+			return storer.Put(hash, encodedNode)
+		})
+		require.Nil(builder.t, err)
+	}
+
+	return storer, rootHash
+}
+
+func testAddress(index int) []byte {
+	return testHasher.Compute(fmt.Sprintf("address%d", index))
+}
+
+func createMockArgsStateChecker(storer common.BaseStorer) ArgsStateChecker {
+	return ArgsStateChecker{
+		Storer:     storer,
+		Marshaller: testMarshaller,
+		Hasher:     testHasher,
+	}
+}
+
+func checkState(t *testing.T, args ArgsStateChecker, rootHash []byte) *Report {
+	sc, err := NewStateChecker(args)
+	require.Nil(t, err)
+
+	report, err := sc.Check(rootHash)
+	require.Nil(t, err)
+
+	return report
+}
+
+func TestNewStateChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		sc, err := NewStateChecker(createMockArgsStateChecker(nil))
+		assert.Equal(t, ErrNilStorer, err)
+		assert.Nil(t, sc)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateChecker(testscommon.NewMemDbMock())
+		args.Marshaller = nil
+		sc, err := NewStateChecker(args)
+		assert.Equal(t, ErrNilMarshaller, err)
+		assert.Nil(t, sc)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateChecker(testscommon.NewMemDbMock())
+		args.Hasher = nil
+		sc, err := NewStateChecker(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.Nil(t, sc)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sc, err := NewStateChecker(createMockArgsStateChecker(testscommon.NewMemDbMock()))
+		assert.Nil(t, err)
+		assert.False(t, sc.IsInterfaceNil())
+	})
+}
+
+func TestStateChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		sc, _ := NewStateChecker(createMockArgsStateChecker(testscommon.NewMemDbMock()))
+		report, err := sc.Check(nil)
+		assert.Equal(t, ErrEmptyRootHash, err)
+		assert.Nil(t, report)
+	})
+	t.Run("consistent state", func(t *testing.T) {
+		t.Parallel()
+
+		builder := newTestStateBuilder(t)
+		codeHash := builder.addCode([]byte("contract code"))
+		builder.addAccount(testAddress(0), codeHash, builder.addDataTrie(testAddress(0), core.NotSpecified))
+		builder.addAccount(testAddress(1), codeHash, builder.addDataTrie(testAddress(1), core.AutoBalanceEnabled))
+		builder.addAccount(testAddress(2), nil, nil)
+		storer, rootHash := builder.build()
+
+		report := checkState(t, createMockArgsStateChecker(storer), rootHash)
+		assert.True(t, report.IsConsistent)
+		assert.True(t, report.IsMainTrieComplete)
+		assert.Equal(t, hex.EncodeToString(rootHash), report.RootHash)
+		assert.Equal(t, uint64(3), report.NumAccounts)
+		assert.Equal(t, uint64(2), report.NumDataTries)
+		assert.Equal(t, uint64(1), report.NumCodeEntries)
+		assert.True(t, report.NumTrieNodes > 2*testNumDataTrieValues)
+		assert.Empty(t, report.MissingNodes)
+		assert.Empty(t, report.DataTrieMismatches)
+	})
+	t.Run("missing data trie node should be reported with the account", func(t *testing.T) {
+		t.Parallel()
+
+		builder := newTestStateBuilder(t)
+		dataTrieRootHash := builder.addDataTrie(testAddress(0), core.AutoBalanceEnabled)
+		builder.addAccount(testAddress(0), nil, dataTrieRootHash)
+		storer, rootHash := builder.build()
+		_ = storer.Remove(dataTrieRootHash)
+
+		report := checkState(t, createMockArgsStateChecker(storer), rootHash)
+		assert.False(t, report.IsConsistent)
+		assert.True(t, report.IsMainTrieComplete)
+		assert.Equal(t, uint64(1), report.NumMissingNodes)
+		require.Len(t, report.MissingNodes, 1)
+		assert.Equal(t, NodeIssue{
+			TrieRootHash: hex.EncodeToString(dataTrieRootHash),
+			Address:      hex.EncodeToString(testAddress(0)),
+			Hash:         hex.EncodeToString(dataTrieRootHash),
+			Reason:       report.MissingNodes[0].Reason,
+		}, report.MissingNodes[0])
+	})
+	t.Run("corrupt accounts trie node should skip the code entries check", func(t *testing.T) {
+		t.Parallel()
+
+		builder := newTestStateBuilder(t)
+		builder.addCode([]byte("dangling code"))
+		builder.addAccount(testAddress(0), nil, nil)
+		storer, rootHash := builder.build()
+		_ = storer.Put(rootHash, []byte("corrupt"))
+
+		report := checkState(t, createMockArgsStateChecker(storer), rootHash)
+		assert.False(t, report.IsConsistent)
+		assert.False(t, report.IsMainTrieComplete)
+		assert.Equal(t, uint64(1), report.NumCorruptNodes)
+		assert.Equal(t, uint64(0), report.NumDanglingCodeEntries)
+	})
+	t.Run("missing and dangling code entries should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		builder := newTestStateBuilder(t)
+		danglingCodeHash := builder.addCode([]byte("dangling code"))
+		missingCodeHash := testHasher.Compute("missing code")
+		builder.addAccount(testAddress(0), missingCodeHash, nil)
+		storer, rootHash := builder.build()
+
+		report := checkState(t, createMockArgsStateChecker(storer), rootHash)
+		assert.False(t, report.IsConsistent)
+		assert.Equal(t, []string{hex.EncodeToString(missingCodeHash)}, report.MissingCodeEntries)
+		assert.Equal(t, []string{hex.EncodeToString(danglingCodeHash)}, report.DanglingCodeEntries)
+	})
+	t.Run("data trie root mismatches should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		builder := newTestStateBuilder(t)
+		sharedDataTrie := builder.addDataTrie(testAddress(0), core.AutoBalanceEnabled)
+		builder.addAccount(testAddress(0), nil, sharedDataTrie)
+		builder.addAccount(testAddress(1), nil, sharedDataTrie)
+		foreignDataTrie := builder.addDataTrie(testAddress(3), core.NotSpecified)
+		builder.addAccount(testAddress(2), nil, foreignDataTrie)
+		storer, rootHash := builder.build()
+
+		report := checkState(t, createMockArgsStateChecker(storer), rootHash)
+		assert.False(t, report.IsConsistent)
+		assert.Equal(t, uint64(2), report.NumDataTrieMismatches)
+		for _, mismatch := range report.DataTrieMismatches {
+			switch mismatch.RootHash {
+			case hex.EncodeToString(foreignDataTrie):
+				assert.Equal(t, hex.EncodeToString(testAddress(2)), mismatch.Address)
+				assert.Equal(t, uint64(testNumDataTrieValues), mismatch.NumMismatchedLeaves)
+			case hex.EncodeToString(sharedDataTrie):
+				assert.Equal(t, uint64(0), mismatch.NumMismatchedLeaves)
+				assert.True(t, strings.Contains(mismatch.Reason, "shared"))
+			default:
+				assert.Fail(t, "unexpected data trie mismatch")
+			}
+		}
+	})
+	t.Run("unrecognized leaves should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		builder := newTestStateBuilder(t)
+		builder.addAccount(testAddress(0), nil, nil)
+		builder.addLeaf([]byte("key"), []byte("not an account"))
+		storer, rootHash := builder.build()
+
+		report := checkState(t, createMockArgsStateChecker(storer), rootHash)
+		assert.False(t, report.IsConsistent)
+		assert.Equal(t, []string{hex.EncodeToString([]byte("key"))}, report.UnrecognizedLeaves)
+	})
+	t.Run("missing nodes should be repaired from the repair source", func(t *testing.T) {
+		t.Parallel()
+
+		builder := newTestStateBuilder(t)
+		dataTrieRootHash := builder.addDataTrie(testAddress(0), core.NotSpecified)
+		builder.addAccount(testAddress(0), nil, dataTrieRootHash)
+		storer, rootHash := builder.build()
+		repairSource, _ := builder.build()
+		_ = storer.Remove(dataTrieRootHash)
+
+		args := createMockArgsStateChecker(storer)
+		args.RepairSource = repairSource
+		report := checkState(t, args, rootHash)
+		assert.True(t, report.IsConsistent)
+		assert.Equal(t, uint64(1), report.NumRepairedNodes)
+		assert.Equal(t, hex.EncodeToString(testAddress(0)), report.RepairedNodes[0].Address)
+		assert.Nil(t, storer.Has(dataTrieRootHash))
+	})
+	t.Run("reported issues should be capped", func(t *testing.T) {
+		t.Parallel()
+
+		builder := newTestStateBuilder(t)
+		for i := 0; i < 5; i++ {
+			builder.addLeaf([]byte(fmt.Sprintf("key%d", i)), []byte("not an account"))
+		}
+		storer, rootHash := builder.build()
+
+		args := createMockArgsStateChecker(storer)
+		args.MaxReportedIssuesPerType = 2
+		report := checkState(t, args, rootHash)
+		assert.Equal(t, uint64(5), report.NumUnrecognizedLeaves)
+		assert.Len(t, report.UnrecognizedLeaves, 2)
+	})
+}
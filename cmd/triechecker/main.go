@@ -0,0 +1,255 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/cmd/triechecker/checker"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	accountsTrieIdentifier = "AccountsTrie"
+	reportFileMode         = 0644
+)
+
+type cfg struct {
+	dbPath            string
+	shard             string
+	epoch             uint
+	rootHash          string
+	repairDBPath      string
+	report            string
+	maxReportedIssues int
+	logLevel          string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// dbPath defines a flag for the directory holding the databases of the node
+	dbPath = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "The directory holding the per epoch databases of a stopped node, including the chain ID (e.g. db/1)",
+		Destination: &argsConfig.dbPath,
+	}
+	// shard defines a flag for the shard of the checked state
+	shard = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "The shard of the checked state. Possible values: 0, 1, 2, ... or metachain",
+		Value:       "0",
+		Destination: &argsConfig.shard,
+	}
+	// epoch defines a flag for the epoch of the checked state
+	epoch = cli.UintFlag{
+		Name:        "epoch",
+		Usage:       "The epoch of the checked state. The accounts trie databases of this epoch and of the previous ones are searched",
+		Destination: &argsConfig.epoch,
+	}
+	// rootHash defines a flag for the root hash of the checked accounts trie
+	rootHash = cli.StringFlag{
+		Name:        "root-hash",
+		Usage:       "The hex encoded root hash of the checked accounts trie",
+		Destination: &argsConfig.rootHash,
+	}
+	// repairDBPath defines a flag for the directory holding the databases used to repair the checked ones
+	repairDBPath = cli.StringFlag{
+		Name: "repair-db-path",
+		Usage: "Optional directory holding the per epoch databases of another node of the same shard, including the chain ID. " +
+			"If set, the missing or corrupt trie nodes are re-fetched from it and written in the newest checked database",
+		Destination: &argsConfig.repairDBPath,
+	}
+	// report defines a flag for the path of the written report
+	report = cli.StringFlag{
+		Name:        "report",
+		Usage:       "Optional path of the JSON report to be written",
+		Destination: &argsConfig.report,
+	}
+	// maxReportedIssues defines a flag for the maximum number of issues of each type listed in the report
+	maxReportedIssues = cli.IntFlag{
+		Name:        "max-reported-issues",
+		Usage:       "The maximum number of issues of each type listed in the report. 0 means no limit",
+		Value:       1000,
+		Destination: &argsConfig.maxReportedIssues,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name:        "log-level",
+		Usage:       "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example, if set to *:INFO the logs for all packages will have the INFO level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("triechecker")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Trie checker Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary checks the consistency of the accounts trie and of the data tries stored by a (stopped) node, optionally repairing the missing nodes from another local database"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		shard,
+		epoch,
+		rootHash,
+		repairDBPath,
+		report,
+		maxReportedIssues,
+		logLevel,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error checking state", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	shardID, err := core.ConvertShardIDToUint32(argsConfig.shard)
+	if err != nil {
+		return err
+	}
+	if len(argsConfig.rootHash) == 0 {
+		return fmt.Errorf("the root-hash flag is required")
+	}
+	rootHashBytes, err := hex.DecodeString(argsConfig.rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the root hash", err)
+	}
+
+	isRepairEnabled := len(argsConfig.repairDBPath) > 0
+	storer, err := factory.NewEpochsStorer(factory.ArgsEpochsStorer{
+		DBPath:     argsConfig.dbPath,
+		ShardID:    shardID,
+		Epoch:      uint32(argsConfig.epoch),
+		Identifier: accountsTrieIdentifier,
+		ReadOnly:   !isRepairEnabled,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	var repairSource common.BaseStorer
+	if isRepairEnabled {
+		repairStorer, errRepair := factory.NewEpochsStorer(factory.ArgsEpochsStorer{
+			DBPath:     argsConfig.repairDBPath,
+			ShardID:    shardID,
+			Epoch:      uint32(argsConfig.epoch),
+			Identifier: accountsTrieIdentifier,
+			ReadOnly:   true,
+		})
+		if errRepair != nil {
+			return errRepair
+		}
+		defer func() {
+			_ = repairStorer.Close()
+		}()
+
+		repairSource = repairStorer
+	}
+
+	// the node uses the same hasher and marshaller for the trie nodes on all the networks
+	stateChecker, err := checker.NewStateChecker(checker.ArgsStateChecker{
+		Storer:                   storer,
+		RepairSource:             repairSource,
+		Marshaller:               &marshal.GogoProtoMarshalizer{},
+		Hasher:                   blake2b.NewBlake2b(),
+		MaxReportedIssuesPerType: argsConfig.maxReportedIssues,
+	})
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	log.Info("checking state", "root hash", argsConfig.rootHash, "shard", argsConfig.shard, "epoch", argsConfig.epoch,
+		"num databases", storer.NumDatabases(), "repair", isRepairEnabled)
+	checkReport, err := stateChecker.Check(rootHashBytes)
+	if err != nil {
+		return err
+	}
+
+	log.Info("check finished",
+		"consistent", checkReport.IsConsistent,
+		"num trie nodes", checkReport.NumTrieNodes,
+		"num accounts", checkReport.NumAccounts,
+		"num data tries", checkReport.NumDataTries,
+		"num code entries", checkReport.NumCodeEntries,
+		"missing nodes", checkReport.NumMissingNodes,
+		"corrupt nodes", checkReport.NumCorruptNodes,
+		"repaired nodes", checkReport.NumRepairedNodes,
+		"missing code entries", checkReport.NumMissingCodeEntries,
+		"dangling code entries", checkReport.NumDanglingCodeEntries,
+		"data trie mismatches", checkReport.NumDataTrieMismatches,
+		"unrecognized leaves", checkReport.NumUnrecognizedLeaves,
+		"duration", time.Since(startTime),
+	)
+
+	return writeReport(checkReport)
+}
+
+func writeReport(checkReport *checker.Report) error {
+	if len(argsConfig.report) == 0 {
+		return nil
+	}
+
+	reportBytes, err := json.MarshalIndent(checkReport, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(argsConfig.report, reportBytes, reportFileMode)
+	if err != nil {
+		return err
+	}
+
+	log.Info("report written", "path", argsConfig.report)
+
+	return nil
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/triesStatistics"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	accountsTrieIdentifier = "AccountsTrie"
+	addressLen             = 32
+	reportFileMode         = 0644
+)
+
+type cfg struct {
+	dbPath     string
+	shard      string
+	epoch      uint
+	rootHash   string
+	top        int
+	addressHrp string
+	report     string
+	logLevel   string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// dbPath defines a flag for the directory holding the databases of the node
+	dbPath = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "The directory holding the per epoch databases of a stopped node, including the chain ID (e.g. db/1)",
+		Destination: &argsConfig.dbPath,
+	}
+	// shard defines a flag for the shard of the analysed state
+	shard = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "The shard of the analysed state. Possible values: 0, 1, 2, ... or metachain",
+		Value:       "0",
+		Destination: &argsConfig.shard,
+	}
+	// epoch defines a flag for the epoch of the analysed state
+	epoch = cli.UintFlag{
+		Name:        "epoch",
+		Usage:       "The epoch of the analysed state. The accounts trie databases of this epoch and of the previous ones are searched",
+		Destination: &argsConfig.epoch,
+	}
+	// rootHash defines a flag for the root hash of the analysed accounts trie
+	rootHash = cli.StringFlag{
+		Name:        "root-hash",
+		Usage:       "The hex encoded root hash of the analysed accounts trie",
+		Destination: &argsConfig.rootHash,
+	}
+	// top defines a flag for the number of heaviest accounts listed in the report
+	top = cli.IntFlag{
+		Name:        "top",
+		Usage:       "The number of accounts with the largest data tries listed in the report, both by size and by number of leaves",
+		Value:       20,
+		Destination: &argsConfig.top,
+	}
+	// addressHrp defines a flag for the human readable part of the reported addresses
+	addressHrp = cli.StringFlag{
+		Name:        "address-hrp",
+		Usage:       "The human readable part used when encoding the reported addresses",
+		Value:       "erd",
+		Destination: &argsConfig.addressHrp,
+	}
+	// report defines a flag for the path of the written report
+	report = cli.StringFlag{
+		Name:        "report",
+		Usage:       "Optional path of the JSON report to be written",
+		Destination: &argsConfig.report,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name:        "log-level",
+		Usage:       "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example, if set to *:INFO the logs for all packages will have the INFO level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("triestats")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Trie statistics Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary computes the statistics of the accounts trie and of the data tries stored by a (stopped) node, listing the accounts with the largest data tries"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		shard,
+		epoch,
+		rootHash,
+		top,
+		addressHrp,
+		report,
+		logLevel,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error computing the tries statistics", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	shardID, err := core.ConvertShardIDToUint32(argsConfig.shard)
+	if err != nil {
+		return err
+	}
+	if len(argsConfig.rootHash) == 0 {
+		return fmt.Errorf("the root-hash flag is required")
+	}
+	rootHashBytes, err := hex.DecodeString(argsConfig.rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the root hash", err)
+	}
+
+	addressConverter, err := pubkeyConverter.NewBech32PubkeyConverter(addressLen, argsConfig.addressHrp)
+	if err != nil {
+		return err
+	}
+
+	storer, err := factory.NewEpochsStorer(factory.ArgsEpochsStorer{
+		DBPath:     argsConfig.dbPath,
+		ShardID:    shardID,
+		Epoch:      uint32(argsConfig.epoch),
+		Identifier: accountsTrieIdentifier,
+		ReadOnly:   true,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	// the node uses the same hasher and marshaller for the trie nodes on all the networks
+	statisticsComputer, err := triesStatistics.NewTriesStatisticsComputer(triesStatistics.ArgsTriesStatisticsComputer{
+		Storer:                 storer,
+		Marshaller:             &marshal.GogoProtoMarshalizer{},
+		Hasher:                 blake2b.NewBlake2b(),
+		AddressPubkeyConverter: addressConverter,
+	})
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	log.Info("computing tries statistics", "root hash", argsConfig.rootHash, "shard", argsConfig.shard, "epoch", argsConfig.epoch,
+		"num databases", storer.NumDatabases())
+	statisticsReport, err := statisticsComputer.ComputeStatistics(context.Background(), rootHashBytes, argsConfig.top)
+	if err != nil {
+		return err
+	}
+
+	log.Info("tries statistics computed",
+		"complete", statisticsReport.IsComplete,
+		"num accounts", statisticsReport.NumAccounts,
+		"missing nodes", statisticsReport.NumMissingNodes,
+		"corrupt nodes", statisticsReport.NumCorruptNodes,
+		"duration", time.Since(startTime),
+	)
+	logTrieTypeStatistics(common.MainTrie, statisticsReport.TriesByType[common.MainTrie])
+	logTrieTypeStatistics(common.DataTrie, statisticsReport.TriesByType[common.DataTrie])
+	for i, account := range statisticsReport.TopAccountsByDataTrieSize {
+		log.Info("heavy account", "rank", i+1, "address", account.Address, "data trie size", core.ConvertBytes(account.DataTrieSize),
+			"num leaves", account.NumLeaves, "max depth", account.MaxDepth, "old version leaves", account.OldVersionLeavesFraction)
+	}
+
+	return writeReport(statisticsReport)
+}
+
+func logTrieTypeStatistics(trieType common.TrieType, stats *triesStatistics.TrieTypeStatistics) {
+	if stats == nil {
+		return
+	}
+
+	log.Info("trie type statistics",
+		"type", trieType,
+		"num tries", stats.NumTries,
+		"num nodes", stats.NumNodes,
+		"total size", core.ConvertBytes(stats.TotalSize),
+		"max depth", stats.MaxDepth,
+		"num leaves", stats.NumLeaves,
+		"leaves by version", stats.NumLeavesByVersion,
+		"old version leaves", stats.OldVersionLeavesFraction,
+	)
+}
+
+func writeReport(statisticsReport *triesStatistics.Report) error {
+	if len(argsConfig.report) == 0 {
+		return nil
+	}
+
+	reportBytes, err := json.MarshalIndent(statisticsReport, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(argsConfig.report, reportBytes, reportFileMode)
+	if err != nil {
+		return err
+	}
+
+	log.Info("report written", "path", argsConfig.report)
+
+	return nil
+}
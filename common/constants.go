@@ -1107,6 +1107,15 @@ const MetricTrieSyncNumReceivedBytes = "erd_trie_sync_num_bytes_received"
 // MetricTrieSyncNumProcessedNodes is the metric that outputs the number of trie nodes processed for accounts during trie sync
 const MetricTrieSyncNumProcessedNodes = "erd_trie_sync_num_nodes_processed"
 
+// MetricTrieSyncNumPendingNodes is the metric that outputs the number of trie nodes waiting to be synced by the parallel trie sync scheduler
+const MetricTrieSyncNumPendingNodes = "erd_trie_sync_num_nodes_pending"
+
+// MetricTrieSyncProgressPercent is the metric that outputs the estimated percent of the main trie synced by the parallel trie sync scheduler
+const MetricTrieSyncProgressPercent = "erd_trie_sync_progress_percent"
+
+// MetricTrieSyncEstimatedTimeLeftInSec is the metric that outputs the estimated time left in seconds for the parallel trie sync scheduler
+const MetricTrieSyncEstimatedTimeLeftInSec = "erd_trie_sync_estimated_time_left_in_seconds"
+
 // FullArchiveMetricSuffix is the suffix added to metrics specific for full archive network
 const FullArchiveMetricSuffix = "_full_archive"
 
@@ -1,7 +1,12 @@
 package common
 
 import (
+	"encoding/base64"
+
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
 )
 
 // GetProofResponse is a struct that stores the response of a GetProof API request
@@ -42,6 +47,95 @@ type TransactionsPoolNonceGapsForSenderApiResponse struct {
 	Gaps   []NonceGapApiResponse `json:"gaps"`
 }
 
+// PoolTransactionDetailsApiResponse is a struct that holds the details of a transaction from pool, together with
+// the outcome of checking it against the selection rules
+type PoolTransactionDetailsApiResponse struct {
+	Hash         string `json:"hash"`
+	Nonce        uint64 `json:"nonce"`
+	GasLimit     uint64 `json:"gasLimit"`
+	GasPrice     uint64 `json:"gasPrice"`
+	Size         int64  `json:"size"`
+	Fee          string `json:"fee"`
+	PricePerUnit uint64 `json:"pricePerUnit"`
+	Selectable   bool   `json:"selectable"`
+	Reason       string `json:"reason,omitempty"`
+}
+
+// RejectedTransactionApiResponse is a struct that holds a transaction recently evicted from pool or rejected at selection
+type RejectedTransactionApiResponse struct {
+	Hash      string `json:"hash"`
+	Sender    string `json:"sender"`
+	Nonce     uint64 `json:"nonce"`
+	Reason    string `json:"reason"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// TransactionsPoolSenderDetailsApiResponse is a struct that holds the data to be returned when inspecting the transactions pool for a sender from an API call.
+// The pool orders the transactions by their price per gas unit, thus the best one of the sender stands for its score.
+type TransactionsPoolSenderDetailsApiResponse struct {
+	Sender           string                              `json:"sender"`
+	AccountNonce     uint64                              `json:"accountNonce"`
+	AccountBalance   string                              `json:"accountBalance"`
+	NumTransactions  int                                 `json:"numTransactions"`
+	TotalBytes       int64                               `json:"totalBytes"`
+	TotalGasLimit    uint64                              `json:"totalGasLimit"`
+	TotalFee         string                              `json:"totalFee"`
+	BestPricePerUnit uint64                              `json:"bestPricePerUnit"`
+	Transactions     []PoolTransactionDetailsApiResponse `json:"transactions"`
+	Rejections       []RejectedTransactionApiResponse    `json:"rejections"`
+}
+
+// TransactionsPoolSelectionApiResponse is a struct that holds the transactions that would be picked by the next selection from an API call
+type TransactionsPoolSelectionApiResponse struct {
+	Transactions  []Transaction `json:"transactions"`
+	TotalGasLimit uint64        `json:"totalGasLimit"`
+}
+
+// TransactionsPoolRejectionsApiResponse is a struct that holds the transactions recently evicted from pool or rejected at selection from an API call
+type TransactionsPoolRejectionsApiResponse struct {
+	Rejections []RejectedTransactionApiResponse `json:"rejections"`
+}
+
+// AddressTransactionsApiResponse is a struct that holds a page of the transactions history of an address from an API call
+type AddressTransactionsApiResponse struct {
+	Transactions    []*transaction.ApiTransactionResult `json:"transactions"`
+	NumTransactions uint64                              `json:"numTransactions"`
+	NextCursor      uint64                              `json:"nextCursor,omitempty"`
+}
+
+// EventsFilterApiRequest holds the criteria of an events query received through the API. The topics are matched
+// by position, an empty topic matching any value.
+type EventsFilterApiRequest struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	FromBlock  uint64   `json:"fromBlock"`
+	ToBlock    uint64   `json:"toBlock"`
+	Cursor     uint64   `json:"cursor"`
+	Size       int      `json:"size"`
+}
+
+// FilteredEventApiResponse is a struct that holds an event matching a filter, along with the details of its transaction and block
+type FilteredEventApiResponse struct {
+	Address        string   `json:"address"`
+	Identifier     string   `json:"identifier"`
+	Topics         [][]byte `json:"topics"`
+	Data           []byte   `json:"data"`
+	AdditionalData [][]byte `json:"additionalData,omitempty"`
+	TxHash         string   `json:"txHash"`
+	EventIndex     uint32   `json:"eventIndex"`
+	Epoch          uint32   `json:"epoch"`
+	Round          uint64   `json:"round"`
+	BlockNonce     uint64   `json:"blockNonce"`
+	BlockHash      string   `json:"blockHash"`
+}
+
+// FilteredEventsApiResponse is a struct that holds a page of the events matching a filter from an API call
+type FilteredEventsApiResponse struct {
+	Events     []*FilteredEventApiResponse `json:"events"`
+	NextCursor uint64                      `json:"nextCursor,omitempty"`
+}
+
 // DelegationDataAPI will be used when requesting the genesis balances from API
 type DelegationDataAPI struct {
 	Address string `json:"address"`
@@ -76,6 +170,12 @@ type AlteredAccountsForBlockAPIResponse struct {
 	Accounts []*alteredAccount.AlteredAccount `json:"accounts"`
 }
 
+// SCQueryApiResult holds the outcome of one query from a batch of queries, to be returned on API calls
+type SCQueryApiResult struct {
+	Data  *vm.VMOutputApi `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
 // AuctionNode holds data needed for a node in auction to respond to API calls
 type AuctionNode struct {
 	BlsKey    string `json:"blsKey"`
@@ -91,3 +191,36 @@ type AuctionListValidatorAPIResponse struct {
 	QualifiedTopUp string         `json:"qualifiedTopUp"`
 	Nodes          []*AuctionNode `json:"nodes"`
 }
+
+// AddressState will hold the address state
+type AddressState struct {
+	Address          string            `json:"address"`
+	Nonce            *uint64           `json:"nonce,omitempty"`
+	Balance          string            `json:"balance,omitempty"`
+	Code             string            `json:"code,omitempty"`
+	RootHash         string            `json:"rootHash,omitempty"`
+	CodeMetadata     string            `json:"codeMetadata,omitempty"`
+	CodeHash         string            `json:"codeHash,omitempty"`
+	DeveloperRewards string            `json:"developerReward,omitempty"`
+	Owner            string            `json:"ownerAddress,omitempty"`
+	Pairs            map[string]string `json:"pairs,omitempty"`
+}
+
+// NewAddressStateFromAccountResponse will convert the provided account response into an address state that can be
+// fed back into the chain simulator. The response is expected to already contain the code and the key-value pairs.
+// The root hash is intentionally left empty as the data trie is rebuilt from the pairs when the state is set.
+func NewAddressStateFromAccountResponse(account *api.AccountResponse) *AddressState {
+	nonce := account.Nonce
+
+	return &AddressState{
+		Address:          account.Address,
+		Nonce:            &nonce,
+		Balance:          account.Balance,
+		Code:             account.Code,
+		CodeMetadata:     base64.StdEncoding.EncodeToString(account.CodeMetadata),
+		CodeHash:         base64.StdEncoding.EncodeToString(account.CodeHash),
+		DeveloperRewards: account.DeveloperReward,
+		Owner:            account.OwnerAddress,
+		Pairs:            account.Pairs,
+	}
+}
@@ -19,6 +19,11 @@ type HeadersPoolConfig struct {
 	NumElementsToRemoveOnEviction int
 }
 
+// TxPoolConfig will map the transactions pool configuration, apart from the cache sizes
+type TxPoolConfig struct {
+	MinGasPriceBumpPercentForReplacement uint32
+}
+
 // DBConfig will map the database configuration
 type DBConfig struct {
 	FilePath            string
@@ -208,6 +213,7 @@ type Config struct {
 
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
+	TxPoolConfig            TxPoolConfig
 	BlockSizeThrottleConfig BlockSizeThrottleConfig
 	VirtualMachine          VirtualMachineServicesConfig
 	BuiltInFunctions        BuiltInFunctionsConfig
@@ -329,14 +335,18 @@ type EndpointsThrottlersConfig struct {
 
 // WebServerAntifloodConfig will hold the anti-flooding parameters for the web server
 type WebServerAntifloodConfig struct {
-	WebServerAntifloodEnabled          bool
-	SimultaneousRequests               uint32
-	SameSourceRequests                 uint32
-	SameSourceResetIntervalInSec       uint32
-	TrieOperationsDeadlineMilliseconds uint32
-	GetAddressesBulkMaxSize            uint32
-	VmQueryDelayAfterStartInSec        uint32
-	EndpointsThrottlers                []EndpointsThrottlersConfig
+	WebServerAntifloodEnabled             bool
+	SimultaneousRequests                  uint32
+	SameSourceRequests                    uint32
+	SameSourceResetIntervalInSec          uint32
+	TrieOperationsDeadlineMilliseconds    uint32
+	GetAddressesBulkMaxSize               uint32
+	VmQueryDelayAfterStartInSec           uint32
+	StateDiffMaxAccountsPerPage           uint32
+	StateDiffMaxDataTrieChangesPerAccount uint32
+	TrieStatisticsMaxTopAccounts          uint32
+	TrieStatisticsDeadlineInSec           uint32
+	EndpointsThrottlers                   []EndpointsThrottlersConfig
 }
 
 // BlackListConfig will hold the p2p peer black list threshold values
@@ -426,6 +436,7 @@ type WasmVMVersionByEpoch struct {
 type QueryVirtualMachineConfig struct {
 	VirtualMachineConfig
 	NumConcurrentVMs int
+	NumHistoricalVMs int
 }
 
 // VirtualMachineGasConfig holds the configuration for the virtual machine(s) gas operations
@@ -478,6 +489,10 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	AddressHistoryIndexEnabled         bool
+	AddressHistoryStorageConfig        StorageConfig
+	EventsIndexEnabled                 bool
+	EventsIndexStorageConfig           StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -541,8 +556,12 @@ type ProcessDebugConfig struct {
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
-	Logging     ApiLoggingConfig
-	APIPackages map[string]APIPackageConfig
+	Logging       ApiLoggingConfig
+	Subscriptions ApiSubscriptionsConfig
+	RateLimiting  ApiRateLimitingConfig
+	GraphQL       ApiGraphQLConfig
+	JsonRpc       ApiJsonRpcConfig
+	APIPackages   map[string]APIPackageConfig
 }
 
 // ApiLoggingConfig holds the configuration related to API requests logging
@@ -551,6 +570,53 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
+// ApiSubscriptionsConfig holds the configuration related to the websocket subscriptions
+type ApiSubscriptionsConfig struct {
+	Enabled                   bool
+	MaxClients                int
+	MaxSubscriptionsPerClient int
+	MaxItemsPerSubscription   int
+	ClientBufferSize          int
+}
+
+// ApiRateLimitingConfig holds the configuration related to the token bucket rate limiting of the API requests
+type ApiRateLimitingConfig struct {
+	Enabled                     bool
+	TrustedProxies              []string
+	RequestsPerSecond           uint32
+	Burst                       uint32
+	APIKeyHeader                string
+	APIKeys                     []ApiKeyRateLimitConfig
+	RouteCosts                  []ApiRouteCostConfig
+	IdleSourcesCleanupInSeconds uint32
+}
+
+// ApiKeyRateLimitConfig holds the token bucket limits applied to the requests carrying an API key
+type ApiKeyRateLimitConfig struct {
+	Key               string
+	RequestsPerSecond uint32
+	Burst             uint32
+}
+
+// ApiRouteCostConfig holds the number of tokens consumed by a request on the provided route
+type ApiRouteCostConfig struct {
+	Route string
+	Cost  uint32
+}
+
+// ApiGraphQLConfig holds the limits applied on the queries served by the GraphQL endpoint
+type ApiGraphQLConfig struct {
+	MaxQueryLengthInBytes uint32
+	MaxQueryDepth         uint32
+	MaxQueryCost          uint32
+}
+
+// ApiJsonRpcConfig holds the settings of the JSON-RPC requests served over websocket
+type ApiJsonRpcConfig struct {
+	WebSocketAllowedOrigins       []string
+	WebSocketMaxRequestsPerSecond uint32
+}
+
 // APIPackageConfig holds the configuration for the routes of each package
 type APIPackageConfig struct {
 	Routes []RouteConfig
@@ -588,6 +654,7 @@ type Configs struct {
 	FullArchiveP2pConfig     *p2pConfig.P2PConfig
 	FlagsConfig              *ContextFlagsConfig
 	ImportDbConfig           *ImportDbConfig
+	OutportReplayConfig      *OutportReplayConfig
 	ConfigurationPathsHolder *ConfigurationPathsHolder
 	EpochConfig              *EpochConfig
 	RoundConfig              *RoundConfig
@@ -621,6 +688,7 @@ type TrieSyncConfig struct {
 	MaxHardCapForMissingNodes int
 	TrieSyncerVersion         int
 	CheckNodesOnDisk          bool
+	NumParallelSyncWorkers    int
 }
 
 // RequesterConfig represents the config options to be used when setting up the requester instances
@@ -28,6 +28,7 @@ type ContextFlagsConfig struct {
 	OperationMode                string
 	RepopulateTokensSupplies     bool
 	P2PPrometheusMetricsEnabled  bool
+	StateArchivePath             string
 }
 
 // ImportDbConfig will hold the import-db parameters
@@ -38,3 +39,15 @@ type ImportDbConfig struct {
 	ImportDbNoSigCheckFlag        bool
 	ImportDbSaveTrieEpochRootHash bool
 }
+
+// OutportReplayConfig will hold the outport replay parameters
+type OutportReplayConfig struct {
+	IsReplayMode       bool
+	IsEpochRange       bool
+	StartNonce         uint64
+	EndNonce           uint64
+	StartEpoch         uint32
+	EndEpoch           uint32
+	MaxBlocksPerSecond uint64
+	CheckpointFilePath string
+}
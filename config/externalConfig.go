@@ -5,6 +5,7 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	HostDriversConfig      []HostDriversConfig
+	FileDriverConfig       FileDriverConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -51,3 +52,16 @@ type HostDriversConfig struct {
 	AcknowledgeTimeoutInSec    int
 	Version                    uint32
 }
+
+// FileDriverConfig will hold the configuration for the file outport driver
+type FileDriverConfig struct {
+	Enabled                  bool
+	SyncOnWrite              bool
+	WithAcknowledge          bool
+	Path                     string
+	MarshallerType           string
+	CheckpointFileName       string
+	MaxSegmentSizeInMB       uint64
+	MaxSegmentFiles          int
+	MaxUnacknowledgedRecords uint64
+}
@@ -433,6 +433,13 @@ func TestAPIRoutesToml(t *testing.T) {
 			LoggingEnabled:          true,
 			ThresholdInMicroSeconds: loggingThreshold,
 		},
+		Subscriptions: ApiSubscriptionsConfig{
+			Enabled:                   true,
+			MaxClients:                100,
+			MaxSubscriptionsPerClient: 20,
+			MaxItemsPerSubscription:   1000,
+			ClientBufferSize:          500,
+		},
 		APIPackages: map[string]APIPackageConfig{
 			package0: {
 				Routes: []RouteConfig{
@@ -453,6 +460,13 @@ func TestAPIRoutesToml(t *testing.T) {
     LoggingEnabled = true
     ThresholdInMicroSeconds = 10
 
+[Subscriptions]
+    Enabled = true
+    MaxClients = 100
+    MaxSubscriptionsPerClient = 20
+    MaxItemsPerSubscription = 1000
+    ClientBufferSize = 500
+
      # API routes configuration
 [APIPackages]
 
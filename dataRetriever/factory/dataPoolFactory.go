@@ -63,11 +63,12 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 	mainConfig := args.Config
 
 	txPool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
-		Config:         factory.GetCacherFromConfig(mainConfig.TxDataPool),
-		TxGasHandler:   args.EconomicsData,
-		Marshalizer:    args.Marshalizer,
-		NumberOfShards: args.ShardCoordinator.NumberOfShards(),
-		SelfShardID:    args.ShardCoordinator.SelfId(),
+		Config:                               factory.GetCacherFromConfig(mainConfig.TxDataPool),
+		TxGasHandler:                         args.EconomicsData,
+		Marshalizer:                          args.Marshalizer,
+		NumberOfShards:                       args.ShardCoordinator.NumberOfShards(),
+		SelfShardID:                          args.ShardCoordinator.SelfId(),
+		MinGasPriceBumpPercentForReplacement: mainConfig.TxPoolConfig.MinGasPriceBumpPercentForReplacement,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("%w while creating the cache for the transactions", err)
@@ -16,6 +16,10 @@ type ArgShardedTxPool struct {
 	Marshalizer    marshal.Marshalizer
 	NumberOfShards uint32
 	SelfShardID    uint32
+
+	// MinGasPriceBumpPercentForReplacement is the minimum increase of the gas price (in percents) for a transaction to
+	// replace the one with the same sender and nonce. If zero, a strictly higher gas price is required.
+	MinGasPriceBumpPercentForReplacement uint32
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -28,6 +28,8 @@ type shardedTxPool struct {
 	configPrototypeSourceMe      txcache.ConfigSourceMe
 	selfShardID                  uint32
 	host                         txcache.MempoolHost
+	rejectedTxs                  *txcache.RejectedTransactionsLog
+	txReplacer                   *txcache.TxReplacer
 }
 
 type txPoolShard struct {
@@ -86,6 +88,8 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		configPrototypeSourceMe:      configPrototypeSourceMe,
 		selfShardID:                  args.SelfShardID,
 		host:                         mempoolHost,
+		rejectedTxs:                  txcache.NewRejectedTransactionsLog(storage.TxPoolNumRejectedTransactionsToKeep),
+		txReplacer:                   txcache.NewTxReplacer(args.MinGasPriceBumpPercentForReplacement),
 	}
 
 	return shardedTxPoolObject, nil
@@ -148,6 +152,8 @@ func (txPool *shardedTxPool) createTxCache(cacheID string) txCache {
 			return txcache.NewDisabledCache()
 		}
 
+		cache.RegisterEvictionHandler(txPool.onEvicted)
+
 		return cache
 	}
 
@@ -175,32 +181,130 @@ func (txPool *shardedTxPool) AddData(key []byte, value interface{}, sizeInBytes
 		return
 	}
 
+	_ = txPool.AddTransaction(key, valueAsTransaction, sizeInBytes, cacheID)
+}
+
+// AddTransaction adds the transaction to the cache. A transaction having the same sender and nonce as the ones
+// already in the cache (of the self shard) replaces them if its gas price is high enough, otherwise it is rejected
+// with txcache.ErrReplacementUnderpriced.
+func (txPool *shardedTxPool) AddTransaction(key []byte, tx data.TransactionHandler, sizeInBytes int, cacheID string) error {
 	sourceShardID, destinationShardID, err := process.ParseShardCacherIdentifier(cacheID)
 	if err != nil {
-		log.Error("shardedTxPool.AddData()", "err", err)
-		return
+		log.Error("shardedTxPool.AddTransaction()", "err", err)
+		return err
 	}
 
 	wrapper := &txcache.WrappedTransaction{
-		Tx:              valueAsTransaction,
+		Tx:              tx,
 		TxHash:          key,
 		SenderShardID:   sourceShardID,
 		ReceiverShardID: destinationShardID,
 		Size:            int64(sizeInBytes),
 	}
 
-	txPool.addTx(wrapper, cacheID)
+	return txPool.addTx(wrapper, cacheID)
+}
+
+// CheckReplacement checks, without altering the pool, whether AddTransaction would reject the transaction with
+// txcache.ErrReplacementUnderpriced. Only the caches of the self shard apply the "replace-by-fee" rules.
+func (txPool *shardedTxPool) CheckReplacement(key []byte, tx data.TransactionHandler, cacheID string) error {
+	sourceMeCache, ok := txPool.getTxCache(cacheID).(*txcache.TxCache)
+	if !ok {
+		return nil
+	}
+
+	wrapper := &txcache.WrappedTransaction{
+		Tx:     tx,
+		TxHash: key,
+	}
+
+	return txPool.txReplacer.CheckReplacement(sourceMeCache, wrapper)
 }
 
 // addTx adds the transaction to the cache
-func (txPool *shardedTxPool) addTx(tx *txcache.WrappedTransaction, cacheID string) {
+func (txPool *shardedTxPool) addTx(tx *txcache.WrappedTransaction, cacheID string) error {
 	shard := txPool.getOrCreateShard(cacheID)
 	cache := shard.Cache
 
-	_, added := cache.AddTx(tx)
+	added, replaced, err := txPool.addTxToCache(cache, tx)
+	txPool.recordReplacementOutcome(tx, replaced, err)
+	if err == nil {
+		txPool.recordIncomingTxIfEvicted(cache, tx)
+	}
 	if added {
 		txPool.onAdded(tx.TxHash, tx)
 	}
+
+	return err
+}
+
+// addTxToCache applies the "replace-by-fee" rules for the transactions sent from the self shard. The other caches
+// hold transactions of senders from other shards, ordered by the sender's shard, thus they are simply added.
+func (txPool *shardedTxPool) addTxToCache(cache txCache, tx *txcache.WrappedTransaction) (bool, []*txcache.WrappedTransaction, error) {
+	sourceMeCache, ok := cache.(*txcache.TxCache)
+	if !ok {
+		_, added := cache.AddTx(tx)
+		return added, nil, nil
+	}
+
+	added, replaced, err := txPool.txReplacer.AddTx(sourceMeCache, tx)
+	if err != nil {
+		log.Trace("shardedTxPool.addTxToCache: transaction not added",
+			"txHash", tx.TxHash,
+			"nonce", tx.Tx.GetNonce(),
+			"gasPrice", tx.Tx.GetGasPrice(),
+			"err", err,
+		)
+		return false, nil, err
+	}
+
+	for _, replacedTx := range replaced {
+		log.Trace("shardedTxPool.addTxToCache: transaction replaced",
+			"txHash", replacedTx.TxHash,
+			"replacementTxHash", tx.TxHash,
+			"nonce", tx.Tx.GetNonce(),
+			"oldGasPrice", replacedTx.Tx.GetGasPrice(),
+			"newGasPrice", tx.Tx.GetGasPrice(),
+		)
+	}
+
+	return added, replaced, nil
+}
+
+// recordReplacementOutcome records the replaced transactions or, if underpriced, the incoming one
+func (txPool *shardedTxPool) recordReplacementOutcome(
+	incomingTx *txcache.WrappedTransaction,
+	replaced []*txcache.WrappedTransaction,
+	err error,
+) {
+	if err == txcache.ErrReplacementUnderpriced {
+		txPool.rejectedTxs.Add(txcache.NewRejectedTransaction(incomingTx, txcache.RejectionReasonReplacementUnderpriced))
+	}
+
+	for _, replacedTx := range replaced {
+		txPool.rejectedTxs.Add(txcache.NewRejectedTransaction(replacedTx, txcache.RejectionReasonReplacedByFee))
+	}
+}
+
+// recordIncomingTxIfEvicted records the incoming transaction if a cross cache has evicted it right away. The caches of
+// the self shard notify their evicted transactions on their own (see onEvicted).
+func (txPool *shardedTxPool) recordIncomingTxIfEvicted(cache txCache, incomingTx *txcache.WrappedTransaction) {
+	_, isSourceMe := cache.(*txcache.TxCache)
+	if isSourceMe || cache.Has(incomingTx.TxHash) {
+		return
+	}
+
+	txPool.rejectedTxs.Add(txcache.NewRejectedTransaction(incomingTx, txcache.RejectionReasonEvictedUnderHighLoad))
+}
+
+// onEvicted records the transactions evicted by the caches of the self shard
+func (txPool *shardedTxPool) onEvicted(evicted []*txcache.WrappedTransaction, reason txcache.RejectionReason) {
+	rejected := make([]*txcache.RejectedTransaction, 0, len(evicted))
+	for _, tx := range evicted {
+		rejected = append(rejected, txcache.NewRejectedTransaction(tx, reason))
+	}
+
+	txPool.rejectedTxs.Add(rejected...)
 }
 
 func (txPool *shardedTxPool) onAdded(key []byte, value interface{}) {
@@ -296,7 +400,7 @@ func (txPool *shardedTxPool) MergeShardStores(sourceCacheID, destCacheID string)
 	sourceCache := sourceShard.Cache
 
 	sourceCache.ForEachTransaction(func(txHash []byte, tx *txcache.WrappedTransaction) {
-		txPool.addTx(tx, destCacheID)
+		_ = txPool.addTx(tx, destCacheID)
 	})
 
 	txPool.mutexBackingMap.Lock()
@@ -370,6 +474,22 @@ func (txPool *shardedTxPool) Diagnose(deep bool) {
 	}
 }
 
+// RecordRejectedTransactions adds the provided entries to the log of rejected transactions
+func (txPool *shardedTxPool) RecordRejectedTransactions(rejected []*txcache.RejectedTransaction) {
+	txPool.rejectedTxs.Add(rejected...)
+}
+
+// GetRejectedTransactions returns the most recently evicted or rejected transactions, the oldest first
+func (txPool *shardedTxPool) GetRejectedTransactions() []*txcache.RejectedTransaction {
+	return txPool.rejectedTxs.GetAll()
+}
+
+// GetRejectedTransactionsForSender returns the most recently evicted or rejected transactions of the provided sender,
+// the oldest first
+func (txPool *shardedTxPool) GetRejectedTransactionsForSender(sender []byte) []*txcache.RejectedTransaction {
+	return txPool.rejectedTxs.GetForSender(sender)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txPool *shardedTxPool) IsInterfaceNil() bool {
 	return txPool == nil
@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
 	"github.com/multiversx/mx-chain-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -209,8 +210,10 @@ func Test_SearchFirstData(t *testing.T) {
 	pool := poolAsInterface.(*shardedTxPool)
 
 	tx := createTx("alice", 42)
+	// "0_1" is routed to the same cache as "0", where the nonces of a sender cannot repeat (see replace-by-fee)
+	txWithNextNonce := createTx("alice", 43)
 	pool.AddData([]byte("hash-x"), tx, 0, "0")
-	pool.AddData([]byte("hash-y"), tx, 0, "0_1")
+	pool.AddData([]byte("hash-y"), txWithNextNonce, 0, "0_1")
 	pool.AddData([]byte("hash-z"), tx, 0, "2_3")
 
 	foundTx, ok := pool.SearchFirstData([]byte("hash-x"))
@@ -219,7 +222,7 @@ func Test_SearchFirstData(t *testing.T) {
 
 	foundTx, ok = pool.SearchFirstData([]byte("hash-y"))
 	require.True(t, ok)
-	require.Equal(t, tx, foundTx)
+	require.Equal(t, txWithNextNonce, foundTx)
 
 	foundTx, ok = pool.SearchFirstData([]byte("hash-z"))
 	require.True(t, ok)
@@ -361,6 +364,158 @@ func TestShardedTxPool_ImmunizeSetOfDataAgainstEviction(t *testing.T) {
 	pool.ImmunizeSetOfDataAgainstEviction([][]byte{[]byte("hash")}, "0")
 }
 
+func TestShardedTxPool_RecordsEvictedTransactions(t *testing.T) {
+	t.Run("evicted by sender limits", func(t *testing.T) {
+		poolAsInterface, _ := newTxPoolToTest()
+		pool := poolAsInterface.(*shardedTxPool)
+
+		for nonce := uint64(0); nonce < 11; nonce++ {
+			pool.AddData([]byte(fmt.Sprintf("hash-%d", nonce)), createTx("alice", nonce), 0, "0")
+		}
+
+		rejected := pool.GetRejectedTransactions()
+		require.Equal(t, 1, len(rejected))
+		require.Equal(t, []byte("hash-10"), rejected[0].TxHash)
+		require.Equal(t, uint64(10), rejected[0].Nonce)
+		require.Equal(t, txcache.RejectionReasonEvictedBySenderLimits, rejected[0].Reason)
+		require.Equal(t, rejected, pool.GetRejectedTransactionsForSender([]byte("alice")))
+		require.Empty(t, pool.GetRejectedTransactionsForSender([]byte("bob")))
+	})
+	t.Run("evicted under high load", func(t *testing.T) {
+		poolAsInterface, _ := newTxPoolToTest()
+		pool := poolAsInterface.(*shardedTxPool)
+
+		numTxs := 60
+		for i := 0; i < numTxs; i++ {
+			sender := fmt.Sprintf("sender-%d", i)
+			pool.AddData([]byte(fmt.Sprintf("hash-%d", i)), createTx(sender, 0), 0, "0")
+		}
+
+		cache := pool.getTxCache("0")
+		rejected := pool.GetRejectedTransactions()
+		require.NotEmpty(t, rejected)
+		require.Equal(t, numTxs, len(rejected)+cache.Len())
+		for _, entry := range rejected {
+			require.Equal(t, txcache.RejectionReasonEvictedUnderHighLoad, entry.Reason)
+			require.False(t, cache.Has(entry.TxHash))
+		}
+	})
+	t.Run("removed transactions should not be recorded as evicted", func(t *testing.T) {
+		poolAsInterface, _ := newTxPoolToTest()
+		pool := poolAsInterface.(*shardedTxPool)
+
+		for nonce := uint64(0); nonce < 5; nonce++ {
+			pool.AddData([]byte(fmt.Sprintf("hash-%d", nonce)), createTx("alice", nonce), 0, "0")
+		}
+		pool.RemoveData([]byte("hash-3"), "0")
+
+		require.Empty(t, pool.GetRejectedTransactions())
+	})
+}
+
+func TestShardedTxPool_RecordRejectedTransactions(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	wrappedTx := &txcache.WrappedTransaction{
+		Tx:     createTx("alice", 42),
+		TxHash: []byte("hash"),
+	}
+	pool.RecordRejectedTransactions([]*txcache.RejectedTransaction{
+		txcache.NewRejectedTransaction(wrappedTx, txcache.RejectionReasonIncorrectlyGuarded),
+	})
+
+	rejected := pool.GetRejectedTransactions()
+	require.Equal(t, 1, len(rejected))
+	require.Equal(t, txcache.RejectionReasonIncorrectlyGuarded, rejected[0].Reason)
+}
+
+func TestShardedTxPool_AddTransaction(t *testing.T) {
+	t.Run("invalid cache identifier should error", func(t *testing.T) {
+		poolAsInterface, _ := newTxPoolToTest()
+		pool := poolAsInterface.(*shardedTxPool)
+
+		err := pool.AddTransaction([]byte("hash"), createTx("alice", 42), 0, "invalid-cache-id")
+		require.NotNil(t, err)
+	})
+	t.Run("should replace by fee", func(t *testing.T) {
+		poolAsInterface, _ := newTxPoolToTest()
+		pool := poolAsInterface.(*shardedTxPool)
+		pool.txReplacer = txcache.NewTxReplacer(10)
+		cache := pool.getTxCache("0")
+
+		addedHashes := make([]string, 0)
+		mutAddedHashes := sync.Mutex{}
+		pool.RegisterOnAdded(func(key []byte, value interface{}) {
+			mutAddedHashes.Lock()
+			addedHashes = append(addedHashes, string(key))
+			mutAddedHashes.Unlock()
+		})
+
+		err := pool.AddTransaction([]byte("hash-a"), createTxWithGasPrice("alice", 42, 1_000_000_000), 0, "0")
+		require.Nil(t, err)
+
+		err = pool.AddTransaction([]byte("hash-b"), createTxWithGasPrice("alice", 42, 1_050_000_000), 0, "0")
+		require.Equal(t, txcache.ErrReplacementUnderpriced, err)
+
+		err = pool.AddTransaction([]byte("hash-c"), createTxWithGasPrice("alice", 42, 1_100_000_000), 0, "0")
+		require.Nil(t, err)
+
+		require.Equal(t, 1, cache.Len())
+		require.True(t, cache.Has([]byte("hash-c")))
+
+		rejected := pool.GetRejectedTransactionsForSender([]byte("alice"))
+		require.Equal(t, 2, len(rejected))
+		require.Equal(t, []byte("hash-b"), rejected[0].TxHash)
+		require.Equal(t, txcache.RejectionReasonReplacementUnderpriced, rejected[0].Reason)
+		require.Equal(t, []byte("hash-a"), rejected[1].TxHash)
+		require.Equal(t, txcache.RejectionReasonReplacedByFee, rejected[1].Reason)
+
+		waitABit()
+		mutAddedHashes.Lock()
+		require.Equal(t, []string{"hash-a", "hash-c"}, addedHashes)
+		mutAddedHashes.Unlock()
+	})
+	t.Run("should not replace in the caches of other shards", func(t *testing.T) {
+		poolAsInterface, _ := newTxPoolToTest()
+		pool := poolAsInterface.(*shardedTxPool)
+		cache := pool.getTxCache("1_0")
+
+		err := pool.AddTransaction([]byte("hash-a"), createTxWithGasPrice("alice", 42, 1_000_000_000), 0, "1_0")
+		require.Nil(t, err)
+		err = pool.AddTransaction([]byte("hash-b"), createTxWithGasPrice("alice", 42, 900_000_000), 0, "1_0")
+		require.Nil(t, err)
+
+		require.Equal(t, 2, cache.Len())
+		require.Empty(t, pool.GetRejectedTransactions())
+	})
+}
+
+func TestShardedTxPool_CheckReplacement(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	pool.txReplacer = txcache.NewTxReplacer(10)
+
+	err := pool.AddTransaction([]byte("hash-a"), createTxWithGasPrice("alice", 42, 1_000_000_000), 0, "0")
+	require.Nil(t, err)
+	err = pool.AddTransaction([]byte("hash-b"), createTxWithGasPrice("alice", 42, 1_000_000_000), 0, "1_0")
+	require.Nil(t, err)
+
+	err = pool.CheckReplacement([]byte("hash-c"), createTxWithGasPrice("alice", 42, 1_050_000_000), "0")
+	require.Equal(t, txcache.ErrReplacementUnderpriced, err)
+	err = pool.CheckReplacement([]byte("hash-a"), createTxWithGasPrice("alice", 42, 1_000_000_000), "0")
+	require.Nil(t, err)
+	err = pool.CheckReplacement([]byte("hash-c"), createTxWithGasPrice("alice", 42, 1_100_000_000), "0")
+	require.Nil(t, err)
+	err = pool.CheckReplacement([]byte("hash-c"), createTxWithGasPrice("alice", 42, 900_000_000), "1_0")
+	require.Nil(t, err)
+
+	// the check does not alter the pool
+	require.Equal(t, 1, pool.getTxCache("0").Len())
+	require.True(t, pool.getTxCache("0").Has([]byte("hash-a")))
+	require.Empty(t, pool.GetRejectedTransactions())
+}
+
 func Test_IsInterfaceNil(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	require.False(t, check.IfNil(poolAsInterface))
@@ -407,6 +562,15 @@ func createTx(sender string, nonce uint64) data.TransactionHandler {
 	}
 }
 
+func createTxWithGasPrice(sender string, nonce uint64, gasPrice uint64) data.TransactionHandler {
+	return &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		Nonce:    nonce,
+		GasLimit: 50000,
+		GasPrice: gasPrice,
+	}
+}
+
 func waitABit() {
 	time.Sleep(10 * time.Millisecond)
 }
@@ -49,6 +49,10 @@ const (
 	PeerAccountsUnit UnitType = 21
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 22
+	// AddressHistoryUnit is the address history storage unit identifier
+	AddressHistoryUnit UnitType = 23
+	// EventsIndexUnit is the events index storage unit identifier
+	EventsIndexUnit UnitType = 24
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -110,6 +114,10 @@ func (ut UnitType) String() string {
 		return "PeerAccountsUnit"
 	case ScheduledSCRsUnit:
 		return "ScheduledSCRsUnit"
+	case AddressHistoryUnit:
+		return "AddressHistoryUnit"
+	case EventsIndexUnit:
+		return "EventsIndexUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -55,6 +55,10 @@ func TestUnitType_String(t *testing.T) {
 	require.Equal(t, "PeerAccountsUnit", ut.String())
 	ut = ScheduledSCRsUnit
 	require.Equal(t, "ScheduledSCRsUnit", ut.String())
+	ut = AddressHistoryUnit
+	require.Equal(t, "AddressHistoryUnit", ut.String())
+	ut = EventsIndexUnit
+	require.Equal(t, "EventsIndexUnit", ut.String())
 
 	ut = 200
 	require.Equal(t, "ShardHdrNonceHashDataUnit100", ut.String())
@@ -0,0 +1,828 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: addressHistory.proto
+
+package addressHistory
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AddressHistoryEntry is used to store a transaction (or smart contract result, or reward) touching an address
+type AddressHistoryEntry struct {
+	TxHash      []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	Type        int32  `protobuf:"varint,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Epoch       uint32 `protobuf:"varint,3,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Round       uint64 `protobuf:"varint,4,opt,name=Round,proto3" json:"Round,omitempty"`
+	HeaderNonce uint64 `protobuf:"varint,5,opt,name=HeaderNonce,proto3" json:"HeaderNonce,omitempty"`
+	HeaderHash  []byte `protobuf:"bytes,6,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+}
+
+func (m *AddressHistoryEntry) Reset()      { *m = AddressHistoryEntry{} }
+func (*AddressHistoryEntry) ProtoMessage() {}
+func (*AddressHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3a475f4d12d5066, []int{0}
+}
+func (m *AddressHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressHistoryEntry.Merge(m, src)
+}
+func (m *AddressHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressHistoryEntry proto.InternalMessageInfo
+
+func (m *AddressHistoryEntry) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *AddressHistoryEntry) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *AddressHistoryEntry) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *AddressHistoryEntry) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *AddressHistoryEntry) GetHeaderNonce() uint64 {
+	if m != nil {
+		return m.HeaderNonce
+	}
+	return 0
+}
+
+func (m *AddressHistoryEntry) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+// AddressesByBlock is used to store the addresses touched by a block, so that the block can be reverted
+type AddressesByBlock struct {
+	Addresses [][]byte `protobuf:"bytes,1,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+}
+
+func (m *AddressesByBlock) Reset()      { *m = AddressesByBlock{} }
+func (*AddressesByBlock) ProtoMessage() {}
+func (*AddressesByBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3a475f4d12d5066, []int{1}
+}
+func (m *AddressesByBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressesByBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressesByBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressesByBlock.Merge(m, src)
+}
+func (m *AddressesByBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressesByBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressesByBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressesByBlock proto.InternalMessageInfo
+
+func (m *AddressesByBlock) GetAddresses() [][]byte {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AddressHistoryEntry)(nil), "proto.AddressHistoryEntry")
+	proto.RegisterType((*AddressesByBlock)(nil), "proto.AddressesByBlock")
+}
+
+func init() { proto.RegisterFile("addressHistory.proto", fileDescriptor_e3a475f4d12d5066) }
+
+var fileDescriptor_e3a475f4d12d5066 = []byte{
+	// 289 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x3f, 0x4e, 0xc3, 0x30,
+	0x14, 0xc6, 0xfd, 0x68, 0x53, 0x09, 0x53, 0x10, 0x32, 0x15, 0xb2, 0x10, 0x7a, 0xb2, 0x3a, 0x65,
+	0xa1, 0x45, 0xe2, 0x04, 0x54, 0xaa, 0x94, 0x89, 0xc1, 0xea, 0xc4, 0xd6, 0x26, 0x26, 0xa9, 0x80,
+	0x38, 0xca, 0x1f, 0x89, 0x6c, 0x1c, 0x81, 0x63, 0xb0, 0x71, 0x0d, 0xc6, 0x8c, 0x19, 0x89, 0xb3,
+	0x30, 0xf6, 0x08, 0x08, 0xa7, 0x82, 0x76, 0xf2, 0xfb, 0xfd, 0x2c, 0xfb, 0x7b, 0xfa, 0xe8, 0x68,
+	0x19, 0x04, 0xa9, 0xca, 0x32, 0x6f, 0x9d, 0xe5, 0x3a, 0x2d, 0x27, 0x49, 0xaa, 0x73, 0xcd, 0x1c,
+	0x7b, 0x5c, 0x5c, 0x85, 0xeb, 0x3c, 0x2a, 0x56, 0x13, 0x5f, 0x3f, 0x4f, 0x43, 0x1d, 0xea, 0xa9,
+	0xd5, 0xab, 0xe2, 0xc1, 0x92, 0x05, 0x3b, 0x75, 0xaf, 0xc6, 0x1f, 0x40, 0xcf, 0x6e, 0xf7, 0xbe,
+	0x9b, 0xc7, 0x79, 0x5a, 0xb2, 0x73, 0x3a, 0x58, 0xbc, 0x78, 0xcb, 0x2c, 0xe2, 0x20, 0xc0, 0x1d,
+	0xca, 0x2d, 0x31, 0x46, 0xfb, 0x8b, 0x32, 0x51, 0xfc, 0x40, 0x80, 0xeb, 0x48, 0x3b, 0xb3, 0x11,
+	0x75, 0xe6, 0x89, 0xf6, 0x23, 0xde, 0x13, 0xe0, 0x1e, 0xcb, 0x0e, 0x7e, 0xad, 0xd4, 0x45, 0x1c,
+	0xf0, 0xbe, 0x00, 0xb7, 0x2f, 0x3b, 0x60, 0x82, 0x1e, 0x79, 0x6a, 0x19, 0xa8, 0xf4, 0x4e, 0xc7,
+	0xbe, 0xe2, 0x8e, 0xbd, 0xdb, 0x55, 0x0c, 0x29, 0xed, 0xd0, 0xa6, 0x0f, 0x6c, 0xfa, 0x8e, 0x19,
+	0x5f, 0xd3, 0xd3, 0xed, 0xc2, 0x2a, 0x9b, 0x95, 0xb3, 0x27, 0xed, 0x3f, 0xb2, 0x4b, 0x7a, 0xf8,
+	0xe7, 0x38, 0x88, 0x9e, 0x3b, 0x94, 0xff, 0x62, 0xe6, 0x55, 0x0d, 0x92, 0xba, 0x41, 0xb2, 0x69,
+	0x10, 0x5e, 0x0d, 0xc2, 0xbb, 0x41, 0xf8, 0x34, 0x08, 0x95, 0x41, 0xa8, 0x0d, 0xc2, 0x97, 0x41,
+	0xf8, 0x36, 0x48, 0x36, 0x06, 0xe1, 0xad, 0x45, 0x52, 0xb5, 0x48, 0xea, 0x16, 0xc9, 0xfd, 0xc9,
+	0x7e, 0xd3, 0xab, 0x81, 0x2d, 0xed, 0xe6, 0x67, 0x00, 0xe3, 0x17, 0x3c, 0xbb, 0x82, 0x01, 0x00,
+	0x00,
+}
+
+func (this *AddressHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressHistoryEntry)
+	if !ok {
+		that2, ok := that.(AddressHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.HeaderNonce != that1.HeaderNonce {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	return true
+}
+func (this *AddressesByBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressesByBlock)
+	if !ok {
+		that2, ok := that.(AddressesByBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if !bytes.Equal(this.Addresses[i], that1.Addresses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AddressHistoryEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&addressHistory.AddressHistoryEntry{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "HeaderNonce: "+fmt.Sprintf("%#v", this.HeaderNonce)+",\n")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddressesByBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&addressHistory.AddressesByBlock{")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAddressHistory(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AddressHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintAddressHistory(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HeaderNonce != 0 {
+		i = encodeVarintAddressHistory(dAtA, i, uint64(m.HeaderNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Round != 0 {
+		i = encodeVarintAddressHistory(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintAddressHistory(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintAddressHistory(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintAddressHistory(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressesByBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressesByBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressesByBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintAddressHistory(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAddressHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAddressHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddressHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovAddressHistory(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovAddressHistory(uint64(m.Type))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovAddressHistory(uint64(m.Epoch))
+	}
+	if m.Round != 0 {
+		n += 1 + sovAddressHistory(uint64(m.Round))
+	}
+	if m.HeaderNonce != 0 {
+		n += 1 + sovAddressHistory(uint64(m.HeaderNonce))
+	}
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovAddressHistory(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressesByBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovAddressHistory(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAddressHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAddressHistory(x uint64) (n int) {
+	return sovAddressHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AddressHistoryEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressHistoryEntry{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`HeaderNonce:` + fmt.Sprintf("%v", this.HeaderNonce) + `,`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddressesByBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressesByBlock{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAddressHistory(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AddressHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderNonce", wireType)
+			}
+			m.HeaderNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressesByBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressesByBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressesByBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAddressHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAddressHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAddressHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAddressHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAddressHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAddressHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAddressHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAddressHistory = fmt.Errorf("proto: unexpected end of group")
+)
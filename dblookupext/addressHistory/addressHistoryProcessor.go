@@ -0,0 +1,457 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. addressHistory.proto
+
+package addressHistory
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common/logging"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("dblookupext/addressHistory")
+
+const (
+	numEntriesKeyPrefix = "n_"
+	entryKeyPrefix      = "e_"
+	blockKeyPrefix      = "b_"
+)
+
+// ArgsAddressHistoryProcessor holds the arguments needed to create a new address history processor
+type ArgsAddressHistoryProcessor struct {
+	Marshalizer                marshal.Marshalizer
+	Uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator           sharding.Coordinator
+	AddressHistoryStorer       storage.Storer
+	TransactionsStorer         storage.Storer
+	UnsignedTransactionsStorer storage.Storer
+	RewardTransactionsStorer   storage.Storer
+}
+
+// AddressHistoryPage holds a page of the history of an address, newest entries first
+type AddressHistoryPage struct {
+	Entries    []*AddressHistoryEntry
+	NumEntries uint64
+	// NextCursor is the cursor of the next (older) page, 0 if there are no more entries
+	NextCursor uint64
+}
+
+type addressHistoryProcessor struct {
+	marshalizer                marshal.Marshalizer
+	uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
+	shardCoordinator           sharding.Coordinator
+	storer                     storage.Storer
+	transactionsStorer         storage.Storer
+	unsignedTransactionsStorer storage.Storer
+	rewardTransactionsStorer   storage.Storer
+	mutex                      sync.RWMutex
+}
+
+// NewAddressHistoryProcessor will create a new instance of the address history processor, which indexes the
+// transactions, smart contract results and rewards touching the addresses of the current shard
+func NewAddressHistoryProcessor(args ArgsAddressHistoryProcessor) (*addressHistoryProcessor, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return nil, process.ErrNilUint64Converter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.AddressHistoryStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.TransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.UnsignedTransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.RewardTransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+
+	return &addressHistoryProcessor{
+		marshalizer:                args.Marshalizer,
+		uint64ByteSliceConverter:   args.Uint64ByteSliceConverter,
+		shardCoordinator:           args.ShardCoordinator,
+		storer:                     args.AddressHistoryStorer,
+		transactionsStorer:         args.TransactionsStorer,
+		unsignedTransactionsStorer: args.UnsignedTransactionsStorer,
+		rewardTransactionsStorer:   args.RewardTransactionsStorer,
+	}, nil
+}
+
+// RecordBlock appends the transactions, smart contract results and rewards of the provided miniblocks to the history
+// of the addresses they touch. The transactions are fetched from the provided smart contract results or from storage,
+// so this should be called after the block body has been saved. A block is recorded only once.
+func (ahp *addressHistoryProcessor) RecordBlock(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	miniBlocks []*block.MiniBlock,
+	scrResultsFromPool map[string]data.TransactionHandler,
+) error {
+	ahp.mutex.Lock()
+	defer ahp.mutex.Unlock()
+
+	if ahp.storer.Has(blockKey(blockHeaderHash)) == nil {
+		log.Debug("addressHistoryProcessor.RecordBlock: block already recorded", "blockHeaderHash", blockHeaderHash)
+		return nil
+	}
+
+	addresses, entriesByAddress := ahp.groupEntriesByAddress(blockHeaderHash, blockHeader, miniBlocks, scrResultsFromPool)
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	// the touched addresses are saved first, so that a partially recorded block can still be reverted
+	err := ahp.saveAddressesByBlock(blockHeaderHash, addresses)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		err = ahp.appendEntries([]byte(address), entriesByAddress[address])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ahp *addressHistoryProcessor) groupEntriesByAddress(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	miniBlocks []*block.MiniBlock,
+	scrResultsFromPool map[string]data.TransactionHandler,
+) ([]string, map[string][]*AddressHistoryEntry) {
+	addresses := make([]string, 0)
+	entriesByAddress := make(map[string][]*AddressHistoryEntry)
+	recordedEntries := make(map[string]struct{})
+
+	for _, miniBlock := range miniBlocks {
+		if miniBlock == nil {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, err := ahp.getTransaction(txHash, miniBlock.Type, scrResultsFromPool)
+			if err != nil {
+				logging.LogErrAsWarnExceptAsDebugIfClosingError(log, err, "addressHistoryProcessor.getTransaction",
+					"txHash", txHash, "type", miniBlock.Type, "err", err)
+				continue
+			}
+			if check.IfNil(tx) {
+				continue
+			}
+
+			entry := &AddressHistoryEntry{
+				TxHash:      txHash,
+				Type:        int32(miniBlock.Type),
+				Epoch:       blockHeader.GetEpoch(),
+				Round:       blockHeader.GetRound(),
+				HeaderNonce: blockHeader.GetNonce(),
+				HeaderHash:  blockHeaderHash,
+			}
+
+			for _, address := range ahp.getTouchedAddresses(tx) {
+				recordedEntryKey := address + string(txHash)
+				_, alreadyRecorded := recordedEntries[recordedEntryKey]
+				if alreadyRecorded {
+					continue
+				}
+				recordedEntries[recordedEntryKey] = struct{}{}
+
+				_, addressExists := entriesByAddress[address]
+				if !addressExists {
+					addresses = append(addresses, address)
+				}
+				entriesByAddress[address] = append(entriesByAddress[address], entry)
+			}
+		}
+	}
+
+	return addresses, entriesByAddress
+}
+
+// getTransaction returns nil, without error, for the miniblocks which do not hold transactions of interest
+func (ahp *addressHistoryProcessor) getTransaction(
+	txHash []byte,
+	miniBlockType block.Type,
+	scrResultsFromPool map[string]data.TransactionHandler,
+) (data.TransactionHandler, error) {
+	switch miniBlockType {
+	case block.TxBlock, block.InvalidBlock:
+		return ahp.getTransactionFromStorage(ahp.transactionsStorer, txHash, &transaction.Transaction{})
+	case block.SmartContractResultBlock:
+		scr, found := scrResultsFromPool[string(txHash)]
+		if found {
+			return scr, nil
+		}
+
+		return ahp.getTransactionFromStorage(ahp.unsignedTransactionsStorer, txHash, &smartContractResult.SmartContractResult{})
+	case block.RewardsBlock:
+		return ahp.getTransactionFromStorage(ahp.rewardTransactionsStorer, txHash, &rewardTx.RewardTx{})
+	default:
+		return nil, nil
+	}
+}
+
+func (ahp *addressHistoryProcessor) getTransactionFromStorage(
+	storer storage.Storer,
+	txHash []byte,
+	tx data.TransactionHandler,
+) (data.TransactionHandler, error) {
+	txBytes, err := storer.Get(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ahp.marshalizer.Unmarshal(tx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// getTouchedAddresses returns the senders, receivers, relayers and guardians of the transaction, which belong to
+// the current shard. For smart contract results, the sender is left out, as it is the contract which issued them.
+func (ahp *addressHistoryProcessor) getTouchedAddresses(tx data.TransactionHandler) []string {
+	var candidates [][]byte
+	switch typedTx := tx.(type) {
+	case *transaction.Transaction:
+		candidates = [][]byte{typedTx.SndAddr, typedTx.RcvAddr, typedTx.GuardianAddr}
+	case *smartContractResult.SmartContractResult:
+		candidates = [][]byte{typedTx.RcvAddr, typedTx.RelayerAddr}
+	default:
+		candidates = [][]byte{tx.GetRcvAddr()}
+	}
+
+	addresses := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		if len(candidate) == 0 {
+			continue
+		}
+		if ahp.shardCoordinator.ComputeId(candidate) != ahp.shardCoordinator.SelfId() {
+			continue
+		}
+
+		addresses = append(addresses, string(candidate))
+	}
+
+	return addresses
+}
+
+func (ahp *addressHistoryProcessor) saveAddressesByBlock(blockHeaderHash []byte, addresses []string) error {
+	addressesByBlock := &AddressesByBlock{
+		Addresses: make([][]byte, 0, len(addresses)),
+	}
+	for _, address := range addresses {
+		addressesByBlock.Addresses = append(addressesByBlock.Addresses, []byte(address))
+	}
+
+	addressesByBlockBytes, err := ahp.marshalizer.Marshal(addressesByBlock)
+	if err != nil {
+		return err
+	}
+
+	return ahp.storer.Put(blockKey(blockHeaderHash), addressesByBlockBytes)
+}
+
+func (ahp *addressHistoryProcessor) appendEntries(address []byte, entries []*AddressHistoryEntry) error {
+	numEntries, err := ahp.getNumEntries(address)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		entryBytes, errMarshal := ahp.marshalizer.Marshal(entry)
+		if errMarshal != nil {
+			return errMarshal
+		}
+
+		err = ahp.storer.Put(ahp.entryKey(address, numEntries), entryBytes)
+		if err != nil {
+			return err
+		}
+
+		numEntries++
+	}
+
+	return ahp.saveNumEntries(address, numEntries)
+}
+
+// RevertBlock removes the entries added by the provided block. Since blocks are reverted starting with the
+// most recent one, the entries of the block are the last ones in the history of each touched address.
+func (ahp *addressHistoryProcessor) RevertBlock(blockHeaderHash []byte) error {
+	ahp.mutex.Lock()
+	defer ahp.mutex.Unlock()
+
+	addressesByBlockBytes, err := ahp.storer.Get(blockKey(blockHeaderHash))
+	if storage.IsNotFoundInStorageErr(err) {
+		// nothing recorded for this block
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	addressesByBlock := &AddressesByBlock{}
+	err = ahp.marshalizer.Unmarshal(addressesByBlock, addressesByBlockBytes)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addressesByBlock.Addresses {
+		err = ahp.removeEntriesOfBlock(address, blockHeaderHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ahp.storer.Remove(blockKey(blockHeaderHash))
+}
+
+func (ahp *addressHistoryProcessor) removeEntriesOfBlock(address []byte, blockHeaderHash []byte) error {
+	numEntries, err := ahp.getNumEntries(address)
+	if err != nil {
+		return err
+	}
+
+	initialNumEntries := numEntries
+	for numEntries > 0 {
+		entry, errGet := ahp.getEntry(address, numEntries-1)
+		if errGet != nil {
+			return errGet
+		}
+		if !bytes.Equal(entry.HeaderHash, blockHeaderHash) {
+			break
+		}
+
+		err = ahp.storer.Remove(ahp.entryKey(address, numEntries-1))
+		if err != nil {
+			return err
+		}
+
+		numEntries--
+	}
+
+	if numEntries == initialNumEntries {
+		return nil
+	}
+
+	return ahp.saveNumEntries(address, numEntries)
+}
+
+// GetAddressHistory returns at most maxEntries entries from the history of the address, newest first. The cursor is
+// the one returned along with the previous page, while 0 means starting with the most recent entry.
+func (ahp *addressHistoryProcessor) GetAddressHistory(address []byte, cursor uint64, maxEntries int) (*AddressHistoryPage, error) {
+	if maxEntries < 1 {
+		return nil, ErrInvalidMaxEntries
+	}
+
+	ahp.mutex.RLock()
+	defer ahp.mutex.RUnlock()
+
+	numEntries, err := ahp.getNumEntries(address)
+	if err != nil {
+		return nil, err
+	}
+
+	// the cursor is the index following the newest entry of the page
+	end := numEntries
+	if cursor > 0 && cursor < numEntries {
+		end = cursor
+	}
+
+	entries := make([]*AddressHistoryEntry, 0, core.MinUint64(end, uint64(maxEntries)))
+	index := end
+	for index > 0 && len(entries) < maxEntries {
+		index--
+
+		entry, errGet := ahp.getEntry(address, index)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return &AddressHistoryPage{
+		Entries:    entries,
+		NumEntries: numEntries,
+		NextCursor: index,
+	}, nil
+}
+
+func (ahp *addressHistoryProcessor) getNumEntries(address []byte) (uint64, error) {
+	numEntriesBytes, err := ahp.storer.Get(numEntriesKey(address))
+	if storage.IsNotFoundInStorageErr(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return ahp.uint64ByteSliceConverter.ToUint64(numEntriesBytes)
+}
+
+func (ahp *addressHistoryProcessor) saveNumEntries(address []byte, numEntries uint64) error {
+	if numEntries == 0 {
+		return ahp.storer.Remove(numEntriesKey(address))
+	}
+
+	return ahp.storer.Put(numEntriesKey(address), ahp.uint64ByteSliceConverter.ToByteSlice(numEntries))
+}
+
+func (ahp *addressHistoryProcessor) getEntry(address []byte, index uint64) (*AddressHistoryEntry, error) {
+	entryBytes, err := ahp.storer.Get(ahp.entryKey(address, index))
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &AddressHistoryEntry{}
+	err = ahp.marshalizer.Unmarshal(entry, entryBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (ahp *addressHistoryProcessor) entryKey(address []byte, index uint64) []byte {
+	key := make([]byte, 0, len(entryKeyPrefix)+len(address)+8)
+	key = append(key, entryKeyPrefix...)
+	key = append(key, address...)
+
+	return append(key, ahp.uint64ByteSliceConverter.ToByteSlice(index)...)
+}
+
+func numEntriesKey(address []byte) []byte {
+	return append([]byte(numEntriesKeyPrefix), address...)
+}
+
+func blockKey(blockHeaderHash []byte) []byte {
+	return append([]byte(blockKeyPrefix), blockHeaderHash...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ahp *addressHistoryProcessor) IsInterfaceNil() bool {
+	return ahp == nil
+}
@@ -0,0 +1,307 @@
+package addressHistory
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const otherShardAddressPrefix = "other"
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+
+func createMockArgsAddressHistoryProcessor() ArgsAddressHistoryProcessor {
+	return ArgsAddressHistoryProcessor{
+		Marshalizer:              testMarshalizer,
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+		ShardCoordinator: &testscommon.ShardsCoordinatorMock{
+			ComputeIdCalled: func(address []byte) uint32 {
+				if strings.HasPrefix(string(address), otherShardAddressPrefix) {
+					return 1
+				}
+				return 0
+			},
+		},
+		AddressHistoryStorer:       testscommon.CreateMemUnit(),
+		TransactionsStorer:         testscommon.CreateMemUnit(),
+		UnsignedTransactionsStorer: testscommon.CreateMemUnit(),
+		RewardTransactionsStorer:   testscommon.CreateMemUnit(),
+	}
+}
+
+func putInStorer(t *testing.T, storer storage.Storer, hash string, tx data.TransactionHandler) {
+	txBytes, err := testMarshalizer.Marshal(tx)
+	require.Nil(t, err)
+	require.Nil(t, storer.Put([]byte(hash), txBytes))
+}
+
+func requireHistory(t *testing.T, ahp *addressHistoryProcessor, address string, expectedTxHashes ...string) {
+	page, err := ahp.GetAddressHistory([]byte(address), 0, 100)
+	require.Nil(t, err)
+
+	var txHashes []string
+	for _, entry := range page.Entries {
+		txHashes = append(txHashes, string(entry.TxHash))
+	}
+	require.Equal(t, expectedTxHashes, txHashes, "history of "+address)
+	require.Equal(t, uint64(len(expectedTxHashes)), page.NumEntries)
+}
+
+func recordTransfers(t *testing.T, ahp *addressHistoryProcessor, args ArgsAddressHistoryProcessor, blockHeaderHash string, nonce uint64, numTransfers int) {
+	txHashes := make([][]byte, 0, numTransfers)
+	for i := 0; i < numTransfers; i++ {
+		txHash := fmt.Sprintf("%s-tx%d", blockHeaderHash, i)
+		putInStorer(t, args.TransactionsStorer, txHash, &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")})
+		txHashes = append(txHashes, []byte(txHash))
+	}
+
+	miniBlocks := []*block.MiniBlock{{TxHashes: txHashes, Type: block.TxBlock}}
+	err := ahp.RecordBlock([]byte(blockHeaderHash), &block.Header{Nonce: nonce}, miniBlocks, nil)
+	require.Nil(t, err)
+}
+
+func TestNewAddressHistoryProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAddressHistoryProcessor()
+		args.Marshalizer = nil
+		ahp, err := NewAddressHistoryProcessor(args)
+		assert.Nil(t, ahp)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAddressHistoryProcessor()
+		args.Uint64ByteSliceConverter = nil
+		ahp, err := NewAddressHistoryProcessor(args)
+		assert.Nil(t, ahp)
+		assert.Equal(t, process.ErrNilUint64Converter, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAddressHistoryProcessor()
+		args.ShardCoordinator = nil
+		ahp, err := NewAddressHistoryProcessor(args)
+		assert.Nil(t, ahp)
+		assert.Equal(t, process.ErrNilShardCoordinator, err)
+	})
+	t.Run("nil storers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAddressHistoryProcessor()
+		args.AddressHistoryStorer = nil
+		ahp, err := NewAddressHistoryProcessor(args)
+		assert.Nil(t, ahp)
+		assert.Equal(t, core.ErrNilStore, err)
+
+		args = createMockArgsAddressHistoryProcessor()
+		args.TransactionsStorer = nil
+		ahp, err = NewAddressHistoryProcessor(args)
+		assert.Nil(t, ahp)
+		assert.Equal(t, core.ErrNilStore, err)
+
+		args = createMockArgsAddressHistoryProcessor()
+		args.UnsignedTransactionsStorer = nil
+		ahp, err = NewAddressHistoryProcessor(args)
+		assert.Nil(t, ahp)
+		assert.Equal(t, core.ErrNilStore, err)
+
+		args = createMockArgsAddressHistoryProcessor()
+		args.RewardTransactionsStorer = nil
+		ahp, err = NewAddressHistoryProcessor(args)
+		assert.Nil(t, ahp)
+		assert.Equal(t, core.ErrNilStore, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ahp, err := NewAddressHistoryProcessor(createMockArgsAddressHistoryProcessor())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(ahp))
+	})
+}
+
+func TestAddressHistoryProcessor_RecordBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAddressHistoryProcessor()
+	ahp, _ := NewAddressHistoryProcessor(args)
+
+	putInStorer(t, args.TransactionsStorer, "tx", &transaction.Transaction{
+		SndAddr:      []byte("alice"),
+		RcvAddr:      []byte("bob"),
+		GuardianAddr: []byte("guardian"),
+	})
+	putInStorer(t, args.TransactionsStorer, "txToSelf", &transaction.Transaction{
+		SndAddr: []byte("alice"),
+		RcvAddr: []byte("alice"),
+	})
+	putInStorer(t, args.TransactionsStorer, "txCrossShard", &transaction.Transaction{
+		SndAddr: []byte("bob"),
+		RcvAddr: []byte(otherShardAddressPrefix + "-dave"),
+	})
+	putInStorer(t, args.TransactionsStorer, "txInvalid", &transaction.Transaction{
+		SndAddr: []byte("carol"),
+		RcvAddr: []byte("bob"),
+	})
+	putInStorer(t, args.RewardTransactionsStorer, "reward", &rewardTx.RewardTx{
+		RcvAddr: []byte("carol"),
+	})
+	scrResultsFromPool := map[string]data.TransactionHandler{
+		"scr": &smartContractResult.SmartContractResult{
+			SndAddr:     []byte("contract"),
+			RcvAddr:     []byte("alice"),
+			RelayerAddr: []byte("carol"),
+		},
+	}
+
+	miniBlocks := []*block.MiniBlock{
+		{TxHashes: [][]byte{[]byte("tx"), []byte("txToSelf"), []byte("missing")}, Type: block.TxBlock},
+		{TxHashes: [][]byte{[]byte("txCrossShard")}, Type: block.TxBlock, ReceiverShardID: 1},
+		{TxHashes: [][]byte{[]byte("txInvalid")}, Type: block.InvalidBlock},
+		{TxHashes: [][]byte{[]byte("peerChange")}, Type: block.PeerBlock},
+		{TxHashes: [][]byte{[]byte("reward")}, Type: block.RewardsBlock},
+		{TxHashes: [][]byte{[]byte("scr")}, Type: block.SmartContractResultBlock},
+		nil,
+	}
+	blockHeader := &block.Header{Nonce: 7, Round: 8, Epoch: 2}
+	err := ahp.RecordBlock([]byte("hash"), blockHeader, miniBlocks, scrResultsFromPool)
+	require.Nil(t, err)
+
+	requireHistory(t, ahp, "alice", "scr", "txToSelf", "tx")
+	requireHistory(t, ahp, "bob", "txInvalid", "txCrossShard", "tx")
+	requireHistory(t, ahp, "carol", "scr", "reward", "txInvalid")
+	requireHistory(t, ahp, "guardian", "tx")
+	requireHistory(t, ahp, "contract")
+	requireHistory(t, ahp, otherShardAddressPrefix+"-dave")
+
+	page, _ := ahp.GetAddressHistory([]byte("guardian"), 0, 1)
+	expectedEntry := &AddressHistoryEntry{
+		TxHash:      []byte("tx"),
+		Type:        int32(block.TxBlock),
+		Epoch:       2,
+		Round:       8,
+		HeaderNonce: 7,
+		HeaderHash:  []byte("hash"),
+	}
+	require.Equal(t, []*AddressHistoryEntry{expectedEntry}, page.Entries)
+
+	t.Run("recording the same block again should not duplicate the entries", func(t *testing.T) {
+		err = ahp.RecordBlock([]byte("hash"), blockHeader, miniBlocks, scrResultsFromPool)
+		require.Nil(t, err)
+
+		requireHistory(t, ahp, "alice", "scr", "txToSelf", "tx")
+	})
+}
+
+func TestAddressHistoryProcessor_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAddressHistoryProcessor()
+	ahp, _ := NewAddressHistoryProcessor(args)
+
+	recordTransfers(t, ahp, args, "block1", 1, 2)
+	recordTransfers(t, ahp, args, "block2", 2, 1)
+	requireHistory(t, ahp, "alice", "block2-tx0", "block1-tx1", "block1-tx0")
+
+	err := ahp.RevertBlock([]byte("block2"))
+	require.Nil(t, err)
+	requireHistory(t, ahp, "alice", "block1-tx1", "block1-tx0")
+	requireHistory(t, ahp, "bob", "block1-tx1", "block1-tx0")
+
+	// not recorded or already reverted blocks are ignored
+	err = ahp.RevertBlock([]byte("block2"))
+	require.Nil(t, err)
+	err = ahp.RevertBlock([]byte("unknown"))
+	require.Nil(t, err)
+	requireHistory(t, ahp, "alice", "block1-tx1", "block1-tx0")
+
+	// the block can be recorded again, after being reverted
+	recordTransfers(t, ahp, args, "block2", 2, 1)
+	requireHistory(t, ahp, "alice", "block2-tx0", "block1-tx1", "block1-tx0")
+
+	err = ahp.RevertBlock([]byte("block2"))
+	require.Nil(t, err)
+	err = ahp.RevertBlock([]byte("block1"))
+	require.Nil(t, err)
+	requireHistory(t, ahp, "alice")
+	require.NotNil(t, args.AddressHistoryStorer.Has(numEntriesKey([]byte("alice"))))
+}
+
+func TestAddressHistoryProcessor_GetAddressHistory(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAddressHistoryProcessor()
+	ahp, _ := NewAddressHistoryProcessor(args)
+	recordTransfers(t, ahp, args, "block", 1, 5)
+
+	getPage := func(cursor uint64, maxEntries int) ([]string, uint64) {
+		page, err := ahp.GetAddressHistory([]byte("bob"), cursor, maxEntries)
+		require.Nil(t, err)
+		require.Equal(t, uint64(5), page.NumEntries)
+
+		txHashes := make([]string, 0, len(page.Entries))
+		for _, entry := range page.Entries {
+			txHashes = append(txHashes, string(entry.TxHash))
+		}
+
+		return txHashes, page.NextCursor
+	}
+
+	t.Run("invalid max entries should error", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := ahp.GetAddressHistory([]byte("bob"), 0, 0)
+		require.Nil(t, page)
+		require.Equal(t, ErrInvalidMaxEntries, err)
+	})
+	t.Run("unknown address should return an empty page", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := ahp.GetAddressHistory([]byte("unknown"), 0, 10)
+		require.Nil(t, err)
+		require.Equal(t, &AddressHistoryPage{Entries: []*AddressHistoryEntry{}}, page)
+	})
+	t.Run("should iterate the history, newest entries first", func(t *testing.T) {
+		t.Parallel()
+
+		txHashes, cursor := getPage(0, 2)
+		require.Equal(t, []string{"block-tx4", "block-tx3"}, txHashes)
+		require.Equal(t, uint64(3), cursor)
+
+		txHashes, cursor = getPage(cursor, 2)
+		require.Equal(t, []string{"block-tx2", "block-tx1"}, txHashes)
+		require.Equal(t, uint64(1), cursor)
+
+		txHashes, cursor = getPage(cursor, 2)
+		require.Equal(t, []string{"block-tx0"}, txHashes)
+		require.Equal(t, uint64(0), cursor)
+	})
+	t.Run("cursor past the end should start with the newest entry", func(t *testing.T) {
+		t.Parallel()
+
+		txHashes, cursor := getPage(100, 1)
+		require.Equal(t, []string{"block-tx4"}, txHashes)
+		require.Equal(t, uint64(4), cursor)
+	})
+}
@@ -0,0 +1,6 @@
+package addressHistory
+
+import "errors"
+
+// ErrInvalidMaxEntries signals that an invalid maximum number of entries has been provided
+var ErrInvalidMaxEntries = errors.New("invalid maximum number of entries")
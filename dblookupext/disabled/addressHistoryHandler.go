@@ -0,0 +1,39 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressHistory"
+)
+
+var errorDisabledAddressHistory = errors.New("address history index is disabled")
+
+type addressHistoryHandler struct {
+}
+
+// NewAddressHistoryHandler returns a disabled address history handler
+func NewAddressHistoryHandler() *addressHistoryHandler {
+	return &addressHistoryHandler{}
+}
+
+// RecordBlock does nothing
+func (ahh *addressHistoryHandler) RecordBlock(_ []byte, _ data.HeaderHandler, _ []*block.MiniBlock, _ map[string]data.TransactionHandler) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (ahh *addressHistoryHandler) RevertBlock(_ []byte) error {
+	return nil
+}
+
+// GetAddressHistory returns a not enabled error
+func (ahh *addressHistoryHandler) GetAddressHistory(_ []byte, _ uint64, _ int) (*addressHistory.AddressHistoryPage, error) {
+	return nil, errorDisabledAddressHistory
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ahh *addressHistoryHandler) IsInterfaceNil() bool {
+	return ahh == nil
+}
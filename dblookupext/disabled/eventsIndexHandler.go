@@ -0,0 +1,38 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
+)
+
+var errorDisabledEventsIndex = errors.New("events index is disabled")
+
+type eventsIndexHandler struct {
+}
+
+// NewEventsIndexHandler returns a disabled events index handler
+func NewEventsIndexHandler() *eventsIndexHandler {
+	return &eventsIndexHandler{}
+}
+
+// RecordBlock does nothing
+func (eih *eventsIndexHandler) RecordBlock(_ []byte, _ data.HeaderHandler, _ []*data.LogData) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (eih *eventsIndexHandler) RevertBlock(_ []byte) error {
+	return nil
+}
+
+// GetEvents returns a not enabled error
+func (eih *eventsIndexHandler) GetEvents(_ *eventsIndex.EventsFilter) (*eventsIndex.EventsPage, error) {
+	return nil, errorDisabledEventsIndex
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eih *eventsIndexHandler) IsInterfaceNil() bool {
+	return eih == nil
+}
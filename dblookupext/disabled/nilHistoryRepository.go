@@ -6,7 +6,9 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressHistory"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 )
 
 var errorDisabledHistoryRepository = errors.New("history repository is disabled")
@@ -53,6 +55,16 @@ func (nhr *nilHistoryRepository) GetESDTSupply(_ string) (*esdtSupply.SupplyESDT
 	return nil, errorDisabledHistoryRepository
 }
 
+// GetAddressHistory -
+func (nhr *nilHistoryRepository) GetAddressHistory(_ []byte, _ uint64, _ int) (*addressHistory.AddressHistoryPage, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
+// GetEvents -
+func (nhr *nilHistoryRepository) GetEvents(_ *eventsIndex.EventsFilter) (*eventsIndex.EventsPage, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
 // GetResultsHashesByTxHash -
 func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*dblookupext.ResultsHashesByTxHash, error) {
 	return nil, nil
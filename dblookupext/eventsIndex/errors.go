@@ -0,0 +1,15 @@
+package eventsIndex
+
+import "errors"
+
+// ErrNilEventsFilter signals that a nil events filter has been provided
+var ErrNilEventsFilter = errors.New("nil events filter")
+
+// ErrInvalidMaxEntries signals that an invalid maximum number of entries has been provided
+var ErrInvalidMaxEntries = errors.New("invalid maximum number of entries")
+
+// ErrInvalidBlockRange signals that the last block of the filter is lower than its first block
+var ErrInvalidBlockRange = errors.New("invalid block range")
+
+// ErrEmptyEventsFilter signals that the filter specifies neither an address, nor an identifier, nor a first topic
+var ErrEmptyEventsFilter = errors.New("the events filter should specify an address, an identifier or a first topic")
@@ -0,0 +1,1516 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: eventsIndex.proto
+
+package eventsIndex
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IndexedEvent is used to store an event emitted during the execution of a transaction, along with its context
+type IndexedEvent struct {
+	Address        []byte   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Identifier     []byte   `protobuf:"bytes,2,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Topics         [][]byte `protobuf:"bytes,3,rep,name=Topics,proto3" json:"Topics,omitempty"`
+	Data           []byte   `protobuf:"bytes,4,opt,name=Data,proto3" json:"Data,omitempty"`
+	AdditionalData [][]byte `protobuf:"bytes,5,rep,name=AdditionalData,proto3" json:"AdditionalData,omitempty"`
+	TxHash         []byte   `protobuf:"bytes,6,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	EventIndex     uint32   `protobuf:"varint,7,opt,name=EventIndex,proto3" json:"EventIndex,omitempty"`
+	Epoch          uint32   `protobuf:"varint,8,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Round          uint64   `protobuf:"varint,9,opt,name=Round,proto3" json:"Round,omitempty"`
+	HeaderNonce    uint64   `protobuf:"varint,10,opt,name=HeaderNonce,proto3" json:"HeaderNonce,omitempty"`
+	HeaderHash     []byte   `protobuf:"bytes,11,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+}
+
+func (m *IndexedEvent) Reset()      { *m = IndexedEvent{} }
+func (*IndexedEvent) ProtoMessage() {}
+func (*IndexedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fcd5f81b5b003d0, []int{0}
+}
+func (m *IndexedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *IndexedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedEvent.Merge(m, src)
+}
+func (m *IndexedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedEvent proto.InternalMessageInfo
+
+func (m *IndexedEvent) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *IndexedEvent) GetIdentifier() []byte {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *IndexedEvent) GetTopics() [][]byte {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *IndexedEvent) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *IndexedEvent) GetAdditionalData() [][]byte {
+	if m != nil {
+		return m.AdditionalData
+	}
+	return nil
+}
+
+func (m *IndexedEvent) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *IndexedEvent) GetEventIndex() uint32 {
+	if m != nil {
+		return m.EventIndex
+	}
+	return 0
+}
+
+func (m *IndexedEvent) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *IndexedEvent) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *IndexedEvent) GetHeaderNonce() uint64 {
+	if m != nil {
+		return m.HeaderNonce
+	}
+	return 0
+}
+
+func (m *IndexedEvent) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+// EventReference is used to store a pointer to an indexed event, within the events list of an address, identifier or topic
+type EventReference struct {
+	EventKey    []byte `protobuf:"bytes,1,opt,name=EventKey,proto3" json:"EventKey,omitempty"`
+	HeaderNonce uint64 `protobuf:"varint,2,opt,name=HeaderNonce,proto3" json:"HeaderNonce,omitempty"`
+	HeaderHash  []byte `protobuf:"bytes,3,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+}
+
+func (m *EventReference) Reset()      { *m = EventReference{} }
+func (*EventReference) ProtoMessage() {}
+func (*EventReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fcd5f81b5b003d0, []int{1}
+}
+func (m *EventReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReference.Merge(m, src)
+}
+func (m *EventReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReference proto.InternalMessageInfo
+
+func (m *EventReference) GetEventKey() []byte {
+	if m != nil {
+		return m.EventKey
+	}
+	return nil
+}
+
+func (m *EventReference) GetHeaderNonce() uint64 {
+	if m != nil {
+		return m.HeaderNonce
+	}
+	return 0
+}
+
+func (m *EventReference) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+// EventsByBlock is used to store the events and the index keys touched by a block, so that the block can be reverted
+type EventsByBlock struct {
+	EventKeys [][]byte `protobuf:"bytes,1,rep,name=EventKeys,proto3" json:"EventKeys,omitempty"`
+	IndexKeys [][]byte `protobuf:"bytes,2,rep,name=IndexKeys,proto3" json:"IndexKeys,omitempty"`
+}
+
+func (m *EventsByBlock) Reset()      { *m = EventsByBlock{} }
+func (*EventsByBlock) ProtoMessage() {}
+func (*EventsByBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fcd5f81b5b003d0, []int{2}
+}
+func (m *EventsByBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventsByBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventsByBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsByBlock.Merge(m, src)
+}
+func (m *EventsByBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventsByBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsByBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsByBlock proto.InternalMessageInfo
+
+func (m *EventsByBlock) GetEventKeys() [][]byte {
+	if m != nil {
+		return m.EventKeys
+	}
+	return nil
+}
+
+func (m *EventsByBlock) GetIndexKeys() [][]byte {
+	if m != nil {
+		return m.IndexKeys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*IndexedEvent)(nil), "proto.IndexedEvent")
+	proto.RegisterType((*EventReference)(nil), "proto.EventReference")
+	proto.RegisterType((*EventsByBlock)(nil), "proto.EventsByBlock")
+}
+
+func init() { proto.RegisterFile("eventsIndex.proto", fileDescriptor_4fcd5f81b5b003d0) }
+
+var fileDescriptor_4fcd5f81b5b003d0 = []byte{
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xbb, 0x6e, 0xc2, 0x30,
+	0x14, 0x86, 0xe3, 0x70, 0x37, 0x17, 0xa9, 0x56, 0x55, 0x59, 0xa8, 0xb2, 0x22, 0x86, 0x2a, 0x4b,
+	0x61, 0xe8, 0x13, 0x80, 0x8a, 0x04, 0x42, 0xea, 0x10, 0x75, 0xea, 0x16, 0x62, 0x03, 0x51, 0x69,
+	0x8c, 0x92, 0x50, 0xc1, 0xd6, 0x47, 0xe8, 0x63, 0xf4, 0x39, 0x3a, 0x75, 0x64, 0x64, 0x2c, 0x66,
+	0xe9, 0xc8, 0x23, 0x54, 0x3e, 0xe1, 0x12, 0xd1, 0xa1, 0x53, 0xfc, 0x7f, 0xbf, 0xce, 0xf9, 0x4f,
+	0x8e, 0x8d, 0x2f, 0xc4, 0xab, 0x08, 0xe2, 0xa8, 0x1f, 0x70, 0xb1, 0x68, 0xce, 0x42, 0x19, 0x4b,
+	0x92, 0x83, 0x4f, 0xfd, 0x76, 0xec, 0xc7, 0x93, 0xf9, 0xb0, 0xe9, 0xc9, 0x97, 0xd6, 0x58, 0x8e,
+	0x65, 0x0b, 0xf0, 0x70, 0x3e, 0x02, 0x05, 0x02, 0x4e, 0x49, 0x55, 0xe3, 0xd3, 0xc4, 0x15, 0xe8,
+	0x22, 0x78, 0x57, 0xb7, 0x24, 0x14, 0x17, 0xda, 0x9c, 0x87, 0x22, 0x8a, 0x28, 0xb2, 0x90, 0x5d,
+	0x71, 0x0e, 0x92, 0x30, 0x8c, 0xfb, 0x5c, 0x04, 0xb1, 0x3f, 0xf2, 0x45, 0x48, 0x4d, 0x30, 0x53,
+	0x84, 0x5c, 0xe1, 0xfc, 0xa3, 0x9c, 0xf9, 0x5e, 0x44, 0x33, 0x56, 0xc6, 0xae, 0x38, 0x7b, 0x45,
+	0x08, 0xce, 0xde, 0xbb, 0xb1, 0x4b, 0xb3, 0x50, 0x01, 0x67, 0x72, 0x83, 0x6b, 0x6d, 0xce, 0xfd,
+	0xd8, 0x97, 0x81, 0x3b, 0x05, 0x37, 0x07, 0x35, 0x67, 0x14, 0x7a, 0x2e, 0x7a, 0x6e, 0x34, 0xa1,
+	0x79, 0xa8, 0xde, 0x2b, 0x3d, 0x0b, 0x8c, 0x0b, 0xa3, 0xd3, 0x82, 0x85, 0xec, 0xaa, 0x93, 0x22,
+	0xe4, 0x12, 0xe7, 0xba, 0x33, 0xe9, 0x4d, 0x68, 0x11, 0xac, 0x44, 0x68, 0xea, 0xc8, 0x79, 0xc0,
+	0x69, 0xc9, 0x42, 0x76, 0xd6, 0x49, 0x04, 0xb1, 0x70, 0xb9, 0x27, 0x5c, 0x2e, 0xc2, 0x07, 0x19,
+	0x78, 0x82, 0x62, 0xf0, 0xd2, 0x48, 0xa7, 0x25, 0x12, 0x26, 0x29, 0x27, 0x7f, 0x7e, 0x22, 0x8d,
+	0x00, 0xd7, 0x20, 0xdb, 0x11, 0x23, 0x11, 0x0a, 0x5d, 0x51, 0xc7, 0x45, 0x20, 0x03, 0xb1, 0xdc,
+	0xaf, 0xf1, 0xa8, 0xcf, 0xf3, 0xcc, 0xff, 0xf2, 0x32, 0x7f, 0xf2, 0x06, 0xb8, 0x0a, 0xdd, 0xa2,
+	0xce, 0xb2, 0x33, 0x95, 0xde, 0x33, 0xb9, 0xc6, 0xa5, 0x43, 0x7b, 0x7d, 0x6d, 0x7a, 0x93, 0x27,
+	0xa0, 0x5d, 0xd8, 0x0a, 0xb8, 0x66, 0xe2, 0x1e, 0x41, 0xa7, 0xbb, 0xda, 0x30, 0x63, 0xbd, 0x61,
+	0xc6, 0x6e, 0xc3, 0xd0, 0x9b, 0x62, 0xe8, 0x43, 0x31, 0xf4, 0xa5, 0x18, 0x5a, 0x29, 0x86, 0xd6,
+	0x8a, 0xa1, 0x6f, 0xc5, 0xd0, 0x8f, 0x62, 0xc6, 0x4e, 0x31, 0xf4, 0xbe, 0x65, 0xc6, 0x6a, 0xcb,
+	0x8c, 0xf5, 0x96, 0x19, 0x4f, 0xe5, 0xd4, 0x23, 0x1c, 0xe6, 0xe1, 0x3d, 0xdd, 0xfd, 0x0e, 0x00,
+	0xcf, 0x15, 0x01, 0x06, 0x9a, 0x02, 0x00, 0x00,
+}
+
+func (this *IndexedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IndexedEvent)
+	if !ok {
+		that2, ok := that.(IndexedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !bytes.Equal(this.Identifier, that1.Identifier) {
+		return false
+	}
+	if len(this.Topics) != len(that1.Topics) {
+		return false
+	}
+	for i := range this.Topics {
+		if !bytes.Equal(this.Topics[i], that1.Topics[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if len(this.AdditionalData) != len(that1.AdditionalData) {
+		return false
+	}
+	for i := range this.AdditionalData {
+		if !bytes.Equal(this.AdditionalData[i], that1.AdditionalData[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if this.EventIndex != that1.EventIndex {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.HeaderNonce != that1.HeaderNonce {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	return true
+}
+func (this *EventReference) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EventReference)
+	if !ok {
+		that2, ok := that.(EventReference)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.EventKey, that1.EventKey) {
+		return false
+	}
+	if this.HeaderNonce != that1.HeaderNonce {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	return true
+}
+func (this *EventsByBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EventsByBlock)
+	if !ok {
+		that2, ok := that.(EventsByBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.EventKeys) != len(that1.EventKeys) {
+		return false
+	}
+	for i := range this.EventKeys {
+		if !bytes.Equal(this.EventKeys[i], that1.EventKeys[i]) {
+			return false
+		}
+	}
+	if len(this.IndexKeys) != len(that1.IndexKeys) {
+		return false
+	}
+	for i := range this.IndexKeys {
+		if !bytes.Equal(this.IndexKeys[i], that1.IndexKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *IndexedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&eventsIndex.IndexedEvent{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Identifier: "+fmt.Sprintf("%#v", this.Identifier)+",\n")
+	s = append(s, "Topics: "+fmt.Sprintf("%#v", this.Topics)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "AdditionalData: "+fmt.Sprintf("%#v", this.AdditionalData)+",\n")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "EventIndex: "+fmt.Sprintf("%#v", this.EventIndex)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "HeaderNonce: "+fmt.Sprintf("%#v", this.HeaderNonce)+",\n")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EventReference) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&eventsIndex.EventReference{")
+	s = append(s, "EventKey: "+fmt.Sprintf("%#v", this.EventKey)+",\n")
+	s = append(s, "HeaderNonce: "+fmt.Sprintf("%#v", this.HeaderNonce)+",\n")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EventsByBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&eventsIndex.EventsByBlock{")
+	s = append(s, "EventKeys: "+fmt.Sprintf("%#v", this.EventKeys)+",\n")
+	s = append(s, "IndexKeys: "+fmt.Sprintf("%#v", this.IndexKeys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringEventsIndex(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *IndexedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.HeaderNonce != 0 {
+		i = encodeVarintEventsIndex(dAtA, i, uint64(m.HeaderNonce))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Round != 0 {
+		i = encodeVarintEventsIndex(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintEventsIndex(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EventIndex != 0 {
+		i = encodeVarintEventsIndex(dAtA, i, uint64(m.EventIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AdditionalData) > 0 {
+		for iNdEx := len(m.AdditionalData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdditionalData[iNdEx])
+			copy(dAtA[i:], m.AdditionalData[iNdEx])
+			i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.AdditionalData[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HeaderNonce != 0 {
+		i = encodeVarintEventsIndex(dAtA, i, uint64(m.HeaderNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EventKey) > 0 {
+		i -= len(m.EventKey)
+		copy(dAtA[i:], m.EventKey)
+		i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.EventKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventsByBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventsByBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventsByBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IndexKeys) > 0 {
+		for iNdEx := len(m.IndexKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IndexKeys[iNdEx])
+			copy(dAtA[i:], m.IndexKeys[iNdEx])
+			i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.IndexKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.EventKeys) > 0 {
+		for iNdEx := len(m.EventKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EventKeys[iNdEx])
+			copy(dAtA[i:], m.EventKeys[iNdEx])
+			i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.EventKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEventsIndex(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEventsIndex(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IndexedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEventsIndex(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEventsIndex(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, b := range m.Topics {
+			l = len(b)
+			n += 1 + l + sovEventsIndex(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovEventsIndex(uint64(l))
+	}
+	if len(m.AdditionalData) > 0 {
+		for _, b := range m.AdditionalData {
+			l = len(b)
+			n += 1 + l + sovEventsIndex(uint64(l))
+		}
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEventsIndex(uint64(l))
+	}
+	if m.EventIndex != 0 {
+		n += 1 + sovEventsIndex(uint64(m.EventIndex))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovEventsIndex(uint64(m.Epoch))
+	}
+	if m.Round != 0 {
+		n += 1 + sovEventsIndex(uint64(m.Round))
+	}
+	if m.HeaderNonce != 0 {
+		n += 1 + sovEventsIndex(uint64(m.HeaderNonce))
+	}
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovEventsIndex(uint64(l))
+	}
+	return n
+}
+
+func (m *EventReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventKey)
+	if l > 0 {
+		n += 1 + l + sovEventsIndex(uint64(l))
+	}
+	if m.HeaderNonce != 0 {
+		n += 1 + sovEventsIndex(uint64(m.HeaderNonce))
+	}
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovEventsIndex(uint64(l))
+	}
+	return n
+}
+
+func (m *EventsByBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EventKeys) > 0 {
+		for _, b := range m.EventKeys {
+			l = len(b)
+			n += 1 + l + sovEventsIndex(uint64(l))
+		}
+	}
+	if len(m.IndexKeys) > 0 {
+		for _, b := range m.IndexKeys {
+			l = len(b)
+			n += 1 + l + sovEventsIndex(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEventsIndex(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEventsIndex(x uint64) (n int) {
+	return sovEventsIndex(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *IndexedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IndexedEvent{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Identifier:` + fmt.Sprintf("%v", this.Identifier) + `,`,
+		`Topics:` + fmt.Sprintf("%v", this.Topics) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`AdditionalData:` + fmt.Sprintf("%v", this.AdditionalData) + `,`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`EventIndex:` + fmt.Sprintf("%v", this.EventIndex) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`HeaderNonce:` + fmt.Sprintf("%v", this.HeaderNonce) + `,`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventReference{`,
+		`EventKey:` + fmt.Sprintf("%v", this.EventKey) + `,`,
+		`HeaderNonce:` + fmt.Sprintf("%v", this.HeaderNonce) + `,`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventsByBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventsByBlock{`,
+		`EventKeys:` + fmt.Sprintf("%v", this.EventKeys) + `,`,
+		`IndexKeys:` + fmt.Sprintf("%v", this.IndexKeys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEventsIndex(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *IndexedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventsIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = append(m.Identifier[:0], dAtA[iNdEx:postIndex]...)
+			if m.Identifier == nil {
+				m.Identifier = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, make([]byte, postIndex-iNdEx))
+			copy(m.Topics[len(m.Topics)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalData = append(m.AdditionalData, make([]byte, postIndex-iNdEx))
+			copy(m.AdditionalData[len(m.AdditionalData)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventIndex", wireType)
+			}
+			m.EventIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderNonce", wireType)
+			}
+			m.HeaderNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventsIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventsIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventKey = append(m.EventKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EventKey == nil {
+				m.EventKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderNonce", wireType)
+			}
+			m.HeaderNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventsIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventsByBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventsIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventsByBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventsByBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventKeys = append(m.EventKeys, make([]byte, postIndex-iNdEx))
+			copy(m.EventKeys[len(m.EventKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexKeys = append(m.IndexKeys, make([]byte, postIndex-iNdEx))
+			copy(m.IndexKeys[len(m.IndexKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventsIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEventsIndex(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEventsIndex
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEventsIndex
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEventsIndex
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEventsIndex
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEventsIndex        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEventsIndex          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEventsIndex = fmt.Errorf("proto: unexpected end of group")
+)
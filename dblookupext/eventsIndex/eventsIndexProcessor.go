@@ -0,0 +1,552 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. eventsIndex.proto
+
+package eventsIndex
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("dblookupext/eventsIndex")
+
+const (
+	eventKeyPrefix      = "v_"
+	numEntriesKeyPrefix = "n_"
+	entryKeyPrefix      = "e_"
+	blockKeyPrefix      = "b_"
+
+	addressIndexPrefix    = "a"
+	identifierIndexPrefix = "i"
+	firstTopicIndexPrefix = "t"
+
+	// maxScannedReferences bounds the work done by a single query, when the filter matches only a few of the
+	// events of the chosen index. The remaining references can be scanned using the returned cursor.
+	maxScannedReferences = 10000
+)
+
+type additionalDataHolder interface {
+	GetAdditionalData() [][]byte
+}
+
+// ArgsEventsIndexProcessor holds the arguments needed to create a new events index processor
+type ArgsEventsIndexProcessor struct {
+	Marshalizer              marshal.Marshalizer
+	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	EventsIndexStorer        storage.Storer
+}
+
+// EventsFilter holds the criteria of an events query
+type EventsFilter struct {
+	Address    []byte
+	Identifier []byte
+	// Topics are matched by position, an empty topic matching any value
+	Topics    [][]byte
+	FromNonce uint64
+	// ToNonce is the nonce of the last block to be included, 0 meaning no upper limit
+	ToNonce    uint64
+	Cursor     uint64
+	MaxEntries int
+}
+
+// EventsPage holds a page of the events matching a filter, in the order in which they were emitted
+type EventsPage struct {
+	Events []*IndexedEvent
+	// NextCursor is the cursor of the next page, 0 if there are no more events
+	NextCursor uint64
+}
+
+type eventsIndexProcessor struct {
+	marshalizer              marshal.Marshalizer
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	storer                   storage.Storer
+	mutex                    sync.RWMutex
+}
+
+// NewEventsIndexProcessor will create a new instance of the events index processor, which indexes the events
+// emitted in the current shard by their address, identifier and first topic
+func NewEventsIndexProcessor(args ArgsEventsIndexProcessor) (*eventsIndexProcessor, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return nil, process.ErrNilUint64Converter
+	}
+	if check.IfNil(args.EventsIndexStorer) {
+		return nil, core.ErrNilStore
+	}
+
+	return &eventsIndexProcessor{
+		marshalizer:              args.Marshalizer,
+		uint64ByteSliceConverter: args.Uint64ByteSliceConverter,
+		storer:                   args.EventsIndexStorer,
+	}, nil
+}
+
+// RecordBlock saves the events of the provided logs and appends them to the lists of their address, identifier
+// and first topic. A block is recorded only once.
+func (eip *eventsIndexProcessor) RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, logs []*data.LogData) error {
+	eip.mutex.Lock()
+	defer eip.mutex.Unlock()
+
+	if eip.storer.Has(blockKey(blockHeaderHash)) == nil {
+		log.Debug("eventsIndexProcessor.RecordBlock: block already recorded", "blockHeaderHash", blockHeaderHash)
+		return nil
+	}
+
+	events := eip.extractEvents(blockHeaderHash, blockHeader, logs)
+	if len(events) == 0 {
+		return nil
+	}
+
+	indexKeys, referencesByIndexKey := eip.groupReferencesByIndexKey(events)
+
+	// the block contents are saved first, so that a partially recorded block can still be reverted
+	err := eip.saveEventsByBlock(blockHeaderHash, events, indexKeys)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		err = eip.saveEvent(event)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, indexKey := range indexKeys {
+		err = eip.appendReferences([]byte(indexKey), referencesByIndexKey[indexKey])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (eip *eventsIndexProcessor) extractEvents(blockHeaderHash []byte, blockHeader data.HeaderHandler, logs []*data.LogData) []*IndexedEvent {
+	events := make([]*IndexedEvent, 0)
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		for eventIndex, event := range logData.GetLogEvents() {
+			if check.IfNil(event) {
+				continue
+			}
+
+			indexedEvent := &IndexedEvent{
+				Address:     event.GetAddress(),
+				Identifier:  event.GetIdentifier(),
+				Topics:      event.GetTopics(),
+				Data:        event.GetData(),
+				TxHash:      []byte(logData.TxHash),
+				EventIndex:  uint32(eventIndex),
+				Epoch:       blockHeader.GetEpoch(),
+				Round:       blockHeader.GetRound(),
+				HeaderNonce: blockHeader.GetNonce(),
+				HeaderHash:  blockHeaderHash,
+			}
+			eventWithAdditionalData, ok := event.(additionalDataHolder)
+			if ok {
+				indexedEvent.AdditionalData = eventWithAdditionalData.GetAdditionalData()
+			}
+
+			events = append(events, indexedEvent)
+		}
+	}
+
+	return events
+}
+
+func (eip *eventsIndexProcessor) groupReferencesByIndexKey(events []*IndexedEvent) ([]string, map[string][]*EventReference) {
+	indexKeys := make([]string, 0)
+	referencesByIndexKey := make(map[string][]*EventReference)
+
+	for _, event := range events {
+		reference := &EventReference{
+			EventKey:    eip.eventKey(event.TxHash, event.EventIndex),
+			HeaderNonce: event.HeaderNonce,
+			HeaderHash:  event.HeaderHash,
+		}
+
+		for _, indexKey := range indexKeysOfEvent(event) {
+			_, exists := referencesByIndexKey[indexKey]
+			if !exists {
+				indexKeys = append(indexKeys, indexKey)
+			}
+			referencesByIndexKey[indexKey] = append(referencesByIndexKey[indexKey], reference)
+		}
+	}
+
+	return indexKeys, referencesByIndexKey
+}
+
+func indexKeysOfEvent(event *IndexedEvent) []string {
+	indexKeys := make([]string, 0, 3)
+	if len(event.Address) > 0 {
+		indexKeys = append(indexKeys, addressIndexPrefix+string(event.Address))
+	}
+	if len(event.Identifier) > 0 {
+		indexKeys = append(indexKeys, identifierIndexPrefix+string(event.Identifier))
+	}
+	if len(event.Topics) > 0 && len(event.Topics[0]) > 0 {
+		indexKeys = append(indexKeys, firstTopicIndexPrefix+string(event.Topics[0]))
+	}
+
+	return indexKeys
+}
+
+func (eip *eventsIndexProcessor) saveEventsByBlock(blockHeaderHash []byte, events []*IndexedEvent, indexKeys []string) error {
+	eventsByBlock := &EventsByBlock{
+		EventKeys: make([][]byte, 0, len(events)),
+		IndexKeys: make([][]byte, 0, len(indexKeys)),
+	}
+	for _, event := range events {
+		eventsByBlock.EventKeys = append(eventsByBlock.EventKeys, eip.eventKey(event.TxHash, event.EventIndex))
+	}
+	for _, indexKey := range indexKeys {
+		eventsByBlock.IndexKeys = append(eventsByBlock.IndexKeys, []byte(indexKey))
+	}
+
+	eventsByBlockBytes, err := eip.marshalizer.Marshal(eventsByBlock)
+	if err != nil {
+		return err
+	}
+
+	return eip.storer.Put(blockKey(blockHeaderHash), eventsByBlockBytes)
+}
+
+func (eip *eventsIndexProcessor) saveEvent(event *IndexedEvent) error {
+	eventBytes, err := eip.marshalizer.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return eip.storer.Put(eip.eventKey(event.TxHash, event.EventIndex), eventBytes)
+}
+
+func (eip *eventsIndexProcessor) appendReferences(indexKey []byte, references []*EventReference) error {
+	numEntries, err := eip.getNumEntries(indexKey)
+	if err != nil {
+		return err
+	}
+
+	for _, reference := range references {
+		referenceBytes, errMarshal := eip.marshalizer.Marshal(reference)
+		if errMarshal != nil {
+			return errMarshal
+		}
+
+		err = eip.storer.Put(eip.entryKey(indexKey, numEntries), referenceBytes)
+		if err != nil {
+			return err
+		}
+
+		numEntries++
+	}
+
+	return eip.saveNumEntries(indexKey, numEntries)
+}
+
+// RevertBlock removes the events added by the provided block. Since blocks are reverted starting with the
+// most recent one, the references to the events of the block are the last ones in each touched list.
+func (eip *eventsIndexProcessor) RevertBlock(blockHeaderHash []byte) error {
+	eip.mutex.Lock()
+	defer eip.mutex.Unlock()
+
+	eventsByBlockBytes, err := eip.storer.Get(blockKey(blockHeaderHash))
+	if storage.IsNotFoundInStorageErr(err) {
+		// nothing recorded for this block
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	eventsByBlock := &EventsByBlock{}
+	err = eip.marshalizer.Unmarshal(eventsByBlock, eventsByBlockBytes)
+	if err != nil {
+		return err
+	}
+
+	for _, indexKey := range eventsByBlock.IndexKeys {
+		err = eip.removeReferencesOfBlock(indexKey, blockHeaderHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, eventKey := range eventsByBlock.EventKeys {
+		err = eip.storer.Remove(eventKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	return eip.storer.Remove(blockKey(blockHeaderHash))
+}
+
+func (eip *eventsIndexProcessor) removeReferencesOfBlock(indexKey []byte, blockHeaderHash []byte) error {
+	numEntries, err := eip.getNumEntries(indexKey)
+	if err != nil {
+		return err
+	}
+
+	initialNumEntries := numEntries
+	for numEntries > 0 {
+		reference, errGet := eip.getReference(indexKey, numEntries-1)
+		if errGet != nil {
+			return errGet
+		}
+		if !bytes.Equal(reference.HeaderHash, blockHeaderHash) {
+			break
+		}
+
+		err = eip.storer.Remove(eip.entryKey(indexKey, numEntries-1))
+		if err != nil {
+			return err
+		}
+
+		numEntries--
+	}
+
+	if numEntries == initialNumEntries {
+		return nil
+	}
+
+	return eip.saveNumEntries(indexKey, numEntries)
+}
+
+// GetEvents returns at most filter.MaxEntries events matching the filter, in the order in which they were emitted.
+// The cursor is the one returned along with the previous page, while 0 means starting with the first block of the filter.
+// A page might hold fewer events than requested, even if more events match the filter, so the iteration should
+// continue as long as a cursor is returned.
+func (eip *eventsIndexProcessor) GetEvents(filter *EventsFilter) (*EventsPage, error) {
+	err := checkFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	eip.mutex.RLock()
+	defer eip.mutex.RUnlock()
+
+	indexKey := chooseIndexKey(filter)
+	numEntries, err := eip.getNumEntries(indexKey)
+	if err != nil {
+		return nil, err
+	}
+
+	index := filter.Cursor
+	if index == 0 {
+		index, err = eip.searchFirstReferenceFromNonce(indexKey, numEntries, filter.FromNonce)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	events := make([]*IndexedEvent, 0)
+	numScanned := 0
+	for ; index < numEntries; index++ {
+		if len(events) == filter.MaxEntries || numScanned == maxScannedReferences {
+			return &EventsPage{
+				Events:     events,
+				NextCursor: index,
+			}, nil
+		}
+		numScanned++
+
+		reference, errGet := eip.getReference(indexKey, index)
+		if errGet != nil {
+			return nil, errGet
+		}
+		if filter.ToNonce > 0 && reference.HeaderNonce > filter.ToNonce {
+			break
+		}
+		if reference.HeaderNonce < filter.FromNonce {
+			continue
+		}
+
+		event, errGet := eip.getEvent(reference.EventKey)
+		if errGet != nil {
+			return nil, errGet
+		}
+		if !eventMatchesFilter(event, filter) {
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	return &EventsPage{
+		Events: events,
+	}, nil
+}
+
+func checkFilter(filter *EventsFilter) error {
+	if filter == nil {
+		return ErrNilEventsFilter
+	}
+	if filter.MaxEntries < 1 {
+		return ErrInvalidMaxEntries
+	}
+	if filter.ToNonce > 0 && filter.ToNonce < filter.FromNonce {
+		return ErrInvalidBlockRange
+	}
+	if len(filter.Address) == 0 && len(filter.Identifier) == 0 && (len(filter.Topics) == 0 || len(filter.Topics[0]) == 0) {
+		return ErrEmptyEventsFilter
+	}
+
+	return nil
+}
+
+// chooseIndexKey returns the key of the list of events to be scanned. The events of an address are the most
+// selective ones, followed by the events sharing a first topic (usually a token identifier or an address).
+func chooseIndexKey(filter *EventsFilter) []byte {
+	if len(filter.Address) > 0 {
+		return append([]byte(addressIndexPrefix), filter.Address...)
+	}
+	if len(filter.Topics) > 0 && len(filter.Topics[0]) > 0 {
+		return append([]byte(firstTopicIndexPrefix), filter.Topics[0]...)
+	}
+
+	return append([]byte(identifierIndexPrefix), filter.Identifier...)
+}
+
+func eventMatchesFilter(event *IndexedEvent, filter *EventsFilter) bool {
+	if len(filter.Address) > 0 && !bytes.Equal(event.Address, filter.Address) {
+		return false
+	}
+	if len(filter.Identifier) > 0 && !bytes.Equal(event.Identifier, filter.Identifier) {
+		return false
+	}
+
+	for i, topic := range filter.Topics {
+		if len(topic) == 0 {
+			continue
+		}
+		if i >= len(event.Topics) || !bytes.Equal(event.Topics[i], topic) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// searchFirstReferenceFromNonce returns the index of the first reference of a block with a nonce higher or equal
+// to the provided one. The references are sorted by block nonce, as the blocks are recorded in order.
+func (eip *eventsIndexProcessor) searchFirstReferenceFromNonce(indexKey []byte, numEntries uint64, nonce uint64) (uint64, error) {
+	if nonce == 0 || numEntries == 0 {
+		return 0, nil
+	}
+
+	var searchErr error
+	index := sort.Search(int(numEntries), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+
+		reference, err := eip.getReference(indexKey, uint64(i))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+
+		return reference.HeaderNonce >= nonce
+	})
+	if searchErr != nil {
+		return 0, searchErr
+	}
+
+	return uint64(index), nil
+}
+
+func (eip *eventsIndexProcessor) getNumEntries(indexKey []byte) (uint64, error) {
+	numEntriesBytes, err := eip.storer.Get(numEntriesKey(indexKey))
+	if storage.IsNotFoundInStorageErr(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return eip.uint64ByteSliceConverter.ToUint64(numEntriesBytes)
+}
+
+func (eip *eventsIndexProcessor) saveNumEntries(indexKey []byte, numEntries uint64) error {
+	if numEntries == 0 {
+		return eip.storer.Remove(numEntriesKey(indexKey))
+	}
+
+	return eip.storer.Put(numEntriesKey(indexKey), eip.uint64ByteSliceConverter.ToByteSlice(numEntries))
+}
+
+func (eip *eventsIndexProcessor) getReference(indexKey []byte, index uint64) (*EventReference, error) {
+	referenceBytes, err := eip.storer.Get(eip.entryKey(indexKey, index))
+	if err != nil {
+		return nil, err
+	}
+
+	reference := &EventReference{}
+	err = eip.marshalizer.Unmarshal(reference, referenceBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return reference, nil
+}
+
+func (eip *eventsIndexProcessor) getEvent(eventKey []byte) (*IndexedEvent, error) {
+	eventBytes, err := eip.storer.Get(eventKey)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &IndexedEvent{}
+	err = eip.marshalizer.Unmarshal(event, eventBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (eip *eventsIndexProcessor) eventKey(txHash []byte, eventIndex uint32) []byte {
+	key := make([]byte, 0, len(eventKeyPrefix)+len(txHash)+8)
+	key = append(key, eventKeyPrefix...)
+	key = append(key, txHash...)
+
+	return append(key, eip.uint64ByteSliceConverter.ToByteSlice(uint64(eventIndex))...)
+}
+
+// entryKey places the index before the index key, as the index keys have variable lengths
+func (eip *eventsIndexProcessor) entryKey(indexKey []byte, index uint64) []byte {
+	key := make([]byte, 0, len(entryKeyPrefix)+8+len(indexKey))
+	key = append(key, entryKeyPrefix...)
+	key = append(key, eip.uint64ByteSliceConverter.ToByteSlice(index)...)
+
+	return append(key, indexKey...)
+}
+
+func numEntriesKey(indexKey []byte) []byte {
+	return append([]byte(numEntriesKeyPrefix), indexKey...)
+}
+
+func blockKey(blockHeaderHash []byte) []byte {
+	return append([]byte(blockKeyPrefix), blockHeaderHash...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eip *eventsIndexProcessor) IsInterfaceNil() bool {
+	return eip == nil
+}
@@ -0,0 +1,272 @@
+package eventsIndex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsEventsIndexProcessor() ArgsEventsIndexProcessor {
+	return ArgsEventsIndexProcessor{
+		Marshalizer:              &marshal.GogoProtoMarshalizer{},
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+		EventsIndexStorer:        testscommon.CreateMemUnit(),
+	}
+}
+
+func createLogData(txHash string, events ...*transaction.Event) *data.LogData {
+	return &data.LogData{
+		LogHandler: &transaction.Log{
+			Address: []byte("sender"),
+			Events:  events,
+		},
+		TxHash: txHash,
+	}
+}
+
+func createEvent(address string, identifier string, topics ...string) *transaction.Event {
+	event := &transaction.Event{
+		Address:    []byte(address),
+		Identifier: []byte(identifier),
+		Data:       []byte("data"),
+	}
+	for _, topic := range topics {
+		event.Topics = append(event.Topics, []byte(topic))
+	}
+
+	return event
+}
+
+func recordBlock(t *testing.T, eip *eventsIndexProcessor, blockHeaderHash string, nonce uint64, logs ...*data.LogData) {
+	err := eip.RecordBlock([]byte(blockHeaderHash), &block.Header{Nonce: nonce, Epoch: 1, Round: nonce + 1}, logs)
+	require.Nil(t, err)
+}
+
+func requireEvents(t *testing.T, eip *eventsIndexProcessor, filter *EventsFilter, expectedEvents ...string) {
+	filter.MaxEntries = 100
+	page, err := eip.GetEvents(filter)
+	require.Nil(t, err)
+	require.Zero(t, page.NextCursor)
+
+	var events []string
+	for _, event := range page.Events {
+		events = append(events, fmt.Sprintf("%s/%d", event.TxHash, event.EventIndex))
+	}
+	require.Equal(t, expectedEvents, events)
+}
+
+func TestNewEventsIndexProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsIndexProcessor()
+		args.Marshalizer = nil
+		eip, err := NewEventsIndexProcessor(args)
+		assert.Nil(t, eip)
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsIndexProcessor()
+		args.Uint64ByteSliceConverter = nil
+		eip, err := NewEventsIndexProcessor(args)
+		assert.Nil(t, eip)
+		assert.Equal(t, process.ErrNilUint64Converter, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsIndexProcessor()
+		args.EventsIndexStorer = nil
+		eip, err := NewEventsIndexProcessor(args)
+		assert.Nil(t, eip)
+		assert.Equal(t, core.ErrNilStore, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		eip, err := NewEventsIndexProcessor(createMockArgsEventsIndexProcessor())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(eip))
+	})
+}
+
+func TestEventsIndexProcessor_RecordBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should index the events by address, identifier and first topic", func(t *testing.T) {
+		t.Parallel()
+
+		eip, _ := NewEventsIndexProcessor(createMockArgsEventsIndexProcessor())
+		recordBlock(t, eip, "h1", 1,
+			createLogData("tx1",
+				createEvent("pair", "swap", "WEGLD", "alice"),
+				createEvent("bridge", "deposit", "alice"),
+			),
+			nil,
+			createLogData("tx2", createEvent("pair", "addLiquidity")),
+		)
+
+		requireEvents(t, eip, &EventsFilter{Address: []byte("pair")}, "tx1/0", "tx2/0")
+		requireEvents(t, eip, &EventsFilter{Identifier: []byte("deposit")}, "tx1/1")
+		requireEvents(t, eip, &EventsFilter{Topics: [][]byte{[]byte("alice")}}, "tx1/1")
+		requireEvents(t, eip, &EventsFilter{Identifier: []byte("swap"), Topics: [][]byte{nil, []byte("alice")}}, "tx1/0")
+
+		page, err := eip.GetEvents(&EventsFilter{Identifier: []byte("swap"), MaxEntries: 1})
+		require.Nil(t, err)
+		require.Len(t, page.Events, 1)
+		event := page.Events[0]
+		assert.Equal(t, []byte("pair"), event.Address)
+		assert.Equal(t, [][]byte{[]byte("WEGLD"), []byte("alice")}, event.Topics)
+		assert.Equal(t, []byte("data"), event.Data)
+		assert.Equal(t, []byte("h1"), event.HeaderHash)
+		assert.Equal(t, uint64(1), event.HeaderNonce)
+		assert.Equal(t, uint64(2), event.Round)
+		assert.Equal(t, uint32(1), event.Epoch)
+	})
+	t.Run("block already recorded should not index the events twice", func(t *testing.T) {
+		t.Parallel()
+
+		eip, _ := NewEventsIndexProcessor(createMockArgsEventsIndexProcessor())
+		logs := createLogData("tx1", createEvent("pair", "swap"))
+		recordBlock(t, eip, "h1", 1, logs)
+		recordBlock(t, eip, "h1", 1, logs)
+
+		requireEvents(t, eip, &EventsFilter{Address: []byte("pair")}, "tx1/0")
+	})
+	t.Run("block without events should not be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsIndexProcessor()
+		eip, _ := NewEventsIndexProcessor(args)
+		recordBlock(t, eip, "h1", 1, createLogData("tx1"))
+
+		assert.NotNil(t, args.EventsIndexStorer.Has(blockKey([]byte("h1"))))
+	})
+}
+
+func TestEventsIndexProcessor_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsIndexProcessor()
+	eip, _ := NewEventsIndexProcessor(args)
+	recordBlock(t, eip, "h1", 1, createLogData("tx1", createEvent("pair", "swap", "WEGLD")))
+	recordBlock(t, eip, "h2", 2,
+		createLogData("tx2", createEvent("pair", "swap", "WEGLD")),
+		createLogData("tx3", createEvent("router", "createPair", "WEGLD")),
+	)
+
+	err := eip.RevertBlock([]byte("h2"))
+	require.Nil(t, err)
+
+	requireEvents(t, eip, &EventsFilter{Address: []byte("pair")}, "tx1/0")
+	requireEvents(t, eip, &EventsFilter{Topics: [][]byte{[]byte("WEGLD")}}, "tx1/0")
+	requireEvents(t, eip, &EventsFilter{Address: []byte("router")})
+	assert.NotNil(t, args.EventsIndexStorer.Has(blockKey([]byte("h2"))))
+
+	// a block not recorded is ignored
+	err = eip.RevertBlock([]byte("h3"))
+	require.Nil(t, err)
+
+	// the reverted block can be recorded again
+	recordBlock(t, eip, "h2", 2, createLogData("tx4", createEvent("pair", "swap", "WEGLD")))
+	requireEvents(t, eip, &EventsFilter{Address: []byte("pair")}, "tx1/0", "tx4/0")
+}
+
+func TestEventsIndexProcessor_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid filters should error", func(t *testing.T) {
+		t.Parallel()
+
+		eip, _ := NewEventsIndexProcessor(createMockArgsEventsIndexProcessor())
+
+		page, err := eip.GetEvents(nil)
+		assert.Nil(t, page)
+		assert.Equal(t, ErrNilEventsFilter, err)
+
+		page, err = eip.GetEvents(&EventsFilter{Address: []byte("pair")})
+		assert.Nil(t, page)
+		assert.Equal(t, ErrInvalidMaxEntries, err)
+
+		page, err = eip.GetEvents(&EventsFilter{Address: []byte("pair"), FromNonce: 5, ToNonce: 4, MaxEntries: 1})
+		assert.Nil(t, page)
+		assert.Equal(t, ErrInvalidBlockRange, err)
+
+		page, err = eip.GetEvents(&EventsFilter{Topics: [][]byte{nil, []byte("alice")}, MaxEntries: 1})
+		assert.Nil(t, page)
+		assert.Equal(t, ErrEmptyEventsFilter, err)
+	})
+	t.Run("should combine the criteria", func(t *testing.T) {
+		t.Parallel()
+
+		eip, _ := NewEventsIndexProcessor(createMockArgsEventsIndexProcessor())
+		recordBlock(t, eip, "h1", 1,
+			createLogData("tx1",
+				createEvent("pair", "swap", "WEGLD", "alice"),
+				createEvent("pair", "swap", "USDC", "bob"),
+				createEvent("pair", "sync", "WEGLD"),
+				createEvent("other", "swap", "WEGLD", "alice"),
+			),
+		)
+
+		requireEvents(t, eip, &EventsFilter{Address: []byte("pair"), Identifier: []byte("swap")}, "tx1/0", "tx1/1")
+		requireEvents(t, eip, &EventsFilter{Address: []byte("pair"), Topics: [][]byte{[]byte("WEGLD")}}, "tx1/0", "tx1/2")
+		requireEvents(t, eip, &EventsFilter{Identifier: []byte("swap"), Topics: [][]byte{nil, []byte("alice")}}, "tx1/0", "tx1/3")
+		requireEvents(t, eip, &EventsFilter{Topics: [][]byte{[]byte("WEGLD"), []byte("alice")}}, "tx1/0", "tx1/3")
+		requireEvents(t, eip, &EventsFilter{Address: []byte("pair"), Topics: [][]byte{nil, nil, []byte("carol")}})
+	})
+	t.Run("should apply the block range", func(t *testing.T) {
+		t.Parallel()
+
+		eip, _ := NewEventsIndexProcessor(createMockArgsEventsIndexProcessor())
+		for nonce := uint64(1); nonce <= 10; nonce++ {
+			txHash := fmt.Sprintf("tx%d", nonce)
+			recordBlock(t, eip, fmt.Sprintf("h%d", nonce), nonce, createLogData(txHash, createEvent("pair", "swap")))
+		}
+
+		requireEvents(t, eip, &EventsFilter{Address: []byte("pair"), FromNonce: 4, ToNonce: 6}, "tx4/0", "tx5/0", "tx6/0")
+		requireEvents(t, eip, &EventsFilter{Address: []byte("pair"), FromNonce: 9}, "tx9/0", "tx10/0")
+		requireEvents(t, eip, &EventsFilter{Address: []byte("pair"), ToNonce: 2}, "tx1/0", "tx2/0")
+		requireEvents(t, eip, &EventsFilter{Address: []byte("pair"), FromNonce: 11})
+	})
+	t.Run("should paginate using the cursor", func(t *testing.T) {
+		t.Parallel()
+
+		eip, _ := NewEventsIndexProcessor(createMockArgsEventsIndexProcessor())
+		for nonce := uint64(1); nonce <= 5; nonce++ {
+			txHash := fmt.Sprintf("tx%d", nonce)
+			recordBlock(t, eip, fmt.Sprintf("h%d", nonce), nonce, createLogData(txHash, createEvent("pair", "swap")))
+		}
+
+		filter := &EventsFilter{Address: []byte("pair"), FromNonce: 2, MaxEntries: 2}
+		page, err := eip.GetEvents(filter)
+		require.Nil(t, err)
+		require.Len(t, page.Events, 2)
+		assert.Equal(t, []byte("tx2"), page.Events[0].TxHash)
+		assert.Equal(t, []byte("tx3"), page.Events[1].TxHash)
+		require.NotZero(t, page.NextCursor)
+
+		filter.Cursor = page.NextCursor
+		page, err = eip.GetEvents(filter)
+		require.Nil(t, err)
+		require.Len(t, page.Events, 2)
+		assert.Equal(t, []byte("tx4"), page.Events[0].TxHash)
+		assert.Equal(t, []byte("tx5"), page.Events[1].TxHash)
+		assert.Zero(t, page.NextCursor)
+	})
+}
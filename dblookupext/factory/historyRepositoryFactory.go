@@ -9,9 +9,12 @@ import (
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressHistory"
 	"github.com/multiversx/mx-chain-go/dblookupext/disabled"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
 )
 
 // ArgsHistoryRepositoryFactory holds all dependencies required by the history processor factory in order to create
@@ -23,6 +26,7 @@ type ArgsHistoryRepositoryFactory struct {
 	Marshalizer              marshal.Marshalizer
 	Hasher                   hashing.Hasher
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator         sharding.Coordinator
 }
 
 type historyRepositoryFactory struct {
@@ -32,6 +36,7 @@ type historyRepositoryFactory struct {
 	marshalizer              marshal.Marshalizer
 	hasher                   hashing.Hasher
 	uInt64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	shardCoordinator         sharding.Coordinator
 }
 
 // NewHistoryRepositoryFactory creates an instance of historyRepositoryFactory
@@ -48,6 +53,9 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 	if check.IfNil(args.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
 
 	return &historyRepositoryFactory{
 		selfShardID:              args.SelfShardID,
@@ -56,6 +64,7 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 		marshalizer:              args.Marshalizer,
 		hasher:                   args.Hasher,
 		uInt64ByteSliceConverter: args.Uint64ByteSliceConverter,
+		shardCoordinator:         args.ShardCoordinator,
 	}, nil
 }
 
@@ -109,6 +118,16 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return nil, err
 	}
 
+	addressHistoryHandler, err := hpf.createAddressHistoryHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	eventsIndexHandler, err := hpf.createEventsIndexHandler()
+	if err != nil {
+		return nil, err
+	}
+
 	historyRepArgs := dblookupext.HistoryRepositoryArguments{
 		SelfShardID:                 hpf.selfShardID,
 		Hasher:                      hpf.hasher,
@@ -120,10 +139,65 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: miniblockHashByTxHashStorer,
 		EventsHashesByTxHashStorer:  resultsHashesByTxHashStorer,
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
+		AddressHistoryHandler:       addressHistoryHandler,
+		EventsIndexHandler:          eventsIndexHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
+func (hpf *historyRepositoryFactory) createAddressHistoryHandler() (dblookupext.AddressHistoryHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.AddressHistoryIndexEnabled {
+		return disabled.NewAddressHistoryHandler(), nil
+	}
+
+	addressHistoryStorer, err := hpf.store.GetStorer(dataRetriever.AddressHistoryUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionsStorer, err := hpf.store.GetStorer(dataRetriever.TransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	unsignedTransactionsStorer, err := hpf.store.GetStorer(dataRetriever.UnsignedTransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardTransactionsStorer, err := hpf.store.GetStorer(dataRetriever.RewardTransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	return addressHistory.NewAddressHistoryProcessor(addressHistory.ArgsAddressHistoryProcessor{
+		Marshalizer:                hpf.marshalizer,
+		Uint64ByteSliceConverter:   hpf.uInt64ByteSliceConverter,
+		ShardCoordinator:           hpf.shardCoordinator,
+		AddressHistoryStorer:       addressHistoryStorer,
+		TransactionsStorer:         transactionsStorer,
+		UnsignedTransactionsStorer: unsignedTransactionsStorer,
+		RewardTransactionsStorer:   rewardTransactionsStorer,
+	})
+}
+
+func (hpf *historyRepositoryFactory) createEventsIndexHandler() (dblookupext.EventsIndexHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.EventsIndexEnabled {
+		return disabled.NewEventsIndexHandler(), nil
+	}
+
+	eventsIndexStorer, err := hpf.store.GetStorer(dataRetriever.EventsIndexUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsIndex.NewEventsIndexProcessor(eventsIndex.ArgsEventsIndexProcessor{
+		Marshalizer:              hpf.marshalizer,
+		Uint64ByteSliceConverter: hpf.uInt64ByteSliceConverter,
+		EventsIndexStorer:        eventsIndexStorer,
+	})
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hpf *historyRepositoryFactory) IsInterfaceNil() bool {
 	return hpf == nil
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	processMock "github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/stretchr/testify/require"
@@ -46,6 +47,12 @@ func TestNewHistoryRepositoryFactory(t *testing.T) {
 	require.Equal(t, process.ErrNilUint64Converter, err)
 	require.Nil(t, hrf)
 
+	argsNilShardCoordinator := getArgs()
+	argsNilShardCoordinator.ShardCoordinator = nil
+	hrf, err = factory.NewHistoryRepositoryFactory(argsNilShardCoordinator)
+	require.Equal(t, process.ErrNilShardCoordinator, err)
+	require.Nil(t, hrf)
+
 	hrf, err = factory.NewHistoryRepositoryFactory(args)
 	require.NoError(t, err)
 	require.False(t, check.IfNil(hrf))
@@ -77,6 +84,46 @@ func TestHistoryRepositoryFactory_CreateShouldCreateRegularRepository(t *testing
 	require.True(t, repository.IsEnabled())
 }
 
+func TestHistoryRepositoryFactory_CreateShouldCreateRepositoryWithAddressHistory(t *testing.T) {
+	args := getArgs()
+	args.Config.Enabled = true
+	args.Config.AddressHistoryIndexEnabled = true
+	requestedUnits := make(map[dataRetriever.UnitType]struct{})
+	args.Store = &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			requestedUnits[unitType] = struct{}{}
+			return &storageStubs.StorerStub{}, nil
+		},
+	}
+
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+
+	repository, err := hrf.Create()
+	require.NoError(t, err)
+	require.True(t, repository.IsEnabled())
+	require.Contains(t, requestedUnits, dataRetriever.AddressHistoryUnit)
+}
+
+func TestHistoryRepositoryFactory_CreateShouldCreateRepositoryWithEventsIndex(t *testing.T) {
+	args := getArgs()
+	args.Config.Enabled = true
+	args.Config.EventsIndexEnabled = true
+	requestedUnits := make(map[dataRetriever.UnitType]struct{})
+	args.Store = &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			requestedUnits[unitType] = struct{}{}
+			return &storageStubs.StorerStub{}, nil
+		},
+	}
+
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+
+	repository, err := hrf.Create()
+	require.NoError(t, err)
+	require.True(t, repository.IsEnabled())
+	require.Contains(t, requestedUnits, dataRetriever.EventsIndexUnit)
+}
+
 func TestHistoryRepositoryFactory_CreateMissingStorersReturnsError(t *testing.T) {
 	t.Parallel()
 
@@ -87,6 +134,11 @@ func TestHistoryRepositoryFactory_CreateMissingStorersReturnsError(t *testing.T)
 	t.Run("missing EpochByHashUnit", testWithMissingStorer(dataRetriever.EpochByHashUnit))
 	t.Run("missing MiniblockHashByTxHashUnit", testWithMissingStorer(dataRetriever.MiniblockHashByTxHashUnit))
 	t.Run("missing ResultsHashesByTxHashUnit", testWithMissingStorer(dataRetriever.ResultsHashesByTxHashUnit))
+	t.Run("missing AddressHistoryUnit", testWithMissingStorer(dataRetriever.AddressHistoryUnit))
+	t.Run("missing TransactionUnit", testWithMissingStorer(dataRetriever.TransactionUnit))
+	t.Run("missing UnsignedTransactionUnit", testWithMissingStorer(dataRetriever.UnsignedTransactionUnit))
+	t.Run("missing RewardTransactionUnit", testWithMissingStorer(dataRetriever.RewardTransactionUnit))
+	t.Run("missing EventsIndexUnit", testWithMissingStorer(dataRetriever.EventsIndexUnit))
 }
 
 func testWithMissingStorer(missingUnit dataRetriever.UnitType) func(t *testing.T) {
@@ -95,6 +147,8 @@ func testWithMissingStorer(missingUnit dataRetriever.UnitType) func(t *testing.T
 
 		args := getArgs()
 		args.Config.Enabled = true
+		args.Config.AddressHistoryIndexEnabled = true
+		args.Config.EventsIndexEnabled = true
 		args.Store = &storageStubs.ChainStorerStub{
 			GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
 				if unitType == missingUnit {
@@ -120,5 +174,6 @@ func getArgs() *factory.ArgsHistoryRepositoryFactory {
 		Marshalizer:              &mock.MarshalizerMock{},
 		Hasher:                   &hashingMocks.HasherMock{},
 		Uint64ByteSliceConverter: &processMock.Uint64ByteSliceConverterMock{},
+		ShardCoordinator:         testscommon.NewMultiShardsCoordinatorMock(3),
 	}
 }
@@ -15,7 +15,9 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common/logging"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressHistory"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
@@ -38,6 +40,8 @@ type HistoryRepositoryArguments struct {
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
+	AddressHistoryHandler       AddressHistoryHandler
+	EventsIndexHandler          EventsIndexHandler
 }
 
 type historyRepository struct {
@@ -51,6 +55,8 @@ type historyRepository struct {
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
+	addressHistoryHandler      AddressHistoryHandler
+	eventsIndexHandler         EventsIndexHandler
 
 	// These maps temporarily hold notifications of "notarized at source or destination", to deal with unwanted concurrency effects
 	// The unwanted concurrency effects could be accentuated by the fast db-replay-validate mechanism.
@@ -94,6 +100,12 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.ESDTSuppliesHandler) {
 		return nil, errNilESDTSuppliesHandler
 	}
+	if check.IfNil(arguments.AddressHistoryHandler) {
+		return nil, errNilAddressHistoryHandler
+	}
+	if check.IfNil(arguments.EventsIndexHandler) {
+		return nil, errNilEventsIndexHandler
+	}
 	if check.IfNil(arguments.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
@@ -117,6 +129,8 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
+		addressHistoryHandler:                        arguments.AddressHistoryHandler,
+		eventsIndexHandler:                           arguments.EventsIndexHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
 	}, nil
 }
@@ -178,6 +192,19 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	miniBlocks := make([]*block.MiniBlock, 0, len(body.MiniBlocks)+len(createdIntraShardMiniBlocks))
+	miniBlocks = append(miniBlocks, body.MiniBlocks...)
+	miniBlocks = append(miniBlocks, createdIntraShardMiniBlocks...)
+	err = hr.addressHistoryHandler.RecordBlock(blockHeaderHash, blockHeader, miniBlocks, scrResultsFromPool)
+	if err != nil {
+		return err
+	}
+
+	err = hr.eventsIndexHandler.RecordBlock(blockHeaderHash, blockHeader, logs)
+	if err != nil {
+		return err
+	}
+
 	err = hr.putHashByRound(blockHeaderHash, blockHeader)
 	if err != nil {
 		return err
@@ -480,7 +507,26 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
-	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	err := hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	if err != nil {
+		return err
+	}
+
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+
+	blockHeaderHash, err := core.CalculateHash(hr.marshalizer, hr.hasher, blockHeader)
+	if err != nil {
+		return err
+	}
+
+	err = hr.addressHistoryHandler.RevertBlock(blockHeaderHash)
+	if err != nil {
+		return err
+	}
+
+	return hr.eventsIndexHandler.RevertBlock(blockHeaderHash)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -488,6 +534,16 @@ func (hr *historyRepository) GetESDTSupply(token string) (*esdtSupply.SupplyESDT
 	return hr.esdtSuppliesHandler.GetESDTSupply(token)
 }
 
+// GetAddressHistory will return a page of the history of the given address, newest entries first
+func (hr *historyRepository) GetAddressHistory(address []byte, cursor uint64, maxEntries int) (*addressHistory.AddressHistoryPage, error) {
+	return hr.addressHistoryHandler.GetAddressHistory(address, cursor, maxEntries)
+}
+
+// GetEvents will return a page of the events matching the given filter, in the order in which they were emitted
+func (hr *historyRepository) GetEvents(filter *eventsIndex.EventsFilter) (*eventsIndex.EventsPage, error) {
+	return hr.eventsIndexHandler.GetEvents(filter)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hr *historyRepository) IsInterfaceNil() bool {
 	return hr == nil
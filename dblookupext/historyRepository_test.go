@@ -8,8 +8,13 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common/mock"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressHistory"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
+	dblookupextMock "github.com/multiversx/mx-chain-go/dblookupext/mock"
 	epochStartMocks "github.com/multiversx/mx-chain-go/epochStart/mock"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
@@ -37,6 +42,8 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		Marshalizer:                 &mock.MarshalizerMock{},
 		Hasher:                      &hashingMocks.HasherMock{},
 		ESDTSuppliesHandler:         sp,
+		AddressHistoryHandler:       &dblookupextMock.AddressHistoryHandlerStub{},
+		EventsIndexHandler:          &dblookupextMock.EventsIndexHandlerStub{},
 		Uint64ByteSliceConverter:    &epochStartMocks.Uint64ByteSliceConverterMock{},
 	}
 
@@ -88,6 +95,18 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, process.ErrNilUint64Converter, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.AddressHistoryHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilAddressHistoryHandler, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.EventsIndexHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilEventsIndexHandler, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
@@ -152,6 +171,175 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 	require.Equal(t, 1, repo.blockHashByRound.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
 }
 
+func TestHistoryRepository_RecordBlockShouldRecordTheAddressHistory(t *testing.T) {
+	t.Parallel()
+
+	headerHash := []byte("headerHash")
+	blockHeader := &block.Header{Nonce: 4, Round: 5}
+	bodyMiniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("txA")}}
+	intraShardMiniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("scrA")}, Type: block.SmartContractResultBlock}
+	scrResultsFromPool := map[string]data.TransactionHandler{"scrA": &smartContractResult.SmartContractResult{}}
+
+	args := createMockHistoryRepoArgs(0)
+	recordBlockCalled := false
+	args.AddressHistoryHandler = &dblookupextMock.AddressHistoryHandlerStub{
+		RecordBlockCalled: func(blockHeaderHash []byte, header data.HeaderHandler, miniBlocks []*block.MiniBlock, scrs map[string]data.TransactionHandler) error {
+			recordBlockCalled = true
+			require.Equal(t, headerHash, blockHeaderHash)
+			require.Equal(t, blockHeader, header)
+			require.Equal(t, []*block.MiniBlock{bodyMiniBlock, intraShardMiniBlock}, miniBlocks)
+			require.Equal(t, scrResultsFromPool, scrs)
+			return nil
+		},
+	}
+	repo, _ := NewHistoryRepository(args)
+
+	blockBody := &block.Body{MiniBlocks: []*block.MiniBlock{bodyMiniBlock}}
+	err := repo.RecordBlock(headerHash, blockHeader, blockBody, scrResultsFromPool, nil, []*block.MiniBlock{intraShardMiniBlock}, nil)
+	require.Nil(t, err)
+	require.True(t, recordBlockCalled)
+	require.Len(t, blockBody.MiniBlocks, 1)
+}
+
+func TestHistoryRepository_RecordBlockShouldRecordTheEvents(t *testing.T) {
+	t.Parallel()
+
+	headerHash := []byte("headerHash")
+	blockHeader := &block.Header{Nonce: 4, Round: 5}
+	logs := []*data.LogData{{LogHandler: &transaction.Log{Address: []byte("address")}, TxHash: "txA"}}
+
+	args := createMockHistoryRepoArgs(0)
+	recordBlockCalled := false
+	args.EventsIndexHandler = &dblookupextMock.EventsIndexHandlerStub{
+		RecordBlockCalled: func(blockHeaderHash []byte, header data.HeaderHandler, logsData []*data.LogData) error {
+			recordBlockCalled = true
+			require.Equal(t, headerHash, blockHeaderHash)
+			require.Equal(t, blockHeader, header)
+			require.Equal(t, logs, logsData)
+			return nil
+		},
+	}
+	repo, _ := NewHistoryRepository(args)
+
+	err := repo.RecordBlock(headerHash, blockHeader, &block.Body{}, nil, nil, nil, logs)
+	require.Nil(t, err)
+	require.True(t, recordBlockCalled)
+}
+
+func TestHistoryRepository_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("address history revert error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockHistoryRepoArgs(0)
+		args.AddressHistoryHandler = &dblookupextMock.AddressHistoryHandlerStub{
+			RevertBlockCalled: func(blockHeaderHash []byte) error {
+				return expectedErr
+			},
+		}
+		repo, _ := NewHistoryRepository(args)
+
+		err := repo.RevertBlock(&block.Header{Nonce: 4}, &block.Body{})
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should revert the address history of the block", func(t *testing.T) {
+		t.Parallel()
+
+		blockHeader := &block.Header{Nonce: 4, Round: 5}
+		args := createMockHistoryRepoArgs(0)
+		expectedHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, blockHeader)
+		revertBlockCalled := false
+		args.AddressHistoryHandler = &dblookupextMock.AddressHistoryHandlerStub{
+			RevertBlockCalled: func(blockHeaderHash []byte) error {
+				revertBlockCalled = true
+				require.Equal(t, expectedHash, blockHeaderHash)
+				return nil
+			},
+		}
+		repo, _ := NewHistoryRepository(args)
+
+		err := repo.RevertBlock(blockHeader, &block.Body{})
+		require.Nil(t, err)
+		require.True(t, revertBlockCalled)
+	})
+	t.Run("events index revert error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockHistoryRepoArgs(0)
+		args.EventsIndexHandler = &dblookupextMock.EventsIndexHandlerStub{
+			RevertBlockCalled: func(blockHeaderHash []byte) error {
+				return expectedErr
+			},
+		}
+		repo, _ := NewHistoryRepository(args)
+
+		err := repo.RevertBlock(&block.Header{Nonce: 4}, &block.Body{})
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should revert the events of the block", func(t *testing.T) {
+		t.Parallel()
+
+		blockHeader := &block.Header{Nonce: 4, Round: 5}
+		args := createMockHistoryRepoArgs(0)
+		expectedHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, blockHeader)
+		revertBlockCalled := false
+		args.EventsIndexHandler = &dblookupextMock.EventsIndexHandlerStub{
+			RevertBlockCalled: func(blockHeaderHash []byte) error {
+				revertBlockCalled = true
+				require.Equal(t, expectedHash, blockHeaderHash)
+				return nil
+			},
+		}
+		repo, _ := NewHistoryRepository(args)
+
+		err := repo.RevertBlock(blockHeader, &block.Body{})
+		require.Nil(t, err)
+		require.True(t, revertBlockCalled)
+	})
+}
+
+func TestHistoryRepository_GetAddressHistory(t *testing.T) {
+	t.Parallel()
+
+	expectedPage := &addressHistory.AddressHistoryPage{NumEntries: 7, NextCursor: 2}
+	args := createMockHistoryRepoArgs(0)
+	args.AddressHistoryHandler = &dblookupextMock.AddressHistoryHandlerStub{
+		GetAddressHistoryCalled: func(address []byte, cursor uint64, maxEntries int) (*addressHistory.AddressHistoryPage, error) {
+			require.Equal(t, []byte("address"), address)
+			require.Equal(t, uint64(7), cursor)
+			require.Equal(t, 5, maxEntries)
+			return expectedPage, nil
+		},
+	}
+	repo, _ := NewHistoryRepository(args)
+
+	page, err := repo.GetAddressHistory([]byte("address"), 7, 5)
+	require.Nil(t, err)
+	require.Equal(t, expectedPage, page)
+}
+
+func TestHistoryRepository_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	expectedFilter := &eventsIndex.EventsFilter{Address: []byte("address"), MaxEntries: 5}
+	expectedPage := &eventsIndex.EventsPage{NextCursor: 2}
+	args := createMockHistoryRepoArgs(0)
+	args.EventsIndexHandler = &dblookupextMock.EventsIndexHandlerStub{
+		GetEventsCalled: func(filter *eventsIndex.EventsFilter) (*eventsIndex.EventsPage, error) {
+			require.Equal(t, expectedFilter, filter)
+			return expectedPage, nil
+		},
+	}
+	repo, _ := NewHistoryRepository(args)
+
+	page, err := repo.GetEvents(expectedFilter)
+	require.Nil(t, err)
+	require.Equal(t, expectedPage, page)
+}
+
 func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -3,7 +3,9 @@ package dblookupext
 import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressHistory"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 )
 
 // HistoryRepositoryFactory can create new instances of HistoryRepository
@@ -27,6 +29,8 @@ type HistoryRepository interface {
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	GetAddressHistory(address []byte, cursor uint64, maxEntries int) (*addressHistory.AddressHistoryPage, error)
+	GetEvents(filter *eventsIndex.EventsFilter) (*eventsIndex.EventsPage, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -47,3 +51,19 @@ type SuppliesHandler interface {
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
 	IsInterfaceNil() bool
 }
+
+// AddressHistoryHandler defines the interface of an address history processor
+type AddressHistoryHandler interface {
+	RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniBlocks []*block.MiniBlock, scrResultsFromPool map[string]data.TransactionHandler) error
+	RevertBlock(blockHeaderHash []byte) error
+	GetAddressHistory(address []byte, cursor uint64, maxEntries int) (*addressHistory.AddressHistoryPage, error)
+	IsInterfaceNil() bool
+}
+
+// EventsIndexHandler defines the interface of an events index processor
+type EventsIndexHandler interface {
+	RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, logs []*data.LogData) error
+	RevertBlock(blockHeaderHash []byte) error
+	GetEvents(filter *eventsIndex.EventsFilter) (*eventsIndex.EventsPage, error)
+	IsInterfaceNil() bool
+}
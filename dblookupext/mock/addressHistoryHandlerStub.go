@@ -0,0 +1,46 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressHistory"
+)
+
+// AddressHistoryHandlerStub -
+type AddressHistoryHandlerStub struct {
+	RecordBlockCalled       func(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniBlocks []*block.MiniBlock, scrResultsFromPool map[string]data.TransactionHandler) error
+	RevertBlockCalled       func(blockHeaderHash []byte) error
+	GetAddressHistoryCalled func(address []byte, cursor uint64, maxEntries int) (*addressHistory.AddressHistoryPage, error)
+}
+
+// RecordBlock -
+func (stub *AddressHistoryHandlerStub) RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniBlocks []*block.MiniBlock, scrResultsFromPool map[string]data.TransactionHandler) error {
+	if stub.RecordBlockCalled != nil {
+		return stub.RecordBlockCalled(blockHeaderHash, blockHeader, miniBlocks, scrResultsFromPool)
+	}
+
+	return nil
+}
+
+// RevertBlock -
+func (stub *AddressHistoryHandlerStub) RevertBlock(blockHeaderHash []byte) error {
+	if stub.RevertBlockCalled != nil {
+		return stub.RevertBlockCalled(blockHeaderHash)
+	}
+
+	return nil
+}
+
+// GetAddressHistory -
+func (stub *AddressHistoryHandlerStub) GetAddressHistory(address []byte, cursor uint64, maxEntries int) (*addressHistory.AddressHistoryPage, error) {
+	if stub.GetAddressHistoryCalled != nil {
+		return stub.GetAddressHistoryCalled(address, cursor, maxEntries)
+	}
+
+	return &addressHistory.AddressHistoryPage{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *AddressHistoryHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,45 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
+)
+
+// EventsIndexHandlerStub -
+type EventsIndexHandlerStub struct {
+	RecordBlockCalled func(blockHeaderHash []byte, blockHeader data.HeaderHandler, logs []*data.LogData) error
+	RevertBlockCalled func(blockHeaderHash []byte) error
+	GetEventsCalled   func(filter *eventsIndex.EventsFilter) (*eventsIndex.EventsPage, error)
+}
+
+// RecordBlock -
+func (stub *EventsIndexHandlerStub) RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, logs []*data.LogData) error {
+	if stub.RecordBlockCalled != nil {
+		return stub.RecordBlockCalled(blockHeaderHash, blockHeader, logs)
+	}
+
+	return nil
+}
+
+// RevertBlock -
+func (stub *EventsIndexHandlerStub) RevertBlock(blockHeaderHash []byte) error {
+	if stub.RevertBlockCalled != nil {
+		return stub.RevertBlockCalled(blockHeaderHash)
+	}
+
+	return nil
+}
+
+// GetEvents -
+func (stub *EventsIndexHandlerStub) GetEvents(filter *eventsIndex.EventsFilter) (*eventsIndex.EventsPage, error) {
+	if stub.GetEventsCalled != nil {
+		return stub.GetEventsCalled(filter)
+	}
+
+	return &eventsIndex.EventsPage{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *EventsIndexHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -42,6 +42,7 @@ import (
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/stateArchive"
 	"github.com/multiversx/mx-chain-go/state/syncer"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
@@ -115,6 +116,7 @@ type epochStartBootstrap struct {
 	numConcurrentTrieSyncers   int
 	maxHardCapForMissingNodes  int
 	trieSyncerVersion          int
+	numParallelSyncWorkers     int
 	checkNodesOnDisk           bool
 	bootstrapHeartbeatSender   update.Closer
 	trieSyncStatisticsProvider common.SizeSyncStatisticsHandler
@@ -232,6 +234,7 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 		numConcurrentTrieSyncers:        args.GeneralConfig.TrieSync.NumConcurrentTrieSyncers,
 		maxHardCapForMissingNodes:       args.GeneralConfig.TrieSync.MaxHardCapForMissingNodes,
 		trieSyncerVersion:               args.GeneralConfig.TrieSync.TrieSyncerVersion,
+		numParallelSyncWorkers:          args.GeneralConfig.TrieSync.NumParallelSyncWorkers,
 		checkNodesOnDisk:                args.GeneralConfig.TrieSync.CheckNodesOnDisk,
 		dataSyncerFactory:               args.DataSyncerCreator,
 		storerScheduledSCRs:             args.ScheduledSCRsStorer,
@@ -1100,15 +1103,26 @@ func (e *epochStartBootstrap) updateDataForScheduled(
 }
 
 func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
+	e.mutTrieStorageManagers.RLock()
+	trieStorageManager := e.trieStorageManagers[dataRetriever.UserAccountsUnit.String()]
+	e.mutTrieStorageManagers.RUnlock()
+
+	if len(e.flagsConfig.StateArchivePath) > 0 {
+		err := e.importUserAccountsState(rootHash, trieStorageManager)
+		if err == nil {
+			return nil
+		}
+
+		// the trie nodes already imported were checked against their hashes, so the network sync can reuse them
+		log.Warn("could not import the state archive, syncing the accounts state from the network",
+			"path", e.flagsConfig.StateArchivePath, "error", err)
+	}
+
 	thr, err := throttler.NewNumGoRoutinesThrottler(int32(e.numConcurrentTrieSyncers))
 	if err != nil {
 		return err
 	}
 
-	e.mutTrieStorageManagers.RLock()
-	trieStorageManager := e.trieStorageManagers[dataRetriever.UserAccountsUnit.String()]
-	e.mutTrieStorageManagers.RUnlock()
-
 	argsUserAccountsSyncer := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                            e.coreComponentsHolder.Hasher(),
@@ -1120,6 +1134,7 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 			MaxTrieLevelInMemory:              e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
 			MaxHardCapForMissingNodes:         e.maxHardCapForMissingNodes,
 			TrieSyncerVersion:                 e.trieSyncerVersion,
+			NumSyncWorkers:                    e.numParallelSyncWorkers,
 			CheckNodesOnDisk:                  e.checkNodesOnDisk,
 			UserAccountsSyncStatisticsHandler: e.trieSyncStatisticsProvider,
 			AppStatusHandler:                  e.statusHandler,
@@ -1142,6 +1157,28 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	return nil
 }
 
+func (e *epochStartBootstrap) importUserAccountsState(rootHash []byte, trieStorageManager common.StorageManager) error {
+	importer, err := stateArchive.NewStateImporter(stateArchive.ArgsStateImporter{
+		Marshaller: e.coreComponentsHolder.InternalMarshalizer(),
+		Hasher:     e.coreComponentsHolder.Hasher(),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("importing the accounts state from archive", "path", e.flagsConfig.StateArchivePath, "root hash", rootHash)
+	manifest, err := importer.Import(e.flagsConfig.StateArchivePath, rootHash, e.shardCoordinator.SelfId(), trieStorageManager)
+	if err != nil {
+		return err
+	}
+
+	storageMarker.NewTrieStorageMarker().MarkStorerAsSyncedAndActive(trieStorageManager)
+	log.Info("imported the accounts state from archive", "epoch", manifest.Epoch,
+		"num trie nodes", manifest.NumTrieNodes, "num data tries", manifest.NumDataTries)
+
+	return nil
+}
+
 func (e *epochStartBootstrap) createStorageServiceForImportDB(
 	shardCoordinator sharding.Coordinator,
 	pathManager storage.PathManagerHandler,
@@ -1194,6 +1231,7 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 			MaxTrieLevelInMemory:              e.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
 			MaxHardCapForMissingNodes:         e.maxHardCapForMissingNodes,
 			TrieSyncerVersion:                 e.trieSyncerVersion,
+			NumSyncWorkers:                    e.numParallelSyncWorkers,
 			CheckNodesOnDisk:                  e.checkNodesOnDisk,
 			UserAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 			AppStatusHandler:                  disabledCommon.NewAppStatusHandler(),
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -19,6 +20,8 @@ import (
 	dataBatch "github.com/multiversx/mx-chain-core-go/data/batch"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/statistics"
 	disabledStatistics "github.com/multiversx/mx-chain-go/common/statistics/disabled"
@@ -32,6 +35,7 @@ import (
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/stateArchive"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	epochStartMocks "github.com/multiversx/mx-chain-go/testscommon/bootstrapMocks/epochStart"
@@ -52,6 +56,7 @@ import (
 	storageMocks "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/multiversx/mx-chain-go/testscommon/syncer"
 	validatorInfoCacherStub "github.com/multiversx/mx-chain-go/testscommon/validatorInfoCacher"
+	"github.com/multiversx/mx-chain-go/trie"
 	"github.com/multiversx/mx-chain-go/trie/factory"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1106,6 +1111,92 @@ func TestSyncUserAccountsState(t *testing.T) {
 	assert.Equal(t, state.ErrNilRequestHandler, err)
 }
 
+func TestSyncUserAccountsState_WithStateArchive(t *testing.T) {
+	createEpochStartProvider := func(t *testing.T, stateArchivePath string) *epochStartBootstrap {
+		coreComp, cryptoComp := createComponentsForEpochStart()
+		coreComp.IntMarsh = &marshal.GogoProtoMarshalizer{}
+		coreComp.Hash = blake2b.NewBlake2b()
+		args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+		args.FlagsConfig.StateArchivePath = stateArchivePath
+
+		epochStartProvider, _ := NewEpochStartBootstrap(args)
+		epochStartProvider.shardCoordinator = mock.NewMultipleShardsCoordinatorMock()
+		epochStartProvider.dataPool = &dataRetrieverMock.PoolsHolderStub{
+			TrieNodesCalled: func() storage.Cacher {
+				return &testscommon.CacherStub{
+					GetCalled: func(key []byte) (value interface{}, ok bool) {
+						return nil, true
+					},
+				}
+			},
+		}
+
+		triesContainer, trieStorageManagers, err := factory.CreateTriesComponentsForShardId(
+			args.GeneralConfig,
+			coreComp,
+			disabled.NewChainStorer(),
+			disabledStatistics.NewStateStatistics(),
+		)
+		require.Nil(t, err)
+		epochStartProvider.trieContainer = triesContainer
+		epochStartProvider.trieStorageManagers = trieStorageManagers
+
+		return epochStartProvider
+	}
+
+	createStateArchive := func(t *testing.T, shardID uint32) (string, []byte) {
+		storageManagerArgs := storageMocks.GetStorageManagerArgs()
+		storageManagerArgs.Marshalizer = &marshal.GogoProtoMarshalizer{}
+		storageManagerArgs.Hasher = blake2b.NewBlake2b()
+		trieStorageManager, _ := trie.NewTrieStorageManager(storageManagerArgs)
+		tr, _ := trie.NewTrie(trieStorageManager, storageManagerArgs.Marshalizer, storageManagerArgs.Hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+		for i := 0; i < 10; i++ {
+			_ = tr.Update([]byte(fmt.Sprintf("address%d", i)), []byte(fmt.Sprintf("account%d", i)))
+		}
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		exporter, _ := stateArchive.NewStateExporter(stateArchive.ArgsStateExporter{
+			Marshaller: storageManagerArgs.Marshalizer,
+			Hasher:     storageManagerArgs.Hasher,
+			Storer:     trieStorageManager,
+		})
+		archivePath := filepath.Join(t.TempDir(), "state.tar.gz")
+		_, err := exporter.Export(rootHash, 0, shardID, archivePath)
+		require.Nil(t, err)
+
+		return archivePath, rootHash
+	}
+
+	t.Run("invalid archive should fall back to the network sync", func(t *testing.T) {
+		epochStartProvider := createEpochStartProvider(t, filepath.Join(t.TempDir(), "missing.tar.gz"))
+
+		err := epochStartProvider.syncUserAccountsState([]byte("rootHash"))
+		assert.Equal(t, state.ErrNilRequestHandler, err)
+	})
+	t.Run("archive of another shard should fall back to the network sync", func(t *testing.T) {
+		archivePath, rootHash := createStateArchive(t, 1)
+		epochStartProvider := createEpochStartProvider(t, archivePath)
+
+		err := epochStartProvider.syncUserAccountsState(rootHash)
+		assert.Equal(t, state.ErrNilRequestHandler, err)
+	})
+	t.Run("should import the archive", func(t *testing.T) {
+		archivePath, rootHash := createStateArchive(t, 0)
+		epochStartProvider := createEpochStartProvider(t, archivePath)
+
+		err := epochStartProvider.syncUserAccountsState(rootHash)
+		require.Nil(t, err)
+
+		trieStorageManager := epochStartProvider.trieStorageManagers[dataRetriever.UserAccountsUnit.String()]
+		_, err = trieStorageManager.Get(rootHash)
+		assert.Nil(t, err)
+		syncedVal, err := trieStorageManager.Get([]byte(common.TrieSyncedKey))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(common.TrieSyncedVal), syncedVal)
+	})
+}
+
 func TestRequestAndProcessForShard_ShouldFail(t *testing.T) {
 	notarizedShardHeaderHash := []byte("notarizedShardHeaderHash")
 	prevShardHeaderHash := []byte("prevShardHeaderHash")
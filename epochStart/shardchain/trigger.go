@@ -299,6 +299,54 @@ func (t *trigger) clearMissingMiniBlocksMap(epoch uint32) {
 	}
 }
 
+// RemoveMetaHeadersAfterEpoch drops the received meta headers of the epochs after the provided one, so that their
+// epoch start blocks can be received and finalized again. It is meant to be called after a node was reverted in time
+// (LoadState does not alter the received meta headers)
+func (t *trigger) RemoveMetaHeadersAfterEpoch(epoch uint32) {
+	t.mutTrigger.Lock()
+	defer t.mutTrigger.Unlock()
+
+	for finalizedEpoch := range t.mapFinalizedEpochs {
+		if finalizedEpoch > epoch {
+			delete(t.mapFinalizedEpochs, finalizedEpoch)
+		}
+	}
+
+	for hash, metaHdr := range t.mapEpochStartHdrs {
+		if metaHdr.GetEpoch() > epoch {
+			delete(t.mapEpochStartHdrs, hash)
+		}
+	}
+
+	for hash, metaHdr := range t.mapHashHdr {
+		if metaHdr.GetEpoch() <= epoch {
+			continue
+		}
+
+		delete(t.mapHashHdr, hash)
+		t.removeHashFromNonceMap(metaHdr.GetNonce(), hash)
+	}
+}
+
+func (t *trigger) removeHashFromNonceMap(nonce uint64, hash string) {
+	hashes := t.mapNonceHashes[nonce]
+	for i := range hashes {
+		if hashes[i] != hash {
+			continue
+		}
+
+		hashes = append(hashes[:i], hashes[i+1:]...)
+		break
+	}
+
+	if len(hashes) == 0 {
+		delete(t.mapNonceHashes, nonce)
+		return
+	}
+
+	t.mapNonceHashes[nonce] = hashes
+}
+
 func (t *trigger) clearMissingValidatorsInfoMap(epoch uint32) {
 	t.mutMissingValidatorsInfo.Lock()
 	defer t.mutMissingValidatorsInfo.Unlock()
@@ -184,3 +184,42 @@ func createLegacyTriggerRegistryFromTrigger(t *trigger) *legacyTriggerRegistry {
 		EpochStartShardHeader:       header,
 	}
 }
+
+func TestTrigger_LoadStateShouldNotAlterTheReceivedMetaHeaders(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockShardEpochStartTriggerArguments()
+	bootStorer := genericMocks.NewStorerMock()
+	arguments.Storage = &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			return bootStorer, nil
+		},
+	}
+
+	key := []byte("key")
+	epochStartTrigger, _ := NewEpochStartTrigger(arguments)
+	epochStartTrigger.metaEpoch = 3
+	err := epochStartTrigger.saveState(key)
+	require.Nil(t, err)
+
+	epochStartMetaHdr := &block.MetaBlock{Epoch: 3, Nonce: 30, EpochStart: block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{}}}}
+	newerEpochStartMetaHdr := &block.MetaBlock{Epoch: 4, Nonce: 40, EpochStart: block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{}}}}
+	epochStartTrigger.mapFinalizedEpochs[3] = "hash3"
+	epochStartTrigger.mapFinalizedEpochs[4] = "hash4"
+	epochStartTrigger.mapEpochStartHdrs["hash3"] = epochStartMetaHdr
+	epochStartTrigger.mapEpochStartHdrs["hash4"] = newerEpochStartMetaHdr
+	epochStartTrigger.mapHashHdr["hash3"] = epochStartMetaHdr
+	epochStartTrigger.mapHashHdr["hash4"] = newerEpochStartMetaHdr
+	epochStartTrigger.mapNonceHashes[30] = []string{"hash3"}
+	epochStartTrigger.mapNonceHashes[40] = []string{"hash4"}
+	epochStartTrigger.metaEpoch = 4
+
+	err = epochStartTrigger.LoadState(key)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint32(3), epochStartTrigger.MetaEpoch())
+	assert.Equal(t, map[uint32]string{3: "hash3", 4: "hash4"}, epochStartTrigger.mapFinalizedEpochs)
+	assert.Equal(t, map[string]data.HeaderHandler{"hash3": epochStartMetaHdr, "hash4": newerEpochStartMetaHdr}, epochStartTrigger.mapEpochStartHdrs)
+	assert.Equal(t, map[string]data.HeaderHandler{"hash3": epochStartMetaHdr, "hash4": newerEpochStartMetaHdr}, epochStartTrigger.mapHashHdr)
+	assert.Equal(t, map[uint64][]string{30: {"hash3"}, 40: {"hash4"}}, epochStartTrigger.mapNonceHashes)
+}
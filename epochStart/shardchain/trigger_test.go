@@ -738,3 +738,29 @@ func TestTrigger_AddMissingValidatorsInfo(t *testing.T) {
 	assert.Equal(t, uint32(1), epochStartTrigger.mapMissingValidatorsInfo["c"])
 	epochStartTrigger.mutMissingValidatorsInfo.RUnlock()
 }
+
+func TestTrigger_RemoveMetaHeadersAfterEpoch(t *testing.T) {
+	t.Parallel()
+
+	epochStartTrigger, _ := NewEpochStartTrigger(createMockShardEpochStartTriggerArguments())
+
+	epochStartMetaHdr := &block.MetaBlock{Epoch: 3, Nonce: 30, EpochStart: block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{}}}}
+	newerEpochStartMetaHdr := &block.MetaBlock{Epoch: 4, Nonce: 40, EpochStart: block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{}}}}
+	newerMetaHdr := &block.MetaBlock{Epoch: 4, Nonce: 30}
+	epochStartTrigger.mapFinalizedEpochs[3] = "hash3"
+	epochStartTrigger.mapFinalizedEpochs[4] = "hash4"
+	epochStartTrigger.mapEpochStartHdrs["hash3"] = epochStartMetaHdr
+	epochStartTrigger.mapEpochStartHdrs["hash4"] = newerEpochStartMetaHdr
+	epochStartTrigger.mapHashHdr["hash3"] = epochStartMetaHdr
+	epochStartTrigger.mapHashHdr["hash4"] = newerEpochStartMetaHdr
+	epochStartTrigger.mapHashHdr["other hash"] = newerMetaHdr
+	epochStartTrigger.mapNonceHashes[30] = []string{"hash3", "other hash"}
+	epochStartTrigger.mapNonceHashes[40] = []string{"hash4"}
+
+	epochStartTrigger.RemoveMetaHeadersAfterEpoch(3)
+
+	assert.Equal(t, map[uint32]string{3: "hash3"}, epochStartTrigger.mapFinalizedEpochs)
+	assert.Equal(t, map[string]data.HeaderHandler{"hash3": epochStartMetaHdr}, epochStartTrigger.mapEpochStartHdrs)
+	assert.Equal(t, map[string]data.HeaderHandler{"hash3": epochStartMetaHdr}, epochStartTrigger.mapHashHdr)
+	assert.Equal(t, map[uint64][]string{30: {"hash3"}}, epochStartTrigger.mapNonceHashes)
+}
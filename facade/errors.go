@@ -44,5 +44,11 @@ var ErrEmptyGasConfigs = errors.New("empty gas configs")
 // ErrTooManyAddressesInBulk signals that there are too many addresses present in a bulk request
 var ErrTooManyAddressesInBulk = errors.New("too many addresses in the bulk request")
 
+// ErrInvalidStateDiffPageSize signals that an invalid number of accounts was requested in a state diff page
+var ErrInvalidStateDiffPageSize = errors.New("invalid number of accounts in the state diff page")
+
+// ErrInvalidNumTopAccounts signals that an invalid number of top accounts was requested in the tries statistics
+var ErrInvalidNumTopAccounts = errors.New("invalid number of top accounts in the tries statistics")
+
 // ErrNilStatusMetrics signals that a nil status metrics was provided
 var ErrNilStatusMetrics = errors.New("nil status metrics handler")
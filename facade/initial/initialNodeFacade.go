@@ -21,6 +21,9 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/proofBundle"
+	"github.com/multiversx/mx-chain-go/state/stateDiff"
+	"github.com/multiversx/mx-chain-go/state/triesStatistics"
 )
 
 var errNodeStarting = errors.New("node is starting")
@@ -71,6 +74,21 @@ func (inf *initialNodeFacade) GetProofDataTrie(_ string, _ string, _ string) (*c
 	return nil, nil, errNodeStarting
 }
 
+// GetProofBundle -
+func (inf *initialNodeFacade) GetProofBundle(_ string, _ string) (*proofBundle.Bundle, error) {
+	return nil, errNodeStarting
+}
+
+// GetStateDiff -
+func (inf *initialNodeFacade) GetStateDiff(_ stateDiff.Request) (*stateDiff.StateDiff, error) {
+	return nil, errNodeStarting
+}
+
+// GetTriesStatistics -
+func (inf *initialNodeFacade) GetTriesStatistics(_ string, _ int) (*triesStatistics.Report, error) {
+	return nil, errNodeStarting
+}
+
 // GetProofCurrentRootHash -
 func (inf *initialNodeFacade) GetProofCurrentRootHash(_ string) (*common.GetProofResponse, error) {
 	return nil, errNodeStarting
@@ -167,7 +185,7 @@ func (inf *initialNodeFacade) SendBulkTransactions(_ []*transaction.Transaction)
 }
 
 // SimulateTransactionExecution returns nil and error
-func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction, _ bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 	return nil, errNodeStarting
 }
 
@@ -221,6 +239,11 @@ func (inf *initialNodeFacade) ExecuteSCQuery(_ *process.SCQuery) (*vm.VMOutputAp
 	return nil, api.BlockInfo{}, errNodeStarting
 }
 
+// ExecuteSCQueries returns nil and error
+func (inf *initialNodeFacade) ExecuteSCQueries(_ []*process.SCQuery) ([]*common.SCQueryApiResult, api.BlockInfo, error) {
+	return nil, api.BlockInfo{}, errNodeStarting
+}
+
 // PprofEnabled returns false
 func (inf *initialNodeFacade) PprofEnabled() bool {
 	return inf.pprofEnabled
@@ -406,6 +429,36 @@ func (inf *initialNodeFacade) GetTransactionsPoolNonceGapsForSender(_ string) (*
 	return nil, errNodeStarting
 }
 
+// GetTransactionsPoolSenderDetails returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsPoolSenderDetails(_ string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPoolSelection returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsPoolSelection(_ string) (*common.TransactionsPoolSelectionApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPoolRejections returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsPoolRejections(_ string) (*common.TransactionsPoolRejectionsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsForAddress returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsForAddress(_ string, _ uint64, _ int) (*common.AddressTransactionsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// FilterEvents returns a nil structure and error
+func (inf *initialNodeFacade) FilterEvents(_ *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// HandleSubscriptionsConnection returns error
+func (inf *initialNodeFacade) HandleSubscriptionsConnection(_ common.WebSocketConnection) error {
+	return errNodeStarting
+}
+
 // GetTransactionsPoolForSender returns a nil structure and error
 func (inf *initialNodeFacade) GetTransactionsPoolForSender(_, _ string) (*common.TransactionsPoolForSenderApiResponse, error) {
 	return nil, errNodeStarting
@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/state/stateDiff"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
@@ -103,7 +105,7 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Equal(t, uint64(0), u1)
 	assert.Equal(t, errNodeStarting, err)
 
-	u2, err := inf.SimulateTransactionExecution(nil)
+	u2, err := inf.SimulateTransactionExecution(nil, false)
 	assert.Nil(t, u2)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -130,6 +132,10 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, vo)
 	assert.Equal(t, errNodeStarting, err)
 
+	queriesResults, _, err := inf.ExecuteSCQueries(nil)
+	assert.Nil(t, queriesResults)
+	assert.Equal(t, errNodeStarting, err)
+
 	b = inf.PprofEnabled()
 	assert.True(t, b)
 
@@ -185,6 +191,14 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, proof)
 	assert.Equal(t, errNodeStarting, err)
 
+	diff, err := inf.GetStateDiff(stateDiff.Request{})
+	assert.Nil(t, diff)
+	assert.Equal(t, errNodeStarting, err)
+
+	triesStatisticsReport, err := inf.GetTriesStatistics("", 0)
+	assert.Nil(t, triesStatisticsReport)
+	assert.Equal(t, errNodeStarting, err)
+
 	b, err = inf.VerifyProof("", "", nil)
 	assert.False(t, b)
 	assert.Equal(t, errNodeStarting, err)
@@ -333,6 +347,29 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, txPoolGaps)
 	assert.Equal(t, errNodeStarting, err)
 
+	txPoolDetails, err := inf.GetTransactionsPoolSenderDetails("")
+	assert.Nil(t, txPoolDetails)
+	assert.Equal(t, errNodeStarting, err)
+
+	txPoolSelection, err := inf.GetTransactionsPoolSelection("")
+	assert.Nil(t, txPoolSelection)
+	assert.Equal(t, errNodeStarting, err)
+
+	txPoolRejections, err := inf.GetTransactionsPoolRejections("")
+	assert.Nil(t, txPoolRejections)
+	assert.Equal(t, errNodeStarting, err)
+
+	addressTransactions, err := inf.GetTransactionsForAddress("", 0, 0)
+	assert.Nil(t, addressTransactions)
+	assert.Equal(t, errNodeStarting, err)
+
+	filteredEvents, err := inf.FilterEvents(&common.EventsFilterApiRequest{})
+	assert.Nil(t, filteredEvents)
+	assert.Equal(t, errNodeStarting, err)
+
+	err = inf.HandleSubscriptionsConnection(nil)
+	assert.Equal(t, errNodeStarting, err)
+
 	count := inf.GetManagedKeysCount()
 	assert.Zero(t, count)
 
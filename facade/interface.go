@@ -18,6 +18,9 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/proofBundle"
+	"github.com/multiversx/mx-chain-go/state/stateDiff"
+	"github.com/multiversx/mx-chain-go/state/triesStatistics"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
@@ -107,21 +110,25 @@ type NodeHandler interface {
 
 	GetProof(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	GetProofBundle(address string, key string) (*proofBundle.Bundle, error)
+	GetStateDiff(request stateDiff.Request, ctx context.Context) (*stateDiff.StateDiff, error)
+	GetTriesStatistics(rootHash string, numTopAccounts int, ctx context.Context) (*triesStatistics.Report, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
-	ProcessTx(tx *transaction.Transaction, currentHeader coreData.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error)
+	ProcessTx(tx *transaction.Transaction, currentHeader coreData.HeaderHandler, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
 	IsInterfaceNil() bool
 }
 
 // ApiResolver defines a structure capable of resolving REST API requests
 type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedList(ctx context.Context) ([]*api.DirectStakedValue, error)
@@ -132,6 +139,12 @@ type ApiResolver interface {
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolSenderDetails(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error)
+	GetTransactionsPoolSelection(fields string) (*common.TransactionsPoolSelectionApiResponse, error)
+	GetTransactionsPoolRejections(sender string) (*common.TransactionsPoolRejectionsApiResponse, error)
+	GetTransactionsForAddress(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error)
+	FilterEvents(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error)
+	HandleSubscriptionsConnection(conn common.WebSocketConnection) error
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
@@ -17,9 +17,10 @@ import (
 // ApiResolverStub -
 type ApiResolverStub struct {
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteSCQueriesHandler                     func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
 	GetTotalStakedValueHandler                  func(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedListHandler                  func(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                    func(ctx context.Context) ([]*api.Delegator, error)
@@ -43,6 +44,12 @@ type ApiResolverStub struct {
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolSenderDetailsCalled      func(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error)
+	GetTransactionsPoolSelectionCalled          func(fields string) (*common.TransactionsPoolSelectionApiResponse, error)
+	GetTransactionsPoolRejectionsCalled         func(sender string) (*common.TransactionsPoolRejectionsApiResponse, error)
+	GetTransactionsForAddressCalled             func(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error)
+	FilterEventsCalled                          func(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error)
+	HandleSubscriptionsConnectionCalled         func(conn common.WebSocketConnection) error
 	GetGasConfigsCalled                         func() map[string]map[string]uint64
 	GetManagedKeysCountCalled                   func() int
 	GetManagedKeysCalled                        func() []string
@@ -116,6 +123,15 @@ func (ars *ApiResolverStub) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return nil, nil, nil
 }
 
+// ExecuteSCQueries -
+func (ars *ApiResolverStub) ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	if ars.ExecuteSCQueriesHandler != nil {
+		return ars.ExecuteSCQueriesHandler(queries)
+	}
+
+	return nil, nil, nil
+}
+
 // StatusMetrics -
 func (ars *ApiResolverStub) StatusMetrics() external.StatusMetricsHandler {
 	if ars.StatusMetricsHandler != nil {
@@ -135,9 +151,9 @@ func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transacti
 }
 
 // SimulateTransactionExecution -
-func (ars *ApiResolverStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (ars *ApiResolverStub) SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if ars.SimulateTransactionExecutionHandler != nil {
-		return ars.SimulateTransactionExecutionHandler(tx)
+		return ars.SimulateTransactionExecutionHandler(tx, withTrace)
 	}
 	return nil, nil
 }
@@ -237,6 +253,60 @@ func (ars *ApiResolverStub) GetTransactionsPoolNonceGapsForSender(sender string,
 	return nil, nil
 }
 
+// GetTransactionsPoolSenderDetails -
+func (ars *ApiResolverStub) GetTransactionsPoolSenderDetails(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+	if ars.GetTransactionsPoolSenderDetailsCalled != nil {
+		return ars.GetTransactionsPoolSenderDetailsCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolSelection -
+func (ars *ApiResolverStub) GetTransactionsPoolSelection(fields string) (*common.TransactionsPoolSelectionApiResponse, error) {
+	if ars.GetTransactionsPoolSelectionCalled != nil {
+		return ars.GetTransactionsPoolSelectionCalled(fields)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolRejections -
+func (ars *ApiResolverStub) GetTransactionsPoolRejections(sender string) (*common.TransactionsPoolRejectionsApiResponse, error) {
+	if ars.GetTransactionsPoolRejectionsCalled != nil {
+		return ars.GetTransactionsPoolRejectionsCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsForAddress -
+func (ars *ApiResolverStub) GetTransactionsForAddress(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error) {
+	if ars.GetTransactionsForAddressCalled != nil {
+		return ars.GetTransactionsForAddressCalled(address, cursor, size)
+	}
+
+	return nil, nil
+}
+
+// FilterEvents -
+func (ars *ApiResolverStub) FilterEvents(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error) {
+	if ars.FilterEventsCalled != nil {
+		return ars.FilterEventsCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// HandleSubscriptionsConnection -
+func (ars *ApiResolverStub) HandleSubscriptionsConnection(conn common.WebSocketConnection) error {
+	if ars.HandleSubscriptionsConnectionCalled != nil {
+		return ars.HandleSubscriptionsConnectionCalled(conn)
+	}
+
+	return nil
+}
+
 // GetInternalMetaBlockByHash -
 func (ars *ApiResolverStub) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	if ars.GetInternalMetaBlockByHashCalled != nil {
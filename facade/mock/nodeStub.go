@@ -14,6 +14,9 @@ import (
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/state/proofBundle"
+	"github.com/multiversx/mx-chain-go/state/stateDiff"
+	"github.com/multiversx/mx-chain-go/state/triesStatistics"
 )
 
 // NodeStub -
@@ -52,6 +55,9 @@ type NodeStub struct {
 	GetAllIssuedESDTsCalled                        func(tokenType string, ctx context.Context) ([]string, error)
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	GetProofBundleCalled                           func(address string, key string) (*proofBundle.Bundle, error)
+	GetStateDiffCalled                             func(request stateDiff.Request, ctx context.Context) (*stateDiff.StateDiff, error)
+	GetTriesStatisticsCalled                       func(rootHash string, numTopAccounts int, ctx context.Context) (*triesStatistics.Report, error)
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
 	GetTokenSupplyCalled                           func(token string) (*api.ESDTSupply, error)
 	IsDataTrieMigratedCalled                       func(address string, options api.AccountQueryOptions) (bool, error)
@@ -76,6 +82,33 @@ func (ns *NodeStub) GetProofDataTrie(rootHash string, address string, key string
 	return nil, nil, nil
 }
 
+// GetProofBundle -
+func (ns *NodeStub) GetProofBundle(address string, key string) (*proofBundle.Bundle, error) {
+	if ns.GetProofBundleCalled != nil {
+		return ns.GetProofBundleCalled(address, key)
+	}
+
+	return nil, nil
+}
+
+// GetStateDiff -
+func (ns *NodeStub) GetStateDiff(request stateDiff.Request, ctx context.Context) (*stateDiff.StateDiff, error) {
+	if ns.GetStateDiffCalled != nil {
+		return ns.GetStateDiffCalled(request, ctx)
+	}
+
+	return nil, nil
+}
+
+// GetTriesStatistics -
+func (ns *NodeStub) GetTriesStatistics(rootHash string, numTopAccounts int, ctx context.Context) (*triesStatistics.Report, error) {
+	if ns.GetTriesStatisticsCalled != nil {
+		return ns.GetTriesStatisticsCalled(rootHash, numTopAccounts, ctx)
+	}
+
+	return nil, nil
+}
+
 // VerifyProof -
 func (ns *NodeStub) VerifyProof(rootHash string, address string, proof [][]byte) (bool, error) {
 	if ns.VerifyProofCalled != nil {
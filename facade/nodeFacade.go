@@ -28,6 +28,9 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/proofBundle"
+	"github.com/multiversx/mx-chain-go/state/stateDiff"
+	"github.com/multiversx/mx-chain-go/state/triesStatistics"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
@@ -295,8 +298,8 @@ func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint
 }
 
 // SimulateTransactionExecution will simulate a transaction's execution and will return the results
-func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
-	return nf.apiResolver.SimulateTransactionExecution(tx)
+func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nf.apiResolver.SimulateTransactionExecution(tx, withTrace)
 }
 
 // GetTransaction gets the transaction with a specified hash
@@ -334,6 +337,36 @@ func (nf *nodeFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*com
 	return nf.apiResolver.GetTransactionsPoolNonceGapsForSender(sender, accountResponse.Nonce)
 }
 
+// GetTransactionsPoolSenderDetails will return the transactions from pool for sender, checked against the selection rules, that is to be returned on API calls
+func (nf *nodeFacade) GetTransactionsPoolSenderDetails(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+	return nf.apiResolver.GetTransactionsPoolSenderDetails(sender)
+}
+
+// GetTransactionsPoolSelection will return the transactions that would be picked from pool by the next selection, that is to be returned on API calls
+func (nf *nodeFacade) GetTransactionsPoolSelection(fields string) (*common.TransactionsPoolSelectionApiResponse, error) {
+	return nf.apiResolver.GetTransactionsPoolSelection(fields)
+}
+
+// GetTransactionsPoolRejections will return the transactions recently evicted from pool or rejected at selection, that is to be returned on API calls
+func (nf *nodeFacade) GetTransactionsPoolRejections(sender string) (*common.TransactionsPoolRejectionsApiResponse, error) {
+	return nf.apiResolver.GetTransactionsPoolRejections(sender)
+}
+
+// GetTransactionsForAddress will return a page of the transactions history of the provided address, that is to be returned on API calls
+func (nf *nodeFacade) GetTransactionsForAddress(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error) {
+	return nf.apiResolver.GetTransactionsForAddress(address, cursor, size)
+}
+
+// FilterEvents will return a page of the events matching the provided filter, that is to be returned on API calls
+func (nf *nodeFacade) FilterEvents(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error) {
+	return nf.apiResolver.FilterEvents(filter)
+}
+
+// HandleSubscriptionsConnection will serve the websocket subscriptions on the provided connection, blocking until it is closed
+func (nf *nodeFacade) HandleSubscriptionsConnection(conn common.WebSocketConnection) error {
+	return nf.apiResolver.HandleSubscriptionsConnection(conn)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
@@ -341,19 +374,7 @@ func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*
 
 // GetAccount returns a response containing information about the account correlated with provided address
 func (nf *nodeFacade) GetAccount(address string, options apiData.AccountQueryOptions) (apiData.AccountResponse, apiData.BlockInfo, error) {
-	var accountResponse apiData.AccountResponse
-	var blockInfo apiData.BlockInfo
-	var err error
-
-	if options.WithKeys {
-		ctx, cancel := nf.getContextForApiTrieRangeOperations()
-		defer cancel()
-
-		accountResponse, blockInfo, err = nf.node.GetAccountWithKeys(address, options, ctx)
-	} else {
-		accountResponse, blockInfo, err = nf.node.GetAccount(address, options)
-	}
-
+	accountResponse, blockInfo, err := nf.getAccount(address, options)
 	if err != nil {
 		return apiData.AccountResponse{}, apiData.BlockInfo{}, err
 	}
@@ -364,6 +385,17 @@ func (nf *nodeFacade) GetAccount(address string, options apiData.AccountQueryOpt
 	return accountResponse, blockInfo, nil
 }
 
+func (nf *nodeFacade) getAccount(address string, options apiData.AccountQueryOptions) (apiData.AccountResponse, apiData.BlockInfo, error) {
+	if !options.WithKeys {
+		return nf.node.GetAccount(address, options)
+	}
+
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetAccountWithKeys(address, options, ctx)
+}
+
 // GetAccounts returns the state of the provided addresses
 func (nf *nodeFacade) GetAccounts(addresses []string, options apiData.AccountQueryOptions) (map[string]*apiData.AccountResponse, apiData.BlockInfo, error) {
 	numAddresses := uint32(len(addresses))
@@ -377,7 +409,7 @@ func (nf *nodeFacade) GetAccounts(addresses []string, options apiData.AccountQue
 	var blockInfo apiData.BlockInfo
 
 	for i, address := range addresses {
-		accountResponse, blockInfoForAccount, err := nf.node.GetAccount(address, options)
+		accountResponse, blockInfoForAccount, err := nf.getAccount(address, options)
 		if err != nil {
 			return nil, apiData.BlockInfo{}, err
 		}
@@ -449,6 +481,26 @@ func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, a
 	return nf.convertVmOutputToApiResponse(vmOutput), queryBlockInfoToApiResource(blockInfo), nil
 }
 
+// ExecuteSCQueries executes all the provided queries against the same state
+func (nf *nodeFacade) ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryApiResult, apiData.BlockInfo, error) {
+	results, blockInfo, err := nf.apiResolver.ExecuteSCQueries(queries)
+	if err != nil {
+		return nil, apiData.BlockInfo{}, err
+	}
+
+	apiResults := make([]*common.SCQueryApiResult, 0, len(results))
+	for _, result := range results {
+		if result.Err != nil {
+			apiResults = append(apiResults, &common.SCQueryApiResult{Error: result.Err.Error()})
+			continue
+		}
+
+		apiResults = append(apiResults, &common.SCQueryApiResult{Data: nf.convertVmOutputToApiResponse(result.VMOutput)})
+	}
+
+	return apiResults, queryBlockInfoToApiResource(blockInfo), nil
+}
+
 // PprofEnabled returns if profiling mode should be active or not on the application
 func (nf *nodeFacade) PprofEnabled() bool {
 	return nf.config.PprofEnabled
@@ -591,6 +643,61 @@ func (nf *nodeFacade) GetProofDataTrie(rootHash string, address string, key stri
 	return nf.node.GetProofDataTrie(rootHash, address, key)
 }
 
+// GetProofBundle returns the Merkle proofs for the given address and optional data trie key, bundled with the signed
+// header committing to the proven state
+func (nf *nodeFacade) GetProofBundle(address string, key string) (*proofBundle.Bundle, error) {
+	return nf.node.GetProofBundle(address, key)
+}
+
+// GetStateDiff returns a page of the accounts which differ between the two states described by the request
+func (nf *nodeFacade) GetStateDiff(request stateDiff.Request) (*stateDiff.StateDiff, error) {
+	maxAccounts := nf.wsAntifloodConfig.StateDiffMaxAccountsPerPage
+	if request.MaxAccounts <= 0 || uint32(request.MaxAccounts) > maxAccounts {
+		return nil, fmt.Errorf("%w (provided: %d, maximum: %d)", ErrInvalidStateDiffPageSize, request.MaxAccounts, maxAccounts)
+	}
+	request.MaxDataTrieChangesPerAccount = int(nf.wsAntifloodConfig.StateDiffMaxDataTrieChangesPerAccount)
+
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetStateDiff(request, ctx)
+}
+
+// GetTriesStatistics returns the statistics of the accounts trie with the given root hash and of its data tries,
+// together with the numTopAccounts accounts holding the largest data tries. If no root hash is provided, the statistics
+// are computed for the current state
+func (nf *nodeFacade) GetTriesStatistics(rootHash string, numTopAccounts int) (*triesStatistics.Report, error) {
+	maxTopAccounts := nf.wsAntifloodConfig.TrieStatisticsMaxTopAccounts
+	if numTopAccounts <= 0 || uint32(numTopAccounts) > maxTopAccounts {
+		return nil, fmt.Errorf("%w (provided: %d, maximum: %d)", ErrInvalidNumTopAccounts, numTopAccounts, maxTopAccounts)
+	}
+
+	if len(rootHash) == 0 {
+		currentRootHash := nf.blockchain.GetCurrentBlockRootHash()
+		if len(currentRootHash) == 0 {
+			return nil, ErrEmptyRootHash
+		}
+
+		rootHash = hex.EncodeToString(currentRootHash)
+	}
+
+	ctx, cancel := nf.getContextForTrieStatistics()
+	defer cancel()
+
+	return nf.node.GetTriesStatistics(rootHash, numTopAccounts, ctx)
+}
+
+// getContextForTrieStatistics returns a context with a longer deadline than the one of the trie range operations,
+// as all the tries of the state are walked
+func (nf *nodeFacade) getContextForTrieStatistics() (context.Context, context.CancelFunc) {
+	if !nf.wsAntifloodConfig.WebServerAntifloodEnabled {
+		return context.WithCancel(context.Background())
+	}
+
+	timeout := time.Duration(nf.wsAntifloodConfig.TrieStatisticsDeadlineInSec) * time.Second
+	return context.WithTimeout(context.Background(), timeout)
+}
+
 // GetProofCurrentRootHash returns the Merkle proof for the given address and current root hash
 func (nf *nodeFacade) GetProofCurrentRootHash(address string) (*common.GetProofResponse, error) {
 	rootHash := nf.blockchain.GetCurrentBlockRootHash()
@@ -21,6 +21,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/facade/mock"
@@ -29,6 +30,8 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/stateDiff"
+	"github.com/multiversx/mx-chain-go/state/triesStatistics"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -421,6 +424,33 @@ func TestNodeFacade_GetAccounts(t *testing.T) {
 		require.Empty(t, blockInfo)
 		require.Equal(t, &expectedAcount, resp["test"])
 	})
+
+	t.Run("with keys should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedAcount := api.AccountResponse{
+			Address: "test",
+			Pairs:   map[string]string{"6b6579": "76616c7565"},
+		}
+		node := &mock.NodeStub{}
+		node.GetAccountCalled = func(address string, _ api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+			require.Fail(t, "should have not been called")
+			return api.AccountResponse{}, api.BlockInfo{}, nil
+		}
+		node.GetAccountWithKeysCalled = func(address string, options api.AccountQueryOptions, _ context.Context) (api.AccountResponse, api.BlockInfo, error) {
+			require.True(t, options.WithKeys)
+			return expectedAcount, api.BlockInfo{}, nil
+		}
+
+		arg := createMockArguments()
+		arg.Node = node
+		arg.WsAntifloodConfig.GetAddressesBulkMaxSize = 1
+		nf, _ := NewNodeFacade(arg)
+
+		resp, _, err := nf.GetAccounts([]string{"test"}, api.AccountQueryOptions{WithKeys: true})
+		require.NoError(t, err)
+		require.Equal(t, &expectedAcount, resp["test"])
+	})
 }
 
 func TestNodeFacade_GetUsername(t *testing.T) {
@@ -1126,6 +1156,148 @@ func TestNodeFacade_GetProof(t *testing.T) {
 	require.Equal(t, expectedResponse, response)
 }
 
+func TestNodeFacade_GetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid page size should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.StateDiffMaxAccountsPerPage = 10
+		arg.Node = &mock.NodeStub{
+			GetStateDiffCalled: func(_ stateDiff.Request, _ context.Context) (*stateDiff.StateDiff, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		diff, err := nf.GetStateDiff(stateDiff.Request{MaxAccounts: 11})
+		require.Nil(t, diff)
+		require.True(t, errors.Is(err, ErrInvalidStateDiffPageSize))
+		require.Equal(t, "invalid number of accounts in the state diff page (provided: 11, maximum: 10)", err.Error())
+
+		diff, err = nf.GetStateDiff(stateDiff.Request{MaxAccounts: 0})
+		require.Nil(t, diff)
+		require.True(t, errors.Is(err, ErrInvalidStateDiffPageSize))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedDiff := &stateDiff.StateDiff{FromRootHash: "aa", ToRootHash: "bb"}
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.StateDiffMaxAccountsPerPage = 10
+		arg.WsAntifloodConfig.StateDiffMaxDataTrieChangesPerAccount = 100
+		arg.Node = &mock.NodeStub{
+			GetStateDiffCalled: func(request stateDiff.Request, ctx context.Context) (*stateDiff.StateDiff, error) {
+				assert.NotNil(t, ctx)
+				assert.Equal(t, stateDiff.Request{
+					FromRootHash:                 "aa",
+					ToRootHash:                   "bb",
+					MaxAccounts:                  10,
+					MaxDataTrieChangesPerAccount: 100,
+				}, request)
+
+				return expectedDiff, nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		diff, err := nf.GetStateDiff(stateDiff.Request{FromRootHash: "aa", ToRootHash: "bb", MaxAccounts: 10})
+		require.NoError(t, err)
+		require.Equal(t, expectedDiff, diff)
+	})
+}
+
+func TestNodeFacade_GetTriesStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of top accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.TrieStatisticsMaxTopAccounts = 10
+		arg.Node = &mock.NodeStub{
+			GetTriesStatisticsCalled: func(_ string, _ int, _ context.Context) (*triesStatistics.Report, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		report, err := nf.GetTriesStatistics("aa", 11)
+		require.Nil(t, report)
+		require.True(t, errors.Is(err, ErrInvalidNumTopAccounts))
+		require.Equal(t, "invalid number of top accounts in the tries statistics (provided: 11, maximum: 10)", err.Error())
+
+		report, err = nf.GetTriesStatistics("aa", 0)
+		require.Nil(t, report)
+		require.True(t, errors.Is(err, ErrInvalidNumTopAccounts))
+	})
+	t.Run("empty current root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.TrieStatisticsMaxTopAccounts = 10
+		arg.Blockchain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockRootHashCalled: func() []byte {
+				return nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		report, err := nf.GetTriesStatistics("", 5)
+		require.Nil(t, report)
+		require.Equal(t, ErrEmptyRootHash, err)
+	})
+	t.Run("should work with the current root hash", func(t *testing.T) {
+		t.Parallel()
+
+		expectedReport := &triesStatistics.Report{RootHash: "aabb"}
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.TrieStatisticsMaxTopAccounts = 10
+		arg.Blockchain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockRootHashCalled: func() []byte {
+				return []byte{0xaa, 0xbb}
+			},
+		}
+		arg.Node = &mock.NodeStub{
+			GetTriesStatisticsCalled: func(rootHash string, numTopAccounts int, ctx context.Context) (*triesStatistics.Report, error) {
+				assert.NotNil(t, ctx)
+				assert.Equal(t, "aabb", rootHash)
+				assert.Equal(t, 5, numTopAccounts)
+
+				return expectedReport, nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		report, err := nf.GetTriesStatistics("", 5)
+		require.NoError(t, err)
+		require.Equal(t, expectedReport, report)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedReport := &triesStatistics.Report{RootHash: "ccdd"}
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.TrieStatisticsMaxTopAccounts = 10
+		arg.Node = &mock.NodeStub{
+			GetTriesStatisticsCalled: func(rootHash string, numTopAccounts int, _ context.Context) (*triesStatistics.Report, error) {
+				assert.Equal(t, "ccdd", rootHash)
+				assert.Equal(t, 10, numTopAccounts)
+
+				return expectedReport, nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		report, err := nf.GetTriesStatistics("ccdd", 10)
+		require.NoError(t, err)
+		require.Equal(t, expectedReport, report)
+	})
+}
+
 func TestNodeFacade_GetProofCurrentRootHash(t *testing.T) {
 	t.Parallel()
 
@@ -1412,6 +1584,61 @@ func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 	})
 }
 
+func TestNodeFacade_ExecuteSCQueries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+				return nil, nil, expectedErr
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+
+		results, _, err := nf.ExecuteSCQueries([]*process.SCQuery{{}})
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, results)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		providedRootHash := []byte("root hash")
+		arg.ApiResolver = &mock.ApiResolverStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+				require.Equal(t, 2, len(queries))
+				return []*process.SCQueryResult{
+					{
+						VMOutput: &vmcommon.VMOutput{
+							ReturnData: [][]byte{[]byte("test return data")},
+							ReturnCode: vmcommon.Ok,
+						},
+					},
+					{
+						Err: expectedErr,
+					},
+				}, holders.NewBlockInfo(nil, 37, providedRootHash), nil
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+
+		results, blockInfo, err := nf.ExecuteSCQueries([]*process.SCQuery{{}, {}})
+		require.NoError(t, err)
+		require.Equal(t, uint64(37), blockInfo.Nonce)
+		require.Equal(t, hex.EncodeToString(providedRootHash), blockInfo.RootHash)
+		require.Equal(t, 2, len(results))
+		require.Equal(t, [][]byte{[]byte("test return data")}, results[0].Data.ReturnData)
+		require.Empty(t, results[0].Error)
+		require.Nil(t, results[1].Data)
+		require.Equal(t, expectedErr.Error(), results[1].Error)
+	})
+}
+
 func TestNodeFacade_GetBlockByRoundShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -2000,6 +2227,167 @@ func TestNodeFacade_GetLastPoolNonceForSender(t *testing.T) {
 	})
 }
 
+func TestNodeFacade_GetTransactionsPoolInspection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			GetTransactionsPoolSenderDetailsCalled: func(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+				return nil, expectedErr
+			},
+			GetTransactionsPoolSelectionCalled: func(fields string) (*common.TransactionsPoolSelectionApiResponse, error) {
+				return nil, expectedErr
+			},
+			GetTransactionsPoolRejectionsCalled: func(sender string) (*common.TransactionsPoolRejectionsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+		details, err := nf.GetTransactionsPoolSenderDetails("alice")
+		require.Nil(t, details)
+		require.Equal(t, expectedErr, err)
+
+		selection, err := nf.GetTransactionsPoolSelection("")
+		require.Nil(t, selection)
+		require.Equal(t, expectedErr, err)
+
+		rejections, err := nf.GetTransactionsPoolRejections("")
+		require.Nil(t, rejections)
+		require.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedDetails := &common.TransactionsPoolSenderDetailsApiResponse{Sender: "alice", NumTransactions: 2}
+		expectedSelection := &common.TransactionsPoolSelectionApiResponse{TotalGasLimit: 100000}
+		expectedRejections := &common.TransactionsPoolRejectionsApiResponse{
+			Rejections: []common.RejectedTransactionApiResponse{{Hash: "bb", Sender: "bob", Nonce: 1, Reason: "lower nonce"}},
+		}
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			GetTransactionsPoolSenderDetailsCalled: func(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+				return expectedDetails, nil
+			},
+			GetTransactionsPoolSelectionCalled: func(fields string) (*common.TransactionsPoolSelectionApiResponse, error) {
+				return expectedSelection, nil
+			},
+			GetTransactionsPoolRejectionsCalled: func(sender string) (*common.TransactionsPoolRejectionsApiResponse, error) {
+				return expectedRejections, nil
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+		details, err := nf.GetTransactionsPoolSenderDetails("alice")
+		require.NoError(t, err)
+		require.Equal(t, expectedDetails, details)
+
+		selection, err := nf.GetTransactionsPoolSelection("")
+		require.NoError(t, err)
+		require.Equal(t, expectedSelection, selection)
+
+		rejections, err := nf.GetTransactionsPoolRejections("bob")
+		require.NoError(t, err)
+		require.Equal(t, expectedRejections, rejections)
+	})
+}
+
+func TestNodeFacade_GetTransactionsForAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			GetTransactionsForAddressCalled: func(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+		transactions, err := nf.GetTransactionsForAddress("alice", 0, 10)
+		require.Nil(t, transactions)
+		require.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTransactions := &common.AddressTransactionsApiResponse{NumTransactions: 1}
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			GetTransactionsForAddressCalled: func(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error) {
+				return expectedTransactions, nil
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+		transactions, err := nf.GetTransactionsForAddress("alice", 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, expectedTransactions, transactions)
+	})
+}
+
+func TestNodeFacade_FilterEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			FilterEventsCalled: func(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+		events, err := nf.FilterEvents(&common.EventsFilterApiRequest{Identifier: "swap", Size: 10})
+		require.Nil(t, events)
+		require.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedEvents := &common.FilteredEventsApiResponse{NextCursor: 1}
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			FilterEventsCalled: func(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error) {
+				return expectedEvents, nil
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+		events, err := nf.FilterEvents(&common.EventsFilterApiRequest{Identifier: "swap", Size: 10})
+		require.NoError(t, err)
+		require.Equal(t, expectedEvents, events)
+	})
+}
+
+func TestNodeFacade_HandleSubscriptionsConnection(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		HandleSubscriptionsConnectionCalled: func(conn common.WebSocketConnection) error {
+			wasCalled = true
+			return expectedErr
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	err := nf.HandleSubscriptionsConnection(&testscommon.WebSocketConnectionStub{})
+	require.Equal(t, expectedErr, err)
+	require.True(t, wasCalled)
+}
+
 func TestNodeFacade_GetTransactionsPoolNonceGapsForSender(t *testing.T) {
 	t.Parallel()
 
@@ -2197,14 +2585,14 @@ func TestNodeFacade_SimulateTransactionExecution(t *testing.T) {
 	}
 	args := createMockArguments()
 	args.ApiResolver = &mock.ApiResolverStub{
-		SimulateTransactionExecutionHandler: func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+		SimulateTransactionExecutionHandler: func(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 			return providedResponse, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(args)
 
-	response, err := nf.SimulateTransactionExecution(&transaction.Transaction{})
+	response, err := nf.SimulateTransactionExecution(&transaction.Transaction{}, false)
 	require.NoError(t, err)
 	require.Equal(t, providedResponse, response)
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/disabled"
@@ -20,6 +21,8 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/external/blockAPI"
 	"github.com/multiversx/mx-chain-go/node/external/logs"
+	"github.com/multiversx/mx-chain-go/node/external/subscriptions"
+	disabledSubscriptions "github.com/multiversx/mx-chain-go/node/external/subscriptions/disabled"
 	"github.com/multiversx/mx-chain-go/node/external/timemachine/fee"
 	"github.com/multiversx/mx-chain-go/node/external/transactionAPI"
 	"github.com/multiversx/mx-chain-go/node/trieIterators"
@@ -221,6 +224,11 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	subscriptionsHandler, err := createSubscriptionsHandler(args)
+	if err != nil {
+		return nil, err
+	}
+
 	argsDataFieldParser := &datafield.ArgsOperationDataFieldParser{
 		AddressLength: args.CoreComponents.AddressPubKeyConverter().Len(),
 		Marshalizer:   args.CoreComponents.InternalMarshalizer(),
@@ -246,6 +254,9 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		DataFieldParser:          dataFieldParser,
 		TxMarshaller:             args.CoreComponents.TxMarshalizer(),
 		EnableEpochsHandler:      args.CoreComponents.EnableEpochsHandler(),
+		AccountsAdapterAPI:       args.StateComponents.AccountsAdapterAPI(),
+		GuardedAccountHandler:    args.BootstrapComponents.GuardedAccountHandler(),
+		TxVersionChecker:         args.CoreComponents.TxVersionChecker(),
 	}
 	apiTransactionProcessor, err := transactionAPI.NewAPITransactionProcessor(argsAPITransactionProc)
 	if err != nil {
@@ -270,6 +281,8 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
 		APITransactionHandler:    apiTransactionProcessor,
+		LogsFacade:               logsFacade,
+		SubscriptionsHandler:     subscriptionsHandler,
 		APIBlockHandler:          apiBlockProcessor,
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
 		GenesisNodesSetupHandler: args.CoreComponents.GenesisNodesSetup(),
@@ -313,13 +326,53 @@ func createScQueryService(
 		isInHistoricalBalancesMode: args.isInHistoricalBalancesMode,
 	}
 
+	list, storageManagers, err := createScQueryElements(argsQueryElem, 0, numConcurrentVms)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sqQueryDispatcher, err := smartContract.NewScQueryServiceDispatcher(list)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	numHistoricalVms := args.generalConfig.VirtualMachine.Querying.NumHistoricalVMs
+	if numHistoricalVms < 1 {
+		return sqQueryDispatcher, storageManagers, nil
+	}
+
+	historicalList, historicalStorageManagers, err := createScQueryElements(argsQueryElem, numConcurrentVms, numHistoricalVms)
+	if err != nil {
+		return nil, nil, err
+	}
+	storageManagers = append(storageManagers, historicalStorageManagers...)
+
+	// all the query elements resolve the same root hash for a query, so any of them can be used
+	rootHashResolver, ok := historicalList[0].(process.SCQueryRootHashResolver)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w for the SC query root hash resolver", process.ErrWrongTypeAssertion)
+	}
+
+	historicalDispatcher, err := smartContract.NewHistoricalScQueryServiceDispatcher(sqQueryDispatcher, historicalList, rootHashResolver)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return historicalDispatcher, storageManagers, nil
+}
+
+func createScQueryElements(
+	argsQueryElem *scQueryElementArgs,
+	startIndex int,
+	numElements int,
+) ([]process.SCQueryService, []common.StorageManager, error) {
 	var err error
 	var scQueryService process.SCQueryService
 	var storageManager common.StorageManager
-	storageManagers := make([]common.StorageManager, 0, numConcurrentVms)
+	storageManagers := make([]common.StorageManager, 0, numElements)
 
-	list := make([]process.SCQueryService, 0, numConcurrentVms)
-	for i := 0; i < numConcurrentVms; i++ {
+	list := make([]process.SCQueryService, 0, numElements)
+	for i := startIndex; i < startIndex+numElements; i++ {
 		argsQueryElem.index = i
 		scQueryService, storageManager, err = createScQueryElement(*argsQueryElem)
 		if err != nil {
@@ -330,12 +383,7 @@ func createScQueryService(
 		storageManagers = append(storageManagers, storageManager)
 	}
 
-	sqQueryDispatcher, err := smartContract.NewScQueryServiceDispatcher(list)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return sqQueryDispatcher, storageManagers, nil
+	return list, storageManagers, nil
 }
 
 func createScQueryElement(
@@ -738,8 +786,57 @@ func createAPIBlockProcessorArgs(args *ApiResolverArgs, apiTransactionHandler ex
 
 func createLogsFacade(args *ApiResolverArgs) (factory.LogsFacade, error) {
 	return logs.NewLogsFacade(logs.ArgsNewLogsFacade{
-		StorageService:  args.DataComponents.StorageService(),
-		Marshaller:      args.CoreComponents.InternalMarshalizer(),
-		PubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
+		StorageService:    args.DataComponents.StorageService(),
+		Marshaller:        args.CoreComponents.InternalMarshalizer(),
+		PubKeyConverter:   args.CoreComponents.AddressPubKeyConverter(),
+		HistoryRepository: args.ProcessComponents.HistoryRepository(),
+	})
+}
+
+func createSubscriptionsHandler(args *ApiResolverArgs) (external.SubscriptionsHandler, error) {
+	subscriptionsConfig := args.Configs.ApiRoutesConfig.Subscriptions
+	if !subscriptionsConfig.Enabled {
+		return disabledSubscriptions.NewSubscriptionsHandler(), nil
+	}
+
+	blockContainer, err := createBlockCreatorsContainer()
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionsHub, err := subscriptions.NewSubscriptionsHub(subscriptions.ArgsSubscriptionsHub{
+		Config:                 subscriptionsConfig,
+		Marshaller:             args.CoreComponents.InternalMarshalizer(),
+		BlockContainer:         blockContainer,
+		ShardCoordinator:       args.ProcessComponents.ShardCoordinator(),
+		AddressPubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = args.StatusComponents.OutportHandler().SubscribeDriver(subscriptionsHub)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptionsHub, nil
+}
+
+func createBlockCreatorsContainer() (subscriptions.BlockContainerHandler, error) {
+	container := block.NewEmptyBlockCreatorsContainer()
+	err := container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.ShardHeaderV2, block.NewEmptyHeaderV2Creator())
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.MetaHeader, block.NewEmptyMetaBlockCreator())
+	if err != nil {
+		return nil, err
+	}
+
+	return container, nil
 }
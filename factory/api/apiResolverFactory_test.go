@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -16,6 +17,7 @@ import (
 	"github.com/multiversx/mx-chain-go/factory/bootstrap"
 	"github.com/multiversx/mx-chain-go/factory/mock"
 	testsMocks "github.com/multiversx/mx-chain-go/integrationTests/mock"
+	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/sync/disabled"
 	"github.com/multiversx/mx-chain-go/state"
@@ -31,6 +33,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/guardianMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/mainFactoryMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	outportStub "github.com/multiversx/mx-chain-go/testscommon/outport"
 	stateMocks "github.com/multiversx/mx-chain-go/testscommon/state"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/require"
@@ -38,6 +41,8 @@ import (
 
 const unreachableStep = 10000
 
+var expectedErr = errors.New("expected error")
+
 type failingSteps struct {
 	marshallerStepCounter int
 	marshallerFailingStep int
@@ -94,6 +99,7 @@ func createMockArgs(t *testing.T) *api.ApiResolverArgs {
 			GeneralConfig:   &cfg,
 			EpochConfig:     &config.EpochConfig{},
 			EconomicsConfig: &economicsConfig,
+			ApiRoutesConfig: &config.ApiRoutesConfig{},
 		},
 		CoreComponents:       coreComponents,
 		DataComponents:       dataComponents,
@@ -169,6 +175,67 @@ func TestCreateApiResolver(t *testing.T) {
 		require.True(t, strings.Contains(err.Error(), "VirtualMachine.Querying.NumConcurrentVms"))
 		require.True(t, check.IfNil(apiResolver))
 	})
+	t.Run("should work with historical VMs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Configs.GeneralConfig.VirtualMachine.Querying.NumHistoricalVMs = 2
+		apiResolver, err := api.CreateApiResolver(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(apiResolver))
+		require.Nil(t, apiResolver.Close())
+	})
+
+	t.Run("should work with subscriptions enabled", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Configs.ApiRoutesConfig.Subscriptions = config.ApiSubscriptionsConfig{
+			Enabled:                   true,
+			MaxClients:                1,
+			MaxSubscriptionsPerClient: 1,
+			MaxItemsPerSubscription:   1,
+			ClientBufferSize:          1,
+		}
+		wasSubscribed := false
+		args.StatusComponents = &mainFactoryMocks.StatusComponentsStub{
+			ManagedPeersMonitorField: &testscommon.ManagedPeersMonitorStub{},
+			Outport: &outportStub.OutportStub{
+				SubscribeDriverCalled: func(driver outport.Driver) error {
+					wasSubscribed = true
+					return nil
+				},
+			},
+		}
+		apiResolver, err := api.CreateApiResolver(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(apiResolver))
+		require.True(t, wasSubscribed)
+		require.Nil(t, apiResolver.Close())
+	})
+	t.Run("subscriptions driver cannot be subscribed should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Configs.ApiRoutesConfig.Subscriptions = config.ApiSubscriptionsConfig{
+			Enabled:                   true,
+			MaxClients:                1,
+			MaxSubscriptionsPerClient: 1,
+			MaxItemsPerSubscription:   1,
+			ClientBufferSize:          1,
+		}
+		args.StatusComponents = &mainFactoryMocks.StatusComponentsStub{
+			ManagedPeersMonitorField: &testscommon.ManagedPeersMonitorStub{},
+			Outport: &outportStub.OutportStub{
+				SubscribeDriverCalled: func(driver outport.Driver) error {
+					return expectedErr
+				},
+			},
+		}
+		apiResolver, err := api.CreateApiResolver(args)
+		require.Equal(t, expectedErr, err)
+		require.True(t, check.IfNil(apiResolver))
+	})
 
 	failingStepsInstance := &failingSteps{}
 	failingArgs := createFailingMockArgs(t, failingStepsInstance)
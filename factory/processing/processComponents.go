@@ -1847,6 +1847,7 @@ func (pcf *processComponentsFactory) createExportFactoryHandler(
 		MaxHardCapForMissingNodes:        pcf.config.TrieSync.MaxHardCapForMissingNodes,
 		NumConcurrentTrieSyncers:         pcf.config.TrieSync.NumConcurrentTrieSyncers,
 		TrieSyncerVersion:                pcf.config.TrieSync.TrieSyncerVersion,
+		NumParallelSyncWorkers:           pcf.config.TrieSync.NumParallelSyncWorkers,
 		NodeOperationMode:                nodeOperationMode,
 	}
 	return updateFactory.NewExportHandlerFactory(argsExporter)
@@ -27,12 +27,28 @@ import (
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 )
 
+type executionTracerHandler interface {
+	transactionEvaluator.ExecutionTracer
+	WrapBuiltInFunctions(container vmcommon.BuiltInFunctionContainer) error
+	WrapVMContainer(vmContainer process.VirtualMachinesContainer, accounts state.AccountsAdapter) (process.VirtualMachinesContainer, error)
+}
+
 func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.TransactionEvaluator, process.VirtualMachinesContainerFactory, error) {
 	simulationAccountsDB, err := transactionEvaluator.NewSimulationAccountsDB(pcf.state.AccountsAdapterAPI())
 	if err != nil {
 		return nil, nil, err
 	}
 
+	executionTracer, err := transactionEvaluator.NewExecutionTracer(pcf.coreData.AddressPubKeyConverter())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = simulationAccountsDB.SetExecutionTracer(executionTracer)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	vmOutputCacherConfig := storageFactory.GetCacherFromConfig(pcf.config.VMOutputCacher)
 	vmOutputCacher, err := storageunit.NewCache(vmOutputCacherConfig)
 	if err != nil {
@@ -47,7 +63,7 @@ func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.Tr
 		return nil, nil, err
 	}
 
-	txSimulatorProcessorArgs, vmContainerFactory, txTypeHandler, err := pcf.createArgsTxSimulatorProcessor(simulationAccountsDB, vmOutputCacher, txLogsProcessor)
+	txSimulatorProcessorArgs, vmContainerFactory, txTypeHandler, err := pcf.createArgsTxSimulatorProcessor(simulationAccountsDB, vmOutputCacher, txLogsProcessor, executionTracer)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -66,6 +82,7 @@ func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.Tr
 	txSimulatorProcessorArgs.Hasher = pcf.coreData.Hasher()
 	txSimulatorProcessorArgs.Marshalizer = pcf.coreData.InternalMarshalizer()
 	txSimulatorProcessorArgs.DataFieldParser = dataFieldParser
+	txSimulatorProcessorArgs.ExecutionTracer = executionTracer
 
 	txSimulator, err := transactionEvaluator.NewTransactionSimulator(txSimulatorProcessorArgs)
 	if err != nil {
@@ -89,12 +106,13 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessor(
 	accountsAdapter state.AccountsAdapter,
 	vmOutputCacher storage.Cacher,
 	txLogsProcessor process.TransactionLogProcessor,
+	executionTracer executionTracerHandler,
 ) (transactionEvaluator.ArgsTxSimulator, process.VirtualMachinesContainerFactory, process.TxTypeHandler, error) {
 	shardID := pcf.bootstrapComponents.ShardCoordinator().SelfId()
 	if shardID == core.MetachainShardId {
-		return pcf.createArgsTxSimulatorProcessorForMeta(accountsAdapter, vmOutputCacher, txLogsProcessor)
+		return pcf.createArgsTxSimulatorProcessorForMeta(accountsAdapter, vmOutputCacher, txLogsProcessor, executionTracer)
 	} else {
-		return pcf.createArgsTxSimulatorProcessorShard(accountsAdapter, vmOutputCacher, txLogsProcessor)
+		return pcf.createArgsTxSimulatorProcessorShard(accountsAdapter, vmOutputCacher, txLogsProcessor, executionTracer)
 	}
 }
 
@@ -102,6 +120,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 	accountsAdapter state.AccountsAdapter,
 	vmOutputCacher storage.Cacher,
 	txLogsProcessor process.TransactionLogProcessor,
+	executionTracer executionTracerHandler,
 ) (transactionEvaluator.ArgsTxSimulator, process.VirtualMachinesContainerFactory, process.TxTypeHandler, error) {
 	args := transactionEvaluator.ArgsTxSimulator{}
 
@@ -144,7 +163,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 
 	args.BlockChainHook = vmContainerFactory.BlockChainHookImpl()
 
-	vmContainer, err := vmContainerFactory.Create()
+	vmContainer, err := pcf.createTracedVMContainer(vmContainerFactory, builtInFuncFactory, accountsAdapter, executionTracer)
 	if err != nil {
 		return args, nil, nil, err
 	}
@@ -227,6 +246,38 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 	return args, vmContainerFactory, txTypeHandler, nil
 }
 
+// createTracedVMContainer creates the VM container and wraps it, together with the built-in functions, so that the
+// executions can be recorded by the tracer. The built-in functions are wrapped after all the setters of the factory
+// were called, as those setters need the original implementations
+func (pcf *processComponentsFactory) createTracedVMContainer(
+	vmContainerFactory process.VirtualMachinesContainerFactory,
+	builtInFuncFactory vmcommon.BuiltInFunctionFactory,
+	accountsAdapter state.AccountsAdapter,
+	executionTracer executionTracerHandler,
+) (process.VirtualMachinesContainer, error) {
+	vmContainer, err := vmContainerFactory.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	err = executionTracer.WrapBuiltInFunctions(builtInFuncFactory.BuiltInFunctionContainer())
+	if err != nil {
+		return nil, err
+	}
+
+	tracedVMContainer, err := executionTracer.WrapVMContainer(vmContainer, accountsAdapter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vmContainerFactory.BlockChainHookImpl().SetVMContainer(tracedVMContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	return tracedVMContainer, nil
+}
+
 func (pcf *processComponentsFactory) createTxTypeHandler(builtInFuncFactory vmcommon.BuiltInFunctionFactory) (process.TxTypeHandler, error) {
 	esdtTransferParser, err := parsers.NewESDTTransferParser(pcf.coreData.InternalMarshalizer())
 	if err != nil {
@@ -249,6 +300,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 	accountsAdapter state.AccountsAdapter,
 	vmOutputCacher storage.Cacher,
 	txLogsProcessor process.TransactionLogProcessor,
+	executionTracer executionTracerHandler,
 ) (transactionEvaluator.ArgsTxSimulator, process.VirtualMachinesContainerFactory, process.TxTypeHandler, error) {
 	args := transactionEvaluator.ArgsTxSimulator{}
 
@@ -311,7 +363,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 		return args, nil, nil, err
 	}
 
-	vmContainer, err := vmContainerFactory.Create()
+	vmContainer, err := pcf.createTracedVMContainer(vmContainerFactory, builtInFuncFactory, accountsAdapter, executionTracer)
 	if err != nil {
 		return args, nil, nil, err
 	}
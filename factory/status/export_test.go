@@ -34,3 +34,8 @@ func ComputeConnectedPeers(
 func (scf *statusComponentsFactory) MakeHostDriversArgs() ([]outportDriverFactory.ArgsHostDriverFactory, error) {
 	return scf.makeHostDriversArgs()
 }
+
+// MakeFileDriverArgs -
+func (scf *statusComponentsFactory) MakeFileDriverArgs() (outportDriverFactory.ArgsFileDriverFactory, error) {
+	return scf.makeFileDriverArgs()
+}
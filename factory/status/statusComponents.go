@@ -230,12 +230,18 @@ func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandle
 		return nil, err
 	}
 
+	fileDriverArgs, err := scf.makeFileDriverArgs()
+	if err != nil {
+		return nil, err
+	}
+
 	outportFactoryArgs := &outportDriverFactory.OutportFactoryArgs{
 		ShardID:                   scf.shardCoordinator.SelfId(),
 		RetrialInterval:           common.RetrialIntervalForOutportDriver,
 		ElasticIndexerFactoryArgs: scf.makeElasticIndexerArgs(),
 		EventNotifierFactoryArgs:  eventNotifierArgs,
 		HostDriversArgs:           hostDriversArgs,
+		FileDriverArgs:            fileDriverArgs,
 		IsImportDB:                scf.isInImportMode,
 		ChainHandler:              scf.dataComponents.Blockchain(),
 	}
@@ -303,3 +309,20 @@ func (scf *statusComponentsFactory) makeHostDriversArgs() ([]outportDriverFactor
 
 	return argsHostDriverFactorySlice, nil
 }
+
+func (scf *statusComponentsFactory) makeFileDriverArgs() (outportDriverFactory.ArgsFileDriverFactory, error) {
+	fileDriverConfig := scf.externalConfig.FileDriverConfig
+	if !fileDriverConfig.Enabled {
+		return outportDriverFactory.ArgsFileDriverFactory{}, nil
+	}
+
+	marshaller, err := factoryMarshalizer.NewMarshalizer(fileDriverConfig.MarshallerType)
+	if err != nil {
+		return outportDriverFactory.ArgsFileDriverFactory{}, err
+	}
+
+	return outportDriverFactory.ArgsFileDriverFactory{
+		Config:     fileDriverConfig,
+		Marshaller: marshaller,
+	}, nil
+}
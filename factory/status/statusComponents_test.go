@@ -274,3 +274,49 @@ func TestMakeHostDriversArgs(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, 1, len(res))
 }
+
+func TestMakeFileDriverArgs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled driver should return empty args", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockStatusComponentsFactoryArgs()
+		args.ExternalConfig.FileDriverConfig = config.FileDriverConfig{
+			Enabled:        false,
+			MarshallerType: "invalid",
+		}
+		scf, _ := statusComp.NewStatusComponentsFactory(args)
+		res, err := scf.MakeFileDriverArgs()
+		require.Nil(t, err)
+		require.Nil(t, res.Marshaller)
+	})
+	t.Run("invalid marshaller type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockStatusComponentsFactoryArgs()
+		args.ExternalConfig.FileDriverConfig = config.FileDriverConfig{
+			Enabled:        true,
+			MarshallerType: "invalid",
+		}
+		scf, _ := statusComp.NewStatusComponentsFactory(args)
+		_, err := scf.MakeFileDriverArgs()
+		require.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockStatusComponentsFactoryArgs()
+		args.ExternalConfig.FileDriverConfig = config.FileDriverConfig{
+			Enabled:            true,
+			Path:               "outport",
+			MarshallerType:     "json",
+			MaxSegmentSizeInMB: 1,
+		}
+		scf, _ := statusComp.NewStatusComponentsFactory(args)
+		res, err := scf.MakeFileDriverArgs()
+		require.Nil(t, err)
+		require.NotNil(t, res.Marshaller)
+		require.Equal(t, args.ExternalConfig.FileDriverConfig, res.Config)
+	})
+}
@@ -11,6 +11,7 @@ import (
 type QueryServiceStub struct {
 	ComputeScCallGasLimitCalled func(tx *transaction.Transaction) (uint64, error)
 	ExecuteQueryCalled          func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteQueriesCalled        func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error)
 	CloseCalled                 func() error
 }
 
@@ -32,6 +33,15 @@ func (qss *QueryServiceStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMO
 	return &vmcommon.VMOutput{}, nil, nil
 }
 
+// ExecuteQueries -
+func (qss *QueryServiceStub) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	if qss.ExecuteQueriesCalled != nil {
+		return qss.ExecuteQueriesCalled(queries)
+	}
+
+	return make([]*process.SCQueryResult, 0), nil, nil
+}
+
 // Close -
 func (qss *QueryServiceStub) Close() error {
 	if qss.CloseCalled != nil {
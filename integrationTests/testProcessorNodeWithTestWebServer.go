@@ -108,7 +108,7 @@ func createTestApiConfig() config.ApiRoutesConfig {
 		"network":     {"/status", "/total-staked", "/economics", "/config"},
 		"log":         {"/log"},
 		"validator":   {"/statistics"},
-		"vm-values":   {"/hex", "/string", "/int", "/query"},
+		"vm-values":   {"/hex", "/string", "/int", "/query", "/query-multiple"},
 		"transaction": {"/send", "/simulate", "/send-multiple", "/cost", "/:txhash", "/pool"},
 		"block":       {"/by-nonce/:nonce", "/by-hash/:hash", "/by-round/:round"},
 	}
@@ -171,6 +171,9 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	dataFieldParser, err := datafield.NewOperationDataFieldParser(argsDataFieldParser)
 	log.LogIfError(err)
 
+	executionTracer, err := transactionEvaluator.NewExecutionTracer(TestAddressPubkeyConverter)
+	log.LogIfError(err)
+
 	argSimulator := transactionEvaluator.ArgsTxSimulator{
 		TransactionProcessor:      tpn.TxProcessor,
 		IntermediateProcContainer: tpn.InterimProcContainer,
@@ -181,6 +184,7 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		VMOutputCacher:            &testscommon.CacherMock{},
 		DataFieldParser:           dataFieldParser,
 		BlockChainHook:            tpn.BlockchainHook,
+		ExecutionTracer:           executionTracer,
 	}
 
 	txSimulator, err := transactionEvaluator.NewTransactionSimulator(argSimulator)
@@ -189,6 +193,9 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	wrappedAccounts, err := transactionEvaluator.NewSimulationAccountsDB(tpn.AccntState)
 	log.LogIfError(err)
 
+	err = wrappedAccounts.SetExecutionTracer(executionTracer)
+	log.LogIfError(err)
+
 	argsTransactionEvaluator := transactionEvaluator.ArgsApiTransactionEvaluator{
 		TxTypeHandler:       txTypeHandler,
 		FeeHandler:          tpn.EconomicsData,
@@ -238,6 +245,9 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		DataFieldParser:          dataFieldParser,
 		TxMarshaller:             &marshallerMock.MarshalizerMock{},
 		EnableEpochsHandler:      tpn.EnableEpochsHandler,
+		AccountsAdapterAPI:       tpn.AccntState,
+		GuardedAccountHandler:    tpn.GuardedAccountHandler,
+		TxVersionChecker:         &testscommon.TxVersionCheckerStub{},
 	}
 	apiTransactionHandler, err := transactionAPI.NewAPITransactionProcessor(argsApiTransactionProc)
 	log.LogIfError(err)
@@ -276,6 +286,8 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
 		APITransactionHandler:    apiTransactionHandler,
+		LogsFacade:               logsFacade,
+		SubscriptionsHandler:     &testscommon.SubscriptionsHandlerStub{},
 		APIBlockHandler:          blockAPIHandler,
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
 		GenesisNodesSetupHandler: &genesisMocks.NodesSetupStub{},
@@ -924,6 +924,16 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		return nil, err
 	}
 
+	executionTracer, err := transactionEvaluator.NewExecutionTracer(pubkeyConv)
+	if err != nil {
+		return nil, err
+	}
+
+	err = simulationAccountsDB.SetExecutionTracer(executionTracer)
+	if err != nil {
+		return nil, err
+	}
+
 	argsFactory := shard.ArgsNewIntermediateProcessorsContainerFactory{
 		ShardCoordinator:        shardCoordinator,
 		Marshalizer:             integrationtests.TestMarshalizer,
@@ -991,6 +1001,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		Hasher:                 integrationtests.TestHasher,
 		DataFieldParser:        dataFieldParser,
 		BlockChainHook:         blockChainHook,
+		ExecutionTracer:        executionTracer,
 	}
 
 	argsNewSCProcessor.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
@@ -17,6 +17,7 @@ import (
 	chainSimulatorErrors "github.com/multiversx/mx-chain-go/node/chainSimulator/errors"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
 	mxChainSharding "github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -30,10 +31,19 @@ import (
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
-const delaySendTxs = time.Millisecond
+const (
+	delaySendTxs = time.Millisecond
+	// the metachain accounts the skipped rounds of its own chain in the first block after a skip and the ones of the
+	// shards in the next block, which notarizes the first shard blocks produced after the skip
+	roundsToNotarizeSkippedRounds = 2
+)
 
 var log = logger.GetOrCreate("chainSimulator")
 
+type ratingPenaltiesIgnorer interface {
+	IgnorePenaltiesUntilRound(round int64)
+}
+
 type transactionWithResult struct {
 	hexHash string
 	tx      *transaction.Transaction
@@ -72,11 +82,16 @@ type simulator struct {
 	chanStopNodeProcess    chan endProcess.ArgEndProcess
 	syncedBroadcastNetwork components.SyncedBroadcastNetworkHandler
 	handlers               []ChainHandler
+	handlersByShard        map[uint32]ChainHandler
 	initialWalletKeys      *dtos.InitialWalletKeys
 	initialStakedKeys      map[string]*dtos.BLSKey
 	validatorsPrivateKeys  []crypto.PrivateKey
 	nodes                  map[uint32]process.NodeHandler
 	numOfShards            uint32
+	roundsPerEpoch         int64
+	snapshots              map[int]*simulatorSnapshot
+	lastSnapshotID         int
+	isPruningBlocked       bool
 	mutex                  sync.RWMutex
 }
 
@@ -95,10 +110,12 @@ func NewBaseChainSimulator(args ArgsBaseChainSimulator) (*simulator, error) {
 		syncedBroadcastNetwork: components.NewSyncedBroadcastNetwork(),
 		nodes:                  make(map[uint32]process.NodeHandler),
 		handlers:               make([]ChainHandler, 0, args.NumOfShards+1),
+		handlersByShard:        make(map[uint32]ChainHandler),
 		numOfShards:            args.NumOfShards,
 		chanStopNodeProcess:    make(chan endProcess.ArgEndProcess),
 		mutex:                  sync.RWMutex{},
 		initialStakedKeys:      make(map[string]*dtos.BLSKey),
+		snapshots:              make(map[int]*simulatorSnapshot),
 	}
 
 	err := instance.createChainHandlers(args)
@@ -149,6 +166,7 @@ func (s *simulator) createChainHandlers(args ArgsBaseChainSimulator) error {
 		shardID := node.GetShardCoordinator().SelfId()
 		s.nodes[shardID] = node
 		s.handlers = append(s.handlers, chainHandler)
+		s.handlersByShard[shardID] = chainHandler
 
 		if node.GetShardCoordinator().SelfId() == core.MetachainShardId {
 			currentRootHash, errRootHash := node.GetProcessComponents().ValidatorsStatistics().RootHash()
@@ -177,6 +195,7 @@ func (s *simulator) createChainHandlers(args ArgsBaseChainSimulator) error {
 	}
 
 	s.initialWalletKeys = outputConfigs.InitialWallets
+	s.roundsPerEpoch = outputConfigs.Configs.GeneralConfig.EpochStartConfig.RoundsPerEpoch
 	s.validatorsPrivateKeys = outputConfigs.ValidatorsPrivateKeys
 
 	log.Info("running the chain simulator with the following parameters",
@@ -224,6 +243,10 @@ func (s *simulator) GenerateBlocks(numOfBlocks int) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
+	return s.generateBlocks(numOfBlocks)
+}
+
+func (s *simulator) generateBlocks(numOfBlocks int) error {
 	for idx := 0; idx < numOfBlocks; idx++ {
 		s.incrementRoundOnAllValidators()
 		err := s.allNodesCreateBlocks()
@@ -239,6 +262,10 @@ func (s *simulator) GenerateBlocksUntilEpochIsReached(targetEpoch int32) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
+	return s.generateBlocksUntilEpochIsReached(targetEpoch)
+}
+
+func (s *simulator) generateBlocksUntilEpochIsReached(targetEpoch int32) error {
 	maxNumberOfRounds := 10000
 	for idx := 0; idx < maxNumberOfRounds; idx++ {
 		s.incrementRoundOnAllValidators()
@@ -259,6 +286,120 @@ func (s *simulator) GenerateBlocksUntilEpochIsReached(targetEpoch int32) error {
 	return fmt.Errorf("exceeded rounds to generate blocks")
 }
 
+// WarpToRound will move all nodes to the provided round without generating a block for each skipped round.
+// Only the last numOfBlocks rounds, up to and including the target round, are produced as regular blocks. If fewer
+// rounds are left to the target, only those are produced.
+// Every epoch boundary crossed on the way is processed by generating blocks until all nodes reach the new epoch,
+// so the epoch start mechanism is still triggered for each skipped epoch.
+// The skipped rounds are not missed blocks, so they do not decrease the validators rating.
+// The rounds keep the duration provided at construction time, a custom duration per call is not supported
+func (s *simulator) WarpToRound(targetRound int64, numOfBlocks int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.warpToRound(targetRound, numOfBlocks)
+}
+
+// WarpTimestamp will move all nodes to the first round that starts at or after the provided unix timestamp,
+// in the same way WarpToRound does
+func (s *simulator) WarpTimestamp(timestamp int64, numOfBlocks int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	roundHandler := s.nodes[core.MetachainShardId].GetCoreComponents().RoundHandler()
+	currentTimestamp := roundHandler.TimeStamp()
+	timeUntilTarget := time.Unix(timestamp, 0).Sub(currentTimestamp)
+	if timeUntilTarget <= 0 {
+		return fmt.Errorf("%w, provided timestamp: %d, current round timestamp: %d",
+			chainSimulatorErrors.ErrInvalidWarpTarget, timestamp, currentTimestamp.Unix())
+	}
+
+	roundDuration := roundHandler.TimeDuration()
+	numOfRounds := int64((timeUntilTarget + roundDuration - 1) / roundDuration)
+
+	return s.warpToRound(roundHandler.Index()+numOfRounds, numOfBlocks)
+}
+
+// InjectFault will register a fault that will be applied when the provided shard creates the block of the given round
+func (s *simulator) InjectFault(shardID uint32, round int64, fault process.Fault) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	handler, found := s.handlersByShard[shardID]
+	if !found {
+		return fmt.Errorf("%w: %d", chainSimulatorErrors.ErrUnknownShard, shardID)
+	}
+
+	return handler.InjectFault(round, fault)
+}
+
+func (s *simulator) warpToRound(targetRound int64, numOfBlocks int) error {
+	if numOfBlocks < 0 {
+		return chainSimulatorErrors.ErrInvalidMaxNumOfBlocks
+	}
+
+	metachainNode := s.nodes[core.MetachainShardId]
+	currentRound := metachainNode.GetCoreComponents().RoundHandler().Index()
+	if targetRound <= currentRound {
+		return fmt.Errorf("%w, target round: %d, current round: %d",
+			chainSimulatorErrors.ErrInvalidWarpTarget, targetRound, currentRound)
+	}
+
+	roundBeforeBlocks := targetRound - int64(numOfBlocks)
+	for {
+		epochStartTrigger := metachainNode.GetProcessComponents().EpochStartTrigger()
+		// the metachain triggers the epoch change on the first round after the epoch start round + rounds per epoch
+		roundBeforeEpochChange := int64(epochStartTrigger.EpochStartRound()) + s.roundsPerEpoch
+		if roundBeforeEpochChange >= roundBeforeBlocks {
+			break
+		}
+
+		s.skipRoundsUntil(roundBeforeEpochChange)
+
+		err := s.generateBlocksUntilEpochIsReached(int32(epochStartTrigger.Epoch() + 1))
+		if err != nil {
+			return err
+		}
+	}
+
+	s.skipRoundsUntil(roundBeforeBlocks)
+
+	// the number of blocks is capped to the rounds left until the target, as the epoch changes processed above
+	// or a target closer than numOfBlocks rounds leave fewer rounds to be produced
+	numOfBlocksToGenerate := targetRound - metachainNode.GetCoreComponents().RoundHandler().Index()
+	if numOfBlocksToGenerate < 0 {
+		numOfBlocksToGenerate = 0
+	}
+	if numOfBlocksToGenerate > int64(numOfBlocks) {
+		numOfBlocksToGenerate = int64(numOfBlocks)
+	}
+
+	log.Info("chain simulator warped", "round", metachainNode.GetCoreComponents().RoundHandler().Index(),
+		"num of blocks to generate", numOfBlocksToGenerate)
+
+	return s.generateBlocks(int(numOfBlocksToGenerate))
+}
+
+// skipRoundsUntil will move all nodes to the provided round, without generating blocks
+func (s *simulator) skipRoundsUntil(round int64) {
+	currentRound := s.nodes[core.MetachainShardId].GetCoreComponents().RoundHandler().Index()
+	if round <= currentRound {
+		return
+	}
+
+	s.setRoundOnAllValidators(round)
+
+	// the skipped rounds are not missed blocks, so the rating penalties are not applied for the blocks that account
+	// them: the first block of each shard after the skip and the metachain blocks notarizing those
+	lastRoundToIgnore := round + roundsToNotarizeSkippedRounds
+	for _, node := range s.nodes {
+		ignorer, ok := node.GetCoreComponents().Rater().(ratingPenaltiesIgnorer)
+		if ok {
+			ignorer.IgnorePenaltiesUntilRound(lastRoundToIgnore)
+		}
+	}
+}
+
 // ForceResetValidatorStatisticsCache will force the reset of the cache used for the validators statistics endpoint
 func (s *simulator) ForceResetValidatorStatisticsCache() error {
 	metachainNode := s.GetNodeHandler(core.MetachainShardId)
@@ -295,6 +436,12 @@ func (s *simulator) incrementRoundOnAllValidators() {
 	}
 }
 
+func (s *simulator) setRoundOnAllValidators(round int64) {
+	for _, node := range s.handlers {
+		node.SetRound(round)
+	}
+}
+
 // ForceChangeOfEpoch will force the change of current epoch
 // This method will call the epoch change trigger and generate block till a new epoch is reached
 func (s *simulator) ForceChangeOfEpoch() error {
@@ -494,6 +641,36 @@ func (s *simulator) SetStateMultiple(stateSlice []*dtos.AddressState) error {
 	return nil
 }
 
+// GetStateMultiple will return the state of the provided addresses, in the same format accepted by SetStateMultiple
+func (s *simulator) GetStateMultiple(addresses []string) ([]*dtos.AddressState, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	addressConverter := s.nodes[core.MetachainShardId].GetCoreComponents().AddressPubKeyConverter()
+	stateSlice := make([]*dtos.AddressState, 0, len(addresses))
+	for _, address := range addresses {
+		addressBytes, err := addressConverter.Decode(address)
+		if err != nil {
+			return nil, err
+		}
+
+		var addressState *dtos.AddressState
+		if bytes.Equal(addressBytes, core.SystemAccountAddress) {
+			addressState, err = s.getStateSystemAccount()
+		} else {
+			shardID := sharding.ComputeShardID(addressBytes, s.numOfShards)
+			addressState, err = s.nodes[shardID].GetStateForAddress(addressBytes)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w for address %s", err, address)
+		}
+
+		stateSlice = append(stateSlice, addressState)
+	}
+
+	return stateSlice, nil
+}
+
 // RemoveAccounts will try to remove all accounts data for the addresses provided
 func (s *simulator) RemoveAccounts(addresses []string) error {
 	s.mutex.Lock()
@@ -650,6 +827,38 @@ func (s *simulator) setStateSystemAccount(state *dtos.AddressState) error {
 	return nil
 }
 
+// getStateSystemAccount merges the key-value pairs of the system account from all shards, since SetStateMultiple
+// will write the same state on every shard
+func (s *simulator) getStateSystemAccount() (*dtos.AddressState, error) {
+	var systemAccountState *dtos.AddressState
+	for shard, node := range s.nodes {
+		addressState, err := node.GetStateForAddress(core.SystemAccountAddress)
+		if errors.Is(err, state.ErrAccNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w for shard %d", err, shard)
+		}
+
+		if systemAccountState == nil {
+			systemAccountState = addressState
+			systemAccountState.Pairs = make(map[string]string)
+		}
+		for key, value := range addressState.Pairs {
+			systemAccountState.Pairs[key] = value
+		}
+	}
+
+	if systemAccountState == nil {
+		return nil, state.ErrAccNotFound
+	}
+	if len(systemAccountState.Pairs) == 0 {
+		systemAccountState.Pairs = nil
+	}
+
+	return systemAccountState, nil
+}
+
 func (s *simulator) removeAllSystemAccounts() error {
 	for shard, node := range s.nodes {
 		err := node.RemoveAccount(core.SystemAccountAddress)
@@ -14,6 +14,8 @@ import (
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/configs"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	chainSimulatorErrors "github.com/multiversx/mx-chain-go/node/chainSimulator/errors"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -430,3 +432,506 @@ func TestSimulator_SentMoveBalanceNoGasForFee(t *testing.T) {
 	_, err = chainSimulator.sendTx(ftx)
 	require.True(t, strings.Contains(err.Error(), errors.ErrInsufficientFunds.Error()))
 }
+
+func TestSimulator_TakeSnapshotAndRevert(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    100,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.RevertToSnapshot(1)
+	require.ErrorIs(t, err, chainSimulatorErrors.ErrSnapshotNotFound)
+
+	wallet0, err := chainSimulator.GenerateAndMintWalletAddress(0, chainSimulatorCommon.InitialAmount)
+	require.Nil(t, err)
+
+	wallet1, err := chainSimulator.GenerateAndMintWalletAddress(1, chainSimulatorCommon.InitialAmount)
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	snapshotID, err := chainSimulator.TakeSnapshot()
+	require.Nil(t, err)
+
+	metaNode := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	snapshotRound := metaNode.GetCoreComponents().RoundHandler().Index()
+	snapshotNonce := metaNode.GetChainHandler().GetCurrentBlockHeader().GetNonce()
+
+	transferValue := big.NewInt(0).Mul(chainSimulatorCommon.OneEGLD, big.NewInt(5))
+	expectedBalance := big.NewInt(0).Add(chainSimulatorCommon.InitialAmount, transferValue)
+	for i := 0; i < 2; i++ {
+		tx := chainSimulatorCommon.GenerateTransaction(wallet0.Bytes, 0, wallet1.Bytes, transferValue, "", 50000)
+		_, err = chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 15)
+		require.Nil(t, err, "iteration %d", i)
+
+		err = chainSimulator.GenerateBlocks(5)
+		require.Nil(t, err)
+
+		account, errGet := chainSimulator.GetAccount(wallet1)
+		require.Nil(t, errGet)
+		require.Equal(t, expectedBalance.String(), account.Balance)
+
+		err = chainSimulator.RevertToSnapshot(snapshotID)
+		require.Nil(t, err)
+
+		require.Equal(t, snapshotRound, metaNode.GetCoreComponents().RoundHandler().Index())
+		require.Equal(t, snapshotNonce, metaNode.GetChainHandler().GetCurrentBlockHeader().GetNonce())
+
+		err = chainSimulator.GenerateBlocks(1)
+		require.Nil(t, err)
+
+		account, errGet = chainSimulator.GetAccount(wallet1)
+		require.Nil(t, errGet)
+		require.Equal(t, chainSimulatorCommon.InitialAmount.String(), account.Balance)
+
+		err = chainSimulator.RevertToSnapshot(snapshotID)
+		require.Nil(t, err)
+	}
+}
+
+func TestSimulator_TakeSnapshotAndRevertAcrossEpochs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    20,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	wallet0, err := chainSimulator.GenerateAndMintWalletAddress(0, chainSimulatorCommon.InitialAmount)
+	require.Nil(t, err)
+
+	wallet1, err := chainSimulator.GenerateAndMintWalletAddress(1, chainSimulatorCommon.InitialAmount)
+	require.Nil(t, err)
+
+	snapshotEpoch := uint32(2)
+	err = chainSimulator.GenerateBlocksUntilEpochIsReached(int32(snapshotEpoch))
+	require.Nil(t, err)
+
+	snapshotID, err := chainSimulator.TakeSnapshot()
+	require.Nil(t, err)
+
+	metaNode := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	snapshotNonce := metaNode.GetChainHandler().GetCurrentBlockHeader().GetNonce()
+
+	transferValue := big.NewInt(0).Mul(chainSimulatorCommon.OneEGLD, big.NewInt(5))
+	expectedBalance := big.NewInt(0).Add(chainSimulatorCommon.InitialAmount, transferValue)
+	for i := 0; i < 2; i++ {
+		tx := chainSimulatorCommon.GenerateTransaction(wallet0.Bytes, 0, wallet1.Bytes, transferValue, "", 50000)
+		_, err = chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 15)
+		require.Nil(t, err, "iteration %d", i)
+
+		err = chainSimulator.GenerateBlocksUntilEpochIsReached(int32(snapshotEpoch + 2))
+		require.Nil(t, err, "iteration %d", i)
+
+		account, errGet := chainSimulator.GetAccount(wallet1)
+		require.Nil(t, errGet)
+		require.Equal(t, expectedBalance.String(), account.Balance)
+
+		err = chainSimulator.RevertToSnapshot(snapshotID)
+		require.Nil(t, err)
+
+		require.Equal(t, snapshotNonce, metaNode.GetChainHandler().GetCurrentBlockHeader().GetNonce())
+		for shardID, node := range chainSimulator.nodes {
+			require.Equal(t, snapshotEpoch, node.GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch(), "shard %d", shardID)
+			require.Equal(t, snapshotEpoch, node.GetProcessComponents().EpochStartTrigger().Epoch(), "shard %d", shardID)
+		}
+
+		account, errGet = chainSimulator.GetAccount(wallet1)
+		require.Nil(t, errGet)
+		require.Equal(t, chainSimulatorCommon.InitialAmount.String(), account.Balance)
+	}
+
+	// the chain should be able to pass again through the reverted epochs
+	err = chainSimulator.GenerateBlocksUntilEpochIsReached(int32(snapshotEpoch + 2))
+	require.Nil(t, err)
+	for shardID, node := range chainSimulator.nodes {
+		require.Equal(t, snapshotEpoch+2, node.GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch(), "shard %d", shardID)
+	}
+}
+
+func TestSimulator_WarpToRoundAndTimestamp(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    20,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	metaNode := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	currentRound := metaNode.GetCoreComponents().RoundHandler().Index()
+
+	err = chainSimulator.WarpToRound(currentRound, 1)
+	require.ErrorIs(t, err, chainSimulatorErrors.ErrInvalidWarpTarget)
+
+	targetRound := int64(110)
+	err = chainSimulator.WarpToRound(targetRound, 2)
+	require.Nil(t, err)
+
+	require.Equal(t, targetRound, metaNode.GetCoreComponents().RoundHandler().Index())
+	require.Equal(t, uint64(targetRound), metaNode.GetChainHandler().GetCurrentBlockHeader().GetRound())
+	for shardID := uint32(0); shardID < 3; shardID++ {
+		require.Equal(t, uint32(5), chainSimulator.GetNodeHandler(shardID).GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch())
+	}
+	require.Equal(t, uint32(5), metaNode.GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch())
+
+	// more blocks than rounds left until the target: only the rounds left are produced
+	targetRound += 3
+	err = chainSimulator.WarpToRound(targetRound, 10)
+	require.Nil(t, err)
+	require.Equal(t, targetRound, metaNode.GetCoreComponents().RoundHandler().Index())
+	require.Equal(t, uint64(targetRound), metaNode.GetChainHandler().GetCurrentBlockHeader().GetRound())
+
+	targetTimestamp := metaNode.GetCoreComponents().RoundHandler().TimeStamp().Add(time.Hour).Unix()
+	err = chainSimulator.WarpTimestamp(targetTimestamp, 1)
+	require.Nil(t, err)
+
+	currentHeader := metaNode.GetChainHandler().GetCurrentBlockHeader()
+	require.Equal(t, uint64(targetTimestamp), currentHeader.GetTimeStamp())
+	require.Greater(t, currentHeader.GetEpoch(), uint32(5))
+
+	// the skipped rounds should not decrease the validators rating
+	err = chainSimulator.ForceResetValidatorStatisticsCache()
+	require.Nil(t, err)
+	statistics, err := metaNode.GetFacadeHandler().ValidatorStatisticsApi()
+	require.Nil(t, err)
+	require.NotEmpty(t, statistics)
+	ratingsData := metaNode.GetCoreComponents().RatingsData()
+	startRating := float32(ratingsData.StartRating()) * 100 / float32(ratingsData.MaxRating())
+	for _, validatorStatistics := range statistics {
+		require.GreaterOrEqual(t, validatorStatistics.TempRating, startRating)
+	}
+
+	wallet0, err := chainSimulator.GenerateAndMintWalletAddress(0, chainSimulatorCommon.InitialAmount)
+	require.Nil(t, err)
+
+	wallet1, err := chainSimulator.GenerateAndMintWalletAddress(1, chainSimulatorCommon.InitialAmount)
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	tx := chainSimulatorCommon.GenerateTransaction(wallet0.Bytes, 0, wallet1.Bytes, chainSimulatorCommon.OneEGLD, "", 50000)
+	_, err = chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 15)
+	require.Nil(t, err)
+}
+
+func TestSimulator_GetStateMultiple(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    20,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	contractAddress := "erd1qqqqqqqqqqqqqpgqmzzm05jeav6d5qvna0q2pmcllelkz8xddz3syjszx5"
+	userAddress := "erd1ss6u80ruas2phpmr82r42xnkd6rxy40g9jl69frppl4qez9w2jpsqj8x97"
+	systemAccountAddress, _ := chainSimulator.GetNodeHandler(0).GetCoreComponents().AddressPubKeyConverter().Encode(core.SystemAccountAddress)
+	userNonce := uint64(37)
+	err = chainSimulator.SetStateMultiple([]*dtos.AddressState{
+		{
+			Address:          contractAddress,
+			Nonce:            new(uint64),
+			Balance:          "431271308732096033771131",
+			Code:             "0061736d0100000001",
+			CodeMetadata:     "BQY=",
+			Owner:            userAddress,
+			DeveloperRewards: "5401004999998",
+			Pairs: map[string]string{
+				"73756d": "0a",
+			},
+		},
+		{
+			Address: userAddress,
+			Nonce:   &userNonce,
+			Balance: "1000",
+			Pairs: map[string]string{
+				"454c524f4e44657364744d45582d613131313131": "120101",
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	err = chainSimulator.SetKeyValueForAddress(systemAccountAddress, map[string]string{
+		"454c524f4e446573647454414d452d616161616161": "0801",
+	})
+	require.Nil(t, err)
+
+	addresses := []string{contractAddress, userAddress, systemAccountAddress}
+	exportedStates, err := chainSimulator.GetStateMultiple(addresses)
+	require.Nil(t, err)
+	require.Len(t, exportedStates, 3)
+
+	contractState := exportedStates[0]
+	require.Equal(t, contractAddress, contractState.Address)
+	require.Equal(t, uint64(0), *contractState.Nonce)
+	require.Equal(t, "431271308732096033771131", contractState.Balance)
+	require.Equal(t, "0061736d0100000001", contractState.Code)
+	require.NotEmpty(t, contractState.CodeHash)
+	require.Equal(t, "BQY=", contractState.CodeMetadata)
+	require.Equal(t, userAddress, contractState.Owner)
+	require.Equal(t, "5401004999998", contractState.DeveloperRewards)
+	require.Equal(t, map[string]string{"73756d": "0a"}, contractState.Pairs)
+
+	userState := exportedStates[1]
+	require.Equal(t, userAddress, userState.Address)
+	require.Equal(t, userNonce, *userState.Nonce)
+	require.Equal(t, "1000", userState.Balance)
+	require.Equal(t, map[string]string{"454c524f4e44657364744d45582d613131313131": "120101"}, userState.Pairs)
+
+	systemAccountState := exportedStates[2]
+	require.Equal(t, "0801", systemAccountState.Pairs["454c524f4e446573647454414d452d616161616161"])
+
+	// replaying the exported state over clean accounts should yield the same state
+	err = chainSimulator.RemoveAccounts(addresses)
+	require.Nil(t, err)
+
+	err = chainSimulator.SetStateMultiple(exportedStates)
+	require.Nil(t, err)
+
+	replayedStates, err := chainSimulator.GetStateMultiple(addresses)
+	require.Nil(t, err)
+	require.Equal(t, exportedStates, replayedStates)
+
+	_, err = chainSimulator.GetStateMultiple([]string{"invalid address"})
+	require.NotNil(t, err)
+}
+
+func TestSimulator_InjectFault(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    100,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.InjectFault(10, 100, process.Fault{Type: process.SkippedLeader})
+	require.ErrorIs(t, err, chainSimulatorErrors.ErrUnknownShard)
+
+	wallet0, err := chainSimulator.GenerateAndMintWalletAddress(0, chainSimulatorCommon.InitialAmount)
+	require.Nil(t, err)
+
+	wallet1, err := chainSimulator.GenerateAndMintWalletAddress(0, chainSimulatorCommon.InitialAmount)
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	metaNode := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	shardNode := chainSimulator.GetNodeHandler(0)
+	currentRound := metaNode.GetCoreComponents().RoundHandler().Index()
+	shardNonce := shardNode.GetChainHandler().GetCurrentBlockHeader().GetNonce()
+	metaNonce := metaNode.GetChainHandler().GetCurrentBlockHeader().GetNonce()
+
+	err = chainSimulator.InjectFault(0, currentRound, process.Fault{Type: process.SkippedLeader})
+	require.ErrorIs(t, err, process.ErrInvalidFaultRound)
+
+	err = chainSimulator.InjectFault(0, currentRound+1, process.Fault{Type: process.SkippedLeader})
+	require.Nil(t, err)
+	err = chainSimulator.InjectFault(0, currentRound+2, process.Fault{Type: process.RejectedBlock})
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(2)
+	require.Nil(t, err)
+
+	require.Equal(t, shardNonce, shardNode.GetChainHandler().GetCurrentBlockHeader().GetNonce())
+	require.Equal(t, metaNonce+2, metaNode.GetChainHandler().GetCurrentBlockHeader().GetNonce())
+
+	currentRound = metaNode.GetCoreComponents().RoundHandler().Index()
+	err = chainSimulator.InjectFault(0, currentRound+1, process.Fault{Type: process.CompetingBlock})
+	require.Nil(t, err)
+	err = chainSimulator.InjectFault(core.MetachainShardId, currentRound+1, process.Fault{Type: process.CompetingBlock})
+	require.Nil(t, err)
+
+	transferValue := big.NewInt(0).Mul(chainSimulatorCommon.OneEGLD, big.NewInt(5))
+	tx := chainSimulatorCommon.GenerateTransaction(wallet0.Bytes, 0, wallet1.Bytes, transferValue, "", 50000)
+	_, err = chainSimulator.sendTx(tx)
+	require.Nil(t, err)
+	time.Sleep(delaySendTxs)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	currentHeader := shardNode.GetChainHandler().GetCurrentBlockHeader()
+	require.Equal(t, shardNonce+1, currentHeader.GetNonce())
+	require.Equal(t, uint64(currentRound+1), currentHeader.GetRound())
+	require.Equal(t, metaNonce+3, metaNode.GetChainHandler().GetCurrentBlockHeader().GetNonce())
+
+	err = chainSimulator.GenerateBlocks(5)
+	require.Nil(t, err)
+
+	require.Equal(t, shardNonce+6, shardNode.GetChainHandler().GetCurrentBlockHeader().GetNonce())
+	require.Equal(t, metaNonce+8, metaNode.GetChainHandler().GetCurrentBlockHeader().GetNonce())
+
+	expectedBalance := big.NewInt(0).Add(chainSimulatorCommon.InitialAmount, transferValue)
+	account, err := chainSimulator.GetAccount(wallet1)
+	require.Nil(t, err)
+	require.Equal(t, expectedBalance.String(), account.Balance)
+}
+
+func TestSimulator_InjectMissingSignaturesFault(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	consensusGroupSize := uint32(4)
+	chainSimulator, err := NewBaseChainSimulator(ArgsBaseChainSimulator{
+		ArgsChainSimulator: ArgsChainSimulator{
+			BypassTxSignatureCheck: true,
+			TempDir:                t.TempDir(),
+			PathToInitialConfig:    defaultPathToInitialConfig,
+			NumOfShards:            3,
+			GenesisTimestamp:       time.Now().Unix(),
+			RoundDurationInMillis:  uint64(6000),
+			RoundsPerEpoch:         core.OptionalUint64{HasValue: true, Value: 100},
+			ApiInterface:           api.NewNoApiInterface(),
+			MinNodesPerShard:       consensusGroupSize,
+			MetaChainMinNodes:      consensusGroupSize,
+		},
+		ConsensusGroupSize:          consensusGroupSize,
+		MetaChainConsensusGroupSize: consensusGroupSize,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	shardNode := chainSimulator.GetNodeHandler(0)
+	currentRound := shardNode.GetCoreComponents().RoundHandler().Index()
+	shardNonce := shardNode.GetChainHandler().GetCurrentBlockHeader().GetNonce()
+
+	err = chainSimulator.InjectFault(0, currentRound+1, process.Fault{
+		Type:                  process.MissingSignatures,
+		MissingSignersIndexes: []int{int(consensusGroupSize)},
+	})
+	require.ErrorIs(t, err, process.ErrInvalidMissingSignerIndex)
+
+	err = chainSimulator.InjectFault(0, currentRound+1, process.Fault{
+		Type:                  process.MissingSignatures,
+		MissingSignersIndexes: []int{2},
+	})
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	currentHeader := shardNode.GetChainHandler().GetCurrentBlockHeader()
+	require.Equal(t, shardNonce+1, currentHeader.GetNonce())
+	require.Equal(t, []byte{0x0b}, currentHeader.GetPubKeysBitmap())
+
+	// the remaining signers are enough to reach the consensus threshold, so the chain continues normally
+	err = chainSimulator.GenerateBlocks(3)
+	require.Nil(t, err)
+
+	currentHeader = shardNode.GetChainHandler().GetCurrentBlockHeader()
+	require.Equal(t, shardNonce+4, currentHeader.GetNonce())
+	require.Equal(t, []byte{0x0f}, currentHeader.GetPubKeysBitmap())
+}
@@ -193,7 +193,12 @@ func CreateCoreComponents(args ArgsCoreComponentsHolder) (*coreComponentsHolder,
 		return nil, err
 	}
 
-	instance.rater, err = rating.NewBlockSigningRater(instance.ratingsData)
+	blockSigningRater, err := rating.NewBlockSigningRater(instance.ratingsData)
+	if err != nil {
+		return nil, err
+	}
+
+	instance.rater, err = NewSkippedRoundsRater(blockSigningRater, instance.roundHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -27,6 +27,11 @@ func (handler *manualRoundHandler) IncrementIndex() {
 	atomic.AddInt64(&handler.index, 1)
 }
 
+// SetIndex will set the current round index to the provided value
+func (handler *manualRoundHandler) SetIndex(index int64) {
+	atomic.StoreInt64(&handler.index, index)
+}
+
 // Index returns the current index
 func (handler *manualRoundHandler) Index() int64 {
 	return atomic.LoadInt64(&handler.index)
@@ -34,6 +34,10 @@ func TestManualRoundHandler_Operations(t *testing.T) {
 	require.Equal(t, providedIndex, handler.Index())
 	handler.IncrementIndex()
 	require.Equal(t, providedIndex+1, handler.Index())
+	handler.SetIndex(providedIndex + 10)
+	require.Equal(t, providedIndex+10, handler.Index())
+	handler.SetIndex(providedIndex + 1)
+	require.Equal(t, providedIndex+1, handler.Index())
 	expectedTimestamp := time.Unix(handler.genesisTimeStamp, 0).Add(providedRoundDuration)
 	require.Equal(t, expectedTimestamp, handler.TimeStamp())
 	require.Equal(t, providedRoundDuration, handler.TimeDuration())
@@ -0,0 +1,76 @@
+package components
+
+import (
+	"sync/atomic"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/sharding"
+)
+
+// skippedRoundsRater wraps a rater and does not apply the rating penalties while the current round is at or before
+// a provided round. It is used so that the rounds skipped by the chain simulator are not accounted as missed blocks
+type skippedRoundsRater struct {
+	sharding.PeerAccountListAndRatingHandler
+	roundHandler      consensus.RoundHandler
+	lastRoundToIgnore int64
+}
+
+// NewSkippedRoundsRater returns a rater that can ignore the rating penalties caused by skipped rounds
+func NewSkippedRoundsRater(rater sharding.PeerAccountListAndRatingHandler, roundHandler consensus.RoundHandler) (*skippedRoundsRater, error) {
+	if check.IfNil(rater) {
+		return nil, errors.ErrNilRater
+	}
+	if check.IfNil(roundHandler) {
+		return nil, errors.ErrNilRoundHandler
+	}
+
+	return &skippedRoundsRater{
+		PeerAccountListAndRatingHandler: rater,
+		roundHandler:                    roundHandler,
+		lastRoundToIgnore:               -1,
+	}, nil
+}
+
+// IgnorePenaltiesUntilRound will not apply the rating penalties for the blocks processed up to and including the
+// provided round
+func (rater *skippedRoundsRater) IgnorePenaltiesUntilRound(round int64) {
+	atomic.StoreInt64(&rater.lastRoundToIgnore, round)
+}
+
+// ComputeDecreaseProposer returns the current rating if the penalties are ignored in the current round
+func (rater *skippedRoundsRater) ComputeDecreaseProposer(shardId uint32, currentRating uint32, consecutiveMisses uint32) uint32 {
+	if rater.shouldIgnorePenalties() {
+		return currentRating
+	}
+
+	return rater.PeerAccountListAndRatingHandler.ComputeDecreaseProposer(shardId, currentRating, consecutiveMisses)
+}
+
+// ComputeDecreaseValidator returns the current rating if the penalties are ignored in the current round
+func (rater *skippedRoundsRater) ComputeDecreaseValidator(shardId uint32, currentRating uint32) uint32 {
+	if rater.shouldIgnorePenalties() {
+		return currentRating
+	}
+
+	return rater.PeerAccountListAndRatingHandler.ComputeDecreaseValidator(shardId, currentRating)
+}
+
+// RevertIncreaseValidator returns the current rating if the penalties are ignored in the current round
+func (rater *skippedRoundsRater) RevertIncreaseValidator(shardId uint32, currentRating uint32, nrReverts uint32) uint32 {
+	if rater.shouldIgnorePenalties() {
+		return currentRating
+	}
+
+	return rater.PeerAccountListAndRatingHandler.RevertIncreaseValidator(shardId, currentRating, nrReverts)
+}
+
+func (rater *skippedRoundsRater) shouldIgnorePenalties() bool {
+	return rater.roundHandler.Index() <= atomic.LoadInt64(&rater.lastRoundToIgnore)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rater *skippedRoundsRater) IsInterfaceNil() bool {
+	return rater == nil
+}
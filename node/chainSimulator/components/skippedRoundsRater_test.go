@@ -0,0 +1,83 @@
+package components
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSkippedRoundsRater(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil rater should error", func(t *testing.T) {
+		t.Parallel()
+
+		rater, err := NewSkippedRoundsRater(nil, NewManualRoundHandler(0, time.Second, 0))
+		require.Equal(t, errors.ErrNilRater, err)
+		require.Nil(t, rater)
+	})
+	t.Run("nil round handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		rater, err := NewSkippedRoundsRater(&testscommon.RaterMock{}, nil)
+		require.Equal(t, errors.ErrNilRoundHandler, err)
+		require.Nil(t, rater)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rater, err := NewSkippedRoundsRater(&testscommon.RaterMock{}, NewManualRoundHandler(0, time.Second, 0))
+		require.NoError(t, err)
+		require.NotNil(t, rater)
+	})
+}
+
+func TestSkippedRoundsRater_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var rater *skippedRoundsRater
+	require.True(t, rater.IsInterfaceNil())
+
+	rater, _ = NewSkippedRoundsRater(&testscommon.RaterMock{}, NewManualRoundHandler(0, time.Second, 0))
+	require.False(t, rater.IsInterfaceNil())
+}
+
+func TestSkippedRoundsRater_IgnorePenaltiesUntilRound(t *testing.T) {
+	t.Parallel()
+
+	currentRating := uint32(100)
+	penalizedRating := uint32(10)
+	raterMock := &testscommon.RaterMock{
+		ComputeDecreaseProposerCalled: func(shardId uint32, rating uint32, consecutiveMissedBlocks uint32) uint32 {
+			return penalizedRating
+		},
+		ComputeDecreaseValidatorCalled: func(shardId uint32, rating uint32) uint32 {
+			return penalizedRating
+		},
+		RevertIncreaseValidatorCalled: func(shardId uint32, rating uint32, nrReverts uint32) uint32 {
+			return penalizedRating
+		},
+	}
+	roundHandler := NewManualRoundHandler(0, time.Second, 10)
+	rater, _ := NewSkippedRoundsRater(raterMock, roundHandler)
+
+	requirePenalties := func(expectedRating uint32) {
+		require.Equal(t, expectedRating, rater.ComputeDecreaseProposer(0, currentRating, 1))
+		require.Equal(t, expectedRating, rater.ComputeDecreaseValidator(0, currentRating))
+		require.Equal(t, expectedRating, rater.RevertIncreaseValidator(0, currentRating, 1))
+	}
+
+	requirePenalties(penalizedRating)
+
+	rater.IgnorePenaltiesUntilRound(12)
+	requirePenalties(currentRating)
+
+	roundHandler.SetIndex(12)
+	requirePenalties(currentRating)
+
+	roundHandler.SetIndex(13)
+	requirePenalties(penalizedRating)
+}
@@ -36,6 +36,8 @@ func TestCreateStore(t *testing.T) {
 		dataRetriever.EpochByHashUnit,
 		dataRetriever.ResultsHashesByTxHashUnit,
 		dataRetriever.TrieEpochRootHashUnit,
+		dataRetriever.AddressHistoryUnit,
+		dataRetriever.EventsIndexUnit,
 		dataRetriever.ShardHdrNonceHashDataUnit,
 		dataRetriever.UnitType(101), // shard 2
 	}
@@ -1,6 +1,7 @@
 package components
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
@@ -8,6 +9,8 @@ import (
 	"math/big"
 
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/errChan"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/spos/sposFactory"
@@ -26,7 +29,9 @@ import (
 	"github.com/multiversx/mx-chain-go/state"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	chainData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/endProcess"
 )
 
@@ -186,6 +191,15 @@ func NewTestOnlyProcessingNode(args ArgsTestOnlyProcessingNode) (*testOnlyProces
 		return nil, err
 	}
 
+	trackedHeaders, err := NewTrackedHeadersPool(instance.DataPool.Headers())
+	if err != nil {
+		return nil, err
+	}
+	instance.DataPool = &poolsHolderWithTrackedHeaders{
+		PoolsHolder: instance.DataPool,
+		headers:     trackedHeaders,
+	}
+
 	err = instance.createNodesCoordinator(args.Configs.PreferencesConfig.Preferences, *args.Configs.GeneralConfig)
 	if err != nil {
 		return nil, err
@@ -388,6 +402,11 @@ func (node *testOnlyProcessingNode) GetStatusCoreComponents() factory.StatusCore
 	return node.StatusCoreComponents
 }
 
+// GetStatusComponents will return the status components
+func (node *testOnlyProcessingNode) GetStatusComponents() factory.StatusComponentsHolder {
+	return node.StatusComponentsHolder
+}
+
 func (node *testOnlyProcessingNode) collectClosableComponents(apiInterface APIConfigurator) {
 	node.closeHandler.AddComponent(node.ProcessComponentsHolder)
 	node.closeHandler.AddComponent(node.DataComponentsHolder)
@@ -489,6 +508,80 @@ func (node *testOnlyProcessingNode) SetStateForAddress(address []byte, addressSt
 	return err
 }
 
+// GetStateForAddress will return the state of the given address, in the same format accepted by SetStateForAddress
+func (node *testOnlyProcessingNode) GetStateForAddress(address []byte) (*dtos.AddressState, error) {
+	accountsAdapter := node.StateComponentsHolder.AccountsAdapter()
+	account, err := accountsAdapter.GetExistingAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, errors.New("cannot cast AccountHandler to UserAccountHandler")
+	}
+
+	addressConverter := node.CoreComponentsHolder.AddressPubKeyConverter()
+	accountResponse := &api.AccountResponse{
+		Nonce:           userAccount.GetNonce(),
+		Balance:         userAccount.GetBalance().String(),
+		Code:            hex.EncodeToString(accountsAdapter.GetCode(userAccount.GetCodeHash())),
+		CodeHash:        userAccount.GetCodeHash(),
+		CodeMetadata:    userAccount.GetCodeMetadata(),
+		DeveloperReward: userAccount.GetDeveloperReward().String(),
+	}
+
+	accountResponse.Address, err = addressConverter.Encode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(userAccount.GetOwnerAddress()) > 0 {
+		accountResponse.OwnerAddress, err = addressConverter.Encode(userAccount.GetOwnerAddress())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	accountResponse.Pairs, err = getKeyValueMap(userAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.NewAddressStateFromAccountResponse(accountResponse), nil
+}
+
+func getKeyValueMap(userAccount state.UserAccountHandler) (map[string]string, error) {
+	if check.IfNil(userAccount.DataTrie()) {
+		return nil, nil
+	}
+
+	chLeaves := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err := userAccount.GetAllLeaves(chLeaves, context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	keyValueMap := make(map[string]string)
+	for leaf := range chLeaves.LeavesChan {
+		keyValueMap[hex.EncodeToString(leaf.Key())] = hex.EncodeToString(leaf.Value())
+	}
+
+	err = chLeaves.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keyValueMap) == 0 {
+		return nil, nil
+	}
+
+	return keyValueMap, nil
+}
+
 // RemoveAccount will remove the account for the given address
 func (node *testOnlyProcessingNode) RemoveAccount(address []byte) error {
 	accountsAdapter := node.StateComponentsHolder.AccountsAdapter()
@@ -420,6 +420,62 @@ func TestTestOnlyProcessingNode_SetStateForAddress(t *testing.T) {
 	})
 }
 
+func TestTestOnlyProcessingNode_GetStateForAddress(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	node, err := NewTestOnlyProcessingNode(createMockArgsTestOnlyProcessingNode(t))
+	require.NoError(t, err)
+	nonce := uint64(100)
+
+	address := "erd1qtc600lryvytxuy4h7vn7xmsy5tw6vuw3tskr75cwnmv4mnyjgsq6e5zgj"
+	addressBytes, _ := node.CoreComponentsHolder.AddressPubKeyConverter().Decode(address)
+
+	t.Run("missing account should error", func(t *testing.T) {
+		addressState, errGet := node.GetStateForAddress(addressBytes)
+		require.Error(t, errGet)
+		require.Nil(t, addressState)
+	})
+	t.Run("should work", func(t *testing.T) {
+		err = node.SetStateForAddress(addressBytes, &dtos.AddressState{
+			Address: address,
+			Nonce:   &nonce,
+			Balance: "1000000000000000000",
+			Pairs: map[string]string{
+				"01": "02",
+			},
+		})
+		require.NoError(t, err)
+
+		addressState, errGet := node.GetStateForAddress(addressBytes)
+		require.NoError(t, errGet)
+		require.Equal(t, address, addressState.Address)
+		require.Equal(t, nonce, *addressState.Nonce)
+		require.Equal(t, "1000000000000000000", addressState.Balance)
+		require.Equal(t, map[string]string{"01": "02"}, addressState.Pairs)
+		require.Empty(t, addressState.Code)
+		require.Empty(t, addressState.Owner)
+	})
+	t.Run("account un-castable to UserAccountHandler should error", func(t *testing.T) {
+		nodeLocal, errLocal := NewTestOnlyProcessingNode(createMockArgsTestOnlyProcessingNode(t))
+		require.NoError(t, errLocal)
+
+		nodeLocal.StateComponentsHolder = &factory.StateComponentsMock{
+			Accounts: &state.AccountsStub{
+				GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+					return &state.PeerAccountHandlerMock{}, nil
+				},
+			},
+		}
+
+		addressState, errGet := nodeLocal.GetStateForAddress(addressBytes)
+		require.Error(t, errGet)
+		require.Equal(t, "cannot cast AccountHandler to UserAccountHandler", errGet.Error())
+		require.Nil(t, addressState)
+	})
+}
+
 func TestTestOnlyProcessingNode_IsInterfaceNil(t *testing.T) {
 	if testing.Short() {
 		t.Skip("this is not a short test")
@@ -0,0 +1,103 @@
+package components
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+)
+
+// trackedHeadersPool wraps a headers pool and calls the registered handlers itself, so it can wait for the handlers
+// still running for the already added headers. The chain simulator needs this when reverting to a snapshot, as a
+// handler that finishes after the revert would apply a header that no longer belongs to the chain
+type trackedHeadersPool struct {
+	dataRetriever.HeadersPool
+	mutAddHeader         sync.Mutex
+	mutAddedDataHandlers sync.RWMutex
+	addedDataHandlers    []func(headerHandler data.HeaderHandler, headerHash []byte)
+	pendingHandlers      sync.WaitGroup
+}
+
+// NewTrackedHeadersPool returns a headers pool that can wait for its pending handlers
+func NewTrackedHeadersPool(headersPool dataRetriever.HeadersPool) (*trackedHeadersPool, error) {
+	if check.IfNil(headersPool) {
+		return nil, dataRetriever.ErrNilHeadersDataPool
+	}
+
+	return &trackedHeadersPool{
+		HeadersPool: headersPool,
+	}, nil
+}
+
+// AddHeader adds the header in the wrapped pool and calls the registered handlers if the header was newly added
+func (pool *trackedHeadersPool) AddHeader(headerHash []byte, header data.HeaderHandler) {
+	pool.mutAddHeader.Lock()
+	defer pool.mutAddHeader.Unlock()
+
+	if pool.containsHeader(headerHash) {
+		return
+	}
+
+	pool.HeadersPool.AddHeader(headerHash, header)
+	if !pool.containsHeader(headerHash) {
+		return
+	}
+
+	pool.callAddedDataHandlers(header, headerHash)
+}
+
+func (pool *trackedHeadersPool) containsHeader(headerHash []byte) bool {
+	_, err := pool.HeadersPool.GetHeaderByHash(headerHash)
+	return err == nil
+}
+
+func (pool *trackedHeadersPool) callAddedDataHandlers(headerHandler data.HeaderHandler, headerHash []byte) {
+	pool.mutAddedDataHandlers.RLock()
+	for _, handler := range pool.addedDataHandlers {
+		pool.pendingHandlers.Add(1)
+		go func(handler func(headerHandler data.HeaderHandler, headerHash []byte)) {
+			defer pool.pendingHandlers.Done()
+
+			handler(headerHandler, headerHash)
+		}(handler)
+	}
+	pool.mutAddedDataHandlers.RUnlock()
+}
+
+// RegisterHandler registers a new handler to be called when a new header is added
+func (pool *trackedHeadersPool) RegisterHandler(handler func(headerHandler data.HeaderHandler, headerHash []byte)) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler to a tracked headers pool object")
+		return
+	}
+
+	pool.mutAddedDataHandlers.Lock()
+	pool.addedDataHandlers = append(pool.addedDataHandlers, handler)
+	pool.mutAddedDataHandlers.Unlock()
+}
+
+// WaitForPendingHandlers blocks until all the handlers called for the already added headers have finished
+func (pool *trackedHeadersPool) WaitForPendingHandlers() {
+	pool.pendingHandlers.Wait()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pool *trackedHeadersPool) IsInterfaceNil() bool {
+	return pool == nil
+}
+
+type poolsHolderWithTrackedHeaders struct {
+	dataRetriever.PoolsHolder
+	headers dataRetriever.HeadersPool
+}
+
+// Headers returns the tracked headers pool
+func (holder *poolsHolderWithTrackedHeaders) Headers() dataRetriever.HeadersPool {
+	return holder.headers
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *poolsHolderWithTrackedHeaders) IsInterfaceNil() bool {
+	return holder == nil
+}
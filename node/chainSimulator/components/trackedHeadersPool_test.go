@@ -0,0 +1,93 @@
+package components
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dataRetriever/dataPool/headersCache"
+	dataRetrieverMock "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
+	"github.com/stretchr/testify/require"
+)
+
+func createHeadersPool(t *testing.T) dataRetriever.HeadersPool {
+	headersPool, err := headersCache.NewHeadersPool(config.HeadersPoolConfig{
+		MaxHeadersPerShard:            1000,
+		NumElementsToRemoveOnEviction: 100,
+	})
+	require.Nil(t, err)
+
+	return headersPool
+}
+
+func TestNewTrackedHeadersPool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil headers pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := NewTrackedHeadersPool(nil)
+		require.Equal(t, dataRetriever.ErrNilHeadersDataPool, err)
+		require.Nil(t, pool)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := NewTrackedHeadersPool(createHeadersPool(t))
+		require.NoError(t, err)
+		require.NotNil(t, pool)
+	})
+}
+
+func TestTrackedHeadersPool_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var pool *trackedHeadersPool
+	require.True(t, pool.IsInterfaceNil())
+
+	pool, _ = NewTrackedHeadersPool(createHeadersPool(t))
+	require.False(t, pool.IsInterfaceNil())
+}
+
+func TestTrackedHeadersPool_AddHeaderShouldCallTheHandlersOnlyForNewHeaders(t *testing.T) {
+	t.Parallel()
+
+	pool, _ := NewTrackedHeadersPool(createHeadersPool(t))
+	pool.RegisterHandler(nil)
+
+	numCalls := uint32(0)
+	handler := func(header data.HeaderHandler, hash []byte) {
+		time.Sleep(time.Millisecond * 10)
+		atomic.AddUint32(&numCalls, 1)
+	}
+	pool.RegisterHandler(handler)
+	pool.RegisterHandler(handler)
+
+	for i := uint64(0); i < 3; i++ {
+		header := &block.Header{Nonce: i}
+		hash := []byte{byte(i)}
+		pool.AddHeader(hash, header)
+		pool.AddHeader(hash, header)
+	}
+
+	pool.WaitForPendingHandlers()
+	require.Equal(t, uint32(6), atomic.LoadUint32(&numCalls))
+	require.Equal(t, 3, pool.Len())
+}
+
+func TestPoolsHolderWithTrackedHeaders_Headers(t *testing.T) {
+	t.Parallel()
+
+	trackedHeaders, _ := NewTrackedHeadersPool(createHeadersPool(t))
+	holder := &poolsHolderWithTrackedHeaders{
+		PoolsHolder: dataRetrieverMock.NewPoolsHolderMock(),
+		headers:     trackedHeaders,
+	}
+	require.False(t, holder.IsInterfaceNil())
+	require.Equal(t, trackedHeaders, holder.Headers())
+	require.NotNil(t, holder.Transactions())
+}
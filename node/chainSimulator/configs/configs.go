@@ -118,6 +118,8 @@ func CreateChainSimulatorConfigs(args ArgsChainSimulatorConfigs) (*ArgsConfigsSi
 
 	// enable db lookup extension
 	configs.GeneralConfig.DbLookupExtensions.Enabled = true
+	configs.GeneralConfig.DbLookupExtensions.AddressHistoryIndexEnabled = true
+	configs.GeneralConfig.DbLookupExtensions.EventsIndexEnabled = true
 
 	configs.GeneralConfig.EpochStartConfig.ExtraDelayForRequestBlockInfoInMilliseconds = 1
 	configs.GeneralConfig.EpochStartConfig.GenesisEpoch = args.InitialEpoch
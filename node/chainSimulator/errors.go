@@ -6,4 +6,6 @@ var (
 	errNilChainSimulator = errors.New("nil chain simulator")
 	errNilMetachainNode  = errors.New("nil metachain node")
 	errShardSetupError   = errors.New("shard setup error")
+
+	errBlockTrackerRestoreMismatch = errors.New("block tracker restore mismatch")
 )
@@ -10,3 +10,12 @@ var ErrNilTransaction = errors.New("nil transaction")
 
 // ErrInvalidMaxNumOfBlocks signals that an invalid max numerof blocks has been provided
 var ErrInvalidMaxNumOfBlocks = errors.New("invalid max number of blocks to generate")
+
+// ErrSnapshotNotFound signals that the provided snapshot identifier was not found
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// ErrInvalidWarpTarget signals that the provided warp target is not in the future
+var ErrInvalidWarpTarget = errors.New("invalid warp target, it should be after the current round")
+
+// ErrUnknownShard signals that the provided shard is not handled by the chain simulator
+var ErrUnknownShard = errors.New("unknown shard")
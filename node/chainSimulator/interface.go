@@ -5,13 +5,20 @@ import "github.com/multiversx/mx-chain-go/node/chainSimulator/process"
 // ChainHandler defines what a chain handler should be able to do
 type ChainHandler interface {
 	IncrementRound()
+	SetRound(round int64)
 	CreateNewBlock() error
+	InjectFault(round int64, fault process.Fault) error
 	IsInterfaceNil() bool
 }
 
 // ChainSimulator defines what a chain simulator should be able to do
 type ChainSimulator interface {
 	GenerateBlocks(numOfBlocks int) error
+	WarpToRound(targetRound int64, numOfBlocks int) error
+	WarpTimestamp(timestamp int64, numOfBlocks int) error
+	InjectFault(shardID uint32, round int64, fault process.Fault) error
 	GetNodeHandler(shardID uint32) process.NodeHandler
+	TakeSnapshot() (int, error)
+	RevertToSnapshot(snapshotID int) error
 	IsInterfaceNil() bool
 }
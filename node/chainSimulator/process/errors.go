@@ -4,3 +4,15 @@ import "errors"
 
 // ErrNilNodeHandler signals that a nil node handler has been provided
 var ErrNilNodeHandler = errors.New("nil node handler")
+
+// ErrUnknownFaultType signals that an unknown fault type has been provided
+var ErrUnknownFaultType = errors.New("unknown fault type")
+
+// ErrInvalidFaultRound signals that the fault was requested for a round that was already produced
+var ErrInvalidFaultRound = errors.New("invalid fault round")
+
+// ErrNoMissingSigners signals that the missing signatures fault was requested without any missing signer
+var ErrNoMissingSigners = errors.New("no missing signers provided")
+
+// ErrInvalidMissingSignerIndex signals that an invalid missing signer index has been provided
+var ErrInvalidMissingSignerIndex = errors.New("invalid missing signer index")
@@ -0,0 +1,233 @@
+package process
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/consensus/spos"
+	mxProcess "github.com/multiversx/mx-chain-go/process"
+)
+
+const maxTimeToProcessCompetingBlocks = time.Hour
+
+// FaultType defines the type of fault that can be injected in a round
+type FaultType uint8
+
+const (
+	// NoFault signals that the block of the round will be produced normally
+	NoFault FaultType = iota
+	// SkippedLeader signals that the leader of the round will not propose any block
+	SkippedLeader
+	// RejectedBlock signals that the block proposed by the leader will be created and then discarded
+	RejectedBlock
+	// MissingSignatures signals that some of the consensus group members will not sign the block
+	MissingSignatures
+	// CompetingBlock signals that a second block, with the same nonce and round, will compete with the proposed one
+	CompetingBlock
+)
+
+// String returns the human-readable representation of the fault type
+func (ft FaultType) String() string {
+	switch ft {
+	case NoFault:
+		return "no fault"
+	case SkippedLeader:
+		return "skipped leader"
+	case RejectedBlock:
+		return "rejected block"
+	case MissingSignatures:
+		return "missing signatures"
+	case CompetingBlock:
+		return "competing block"
+	default:
+		return fmt.Sprintf("unknown fault type %d", ft)
+	}
+}
+
+// Fault holds the fault definition that will be applied when creating the block of a round
+type Fault struct {
+	Type FaultType
+	// MissingSignersIndexes holds the indexes in the consensus group of the validators that will not sign the block.
+	// Used only for the MissingSignatures fault type. The leader (index 0) is always signing. If the remaining signers
+	// are not enough to reach the consensus threshold, the block will be rejected by the other shards.
+	MissingSignersIndexes []int
+}
+
+func (creator *blocksCreator) checkFault(fault Fault) error {
+	switch fault.Type {
+	case NoFault, SkippedLeader, RejectedBlock, CompetingBlock:
+		return nil
+	case MissingSignatures:
+		shardID := creator.nodeHandler.GetShardCoordinator().SelfId()
+		consensusGroupSize := creator.nodeHandler.GetProcessComponents().NodesCoordinator().ConsensusGroupSize(shardID)
+		return checkMissingSignersIndexes(fault.MissingSignersIndexes, consensusGroupSize)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnknownFaultType, fault.Type)
+	}
+}
+
+func checkMissingSignersIndexes(missingSignersIndexes []int, consensusGroupSize int) error {
+	if len(missingSignersIndexes) == 0 {
+		return ErrNoMissingSigners
+	}
+	for _, idx := range missingSignersIndexes {
+		if idx <= spos.IndexOfLeaderInConsensusGroup || idx >= consensusGroupSize {
+			return fmt.Errorf("%w: %d, consensus group size: %d", ErrInvalidMissingSignerIndex, idx, consensusGroupSize)
+		}
+	}
+
+	return nil
+}
+
+// InjectFault will register the provided fault for the given round. The fault is applied only once, when the block
+// of that round is created
+func (creator *blocksCreator) InjectFault(round int64, fault Fault) error {
+	err := creator.checkFault(fault)
+	if err != nil {
+		return err
+	}
+
+	currentRound := creator.nodeHandler.GetCoreComponents().RoundHandler().Index()
+	if round <= currentRound {
+		return fmt.Errorf("%w: provided round %d, current round %d", ErrInvalidFaultRound, round, currentRound)
+	}
+
+	creator.mutFaults.Lock()
+	creator.faults[round] = fault
+	creator.mutFaults.Unlock()
+
+	return nil
+}
+
+// ClearFaults will remove all the registered faults
+func (creator *blocksCreator) ClearFaults() {
+	creator.mutFaults.Lock()
+	creator.faults = make(map[int64]Fault)
+	creator.mutFaults.Unlock()
+}
+
+func (creator *blocksCreator) popFault(round int64) Fault {
+	creator.mutFaults.Lock()
+	defer creator.mutFaults.Unlock()
+
+	fault, found := creator.faults[round]
+	if !found {
+		return Fault{Type: NoFault}
+	}
+	delete(creator.faults, round)
+
+	return fault
+}
+
+func neverHaveTime() bool {
+	return false
+}
+
+func haveTimeToProcessCompetingBlocks() time.Duration {
+	return maxTimeToProcessCompetingBlocks
+}
+
+// commitWithCompetingBlock creates a second block for the same round and nonce that does not include any
+// transaction from the pools and lets the fork detector choose between the two blocks. The losing block is committed
+// first and then rolled back, the same way a node does when it finds out it is on the wrong fork. It returns the
+// block that remained committed.
+func (creator *blocksCreator) commitWithCompetingBlock(proposed *proposedBlock, missingSignersIndexes []int) (*proposedBlock, error) {
+	bp := creator.nodeHandler.GetProcessComponents().BlockProcessor()
+	if proposed.header.IsStartOfEpochBlock() {
+		log.Debug("injected fault: competing blocks are not created for epoch start blocks",
+			"shard", proposed.header.GetShardID(),
+			"nonce", proposed.header.GetNonce())
+		return proposed, bp.CommitBlock(proposed.header, proposed.body)
+	}
+
+	chainHandler := creator.nodeHandler.GetChainHandler()
+	prevHeader := chainHandler.GetCurrentBlockHeader()
+	prevHeaderHash := chainHandler.GetCurrentBlockHeaderHash()
+	prevRootHash := chainHandler.GetCurrentBlockRootHash()
+
+	bp.RevertCurrentBlock()
+	competing, err := creator.proposeBlock(missingSignersIndexes, neverHaveTime)
+	if err != nil {
+		return nil, err
+	}
+
+	marshaller := creator.nodeHandler.GetCoreComponents().InternalMarshalizer()
+	hasher := creator.nodeHandler.GetCoreComponents().Hasher()
+	proposedHash, err := core.CalculateHash(marshaller, hasher, proposed.header)
+	if err != nil {
+		return nil, err
+	}
+	competingHash, err := core.CalculateHash(marshaller, hasher, competing.header)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := bytes.Compare(proposedHash, competingHash)
+	if comparison == 0 {
+		log.Debug("injected fault: competing block is identical with the proposed one, nothing to compete for",
+			"shard", proposed.header.GetShardID(),
+			"nonce", proposed.header.GetNonce())
+		return competing, bp.CommitBlock(competing.header, competing.body)
+	}
+
+	// for the same round, the fork detector chooses the block with the lower hash
+	winner, winnerHash, loser, loserHash := proposed, proposedHash, competing, competingHash
+	if comparison > 0 {
+		winner, winnerHash, loser, loserHash = competing, competingHash, proposed, proposedHash
+	}
+
+	if loser == proposed {
+		bp.RevertCurrentBlock()
+		err = bp.ProcessBlock(loser.header, loser.body, haveTimeToProcessCompetingBlocks)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = bp.CommitBlock(loser.header, loser.body)
+	if err != nil {
+		return nil, err
+	}
+
+	forkDetector := creator.nodeHandler.GetProcessComponents().ForkDetector()
+	err = forkDetector.AddHeader(winner.header, winnerHash, mxProcess.BHReceived, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	forkInfo := forkDetector.CheckFork()
+	if !forkInfo.IsDetected || !bytes.Equal(forkInfo.Hash, winnerHash) {
+		forkDetector.RemoveHeader(winner.header.GetNonce(), winnerHash)
+		log.Debug("injected fault: competing block did not trigger a fork",
+			"shard", loser.header.GetShardID(),
+			"nonce", loser.header.GetNonce(),
+			"committed hash", loserHash,
+			"competing hash", winnerHash)
+		return loser, nil
+	}
+
+	log.Debug("injected fault: fork detected, rolling back the committed block",
+		"shard", loser.header.GetShardID(),
+		"nonce", loser.header.GetNonce(),
+		"rolled back hash", loserHash,
+		"winning hash", winnerHash)
+
+	err = RollBackToNonce(creator.nodeHandler, loser.header.GetNonce()-1)
+	if err != nil {
+		return nil, err
+	}
+
+	err = RestoreCurrentBlock(creator.nodeHandler, prevHeader, prevHeaderHash, prevRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bp.ProcessBlock(winner.header, winner.body, haveTimeToProcessCompetingBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return winner, bp.CommitBlock(winner.header, winner.body)
+}
@@ -0,0 +1,336 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/consensus"
+	mockConsensus "github.com/multiversx/mx-chain-go/consensus/mock"
+	"github.com/multiversx/mx-chain-go/factory"
+	"github.com/multiversx/mx-chain-go/integrationTests/mock"
+	chainSimulatorProcess "github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/chainSimulator"
+	testsConsensus "github.com/multiversx/mx-chain-go/testscommon/consensus"
+	testsFactory "github.com/multiversx/mx-chain-go/testscommon/factory"
+	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFaultType_String(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "no fault", chainSimulatorProcess.NoFault.String())
+	require.Equal(t, "skipped leader", chainSimulatorProcess.SkippedLeader.String())
+	require.Equal(t, "rejected block", chainSimulatorProcess.RejectedBlock.String())
+	require.Equal(t, "missing signatures", chainSimulatorProcess.MissingSignatures.String())
+	require.Equal(t, "competing block", chainSimulatorProcess.CompetingBlock.String())
+	require.Equal(t, "unknown fault type 100", chainSimulatorProcess.FaultType(100).String())
+}
+
+func TestBlocksCreator_InjectFault(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown fault type should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := chainSimulatorProcess.NewBlocksCreator(getNodeHandlerWithRound(1))
+		err := creator.InjectFault(2, chainSimulatorProcess.Fault{Type: 100})
+		require.True(t, errors.Is(err, chainSimulatorProcess.ErrUnknownFaultType))
+	})
+	t.Run("past or current round should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := chainSimulatorProcess.NewBlocksCreator(getNodeHandlerWithRound(10))
+		err := creator.InjectFault(10, chainSimulatorProcess.Fault{Type: chainSimulatorProcess.SkippedLeader})
+		require.True(t, errors.Is(err, chainSimulatorProcess.ErrInvalidFaultRound))
+
+		err = creator.InjectFault(9, chainSimulatorProcess.Fault{Type: chainSimulatorProcess.SkippedLeader})
+		require.True(t, errors.Is(err, chainSimulatorProcess.ErrInvalidFaultRound))
+	})
+	t.Run("missing signatures without signers should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := chainSimulatorProcess.NewBlocksCreator(getNodeHandlerWithRound(1))
+		err := creator.InjectFault(2, chainSimulatorProcess.Fault{Type: chainSimulatorProcess.MissingSignatures})
+		require.Equal(t, chainSimulatorProcess.ErrNoMissingSigners, err)
+	})
+	t.Run("missing signatures with the leader index should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := chainSimulatorProcess.NewBlocksCreator(getNodeHandlerWithRound(1))
+		err := creator.InjectFault(2, chainSimulatorProcess.Fault{
+			Type:                  chainSimulatorProcess.MissingSignatures,
+			MissingSignersIndexes: []int{1, 0},
+		})
+		require.True(t, errors.Is(err, chainSimulatorProcess.ErrInvalidMissingSignerIndex))
+	})
+	t.Run("missing signatures with index out of the consensus group should error", func(t *testing.T) {
+		t.Parallel()
+
+		round := int64(1)
+		creator, _ := chainSimulatorProcess.NewBlocksCreator(getNodeHandlerWithConsensusGroup(&round, 3, nil, nil))
+		err := creator.InjectFault(2, chainSimulatorProcess.Fault{
+			Type:                  chainSimulatorProcess.MissingSignatures,
+			MissingSignersIndexes: []int{1, 3},
+		})
+		require.True(t, errors.Is(err, chainSimulatorProcess.ErrInvalidMissingSignerIndex))
+
+		err = creator.InjectFault(2, chainSimulatorProcess.Fault{
+			Type:                  chainSimulatorProcess.MissingSignatures,
+			MissingSignersIndexes: []int{1, 2},
+		})
+		require.NoError(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := chainSimulatorProcess.NewBlocksCreator(getNodeHandlerWithRound(1))
+		err := creator.InjectFault(2, chainSimulatorProcess.Fault{Type: chainSimulatorProcess.RejectedBlock})
+		require.NoError(t, err)
+	})
+}
+
+func TestBlocksCreator_CreateNewBlockWithFaults(t *testing.T) {
+	t.Parallel()
+
+	t.Run("skipped leader should not create the block", func(t *testing.T) {
+		t.Parallel()
+
+		round := int64(1)
+		nodeHandler := getNodeHandlerWithRoundPointer(&round)
+		wasCreateNewHeaderCalled := false
+		nodeHandler.GetProcessComponentsCalled = func() factory.ProcessComponentsHolder {
+			return &mock.ProcessComponentsStub{
+				BlockProcess: &testscommon.BlockProcessorStub{
+					CreateNewHeaderCalled: func(round uint64, nonce uint64) (data.HeaderHandler, error) {
+						wasCreateNewHeaderCalled = true
+						return nil, expectedErr
+					},
+				},
+			}
+		}
+		creator, _ := chainSimulatorProcess.NewBlocksCreator(nodeHandler)
+
+		err := creator.InjectFault(2, chainSimulatorProcess.Fault{Type: chainSimulatorProcess.SkippedLeader})
+		require.NoError(t, err)
+
+		round = 2
+		err = creator.CreateNewBlock()
+		require.NoError(t, err)
+		require.False(t, wasCreateNewHeaderCalled)
+
+		// the fault is applied only once
+		err = creator.CreateNewBlock()
+		require.Equal(t, expectedErr, err)
+		require.True(t, wasCreateNewHeaderCalled)
+	})
+	t.Run("cleared faults should not be applied", func(t *testing.T) {
+		t.Parallel()
+
+		round := int64(1)
+		nodeHandler := getNodeHandlerWithRoundPointer(&round)
+		nodeHandler.GetProcessComponentsCalled = func() factory.ProcessComponentsHolder {
+			return &mock.ProcessComponentsStub{
+				BlockProcess: &testscommon.BlockProcessorStub{
+					CreateNewHeaderCalled: func(round uint64, nonce uint64) (data.HeaderHandler, error) {
+						return nil, expectedErr
+					},
+				},
+			}
+		}
+		creator, _ := chainSimulatorProcess.NewBlocksCreator(nodeHandler)
+
+		err := creator.InjectFault(2, chainSimulatorProcess.Fault{Type: chainSimulatorProcess.SkippedLeader})
+		require.NoError(t, err)
+		creator.ClearFaults()
+
+		round = 2
+		err = creator.CreateNewBlock()
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("rejected block should revert the created block", func(t *testing.T) {
+		t.Parallel()
+
+		round := int64(1)
+		nodeHandler := getNodeHandlerWithRoundPointer(&round)
+		blockProcess := nodeHandler.GetProcessComponents().BlockProcessor().(*testscommon.BlockProcessorStub)
+		nc := nodeHandler.GetProcessComponents().NodesCoordinator()
+		wasRevertCalled := false
+		blockProcess.RevertCurrentBlockCalled = func() {
+			wasRevertCalled = true
+		}
+		blockProcess.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
+			require.Fail(t, "should have not called CommitBlock")
+			return nil
+		}
+		nodeHandler.GetProcessComponentsCalled = func() factory.ProcessComponentsHolder {
+			return &mock.ProcessComponentsStub{
+				BlockProcess: blockProcess,
+				NodesCoord:   nc,
+			}
+		}
+		nodeHandler.GetBroadcastMessengerCalled = func() consensus.BroadcastMessenger {
+			return &mockConsensus.BroadcastMessengerMock{
+				BroadcastHeaderCalled: func(handler data.HeaderHandler, bytes []byte) error {
+					require.Fail(t, "should have not broadcast the header")
+					return nil
+				},
+			}
+		}
+		creator, _ := chainSimulatorProcess.NewBlocksCreator(nodeHandler)
+
+		err := creator.InjectFault(2, chainSimulatorProcess.Fault{Type: chainSimulatorProcess.RejectedBlock})
+		require.NoError(t, err)
+
+		round = 2
+		err = creator.CreateNewBlock()
+		require.NoError(t, err)
+		require.True(t, wasRevertCalled)
+	})
+	t.Run("missing signatures with index out of the new consensus group should error", func(t *testing.T) {
+		t.Parallel()
+
+		round := int64(1)
+		consensusSize := 3
+		nodeHandler := getNodeHandlerWithConsensusGroup(&round, 3, nil, nil)
+		nodesCoordinatorStub := nodeHandler.GetProcessComponents().NodesCoordinator().(*shardingMocks.NodesCoordinatorStub)
+		nodesCoordinatorStub.ConsensusGroupSizeCalled = func(shardID uint32) int {
+			return consensusSize
+		}
+		creator, _ := chainSimulatorProcess.NewBlocksCreator(nodeHandler)
+
+		consensusSize = 4
+		err := creator.InjectFault(2, chainSimulatorProcess.Fault{
+			Type:                  chainSimulatorProcess.MissingSignatures,
+			MissingSignersIndexes: []int{3},
+		})
+		require.NoError(t, err)
+
+		round = 2
+		err = creator.CreateNewBlock()
+		require.True(t, errors.Is(err, chainSimulatorProcess.ErrInvalidMissingSignerIndex))
+	})
+	t.Run("all managed consensus members should sign when no fault is injected", func(t *testing.T) {
+		t.Parallel()
+
+		round := int64(1)
+		var bitmap []byte
+		signers := make([]uint16, 0)
+		creator, _ := chainSimulatorProcess.NewBlocksCreator(getNodeHandlerWithConsensusGroup(&round, 10, &bitmap, &signers))
+
+		err := creator.CreateNewBlock()
+		require.NoError(t, err)
+		require.Equal(t, []byte{0xff, 0x03}, bitmap)
+		require.Equal(t, []uint16{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, signers)
+	})
+	t.Run("missing signatures should not sign with the provided members", func(t *testing.T) {
+		t.Parallel()
+
+		round := int64(1)
+		var bitmap []byte
+		signers := make([]uint16, 0)
+		creator, _ := chainSimulatorProcess.NewBlocksCreator(getNodeHandlerWithConsensusGroup(&round, 10, &bitmap, &signers))
+
+		err := creator.InjectFault(2, chainSimulatorProcess.Fault{
+			Type:                  chainSimulatorProcess.MissingSignatures,
+			MissingSignersIndexes: []int{2, 9},
+		})
+		require.NoError(t, err)
+
+		round = 2
+		err = creator.CreateNewBlock()
+		require.NoError(t, err)
+		require.Equal(t, []byte{0xfb, 0x01}, bitmap)
+		require.Equal(t, []uint16{0, 1, 3, 4, 5, 6, 7, 8}, signers)
+	})
+}
+
+func getNodeHandlerWithRound(round int64) *chainSimulator.NodeHandlerMock {
+	return getNodeHandlerWithRoundPointer(&round)
+}
+
+func getNodeHandlerWithRoundPointer(round *int64) *chainSimulator.NodeHandlerMock {
+	nodeHandler := getNodeHandler()
+	roundHandler := &testscommon.RoundHandlerMock{
+		IndexCalled: func() int64 {
+			return *round
+		},
+		TimeStampCalled: func() time.Time {
+			return time.Now()
+		},
+	}
+	nodeHandler.GetCoreComponentsCalled = func() factory.CoreComponentsHolder {
+		return &testsFactory.CoreComponentsHolderStub{
+			RoundHandlerCalled: func() consensus.RoundHandler {
+				return roundHandler
+			},
+			InternalMarshalizerCalled: func() marshal.Marshalizer {
+				return &testscommon.MarshallerStub{}
+			},
+			HasherCalled: func() hashing.Hasher {
+				return &testscommon.HasherStub{
+					ComputeCalled: func(s string) []byte {
+						return []byte("hash")
+					},
+				}
+			},
+		}
+	}
+
+	return nodeHandler
+}
+
+func getNodeHandlerWithConsensusGroup(round *int64, consensusSize int, bitmap *[]byte, signers *[]uint16) *chainSimulator.NodeHandlerMock {
+	nodeHandler := getNodeHandlerWithRoundPointer(round)
+	blockProcess := nodeHandler.GetProcessComponents().BlockProcessor().(*testscommon.BlockProcessorStub)
+	blockProcess.CreateNewHeaderCalled = func(round uint64, nonce uint64) (data.HeaderHandler, error) {
+		return &testscommon.HeaderHandlerStub{
+			SetPubKeysBitmapCalled: func(providedBitmap []byte) error {
+				if bitmap != nil {
+					*bitmap = providedBitmap
+				}
+				return nil
+			},
+		}, nil
+	}
+	nodesCoord := &shardingMocks.NodesCoordinatorStub{
+		ConsensusGroupSizeCalled: func(shardID uint32) int {
+			return consensusSize
+		},
+		ComputeConsensusGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+			validators := make([]nodesCoordinator.Validator, 0, consensusSize)
+			for i := 0; i < consensusSize; i++ {
+				validators = append(validators, shardingMocks.NewValidatorMock([]byte{byte(i)}, 1, 1))
+			}
+			return validators, nil
+		},
+	}
+	nodeHandler.GetProcessComponentsCalled = func() factory.ProcessComponentsHolder {
+		return &mock.ProcessComponentsStub{
+			BlockProcess: blockProcess,
+			NodesCoord:   nodesCoord,
+		}
+	}
+	keysHandler := nodeHandler.GetCryptoComponents().KeysHandler()
+	nodeHandler.GetCryptoComponentsCalled = func() factory.CryptoComponentsHolder {
+		return &mock.CryptoComponentsStub{
+			KeysHandlerField: keysHandler,
+			SigHandler: &testsConsensus.SigningHandlerStub{
+				CreateSignatureShareForPublicKeyCalled: func(message []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error) {
+					if signers != nil {
+						*signers = append(*signers, index)
+					}
+					return nil, nil
+				},
+			},
+		}
+	}
+
+	return nodeHandler
+}
@@ -21,8 +21,10 @@ type NodeHandler interface {
 	GetStateComponents() factory.StateComponentsHolder
 	GetFacadeHandler() shared.FacadeHandler
 	GetStatusCoreComponents() factory.StatusCoreComponentsHolder
+	GetStatusComponents() factory.StatusComponentsHolder
 	SetKeyValueForAddress(addressBytes []byte, state map[string]string) error
 	SetStateForAddress(address []byte, state *dtos.AddressState) error
+	GetStateForAddress(address []byte) (*dtos.AddressState, error)
 	RemoveAccount(address []byte) error
 	ForceChangeOfEpoch() error
 	Close() error
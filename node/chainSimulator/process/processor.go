@@ -1,6 +1,9 @@
 package process
 
 import (
+	"fmt"
+	"sync"
+
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-go/common"
@@ -11,12 +14,25 @@ import (
 
 var log = logger.GetOrCreate("process-block")
 
+func alwaysHaveTime() bool {
+	return true
+}
+
 type manualRoundHandler interface {
 	IncrementIndex()
+	SetIndex(index int64)
 }
 
 type blocksCreator struct {
 	nodeHandler NodeHandler
+	mutFaults   sync.Mutex
+	faults      map[int64]Fault
+}
+
+type proposedBlock struct {
+	header    data.HeaderHandler
+	body      data.BodyHandler
+	leaderKey []byte
 }
 
 // NewBlocksCreator will create a new instance of blocksCreator
@@ -27,6 +43,7 @@ func NewBlocksCreator(nodeHandler NodeHandler) (*blocksCreator, error) {
 
 	return &blocksCreator{
 		nodeHandler: nodeHandler,
+		faults:      make(map[int64]Fault),
 	}, nil
 }
 
@@ -39,52 +56,97 @@ func (creator *blocksCreator) IncrementRound() {
 	creator.nodeHandler.GetStatusCoreComponents().AppStatusHandler().SetUInt64Value(common.MetricCurrentRound, uint64(roundHandler.Index()))
 }
 
+// SetRound will set the current round to the provided value
+func (creator *blocksCreator) SetRound(round int64) {
+	roundHandler := creator.nodeHandler.GetCoreComponents().RoundHandler()
+	manual := roundHandler.(manualRoundHandler)
+	manual.SetIndex(round)
+
+	creator.nodeHandler.GetStatusCoreComponents().AppStatusHandler().SetUInt64Value(common.MetricCurrentRound, uint64(roundHandler.Index()))
+}
+
 // CreateNewBlock creates and process a new block
 func (creator *blocksCreator) CreateNewBlock() error {
+	round := creator.nodeHandler.GetCoreComponents().RoundHandler().Index()
+	fault := creator.popFault(round)
+	if fault.Type == SkippedLeader {
+		log.Debug("injected fault: leader skipped proposing the block",
+			"shard", creator.nodeHandler.GetShardCoordinator().SelfId(),
+			"round", round)
+		return nil
+	}
+
+	proposed, err := creator.proposeBlock(fault.MissingSignersIndexes, alwaysHaveTime)
+	if err != nil {
+		return err
+	}
+	if proposed == nil {
+		return nil
+	}
+
+	bp := creator.nodeHandler.GetProcessComponents().BlockProcessor()
+	switch fault.Type {
+	case RejectedBlock:
+		bp.RevertCurrentBlock()
+		log.Debug("injected fault: proposed block was rejected",
+			"shard", proposed.header.GetShardID(),
+			"round", proposed.header.GetRound(),
+			"nonce", proposed.header.GetNonce())
+		return nil
+	case CompetingBlock:
+		proposed, err = creator.commitWithCompetingBlock(proposed, fault.MissingSignersIndexes)
+	default:
+		err = bp.CommitBlock(proposed.header, proposed.body)
+	}
+	if err != nil {
+		return err
+	}
+
+	return creator.broadcastBlock(proposed)
+}
+
+// proposeBlock creates the header and the body of a new block, signed by the consensus group members that are
+// managed by the current node. It returns nil if the leader of the round is not managed by the current node.
+func (creator *blocksCreator) proposeBlock(missingSignersIndexes []int, haveTime func() bool) (*proposedBlock, error) {
 	bp := creator.nodeHandler.GetProcessComponents().BlockProcessor()
 
 	nonce, _, prevHash, prevRandSeed, epoch := creator.getPreviousHeaderData()
 	round := creator.nodeHandler.GetCoreComponents().RoundHandler().Index()
 	newHeader, err := bp.CreateNewHeader(uint64(round), nonce+1)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	shardID := creator.nodeHandler.GetShardCoordinator().SelfId()
 	err = newHeader.SetShardID(shardID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = newHeader.SetPrevHash(prevHash)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = newHeader.SetPrevRandSeed(prevRandSeed)
 	if err != nil {
-		return err
-	}
-
-	err = newHeader.SetPubKeysBitmap([]byte{1})
-	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = newHeader.SetChainID([]byte(configs.ChainID))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	headerCreationTime := creator.nodeHandler.GetCoreComponents().RoundHandler().TimeStamp()
 	err = newHeader.SetTimeStamp(uint64(headerCreationTime.Unix()))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	validatorsGroup, err := creator.nodeHandler.GetProcessComponents().NodesCoordinator().ComputeConsensusGroup(prevRandSeed, newHeader.GetRound(), shardID, epoch)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	blsKey := validatorsGroup[spos.IndexOfLeaderInConsensusGroup]
 
@@ -93,52 +155,103 @@ func (creator *blocksCreator) CreateNewBlock() error {
 		log.Debug("cannot propose block - leader bls key is missing",
 			"leader key", blsKey.PubKey(),
 			"shard", creator.nodeHandler.GetShardCoordinator().SelfId())
-		return nil
+		return nil, nil
+	}
+
+	consensusKeys := make([]string, 0, len(validatorsGroup))
+	for _, validator := range validatorsGroup {
+		consensusKeys = append(consensusKeys, string(validator.PubKey()))
+	}
+
+	signersIndexes, bitmap, err := creator.computeSigners(consensusKeys, missingSignersIndexes)
+	if err != nil {
+		return nil, err
+	}
+
+	err = newHeader.SetPubKeysBitmap(bitmap)
+	if err != nil {
+		return nil, err
 	}
 
 	signingHandler := creator.nodeHandler.GetCryptoComponents().ConsensusSigningHandler()
 	randSeed, err := signingHandler.CreateSignatureForPublicKey(newHeader.GetPrevRandSeed(), blsKey.PubKey())
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = newHeader.SetRandSeed(randSeed)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	header, block, err := bp.CreateBlock(newHeader, func() bool {
-		return true
-	})
+	header, body, err := bp.CreateBlock(newHeader, haveTime)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = creator.setHeaderSignatures(header, blsKey.PubKey())
+	err = creator.setHeaderSignatures(header, consensusKeys, signersIndexes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = bp.CommitBlock(header, block)
-	if err != nil {
-		return err
+	return &proposedBlock{
+		header:    header,
+		body:      body,
+		leaderKey: blsKey.PubKey(),
+	}, nil
+}
+
+// computeSigners returns the indexes of the consensus group members that will sign the block, together with the
+// resulting bitmap. Only the members managed by the current node can sign, the leader being always one of them.
+func (creator *blocksCreator) computeSigners(consensusKeys []string, missingSignersIndexes []int) ([]int, []byte, error) {
+	missingSigners := make(map[int]struct{}, len(missingSignersIndexes))
+	for _, idx := range missingSignersIndexes {
+		// the indexes were checked against the consensus group size when the fault was injected, but the group size
+		// might have changed in the meantime if an epoch change occurred
+		if idx >= len(consensusKeys) {
+			return nil, nil, fmt.Errorf("%w: %d, consensus group size: %d", ErrInvalidMissingSignerIndex, idx, len(consensusKeys))
+		}
+		missingSigners[idx] = struct{}{}
 	}
 
-	miniBlocks, transactions, err := bp.MarshalizedDataToBroadcast(header, block)
+	keysHandler := creator.nodeHandler.GetCryptoComponents().KeysHandler()
+	bitmap := make([]byte, (len(consensusKeys)+7)/8)
+	signersIndexes := make([]int, 0, len(consensusKeys))
+	for idx, key := range consensusKeys {
+		_, isMissing := missingSigners[idx]
+		if isMissing {
+			continue
+		}
+
+		isLeader := idx == spos.IndexOfLeaderInConsensusGroup
+		if !isLeader && !keysHandler.IsKeyManagedByCurrentNode([]byte(key)) {
+			continue
+		}
+
+		bitmap[idx/8] |= 1 << uint(idx%8)
+		signersIndexes = append(signersIndexes, idx)
+	}
+
+	return signersIndexes, bitmap, nil
+}
+
+func (creator *blocksCreator) broadcastBlock(proposed *proposedBlock) error {
+	bp := creator.nodeHandler.GetProcessComponents().BlockProcessor()
+	miniBlocks, transactions, err := bp.MarshalizedDataToBroadcast(proposed.header, proposed.body)
 	if err != nil {
 		return err
 	}
 
-	err = creator.nodeHandler.GetBroadcastMessenger().BroadcastHeader(header, blsKey.PubKey())
+	err = creator.nodeHandler.GetBroadcastMessenger().BroadcastHeader(proposed.header, proposed.leaderKey)
 	if err != nil {
 		return err
 	}
 
-	err = creator.nodeHandler.GetBroadcastMessenger().BroadcastMiniBlocks(miniBlocks, blsKey.PubKey())
+	err = creator.nodeHandler.GetBroadcastMessenger().BroadcastMiniBlocks(miniBlocks, proposed.leaderKey)
 	if err != nil {
 		return err
 	}
 
-	return creator.nodeHandler.GetBroadcastMessenger().BroadcastTransactions(transactions, blsKey.PubKey())
+	return creator.nodeHandler.GetBroadcastMessenger().BroadcastTransactions(transactions, proposed.leaderKey)
 }
 
 func (creator *blocksCreator) getPreviousHeaderData() (nonce, round uint64, prevHash, prevRandSeed []byte, epoch uint32) {
@@ -161,7 +274,7 @@ func (creator *blocksCreator) getPreviousHeaderData() (nonce, round uint64, prev
 	return
 }
 
-func (creator *blocksCreator) setHeaderSignatures(header data.HeaderHandler, blsKeyBytes []byte) error {
+func (creator *blocksCreator) setHeaderSignatures(header data.HeaderHandler, consensusKeys []string, signersIndexes []int) error {
 	signingHandler := creator.nodeHandler.GetCryptoComponents().ConsensusSigningHandler()
 	headerClone := header.ShallowClone()
 	_ = headerClone.SetPubKeysBitmap(nil)
@@ -171,20 +284,22 @@ func (creator *blocksCreator) setHeaderSignatures(header data.HeaderHandler, bls
 		return err
 	}
 
-	err = signingHandler.Reset([]string{string(blsKeyBytes)})
+	err = signingHandler.Reset(consensusKeys)
 	if err != nil {
 		return err
 	}
 
 	headerHash := creator.nodeHandler.GetCoreComponents().Hasher().Compute(string(marshalizedHdr))
-	_, err = signingHandler.CreateSignatureShareForPublicKey(
-		headerHash,
-		uint16(0),
-		header.GetEpoch(),
-		blsKeyBytes,
-	)
-	if err != nil {
-		return err
+	for _, idx := range signersIndexes {
+		_, err = signingHandler.CreateSignatureShareForPublicKey(
+			headerHash,
+			uint16(idx),
+			header.GetEpoch(),
+			[]byte(consensusKeys[idx]),
+		)
+		if err != nil {
+			return err
+		}
 	}
 
 	sig, err := signingHandler.AggregateSigs(header.GetPubKeysBitmap(), header.GetEpoch())
@@ -197,7 +312,8 @@ func (creator *blocksCreator) setHeaderSignatures(header data.HeaderHandler, bls
 		return err
 	}
 
-	leaderSignature, err := creator.createLeaderSignature(header, blsKeyBytes)
+	leaderKey := []byte(consensusKeys[spos.IndexOfLeaderInConsensusGroup])
+	leaderSignature, err := creator.createLeaderSignature(header, leaderKey)
 	if err != nil {
 		return err
 	}
@@ -94,6 +94,43 @@ func TestBlocksCreator_IncrementRound(t *testing.T) {
 	require.True(t, wasSetUInt64ValueCalled)
 }
 
+func TestBlocksCreator_SetRound(t *testing.T) {
+	t.Parallel()
+
+	providedRound := int64(1000)
+	setIndex := int64(0)
+	wasSetUInt64ValueCalled := false
+	nodeHandler := &chainSimulator.NodeHandlerMock{
+		GetCoreComponentsCalled: func() factory.CoreComponentsHolder {
+			return &testsFactory.CoreComponentsHolderStub{
+				RoundHandlerCalled: func() consensus.RoundHandler {
+					return &testscommon.RoundHandlerMock{
+						SetIndexCalled: func(index int64) {
+							setIndex = index
+						},
+					}
+				},
+			}
+		},
+		GetStatusCoreComponentsCalled: func() factory.StatusCoreComponentsHolder {
+			return &testsFactory.StatusCoreComponentsStub{
+				AppStatusHandlerField: &statusHandler.AppStatusHandlerStub{
+					SetUInt64ValueHandler: func(key string, value uint64) {
+						wasSetUInt64ValueCalled = true
+						require.Equal(t, common.MetricCurrentRound, key)
+					},
+				},
+			}
+		},
+	}
+	creator, err := chainSimulatorProcess.NewBlocksCreator(nodeHandler)
+	require.NoError(t, err)
+
+	creator.SetRound(providedRound)
+	require.Equal(t, providedRound, setIndex)
+	require.True(t, wasSetUInt64ValueCalled)
+}
+
 func TestBlocksCreator_CreateNewBlock(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,156 @@
+package process
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	mxProcess "github.com/multiversx/mx-chain-go/process"
+)
+
+// RollBackToNonce will roll back, one by one, all the committed blocks of the node with a nonce higher than the
+// provided one. The blockchain handler and the state should be afterward restored by calling RestoreCurrentBlock.
+func RollBackToNonce(node NodeHandler, nonce uint64) error {
+	chainHandler := node.GetChainHandler()
+	currentHeader := chainHandler.GetCurrentBlockHeader()
+	currentHeaderHash := chainHandler.GetCurrentBlockHeaderHash()
+	for !check.IfNil(currentHeader) && currentHeader.GetNonce() > nonce {
+		body := getBlockBodyFromStorage(node, currentHeader)
+		err := rollBackBlock(node, currentHeader, currentHeaderHash, body)
+		if err != nil {
+			return err
+		}
+
+		if currentHeader.GetNonce()-1 <= nonce {
+			break
+		}
+
+		currentHeaderHash = currentHeader.GetPrevHash()
+		currentHeader, err = mxProcess.GetHeaderFromStorage(
+			node.GetShardCoordinator().SelfId(),
+			currentHeaderHash,
+			node.GetCoreComponents().InternalMarshalizer(),
+			node.GetDataComponents().StorageService(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rollBackBlock will remove the provided block from the node's pools, fork detector, storage, history repository and
+// outport drivers, in the same way the bootstrapper does when reverting a block
+func rollBackBlock(node NodeHandler, header data.HeaderHandler, headerHash []byte, body data.BodyHandler) error {
+	processComponents := node.GetProcessComponents()
+
+	err := processComponents.BlockProcessor().RestoreBlockIntoPools(header, body)
+	if err != nil {
+		return err
+	}
+
+	err = processComponents.HistoryRepository().RevertBlock(header, body)
+	if err != nil {
+		return err
+	}
+
+	err = node.GetStatusComponents().OutportHandler().RevertIndexedBlock(&outportcore.HeaderDataWithBody{
+		Body:       body,
+		HeaderHash: headerHash,
+		Header:     header,
+	})
+	if err != nil {
+		log.Warn("rollBackBlock: outport handler cannot revert indexed block", "error", err)
+	}
+
+	processComponents.ForkDetector().RemoveHeader(header.GetNonce(), headerHash)
+	node.GetDataComponents().Datapool().Headers().RemoveHeaderByHash(headerHash)
+	removeHeaderNonceHashMapping(node, header)
+
+	log.Debug("chain simulator rolled back block",
+		"shard", header.GetShardID(),
+		"nonce", header.GetNonce(),
+		"hash", headerHash)
+
+	return nil
+}
+
+// RestoreCurrentBlock will set the provided header as the current block of the node and will revert the accounts
+// state to the provided root hash. A nil header means that the node should be restored to genesis.
+func RestoreCurrentBlock(node NodeHandler, header data.HeaderHandler, headerHash []byte, rootHash []byte) error {
+	chainHandler := node.GetChainHandler()
+	processComponents := node.GetProcessComponents()
+
+	err := chainHandler.SetCurrentBlockHeaderAndRootHash(header, rootHash)
+	if err != nil {
+		return err
+	}
+	chainHandler.SetCurrentBlockHeaderHash(headerHash)
+
+	headerForRevert := header
+	if check.IfNil(headerForRevert) {
+		headerForRevert = chainHandler.GetGenesisHeader()
+	}
+
+	err = processComponents.BlockProcessor().RevertStateToBlock(headerForRevert, rootHash)
+	if err != nil {
+		return err
+	}
+
+	err = processComponents.ScheduledTxsExecutionHandler().RollBackToBlock(headerHash)
+	if err != nil {
+		processComponents.ScheduledTxsExecutionHandler().SetScheduledInfo(&mxProcess.ScheduledInfo{
+			RootHash:        rootHash,
+			IntermediateTxs: make(map[block.Type][]data.TransactionHandler),
+			GasAndFees:      mxProcess.GetZeroGasAndFees(),
+			MiniBlocks:      make(block.MiniBlockSlice, 0),
+		})
+	}
+
+	return nil
+}
+
+func removeHeaderNonceHashMapping(node NodeHandler, header data.HeaderHandler) {
+	shardID := node.GetShardCoordinator().SelfId()
+	unitType := dataRetriever.GetHdrNonceHashDataUnit(shardID)
+	storer, err := node.GetDataComponents().StorageService().GetStorer(unitType)
+	if err != nil {
+		log.Debug("removeHeaderNonceHashMapping.GetStorer", "error", err)
+		return
+	}
+
+	nonceToByteSlice := node.GetCoreComponents().Uint64ByteSliceConverter().ToByteSlice(header.GetNonce())
+	_ = storer.Remove(nonceToByteSlice)
+}
+
+func getBlockBodyFromStorage(node NodeHandler, header data.HeaderHandler) *block.Body {
+	body := &block.Body{}
+	storer, err := node.GetDataComponents().StorageService().GetStorer(dataRetriever.MiniBlockUnit)
+	if err != nil {
+		log.Debug("getBlockBodyFromStorage.GetStorer", "error", err)
+		return body
+	}
+
+	marshaller := node.GetCoreComponents().InternalMarshalizer()
+	for _, miniBlockHeader := range header.GetMiniBlockHeaderHandlers() {
+		buff, errGet := storer.Get(miniBlockHeader.GetHash())
+		if errGet != nil {
+			log.Debug("getBlockBodyFromStorage: miniblock not found in storage",
+				"hash", miniBlockHeader.GetHash(), "error", errGet)
+			continue
+		}
+
+		miniBlock := &block.MiniBlock{}
+		errGet = marshaller.Unmarshal(miniBlock, buff)
+		if errGet != nil {
+			log.Debug("getBlockBodyFromStorage.Unmarshal", "error", errGet)
+			continue
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	return body
+}
@@ -0,0 +1,459 @@
+package chainSimulator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	chainSimulatorErrors "github.com/multiversx/mx-chain-go/node/chainSimulator/errors"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+	mxProcess "github.com/multiversx/mx-chain-go/process"
+	mxChainSharding "github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+type roundIndexSetter interface {
+	SetIndex(index int64)
+}
+
+type notarizedHeadersResetter interface {
+	ResetNotarizedHeaders(crossStartHeaders map[uint32]data.HeaderHandler, selfStartHeaders map[uint32]data.HeaderHandler) error
+}
+
+type pendingHandlersWaiter interface {
+	WaitForPendingHandlers()
+}
+
+type metaHeadersRemover interface {
+	RemoveMetaHeadersAfterEpoch(epoch uint32)
+}
+
+type nodeSnapshot struct {
+	header         data.HeaderHandler
+	headerHash     []byte
+	nonce          uint64
+	rootHash       []byte
+	finalNonce     uint64
+	finalHash      []byte
+	finalRootHash  []byte
+	round          int64
+	epoch          uint32
+	epochStartKey  []byte
+	nodesCoordKey  []byte
+	txsKeys        map[string]struct{}
+	scrsKeys       map[string]struct{}
+	rewardsKeys    map[string]struct{}
+	miniBlocksKeys map[string]struct{}
+	blockTracker   *blockTrackerSnapshot
+}
+
+type headerWithHash struct {
+	header data.HeaderHandler
+	hash   []byte
+}
+
+type blockTrackerSnapshot struct {
+	crossNotarizedHeaders map[uint32][]*headerWithHash
+	selfNotarizedHeaders  map[uint32][]*headerWithHash
+	trackedHeaders        []*headerWithHash
+}
+
+type simulatorSnapshot struct {
+	nodesSnapshots map[uint32]*nodeSnapshot
+}
+
+// TakeSnapshot will record the current state of all nodes (accounts tries, blockchain headers, pools and round counters)
+// and returns the identifier that can be later used to revert to this point
+func (s *simulator) TakeSnapshot() (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.blockTriesPruning()
+
+	snapshot := &simulatorSnapshot{
+		nodesSnapshots: make(map[uint32]*nodeSnapshot, len(s.nodes)),
+	}
+	for shardID, node := range s.nodes {
+		snapshotOfNode, err := takeNodeSnapshot(node)
+		if err != nil {
+			return 0, fmt.Errorf("%w for shard %d", err, shardID)
+		}
+
+		snapshot.nodesSnapshots[shardID] = snapshotOfNode
+	}
+
+	s.lastSnapshotID++
+	s.snapshots[s.lastSnapshotID] = snapshot
+
+	log.Info("chain simulator snapshot taken", "snapshot ID", s.lastSnapshotID)
+
+	return s.lastSnapshotID, nil
+}
+
+// RevertToSnapshot will revert all nodes to the state recorded by the snapshot with the provided identifier.
+// The snapshot remains valid and can be reverted to multiple times, while all the snapshots taken after it are discarded.
+// If epochs were changed after the snapshot was taken, the epoch start trigger, the nodes coordinator and the epoch
+// subscribers are also restored to the epoch recorded in the snapshot.
+func (s *simulator) RevertToSnapshot(snapshotID int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	snapshot, found := s.snapshots[snapshotID]
+	if !found {
+		return fmt.Errorf("%w, snapshot ID: %d", chainSimulatorErrors.ErrSnapshotNotFound, snapshotID)
+	}
+
+	for shardID, node := range s.nodes {
+		err := process.RollBackToNonce(node, snapshot.nodesSnapshots[shardID].nonce)
+		if err != nil {
+			return fmt.Errorf("%w for shard %d", err, shardID)
+		}
+	}
+
+	// the headers restored into pools are notified on separate go routines, let them finish before
+	// restoring the components that are updated by these notifications
+	for _, node := range s.nodes {
+		waitForHeadersPoolHandlers(node)
+	}
+
+	for shardID, node := range s.nodes {
+		err := restoreNodeSnapshot(node, snapshot.nodesSnapshots[shardID])
+		if err != nil {
+			return fmt.Errorf("%w for shard %d", err, shardID)
+		}
+	}
+
+	for _, node := range s.nodes {
+		cleanPoolsAfterRevert(node, snapshot)
+	}
+
+	for id := range s.snapshots {
+		if id > snapshotID {
+			delete(s.snapshots, id)
+		}
+	}
+	s.lastSnapshotID = snapshotID
+
+	log.Info("chain simulator reverted to snapshot", "snapshot ID", snapshotID)
+
+	return nil
+}
+
+// blockTriesPruning will stop the removal of the old trie nodes, so the state tries recorded by any snapshot can
+// always be recreated. The pruning remains blocked for the entire lifetime of the chain simulator
+func (s *simulator) blockTriesPruning() {
+	if s.isPruningBlocked {
+		return
+	}
+
+	for _, node := range s.nodes {
+		for _, trieStorageManager := range node.GetStateComponents().TrieStorageManagers() {
+			trieStorageManager.EnterPruningBufferingMode()
+		}
+	}
+	s.isPruningBlocked = true
+}
+
+func takeNodeSnapshot(node process.NodeHandler) (*nodeSnapshot, error) {
+	chainHandler := node.GetChainHandler()
+
+	rootHash, err := node.GetStateComponents().AccountsAdapter().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	header := chainHandler.GetCurrentBlockHeader()
+	nonce := chainHandler.GetGenesisHeader().GetNonce()
+	if !check.IfNil(header) {
+		nonce = header.GetNonce()
+	}
+
+	finalNonce, finalHash, finalRootHash := chainHandler.GetFinalBlockInfo()
+	dataPool := node.GetDataComponents().Datapool()
+
+	return &nodeSnapshot{
+		header:         header,
+		headerHash:     chainHandler.GetCurrentBlockHeaderHash(),
+		nonce:          nonce,
+		rootHash:       rootHash,
+		finalNonce:     finalNonce,
+		finalHash:      finalHash,
+		finalRootHash:  finalRootHash,
+		round:          node.GetCoreComponents().RoundHandler().Index(),
+		epoch:          node.GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch(),
+		epochStartKey:  node.GetProcessComponents().EpochStartTrigger().GetSavedStateKey(),
+		nodesCoordKey:  node.GetProcessComponents().NodesCoordinator().GetSavedStateKey(),
+		txsKeys:        keysToSet(dataPool.Transactions().Keys()),
+		scrsKeys:       keysToSet(dataPool.UnsignedTransactions().Keys()),
+		rewardsKeys:    keysToSet(dataPool.RewardTransactions().Keys()),
+		miniBlocksKeys: keysToSet(dataPool.MiniBlocks().Keys()),
+		blockTracker:   takeBlockTrackerSnapshot(node),
+	}, nil
+}
+
+func takeBlockTrackerSnapshot(node process.NodeHandler) *blockTrackerSnapshot {
+	blockTracker := node.GetProcessComponents().BlockTracker()
+	snapshot := &blockTrackerSnapshot{
+		crossNotarizedHeaders: make(map[uint32][]*headerWithHash),
+		selfNotarizedHeaders:  make(map[uint32][]*headerWithHash),
+		trackedHeaders:        make([]*headerWithHash, 0),
+	}
+
+	for _, shardID := range getAllShardIDs(node.GetShardCoordinator()) {
+		snapshot.crossNotarizedHeaders[shardID] = getNotarizedHeaders(shardID, blockTracker.GetCrossNotarizedHeader)
+		snapshot.selfNotarizedHeaders[shardID] = getNotarizedHeaders(shardID, blockTracker.GetSelfNotarizedHeader)
+
+		headers, hashes := blockTracker.GetTrackedHeaders(shardID)
+		for idx := range headers {
+			snapshot.trackedHeaders = append(snapshot.trackedHeaders, &headerWithHash{
+				header: headers[idx],
+				hash:   hashes[idx],
+			})
+		}
+	}
+
+	return snapshot
+}
+
+func getAllShardIDs(shardCoordinator mxChainSharding.Coordinator) []uint32 {
+	shardIDs := make([]uint32, 0, shardCoordinator.NumberOfShards()+1)
+	for shardID := uint32(0); shardID < shardCoordinator.NumberOfShards(); shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+
+	return append(shardIDs, core.MetachainShardId)
+}
+
+// getNotarizedHeaders returns the notarized headers of the provided shard, ordered from the oldest to the newest
+func getNotarizedHeaders(
+	shardID uint32,
+	getNotarizedHeader func(shardID uint32, offset uint64) (data.HeaderHandler, []byte, error),
+) []*headerWithHash {
+	headers := make([]*headerWithHash, 0)
+	for offset := uint64(0); ; offset++ {
+		header, hash, err := getNotarizedHeader(shardID, offset)
+		if err != nil {
+			break
+		}
+
+		headers = append([]*headerWithHash{{header: header, hash: hash}}, headers...)
+	}
+
+	return headers
+}
+
+func keysToSet(keys [][]byte) map[string]struct{} {
+	set := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		set[string(key)] = struct{}{}
+	}
+
+	return set
+}
+
+func restoreNodeSnapshot(node process.NodeHandler, snapshot *nodeSnapshot) error {
+	err := process.RestoreCurrentBlock(node, snapshot.header, snapshot.headerHash, snapshot.rootHash)
+	if err != nil {
+		return err
+	}
+	node.GetChainHandler().SetFinalBlockInfo(snapshot.finalNonce, snapshot.finalHash, snapshot.finalRootHash)
+
+	err = restoreEpoch(node, snapshot)
+	if err != nil {
+		return err
+	}
+
+	roundHandler, ok := node.GetCoreComponents().RoundHandler().(roundIndexSetter)
+	if !ok {
+		return fmt.Errorf("%w for round handler", mxProcess.ErrWrongTypeAssertion)
+	}
+	roundHandler.SetIndex(snapshot.round)
+	node.GetStatusCoreComponents().AppStatusHandler().SetUInt64Value(common.MetricCurrentRound, uint64(snapshot.round))
+
+	forkDetector := node.GetProcessComponents().ForkDetector()
+	forkDetector.RestoreToGenesis()
+	if !check.IfNil(snapshot.header) {
+		errNotCritical := forkDetector.AddHeader(snapshot.header, snapshot.headerHash, mxProcess.BHProcessed, nil, nil)
+		if errNotCritical != nil {
+			log.Debug("restoreNodeSnapshot: forkDetector.AddHeader", "error", errNotCritical)
+		}
+	}
+
+	return restoreBlockTracker(node, snapshot.blockTracker)
+}
+
+// restoreEpoch will bring back the epoch dependent components to the epoch recorded in the snapshot, from the states
+// saved by them at the epochs start. The state tries were already reverted together with the current block
+func restoreEpoch(node process.NodeHandler, snapshot *nodeSnapshot) error {
+	currentEpoch := node.GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch()
+	if currentEpoch == snapshot.epoch {
+		return nil
+	}
+
+	epochStartTrigger := node.GetProcessComponents().EpochStartTrigger()
+	err := epochStartTrigger.LoadState(snapshot.epochStartKey)
+	if err != nil {
+		return fmt.Errorf("%w while restoring the epoch start trigger", err)
+	}
+	// the meta headers received after the snapshot are no longer valid, their epoch start blocks will be received again
+	remover, ok := epochStartTrigger.(metaHeadersRemover)
+	if ok {
+		remover.RemoveMetaHeadersAfterEpoch(epochStartTrigger.MetaEpoch())
+	}
+
+	err = node.GetProcessComponents().NodesCoordinator().LoadState(snapshot.nodesCoordKey)
+	if err != nil {
+		return fmt.Errorf("%w while restoring the nodes coordinator", err)
+	}
+
+	headerForEpoch := snapshot.header
+	if check.IfNil(headerForEpoch) {
+		headerForEpoch = node.GetChainHandler().GetGenesisHeader()
+	}
+	node.GetCoreComponents().EpochNotifier().CheckEpoch(headerForEpoch)
+	node.GetStatusCoreComponents().AppStatusHandler().SetUInt64Value(common.MetricEpochNumber, uint64(snapshot.epoch))
+
+	log.Debug("chain simulator restored epoch",
+		"shard", node.GetShardCoordinator().SelfId(),
+		"previous epoch", currentEpoch,
+		"restored epoch", snapshot.epoch)
+
+	return nil
+}
+
+func waitForHeadersPoolHandlers(node process.NodeHandler) {
+	waiter, ok := node.GetDataComponents().Datapool().Headers().(pendingHandlersWaiter)
+	if !ok {
+		log.Warn("waitForHeadersPoolHandlers: headers pool can not wait for its pending handlers")
+		return
+	}
+
+	waiter.WaitForPendingHandlers()
+}
+
+func restoreBlockTracker(node process.NodeHandler, snapshot *blockTrackerSnapshot) error {
+	blockTracker := node.GetProcessComponents().BlockTracker()
+	resetter, ok := blockTracker.(notarizedHeadersResetter)
+	if !ok {
+		return fmt.Errorf("%w for block tracker", mxProcess.ErrWrongTypeAssertion)
+	}
+
+	blockTracker.RestoreToGenesis()
+	err := resetter.ResetNotarizedHeaders(
+		getFirstNotarizedHeaders(snapshot.crossNotarizedHeaders),
+		getFirstNotarizedHeaders(snapshot.selfNotarizedHeaders),
+	)
+	if err != nil {
+		return err
+	}
+
+	for shardID, headers := range snapshot.crossNotarizedHeaders {
+		err = restoreNotarizedHeaders(shardID, headers, blockTracker.GetCrossNotarizedHeader, blockTracker.AddCrossNotarizedHeader)
+		if err != nil {
+			return fmt.Errorf("%w while restoring cross notarized headers", err)
+		}
+	}
+
+	for shardID, headers := range snapshot.selfNotarizedHeaders {
+		err = restoreNotarizedHeaders(shardID, headers, blockTracker.GetSelfNotarizedHeader, blockTracker.AddSelfNotarizedHeader)
+		if err != nil {
+			return fmt.Errorf("%w while restoring self notarized headers", err)
+		}
+	}
+
+	for _, trackedHeader := range snapshot.trackedHeaders {
+		blockTracker.AddTrackedHeader(trackedHeader.header, trackedHeader.hash)
+	}
+
+	return nil
+}
+
+func getFirstNotarizedHeaders(notarizedHeaders map[uint32][]*headerWithHash) map[uint32]data.HeaderHandler {
+	firstHeaders := make(map[uint32]data.HeaderHandler, len(notarizedHeaders))
+	for shardID, headers := range notarizedHeaders {
+		if len(headers) == 0 {
+			continue
+		}
+
+		firstHeaders[shardID] = headers[0].header
+	}
+
+	return firstHeaders
+}
+
+// restoreNotarizedHeaders will add back the recorded notarized headers on top of the first one, which was already
+// set when the notarized headers were reset
+func restoreNotarizedHeaders(
+	shardID uint32,
+	headers []*headerWithHash,
+	getNotarizedHeader func(shardID uint32, offset uint64) (data.HeaderHandler, []byte, error),
+	addNotarizedHeader func(shardID uint32, header data.HeaderHandler, hash []byte),
+) error {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	firstHash := headers[0].hash
+	for _, notarizedHeader := range headers[1:] {
+		if bytes.Equal(notarizedHeader.hash, firstHash) {
+			continue
+		}
+
+		addNotarizedHeader(shardID, notarizedHeader.header, notarizedHeader.hash)
+	}
+
+	_, lastHash, err := getNotarizedHeader(shardID, 0)
+	if err != nil {
+		return err
+	}
+
+	expectedLastHash := headers[len(headers)-1].hash
+	if !bytes.Equal(lastHash, expectedLastHash) {
+		return fmt.Errorf("%w for shard %d, expected last notarized header %x, got %x",
+			errBlockTrackerRestoreMismatch, shardID, expectedLastHash, lastHash)
+	}
+
+	return nil
+}
+
+func cleanPoolsAfterRevert(node process.NodeHandler, snapshot *simulatorSnapshot) {
+	snapshotOfNode := snapshot.nodesSnapshots[node.GetShardCoordinator().SelfId()]
+	dataPool := node.GetDataComponents().Datapool()
+
+	removeShardedDataNotInSnapshot(dataPool.Transactions(), snapshotOfNode.txsKeys)
+	removeShardedDataNotInSnapshot(dataPool.UnsignedTransactions(), snapshotOfNode.scrsKeys)
+	removeShardedDataNotInSnapshot(dataPool.RewardTransactions(), snapshotOfNode.rewardsKeys)
+	removeCachedDataNotInSnapshot(dataPool.MiniBlocks(), snapshotOfNode.miniBlocksKeys)
+	dataPool.CurrentBlockTxs().Clean()
+
+	headersPool := dataPool.Headers()
+	for shardID, snapshotOfShard := range snapshot.nodesSnapshots {
+		for _, nonce := range headersPool.Nonces(shardID) {
+			if nonce > snapshotOfShard.nonce {
+				headersPool.RemoveHeaderByNonceAndShardId(nonce, shardID)
+			}
+		}
+	}
+}
+
+func removeShardedDataNotInSnapshot(pool dataRetriever.ShardedDataCacherNotifier, snapshotKeys map[string]struct{}) {
+	for _, key := range pool.Keys() {
+		_, existedAtSnapshot := snapshotKeys[string(key)]
+		if !existedAtSnapshot {
+			pool.RemoveDataFromAllShards(key)
+		}
+	}
+}
+
+func removeCachedDataNotInSnapshot(cacher storage.Cacher, snapshotKeys map[string]struct{}) {
+	for _, key := range cacher.Keys() {
+		_, existedAtSnapshot := snapshotKeys[string(key)]
+		if !existedAtSnapshot {
+			cacher.Remove(key)
+		}
+	}
+}
@@ -138,3 +138,6 @@ var ErrNilStatusHandler = errors.New("nil status handler")
 
 // ErrNilCreateTransactionArgs signals that create transaction args is nil
 var ErrNilCreateTransactionArgs = errors.New("nil args for create transaction")
+
+// ErrInvalidStateDiffBoundary signals that a compared state was not given by exactly one of its root hash or block nonce
+var ErrInvalidStateDiffBoundary = errors.New("each compared state should be given either by root hash or by block nonce")
@@ -23,6 +23,12 @@ var ErrNilDelegatedListHandler = errors.New("nil delegated list handler")
 // ErrNilAPITransactionHandler signals that a nil api transaction handler has been provided
 var ErrNilAPITransactionHandler = errors.New("nil api transaction handler")
 
+// ErrNilLogsFacade signals that a nil logs facade has been provided
+var ErrNilLogsFacade = errors.New("nil logs facade")
+
+// ErrNilSubscriptionsHandler signals that a nil subscriptions handler has been provided
+var ErrNilSubscriptionsHandler = errors.New("nil subscriptions handler")
+
 // ErrNilAPIBlockHandler signals that a nil api block handler has been provided
 var ErrNilAPIBlockHandler = errors.New("nil api block handler")
 
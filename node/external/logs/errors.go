@@ -5,3 +5,7 @@ import "errors"
 var errCannotCreateLogsFacade = errors.New("cannot create logs facade")
 var errCannotLoadLogs = errors.New("cannot load log(s)")
 var errCannotUnmarshalLog = errors.New("cannot unmarshal log")
+var errCannotFilterEvents = errors.New("cannot filter events")
+
+// ErrDBLookupExtensionIsNotEnabled signals that the db lookup extensions, holding the events index, are not enabled
+var ErrDBLookupExtensionIsNotEnabled = errors.New("db lookup extension is not enabled")
@@ -1,8 +1,12 @@
 package logs
 
 import (
+	"encoding/hex"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 )
 
 type logsConverter struct {
@@ -38,6 +42,53 @@ func (converter *logsConverter) txLogToApiResource(logKey []byte, log *transacti
 	}
 }
 
+func (converter *logsConverter) apiFilterToEventsFilter(apiFilter *common.EventsFilterApiRequest) (*eventsIndex.EventsFilter, error) {
+	filter := &eventsIndex.EventsFilter{
+		Identifier: []byte(apiFilter.Identifier),
+		Topics:     apiFilter.Topics,
+		FromNonce:  apiFilter.FromBlock,
+		ToNonce:    apiFilter.ToBlock,
+		Cursor:     apiFilter.Cursor,
+		MaxEntries: apiFilter.Size,
+	}
+
+	if len(apiFilter.Address) > 0 {
+		address, err := converter.pubKeyConverter.Decode(apiFilter.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Address = address
+	}
+
+	return filter, nil
+}
+
+func (converter *logsConverter) eventsPageToApiResource(page *eventsIndex.EventsPage) *common.FilteredEventsApiResponse {
+	events := make([]*common.FilteredEventApiResponse, len(page.Events))
+
+	for i, event := range page.Events {
+		events[i] = &common.FilteredEventApiResponse{
+			Address:        converter.encodeAddress(event.Address),
+			Identifier:     string(event.Identifier),
+			Topics:         event.Topics,
+			Data:           event.Data,
+			AdditionalData: event.AdditionalData,
+			TxHash:         hex.EncodeToString(event.TxHash),
+			EventIndex:     event.EventIndex,
+			Epoch:          event.Epoch,
+			Round:          event.Round,
+			BlockNonce:     event.HeaderNonce,
+			BlockHash:      hex.EncodeToString(event.HeaderHash),
+		}
+	}
+
+	return &common.FilteredEventsApiResponse{
+		Events:     events,
+		NextCursor: page.NextCursor,
+	}
+}
+
 func (converter *logsConverter) encodeAddress(pubkey []byte) string {
 	return converter.pubKeyConverter.SilentEncode(pubkey, log)
 }
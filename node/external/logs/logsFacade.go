@@ -4,14 +4,17 @@ import (
 	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
 var log = logger.GetOrCreate("node/external/logs")
 
 type logsFacade struct {
-	repository *logsRepository
-	converter  *logsConverter
+	repository        *logsRepository
+	converter         *logsConverter
+	historyRepository dblookupext.HistoryRepository
 }
 
 // NewLogsFacade creates a new logs facade
@@ -25,8 +28,9 @@ func NewLogsFacade(args ArgsNewLogsFacade) (*logsFacade, error) {
 	converter := newLogsConverter(args.PubKeyConverter)
 
 	return &logsFacade{
-		repository: repository,
-		converter:  converter,
+		repository:        repository,
+		converter:         converter,
+		historyRepository: args.HistoryRepository,
 	}, nil
 }
 
@@ -61,6 +65,26 @@ func (facade *logsFacade) IncludeLogsInTransactions(txs []*transaction.ApiTransa
 	return nil
 }
 
+// FilterEvents returns a page of the events matching the provided filter, in the order in which they were emitted.
+// The events are loaded from the events index of the db lookup extensions.
+func (facade *logsFacade) FilterEvents(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error) {
+	if !facade.historyRepository.IsEnabled() {
+		return nil, fmt.Errorf("%w: %v", errCannotFilterEvents, ErrDBLookupExtensionIsNotEnabled)
+	}
+
+	eventsFilter, err := facade.converter.apiFilterToEventsFilter(filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid address: %v", errCannotFilterEvents, err)
+	}
+
+	page, err := facade.historyRepository.GetEvents(eventsFilter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errCannotFilterEvents, err)
+	}
+
+	return facade.converter.eventsPageToApiResource(page), nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (facade *logsFacade) IsInterfaceNil() bool {
 	return facade == nil
@@ -6,7 +6,11 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
+	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/dblookupext"
 	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/stretchr/testify/require"
@@ -15,9 +19,10 @@ import (
 func TestNewLogsFacade(t *testing.T) {
 	t.Run("NilStorageService", func(t *testing.T) {
 		arguments := ArgsNewLogsFacade{
-			StorageService:  nil,
-			Marshaller:      marshallerMock.MarshalizerMock{},
-			PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+			StorageService:    nil,
+			Marshaller:        marshallerMock.MarshalizerMock{},
+			PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+			HistoryRepository: &dblookupext.HistoryRepositoryStub{},
 		}
 
 		facade, err := NewLogsFacade(arguments)
@@ -28,9 +33,10 @@ func TestNewLogsFacade(t *testing.T) {
 
 	t.Run("NilMarshaller", func(t *testing.T) {
 		arguments := ArgsNewLogsFacade{
-			StorageService:  genericMocks.NewChainStorerMock(7),
-			Marshaller:      nil,
-			PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+			StorageService:    genericMocks.NewChainStorerMock(7),
+			Marshaller:        nil,
+			PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+			HistoryRepository: &dblookupext.HistoryRepositoryStub{},
 		}
 
 		facade, err := NewLogsFacade(arguments)
@@ -41,9 +47,10 @@ func TestNewLogsFacade(t *testing.T) {
 
 	t.Run("NilPubKeyConverter", func(t *testing.T) {
 		arguments := ArgsNewLogsFacade{
-			StorageService:  genericMocks.NewChainStorerMock(7),
-			Marshaller:      marshallerMock.MarshalizerMock{},
-			PubKeyConverter: nil,
+			StorageService:    genericMocks.NewChainStorerMock(7),
+			Marshaller:        marshallerMock.MarshalizerMock{},
+			PubKeyConverter:   nil,
+			HistoryRepository: &dblookupext.HistoryRepositoryStub{},
 		}
 
 		facade, err := NewLogsFacade(arguments)
@@ -51,6 +58,20 @@ func TestNewLogsFacade(t *testing.T) {
 		require.ErrorContains(t, err, core.ErrNilPubkeyConverter.Error())
 		require.Nil(t, facade)
 	})
+
+	t.Run("NilHistoryRepository", func(t *testing.T) {
+		arguments := ArgsNewLogsFacade{
+			StorageService:    genericMocks.NewChainStorerMock(7),
+			Marshaller:        marshallerMock.MarshalizerMock{},
+			PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+			HistoryRepository: nil,
+		}
+
+		facade, err := NewLogsFacade(arguments)
+		require.ErrorIs(t, err, errCannotCreateLogsFacade)
+		require.ErrorContains(t, err, process.ErrNilHistoryRepository.Error())
+		require.Nil(t, facade)
+	})
 }
 
 func TestLogsFacade_GetLogShouldWork(t *testing.T) {
@@ -58,9 +79,10 @@ func TestLogsFacade_GetLogShouldWork(t *testing.T) {
 	marshaller := &marshal.GogoProtoMarshalizer{}
 
 	arguments := ArgsNewLogsFacade{
-		StorageService:  storageService,
-		Marshaller:      marshaller,
-		PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		StorageService:    storageService,
+		Marshaller:        marshaller,
+		PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+		HistoryRepository: &dblookupext.HistoryRepositoryStub{},
 	}
 
 	testLog := &transaction.Log{
@@ -96,9 +118,10 @@ func TestLogsFacade_IncludeLogsInTransactionsShouldWork(t *testing.T) {
 	marshaller := &marshal.GogoProtoMarshalizer{}
 
 	arguments := ArgsNewLogsFacade{
-		StorageService:  storageService,
-		Marshaller:      marshaller,
-		PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		StorageService:    storageService,
+		Marshaller:        marshaller,
+		PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+		HistoryRepository: &dblookupext.HistoryRepositoryStub{},
 	}
 
 	facade, _ := NewLogsFacade(arguments)
@@ -145,6 +168,131 @@ func TestLogsFacade_IncludeLogsInTransactionsShouldWork(t *testing.T) {
 	require.Equal(t, "fourth", transactions[3].Logs.Events[0].Identifier)
 }
 
+func TestLogsFacade_FilterEvents(t *testing.T) {
+	t.Parallel()
+
+	createArguments := func(historyRepository *dblookupext.HistoryRepositoryStub) ArgsNewLogsFacade {
+		return ArgsNewLogsFacade{
+			StorageService:    genericMocks.NewChainStorerMock(7),
+			Marshaller:        &marshal.GogoProtoMarshalizer{},
+			PubKeyConverter:   testscommon.NewPubkeyConverterMock(2),
+			HistoryRepository: historyRepository,
+		}
+	}
+
+	t.Run("db lookup extension not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade, _ := NewLogsFacade(createArguments(&dblookupext.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}))
+
+		response, err := facade.FilterEvents(&common.EventsFilterApiRequest{Identifier: "swap", Size: 10})
+		require.Nil(t, response)
+		require.ErrorIs(t, err, errCannotFilterEvents)
+		require.ErrorContains(t, err, ErrDBLookupExtensionIsNotEnabled.Error())
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade, _ := NewLogsFacade(createArguments(&dblookupext.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		}))
+
+		response, err := facade.FilterEvents(&common.EventsFilterApiRequest{Address: "not hex", Size: 10})
+		require.Nil(t, response)
+		require.ErrorIs(t, err, errCannotFilterEvents)
+		require.ErrorContains(t, err, "invalid address")
+	})
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade, _ := NewLogsFacade(createArguments(&dblookupext.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetEventsCalled: func(filter *eventsIndex.EventsFilter) (*eventsIndex.EventsPage, error) {
+				return nil, eventsIndex.ErrEmptyEventsFilter
+			},
+		}))
+
+		response, err := facade.FilterEvents(&common.EventsFilterApiRequest{Size: 10})
+		require.Nil(t, response)
+		require.ErrorIs(t, err, errCannotFilterEvents)
+		require.ErrorContains(t, err, eventsIndex.ErrEmptyEventsFilter.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade, _ := NewLogsFacade(createArguments(&dblookupext.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetEventsCalled: func(filter *eventsIndex.EventsFilter) (*eventsIndex.EventsPage, error) {
+				require.Equal(t, &eventsIndex.EventsFilter{
+					Address:    []byte{0xaa, 0xbb},
+					Identifier: []byte("swap"),
+					Topics:     [][]byte{nil, []byte("alice")},
+					FromNonce:  3,
+					ToNonce:    7,
+					Cursor:     11,
+					MaxEntries: 10,
+				}, filter)
+
+				return &eventsIndex.EventsPage{
+					Events: []*eventsIndex.IndexedEvent{
+						{
+							Address:     []byte{0xaa, 0xbb},
+							Identifier:  []byte("swap"),
+							Topics:      [][]byte{[]byte("WEGLD"), []byte("alice")},
+							Data:        []byte("data"),
+							TxHash:      []byte{0x01},
+							EventIndex:  2,
+							Epoch:       1,
+							Round:       6,
+							HeaderNonce: 5,
+							HeaderHash:  []byte{0x02},
+						},
+					},
+					NextCursor: 13,
+				}, nil
+			},
+		}))
+
+		response, err := facade.FilterEvents(&common.EventsFilterApiRequest{
+			Address:    "aabb",
+			Identifier: "swap",
+			Topics:     [][]byte{nil, []byte("alice")},
+			FromBlock:  3,
+			ToBlock:    7,
+			Cursor:     11,
+			Size:       10,
+		})
+		require.Nil(t, err)
+		require.Equal(t, &common.FilteredEventsApiResponse{
+			Events: []*common.FilteredEventApiResponse{
+				{
+					Address:    "aabb",
+					Identifier: "swap",
+					Topics:     [][]byte{[]byte("WEGLD"), []byte("alice")},
+					Data:       []byte("data"),
+					TxHash:     "01",
+					EventIndex: 2,
+					Epoch:      1,
+					Round:      6,
+					BlockNonce: 5,
+					BlockHash:  "02",
+				},
+			},
+			NextCursor: 13,
+		}, response)
+	})
+}
+
 func TestLogsFacade_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -152,9 +300,10 @@ func TestLogsFacade_IsInterfaceNil(t *testing.T) {
 	require.True(t, lf.IsInterfaceNil())
 
 	arguments := ArgsNewLogsFacade{
-		StorageService:  genericMocks.NewChainStorerMock(7),
-		Marshaller:      &marshal.GogoProtoMarshalizer{},
-		PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		StorageService:    genericMocks.NewChainStorerMock(7),
+		Marshaller:        &marshal.GogoProtoMarshalizer{},
+		PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+		HistoryRepository: &dblookupext.HistoryRepositoryStub{},
 	}
 	lf, _ = NewLogsFacade(arguments)
 	require.False(t, lf.IsInterfaceNil())
@@ -33,6 +33,8 @@ type ArgNodeApiResolver struct {
 	DirectStakedListHandler  DirectStakedListHandler
 	DelegatedListHandler     DelegatedListHandler
 	APITransactionHandler    APITransactionHandler
+	LogsFacade               LogsFacade
+	SubscriptionsHandler     SubscriptionsHandler
 	APIBlockHandler          blockAPI.APIBlockHandler
 	APIInternalBlockHandler  blockAPI.APIInternalBlockHandler
 	GenesisNodesSetupHandler sharding.GenesisNodesSetupHandler
@@ -54,6 +56,8 @@ type nodeApiResolver struct {
 	directStakedListHandler  DirectStakedListHandler
 	delegatedListHandler     DelegatedListHandler
 	apiTransactionHandler    APITransactionHandler
+	logsFacade               LogsFacade
+	subscriptionsHandler     SubscriptionsHandler
 	apiBlockHandler          blockAPI.APIBlockHandler
 	apiInternalBlockHandler  blockAPI.APIInternalBlockHandler
 	genesisNodesSetupHandler sharding.GenesisNodesSetupHandler
@@ -89,6 +93,12 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.APITransactionHandler) {
 		return nil, ErrNilAPITransactionHandler
 	}
+	if check.IfNil(arg.LogsFacade) {
+		return nil, ErrNilLogsFacade
+	}
+	if check.IfNil(arg.SubscriptionsHandler) {
+		return nil, ErrNilSubscriptionsHandler
+	}
 	if check.IfNil(arg.APIBlockHandler) {
 		return nil, ErrNilAPIBlockHandler
 	}
@@ -123,6 +133,8 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		delegatedListHandler:     arg.DelegatedListHandler,
 		apiBlockHandler:          arg.APIBlockHandler,
 		apiTransactionHandler:    arg.APITransactionHandler,
+		logsFacade:               arg.LogsFacade,
+		subscriptionsHandler:     arg.SubscriptionsHandler,
 		apiInternalBlockHandler:  arg.APIInternalBlockHandler,
 		genesisNodesSetupHandler: arg.GenesisNodesSetupHandler,
 		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
@@ -140,6 +152,11 @@ func (nar *nodeApiResolver) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return nar.scQueryService.ExecuteQuery(query)
 }
 
+// ExecuteSCQueries executes all the provided queries against the same state
+func (nar *nodeApiResolver) ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	return nar.scQueryService.ExecuteQueries(queries)
+}
+
 // StatusMetrics returns an implementation of the StatusMetricsHandler interface
 func (nar *nodeApiResolver) StatusMetrics() StatusMetricsHandler {
 	return nar.statusMetricsHandler
@@ -151,8 +168,8 @@ func (nar *nodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transacti
 }
 
 // SimulateTransactionExecution will simulate the provided transaction and return the simulation results
-func (nar *nodeApiResolver) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
-	return nar.apiTransactionEvaluator.SimulateTransactionExecution(tx)
+func (nar *nodeApiResolver) SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nar.apiTransactionEvaluator.SimulateTransactionExecution(tx, withTrace)
 }
 
 // Close closes all underlying components
@@ -214,6 +231,36 @@ func (nar *nodeApiResolver) GetTransactionsPoolNonceGapsForSender(sender string,
 	return nar.apiTransactionHandler.GetTransactionsPoolNonceGapsForSender(sender, senderAccountNonce)
 }
 
+// GetTransactionsPoolSenderDetails will return the transactions from pool for sender, checked against the selection rules, that is to be returned on API calls
+func (nar *nodeApiResolver) GetTransactionsPoolSenderDetails(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPoolSenderDetails(sender)
+}
+
+// GetTransactionsPoolSelection will return the transactions that would be picked from pool by the next selection, that is to be returned on API calls
+func (nar *nodeApiResolver) GetTransactionsPoolSelection(fields string) (*common.TransactionsPoolSelectionApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPoolSelection(fields)
+}
+
+// GetTransactionsPoolRejections will return the transactions recently evicted from pool or rejected at selection, that is to be returned on API calls
+func (nar *nodeApiResolver) GetTransactionsPoolRejections(sender string) (*common.TransactionsPoolRejectionsApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPoolRejections(sender)
+}
+
+// GetTransactionsForAddress will return a page of the transactions history of the provided address, that is to be returned on API calls
+func (nar *nodeApiResolver) GetTransactionsForAddress(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsForAddress(address, cursor, size)
+}
+
+// FilterEvents will return a page of the events matching the provided filter, that is to be returned on API calls
+func (nar *nodeApiResolver) FilterEvents(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error) {
+	return nar.logsFacade.FilterEvents(filter)
+}
+
+// HandleSubscriptionsConnection will serve the websocket subscriptions on the provided connection, blocking until it is closed
+func (nar *nodeApiResolver) HandleSubscriptionsConnection(conn common.WebSocketConnection) error {
+	return nar.subscriptionsHandler.HandleConnection(conn)
+}
+
 // GetBlockByHash will return the block with the given hash and optionally with transactions
 func (nar *nodeApiResolver) GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error) {
 	decodedHash, err := hex.DecodeString(hash)
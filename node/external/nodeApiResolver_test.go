@@ -38,6 +38,8 @@ func createMockArgs() external.ArgNodeApiResolver {
 		DelegatedListHandler:     &mock.DelegatedListProcessorStub{},
 		APIBlockHandler:          &mock.BlockAPIHandlerStub{},
 		APITransactionHandler:    &mock.TransactionAPIHandlerStub{},
+		LogsFacade:               &testscommon.LogsFacadeStub{},
+		SubscriptionsHandler:     &testscommon.SubscriptionsHandlerStub{},
 		APIInternalBlockHandler:  &mock.InternalBlockApiHandlerStub{},
 		GenesisNodesSetupHandler: &genesisMocks.NodesSetupStub{},
 		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
@@ -92,6 +94,28 @@ func TestNewNodeApiResolver_NilTotalStakedValueHandler(t *testing.T) {
 	assert.Equal(t, external.ErrNilTotalStakedValueHandler, err)
 }
 
+func TestNewNodeApiResolver_NilLogsFacade(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.LogsFacade = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilLogsFacade, err)
+}
+
+func TestNewNodeApiResolver_NilSubscriptionsHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.SubscriptionsHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilSubscriptionsHandler, err)
+}
+
 func TestNewNodeApiResolver_NilDirectStakedListHandler(t *testing.T) {
 	t.Parallel()
 
@@ -186,6 +210,24 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_ExecuteSCQueriesShouldCall(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	wasCalled := false
+	arg.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+			wasCalled = true
+			return make([]*process.SCQueryResult, 0), nil, nil
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(arg)
+
+	_, _, _ = nar.ExecuteSCQueries([]*process.SCQuery{{}})
+
+	assert.True(t, wasCalled)
+}
+
 func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
@@ -578,6 +620,104 @@ func TestNodeApiResolver_GetTransactionsPoolNonceGapsForSender(t *testing.T) {
 	})
 }
 
+func TestNodeApiResolver_GetTransactionsPoolInspection(t *testing.T) {
+	t.Parallel()
+
+	expectedDetails := &common.TransactionsPoolSenderDetailsApiResponse{Sender: "alice", AccountNonce: 7}
+	expectedSelection := &common.TransactionsPoolSelectionApiResponse{TotalGasLimit: 50000}
+	expectedRejections := &common.TransactionsPoolRejectionsApiResponse{
+		Rejections: []common.RejectedTransactionApiResponse{{Hash: "aa", Sender: "alice", Nonce: 3, Reason: "nonce gap"}},
+	}
+	arg := createMockArgs()
+	arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetTransactionsPoolSenderDetailsCalled: func(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+			require.Equal(t, "alice", sender)
+			return expectedDetails, nil
+		},
+		GetTransactionsPoolSelectionCalled: func(fields string) (*common.TransactionsPoolSelectionApiResponse, error) {
+			require.Equal(t, "hash", fields)
+			return expectedSelection, nil
+		},
+		GetTransactionsPoolRejectionsCalled: func(sender string) (*common.TransactionsPoolRejectionsApiResponse, error) {
+			require.Equal(t, "alice", sender)
+			return expectedRejections, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+
+	details, err := nar.GetTransactionsPoolSenderDetails("alice")
+	require.NoError(t, err)
+	require.Equal(t, expectedDetails, details)
+
+	selection, err := nar.GetTransactionsPoolSelection("hash")
+	require.NoError(t, err)
+	require.Equal(t, expectedSelection, selection)
+
+	rejections, err := nar.GetTransactionsPoolRejections("alice")
+	require.NoError(t, err)
+	require.Equal(t, expectedRejections, rejections)
+}
+
+func TestNodeApiResolver_GetTransactionsForAddress(t *testing.T) {
+	t.Parallel()
+
+	expectedTransactions := &common.AddressTransactionsApiResponse{NumTransactions: 3, NextCursor: 1}
+	arg := createMockArgs()
+	arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetTransactionsForAddressCalled: func(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error) {
+			require.Equal(t, "alice", address)
+			require.Equal(t, uint64(3), cursor)
+			require.Equal(t, 2, size)
+			return expectedTransactions, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	transactions, err := nar.GetTransactionsForAddress("alice", 3, 2)
+	require.NoError(t, err)
+	require.Equal(t, expectedTransactions, transactions)
+}
+
+func TestNodeApiResolver_FilterEvents(t *testing.T) {
+	t.Parallel()
+
+	expectedFilter := &common.EventsFilterApiRequest{Identifier: "swap", Size: 2}
+	expectedEvents := &common.FilteredEventsApiResponse{NextCursor: 4}
+	arg := createMockArgs()
+	arg.LogsFacade = &testscommon.LogsFacadeStub{
+		FilterEventsCalled: func(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error) {
+			require.Equal(t, expectedFilter, filter)
+			return expectedEvents, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	events, err := nar.FilterEvents(expectedFilter)
+	require.NoError(t, err)
+	require.Equal(t, expectedEvents, events)
+}
+
+func TestNodeApiResolver_HandleSubscriptionsConnection(t *testing.T) {
+	t.Parallel()
+
+	expectedConn := &testscommon.WebSocketConnectionStub{}
+	wasCalled := false
+	arg := createMockArgs()
+	arg.SubscriptionsHandler = &testscommon.SubscriptionsHandlerStub{
+		HandleConnectionCalled: func(conn common.WebSocketConnection) error {
+			wasCalled = true
+			require.True(t, conn == expectedConn)
+			return expectedErr
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	err := nar.HandleSubscriptionsConnection(expectedConn)
+	require.Equal(t, expectedErr, err)
+	require.True(t, wasCalled)
+}
+
 func TestNodeApiResolver_GetGenesisNodesPubKeys(t *testing.T) {
 	t.Parallel()
 
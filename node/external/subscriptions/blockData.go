@@ -0,0 +1,192 @@
+package subscriptions
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/sharding"
+)
+
+// blockTransactions computes, on demand, the status of the transactions included in a committed block
+type blockTransactions struct {
+	pool             *outport.TransactionPool
+	shardCoordinator sharding.Coordinator
+	block            *trackedBlock
+	failedTxs        map[string]struct{}
+	watched          map[string]*TransactionNotification
+	watchedOrder     []string
+}
+
+func newBlockTransactions(pool *outport.TransactionPool, shardCoordinator sharding.Coordinator, block *trackedBlock) *blockTransactions {
+	if pool == nil {
+		pool = &outport.TransactionPool{}
+	}
+
+	return &blockTransactions{
+		pool:             pool,
+		shardCoordinator: shardCoordinator,
+		block:            block,
+		watched:          make(map[string]*TransactionNotification),
+	}
+}
+
+// get returns the status notification of the transaction with the provided hex encoded hash, if included in the block
+func (bt *blockTransactions) get(hash string) (*TransactionNotification, bool) {
+	notification, found := bt.watched[hash]
+	if found {
+		return notification, true
+	}
+
+	status, found := bt.computeStatus(hash)
+	if !found {
+		return nil, false
+	}
+
+	notification = &TransactionNotification{
+		Hash:       hash,
+		Status:     string(status),
+		BlockHash:  bt.block.hash,
+		BlockNonce: bt.block.nonce,
+	}
+	bt.watched[hash] = notification
+	bt.watchedOrder = append(bt.watchedOrder, hash)
+
+	return notification, true
+}
+
+// computeStatus returns the status of the transaction as seen by the current shard: invalid transactions and
+// transactions that signaled an error are final, cross-shard transactions at source remain pending
+func (bt *blockTransactions) computeStatus(hash string) (transaction.TxStatus, bool) {
+	_, isInvalid := bt.pool.InvalidTxs[hash]
+	if isInvalid {
+		return transaction.TxStatusInvalid, true
+	}
+
+	txInfo, found := bt.pool.Transactions[hash]
+	if !found {
+		return "", false
+	}
+
+	if bt.hasFailed(hash) {
+		return transaction.TxStatusFail, true
+	}
+
+	tx := txInfo.GetTransaction()
+	if tx == nil {
+		return transaction.TxStatusSuccess, true
+	}
+
+	selfShardID := bt.shardCoordinator.SelfId()
+	isCrossShardAtSource := bt.shardCoordinator.ComputeId(tx.SndAddr) == selfShardID &&
+		bt.shardCoordinator.ComputeId(tx.RcvAddr) != selfShardID
+	if isCrossShardAtSource {
+		return transaction.TxStatusPending, true
+	}
+
+	return transaction.TxStatusSuccess, true
+}
+
+func (bt *blockTransactions) hasFailed(hash string) bool {
+	if bt.failedTxs == nil {
+		bt.failedTxs = make(map[string]struct{})
+		for _, logData := range bt.pool.Logs {
+			if logData == nil || logData.Log == nil {
+				continue
+			}
+
+			for _, event := range logData.Log.Events {
+				if event != nil && string(event.Identifier) == core.SignalErrorOperation {
+					bt.failedTxs[logData.TxHash] = struct{}{}
+				}
+			}
+		}
+	}
+
+	_, failed := bt.failedTxs[hash]
+	return failed
+}
+
+// watchedNotifications returns the notifications of the transactions requested by at least one subscription
+func (bt *blockTransactions) watchedNotifications() []*TransactionNotification {
+	notifications := make([]*TransactionNotification, 0, len(bt.watchedOrder))
+	for _, hash := range bt.watchedOrder {
+		notifications = append(notifications, bt.watched[hash])
+	}
+
+	return notifications
+}
+
+type blockEvent struct {
+	encodedAddress string
+	event          *transaction.Event
+	notification   *common.FilteredEventApiResponse
+}
+
+// blockEvents extracts, on demand, the events generated in a committed block
+type blockEvents struct {
+	pool            *outport.TransactionPool
+	header          data.HeaderHandler
+	blockHash       string
+	pubKeyConverter core.PubkeyConverter
+	events          []*blockEvent
+}
+
+func newBlockEvents(
+	pool *outport.TransactionPool,
+	header data.HeaderHandler,
+	blockHash string,
+	pubKeyConverter core.PubkeyConverter,
+) *blockEvents {
+	return &blockEvents{
+		pool:            pool,
+		header:          header,
+		blockHash:       blockHash,
+		pubKeyConverter: pubKeyConverter,
+	}
+}
+
+func (be *blockEvents) get() []*blockEvent {
+	if be.events != nil {
+		return be.events
+	}
+
+	be.events = make([]*blockEvent, 0)
+	if be.pool == nil {
+		return be.events
+	}
+
+	for _, logData := range be.pool.Logs {
+		if logData == nil || logData.Log == nil {
+			continue
+		}
+
+		for index, event := range logData.Log.Events {
+			if event == nil {
+				continue
+			}
+
+			encodedAddress := be.pubKeyConverter.SilentEncode(event.Address, log)
+			be.events = append(be.events, &blockEvent{
+				encodedAddress: encodedAddress,
+				event:          event,
+				notification: &common.FilteredEventApiResponse{
+					Address:        encodedAddress,
+					Identifier:     string(event.Identifier),
+					Topics:         event.Topics,
+					Data:           event.Data,
+					AdditionalData: event.AdditionalData,
+					TxHash:         logData.TxHash,
+					EventIndex:     uint32(index),
+					Epoch:          be.header.GetEpoch(),
+					Round:          be.header.GetRound(),
+					BlockNonce:     be.header.GetNonce(),
+					BlockHash:      be.blockHash,
+				},
+			})
+		}
+	}
+
+	return be.events
+}
@@ -0,0 +1,110 @@
+package subscriptions
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type client struct {
+	conn             common.WebSocketConnection
+	chanSend         chan []byte
+	chanClose        chan struct{}
+	closeOnce        sync.Once
+	mutSubscriptions sync.RWMutex
+	subscriptions    map[string]*subscription
+}
+
+func newClient(conn common.WebSocketConnection, bufferSize int) *client {
+	return &client{
+		conn:          conn,
+		chanSend:      make(chan []byte, bufferSize),
+		chanClose:     make(chan struct{}),
+		subscriptions: make(map[string]*subscription),
+	}
+}
+
+func (c *client) addSubscription(sub *subscription, maxSubscriptions int) error {
+	c.mutSubscriptions.Lock()
+	defer c.mutSubscriptions.Unlock()
+
+	if len(c.subscriptions) >= maxSubscriptions {
+		return fmt.Errorf("%w: maximum %d", errTooManySubscriptions, maxSubscriptions)
+	}
+
+	c.subscriptions[sub.id] = sub
+
+	return nil
+}
+
+func (c *client) removeSubscription(id string) error {
+	c.mutSubscriptions.Lock()
+	defer c.mutSubscriptions.Unlock()
+
+	_, found := c.subscriptions[id]
+	if !found {
+		return fmt.Errorf("%w: %s", errUnknownSubscription, id)
+	}
+
+	delete(c.subscriptions, id)
+
+	return nil
+}
+
+// subscriptionsOfTopic returns the client's subscriptions on the provided topic, ordered by their creation
+func (c *client) subscriptionsOfTopic(topic string) []*subscription {
+	c.mutSubscriptions.RLock()
+	defer c.mutSubscriptions.RUnlock()
+
+	subscriptions := make([]*subscription, 0, len(c.subscriptions))
+	for _, sub := range c.subscriptions {
+		if sub.topic == topic {
+			subscriptions = append(subscriptions, sub)
+		}
+	}
+
+	sort.Slice(subscriptions, func(i, j int) bool {
+		if len(subscriptions[i].id) != len(subscriptions[j].id) {
+			return len(subscriptions[i].id) < len(subscriptions[j].id)
+		}
+		return subscriptions[i].id < subscriptions[j].id
+	})
+
+	return subscriptions
+}
+
+// trySend queues the message without blocking, returning false if the client's buffer is full
+func (c *client) trySend(message []byte) bool {
+	select {
+	case c.chanSend <- message:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *client) writeLoop() {
+	for {
+		select {
+		case <-c.chanClose:
+			return
+		case message := <-c.chanSend:
+			err := c.conn.WriteMessage(websocket.TextMessage, message)
+			if err != nil {
+				log.Debug("subscriptions client: cannot write message, closing", "error", err.Error())
+				c.close()
+				return
+			}
+		}
+	}
+}
+
+func (c *client) close() {
+	c.closeOnce.Do(func() {
+		close(c.chanClose)
+		_ = c.conn.Close()
+	})
+}
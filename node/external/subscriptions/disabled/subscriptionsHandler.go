@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+var errSubscriptionsDisabled = errors.New("websocket subscriptions are disabled")
+
+type subscriptionsHandler struct {
+}
+
+// NewSubscriptionsHandler returns a disabled subscriptions handler
+func NewSubscriptionsHandler() *subscriptionsHandler {
+	return &subscriptionsHandler{}
+}
+
+// HandleConnection returns a disabled error
+func (sh *subscriptionsHandler) HandleConnection(_ common.WebSocketConnection) error {
+	return errSubscriptionsDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sh *subscriptionsHandler) IsInterfaceNil() bool {
+	return sh == nil
+}
@@ -0,0 +1,96 @@
+package subscriptions
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+)
+
+const (
+	// MethodSubscribe is the method used by a client in order to create a new subscription
+	MethodSubscribe = "subscribe"
+	// MethodUnsubscribe is the method used by a client in order to cancel one of its subscriptions
+	MethodUnsubscribe = "unsubscribe"
+)
+
+const (
+	// TopicBlocks is the topic used for the blocks committed by the node
+	TopicBlocks = "blocks"
+	// TopicFinalizedBlocks is the topic used for the blocks finalized by the node
+	TopicFinalizedBlocks = "finalizedBlocks"
+	// TopicTransactions is the topic used for the status changes of the watched transactions
+	TopicTransactions = "transactions"
+	// TopicAccounts is the topic used for the changes of the watched accounts
+	TopicAccounts = "accounts"
+	// TopicEvents is the topic used for the events matching a filter
+	TopicEvents = "events"
+)
+
+// Request is the message sent by a client on the websocket connection
+type Request struct {
+	ID           uint64              `json:"id"`
+	Method       string              `json:"method"`
+	Topic        string              `json:"topic,omitempty"`
+	Params       *SubscriptionParams `json:"params,omitempty"`
+	Subscription string              `json:"subscription,omitempty"`
+}
+
+// SubscriptionParams holds the filtering options of a subscription. Each topic uses only a part of the fields:
+// blocks and finalizedBlocks use Shard, transactions use Hashes, accounts use Addresses and events use
+// Address, Identifier and Topics
+type SubscriptionParams struct {
+	Shard      *uint32  `json:"shard,omitempty"`
+	Hashes     []string `json:"hashes,omitempty"`
+	Addresses  []string `json:"addresses,omitempty"`
+	Address    string   `json:"address,omitempty"`
+	Identifier string   `json:"identifier,omitempty"`
+	// Topics are matched by position, an empty topic matching any value
+	Topics [][]byte `json:"topics,omitempty"`
+}
+
+// Response is the message sent to a client as an answer to one of its requests
+type Response struct {
+	ID           uint64 `json:"id"`
+	Subscription string `json:"subscription,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// Notification is the message pushed to a client whenever new data matches one of its subscriptions
+type Notification struct {
+	Subscription string      `json:"subscription"`
+	Topic        string      `json:"topic"`
+	Data         interface{} `json:"data"`
+}
+
+// BlockNotification holds the data pushed on the blocks topic
+type BlockNotification struct {
+	Hash      string `json:"hash"`
+	ShardID   uint32 `json:"shard"`
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	Epoch     uint32 `json:"epoch"`
+	Timestamp uint64 `json:"timestamp"`
+	NumTxs    uint32 `json:"numTxs"`
+	Reverted  bool   `json:"reverted,omitempty"`
+}
+
+// FinalizedBlockNotification holds the data pushed on the finalizedBlocks topic
+type FinalizedBlockNotification struct {
+	Hash    string `json:"hash"`
+	ShardID uint32 `json:"shard"`
+}
+
+// TransactionNotification holds the data pushed on the transactions topic
+type TransactionNotification struct {
+	Hash       string `json:"hash"`
+	Status     string `json:"status"`
+	BlockHash  string `json:"blockHash"`
+	BlockNonce uint64 `json:"blockNonce"`
+	Finalized  bool   `json:"finalized,omitempty"`
+	Reverted   bool   `json:"reverted,omitempty"`
+}
+
+// AccountNotification holds the data pushed on the accounts topic
+type AccountNotification struct {
+	Account    *alteredAccount.AlteredAccount `json:"account"`
+	BlockHash  string                         `json:"blockHash"`
+	BlockNonce uint64                         `json:"blockNonce"`
+}
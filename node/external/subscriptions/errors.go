@@ -0,0 +1,37 @@
+package subscriptions
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilBlockContainerHandler signals that a nil block container handler has been provided
+var ErrNilBlockContainerHandler = errors.New("nil block container handler")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrInvalidConfigValue signals that an invalid config value has been provided
+var ErrInvalidConfigValue = errors.New("invalid config value")
+
+// ErrNilWebSocketConnection signals that a nil websocket connection has been provided
+var ErrNilWebSocketConnection = errors.New("nil websocket connection")
+
+// ErrTooManyClients signals that the maximum number of connected clients has been reached
+var ErrTooManyClients = errors.New("too many connected clients")
+
+// ErrSubscriptionsHubClosed signals that the subscriptions hub has been closed
+var ErrSubscriptionsHubClosed = errors.New("subscriptions hub is closed")
+
+var errInvalidRequest = errors.New("invalid request")
+var errUnknownMethod = errors.New("unknown method")
+var errUnknownTopic = errors.New("unknown topic")
+var errUnknownSubscription = errors.New("unknown subscription")
+var errTooManySubscriptions = errors.New("too many subscriptions")
+var errTooManyItems = errors.New("too many items in subscription")
+var errEmptySubscriptionParams = errors.New("empty subscription params")
+var errInvalidTransactionHash = errors.New("invalid transaction hash")
+var errInvalidAddress = errors.New("invalid address")
@@ -0,0 +1,163 @@
+package subscriptions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+type subscription struct {
+	id         string
+	topic      string
+	shard      *uint32
+	hashes     map[string]struct{}
+	addresses  map[string]struct{}
+	address    string
+	identifier []byte
+	topics     [][]byte
+}
+
+func newSubscription(
+	id string,
+	topic string,
+	params *SubscriptionParams,
+	maxItems int,
+	pubKeyConverter core.PubkeyConverter,
+) (*subscription, error) {
+	if params == nil {
+		params = &SubscriptionParams{}
+	}
+
+	sub := &subscription{
+		id:    id,
+		topic: topic,
+	}
+
+	switch topic {
+	case TopicBlocks, TopicFinalizedBlocks:
+		sub.shard = params.Shard
+		return sub, nil
+	case TopicTransactions:
+		hashes, err := createHashesSet(params.Hashes, maxItems)
+		if err != nil {
+			return nil, err
+		}
+		sub.hashes = hashes
+		return sub, nil
+	case TopicAccounts:
+		addresses, err := createAddressesSet(params.Addresses, maxItems, pubKeyConverter)
+		if err != nil {
+			return nil, err
+		}
+		sub.addresses = addresses
+		return sub, nil
+	case TopicEvents:
+		err := sub.setEventsFilter(params, maxItems, pubKeyConverter)
+		if err != nil {
+			return nil, err
+		}
+		return sub, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownTopic, topic)
+	}
+}
+
+func createHashesSet(hashes []string, maxItems int) (map[string]struct{}, error) {
+	if len(hashes) == 0 {
+		return nil, errEmptySubscriptionParams
+	}
+	if len(hashes) > maxItems {
+		return nil, fmt.Errorf("%w: provided %d, maximum %d", errTooManyItems, len(hashes), maxItems)
+	}
+
+	set := make(map[string]struct{}, len(hashes))
+	for _, hash := range hashes {
+		_, err := hex.DecodeString(hash)
+		if err != nil || len(hash) == 0 {
+			return nil, fmt.Errorf("%w: %s", errInvalidTransactionHash, hash)
+		}
+		set[hash] = struct{}{}
+	}
+
+	return set, nil
+}
+
+func createAddressesSet(addresses []string, maxItems int, pubKeyConverter core.PubkeyConverter) (map[string]struct{}, error) {
+	if len(addresses) == 0 {
+		return nil, errEmptySubscriptionParams
+	}
+	if len(addresses) > maxItems {
+		return nil, fmt.Errorf("%w: provided %d, maximum %d", errTooManyItems, len(addresses), maxItems)
+	}
+
+	set := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		_, err := pubKeyConverter.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s, %s", errInvalidAddress, address, err.Error())
+		}
+		set[address] = struct{}{}
+	}
+
+	return set, nil
+}
+
+func (sub *subscription) setEventsFilter(params *SubscriptionParams, maxItems int, pubKeyConverter core.PubkeyConverter) error {
+	hasFirstTopic := len(params.Topics) > 0 && len(params.Topics[0]) > 0
+	if len(params.Address) == 0 && len(params.Identifier) == 0 && !hasFirstTopic {
+		return errEmptySubscriptionParams
+	}
+	if len(params.Topics) > maxItems {
+		return fmt.Errorf("%w: provided %d, maximum %d", errTooManyItems, len(params.Topics), maxItems)
+	}
+
+	if len(params.Address) > 0 {
+		_, err := pubKeyConverter.Decode(params.Address)
+		if err != nil {
+			return fmt.Errorf("%w: %s, %s", errInvalidAddress, params.Address, err.Error())
+		}
+	}
+
+	sub.address = params.Address
+	sub.identifier = []byte(params.Identifier)
+	sub.topics = params.Topics
+
+	return nil
+}
+
+func (sub *subscription) matchesShard(shardID uint32) bool {
+	return sub.shard == nil || *sub.shard == shardID
+}
+
+func (sub *subscription) matchesHash(hash string) bool {
+	_, found := sub.hashes[hash]
+	return found
+}
+
+func (sub *subscription) matchesAddress(address string) bool {
+	_, found := sub.addresses[address]
+	return found
+}
+
+func (sub *subscription) matchesEvent(encodedAddress string, event *transaction.Event) bool {
+	if len(sub.address) > 0 && sub.address != encodedAddress {
+		return false
+	}
+	if len(sub.identifier) > 0 && !bytes.Equal(sub.identifier, event.Identifier) {
+		return false
+	}
+
+	for i, topic := range sub.topics {
+		if len(topic) == 0 {
+			continue
+		}
+		if i >= len(event.Topics) || !bytes.Equal(event.Topics[i], topic) {
+			return false
+		}
+	}
+
+	return true
+}
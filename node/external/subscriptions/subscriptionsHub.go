@@ -0,0 +1,513 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/sharding"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("node/external/subscriptions")
+
+// ArgsSubscriptionsHub holds the arguments needed to create a subscriptions hub
+type ArgsSubscriptionsHub struct {
+	Config                 config.ApiSubscriptionsConfig
+	Marshaller             marshal.Marshalizer
+	BlockContainer         BlockContainerHandler
+	ShardCoordinator       sharding.Coordinator
+	AddressPubKeyConverter core.PubkeyConverter
+}
+
+type trackedBlock struct {
+	hash         string
+	nonce        uint64
+	transactions []*TransactionNotification
+}
+
+type subscriptionsHub struct {
+	config                 config.ApiSubscriptionsConfig
+	marshaller             marshal.Marshalizer
+	blockContainer         BlockContainerHandler
+	shardCoordinator       sharding.Coordinator
+	addressPubKeyConverter core.PubkeyConverter
+
+	subscriptionsCounter uint64
+
+	mutClients sync.RWMutex
+	clients    map[*client]struct{}
+	closed     bool
+
+	mutTrackedBlocks sync.Mutex
+	trackedBlocks    map[string]*trackedBlock
+}
+
+// NewSubscriptionsHub creates a new subscriptions hub. The hub is an outport driver that pushes the data of the
+// committed, reverted and finalized blocks to the websocket clients that subscribed to it
+func NewSubscriptionsHub(args ArgsSubscriptionsHub) (*subscriptionsHub, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionsHub{
+		config:                 args.Config,
+		marshaller:             args.Marshaller,
+		blockContainer:         args.BlockContainer,
+		shardCoordinator:       args.ShardCoordinator,
+		addressPubKeyConverter: args.AddressPubKeyConverter,
+		clients:                make(map[*client]struct{}),
+		trackedBlocks:          make(map[string]*trackedBlock),
+	}, nil
+}
+
+func checkArgs(args ArgsSubscriptionsHub) error {
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNilReflect(args.BlockContainer) {
+		return ErrNilBlockContainerHandler
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if args.Config.MaxClients < 1 {
+		return fmt.Errorf("%w for MaxClients: %d", ErrInvalidConfigValue, args.Config.MaxClients)
+	}
+	if args.Config.MaxSubscriptionsPerClient < 1 {
+		return fmt.Errorf("%w for MaxSubscriptionsPerClient: %d", ErrInvalidConfigValue, args.Config.MaxSubscriptionsPerClient)
+	}
+	if args.Config.MaxItemsPerSubscription < 1 {
+		return fmt.Errorf("%w for MaxItemsPerSubscription: %d", ErrInvalidConfigValue, args.Config.MaxItemsPerSubscription)
+	}
+	if args.Config.ClientBufferSize < 1 {
+		return fmt.Errorf("%w for ClientBufferSize: %d", ErrInvalidConfigValue, args.Config.ClientBufferSize)
+	}
+
+	return nil
+}
+
+// HandleConnection serves the provided websocket connection, blocking until the connection is closed
+func (hub *subscriptionsHub) HandleConnection(conn common.WebSocketConnection) error {
+	if conn == nil {
+		return ErrNilWebSocketConnection
+	}
+
+	c, err := hub.registerClient(conn)
+	if err != nil {
+		return err
+	}
+	defer hub.unregisterClient(c)
+
+	go c.writeLoop()
+	hub.readLoop(c)
+
+	return nil
+}
+
+func (hub *subscriptionsHub) registerClient(conn common.WebSocketConnection) (*client, error) {
+	hub.mutClients.Lock()
+	defer hub.mutClients.Unlock()
+
+	if hub.closed {
+		return nil, ErrSubscriptionsHubClosed
+	}
+	if len(hub.clients) >= hub.config.MaxClients {
+		return nil, fmt.Errorf("%w: maximum %d", ErrTooManyClients, hub.config.MaxClients)
+	}
+
+	c := newClient(conn, hub.config.ClientBufferSize)
+	hub.clients[c] = struct{}{}
+
+	return c, nil
+}
+
+func (hub *subscriptionsHub) unregisterClient(c *client) {
+	hub.mutClients.Lock()
+	delete(hub.clients, c)
+	hub.mutClients.Unlock()
+
+	c.close()
+}
+
+func (hub *subscriptionsHub) readLoop(c *client) {
+	for {
+		_, message, err := c.conn.ReadMessage()
+		if err != nil {
+			log.Trace("subscriptionsHub: client connection ended", "error", err.Error())
+			return
+		}
+
+		response := hub.processRequest(c, message)
+		responseBytes, err := json.Marshal(response)
+		if err != nil {
+			log.Warn("subscriptionsHub: cannot marshal response", "error", err.Error())
+			continue
+		}
+
+		if !c.trySend(responseBytes) {
+			log.Debug("subscriptionsHub: client buffer is full, disconnecting")
+			return
+		}
+	}
+}
+
+func (hub *subscriptionsHub) processRequest(c *client, message []byte) *Response {
+	request := &Request{}
+	err := json.Unmarshal(message, request)
+	if err != nil {
+		return &Response{Error: fmt.Sprintf("%s: %s", errInvalidRequest.Error(), err.Error())}
+	}
+
+	var subscriptionID string
+	switch request.Method {
+	case MethodSubscribe:
+		subscriptionID, err = hub.subscribe(c, request)
+	case MethodUnsubscribe:
+		subscriptionID = request.Subscription
+		err = c.removeSubscription(request.Subscription)
+	default:
+		err = fmt.Errorf("%w: %s", errUnknownMethod, request.Method)
+	}
+	if err != nil {
+		return &Response{ID: request.ID, Error: err.Error()}
+	}
+
+	return &Response{ID: request.ID, Subscription: subscriptionID}
+}
+
+func (hub *subscriptionsHub) subscribe(c *client, request *Request) (string, error) {
+	id := strconv.FormatUint(atomic.AddUint64(&hub.subscriptionsCounter, 1), 10)
+
+	sub, err := newSubscription(id, request.Topic, request.Params, hub.config.MaxItemsPerSubscription, hub.addressPubKeyConverter)
+	if err != nil {
+		return "", err
+	}
+
+	err = c.addSubscription(sub, hub.config.MaxSubscriptionsPerClient)
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// SaveBlock pushes the committed block, the status of the watched transactions, the watched altered accounts and
+// the matching events to the subscribed clients. It never returns an error, as the outport would retry the call
+func (hub *subscriptionsHub) SaveBlock(outportBlock *outport.OutportBlock) error {
+	if outportBlock == nil || outportBlock.BlockData == nil {
+		return nil
+	}
+
+	header, err := hub.getHeader(outportBlock.BlockData)
+	if err != nil {
+		log.Warn("subscriptionsHub.SaveBlock: cannot decode header", "error", err.Error())
+		return nil
+	}
+
+	tracked := &trackedBlock{
+		hash:  hex.EncodeToString(outportBlock.BlockData.HeaderHash),
+		nonce: header.GetNonce(),
+	}
+	blockNotification := createBlockNotification(outportBlock.BlockData, header)
+	transactions := newBlockTransactions(outportBlock.TransactionPool, hub.shardCoordinator, tracked)
+	events := newBlockEvents(outportBlock.TransactionPool, header, tracked.hash, hub.addressPubKeyConverter)
+
+	hub.mutClients.RLock()
+	for c := range hub.clients {
+		hub.notifyBlock(c, TopicBlocks, blockNotification.ShardID, blockNotification)
+		hub.notifyTransactions(c, transactions)
+		hub.notifyAccounts(c, outportBlock.AlteredAccounts, tracked)
+		hub.notifyEvents(c, events)
+	}
+	hub.mutClients.RUnlock()
+
+	tracked.transactions = transactions.watchedNotifications()
+	hub.mutTrackedBlocks.Lock()
+	hub.trackedBlocks[tracked.hash] = tracked
+	hub.mutTrackedBlocks.Unlock()
+
+	return nil
+}
+
+func (hub *subscriptionsHub) getHeader(blockData *outport.BlockData) (data.HeaderHandler, error) {
+	creator, err := hub.blockContainer.Get(core.HeaderType(blockData.HeaderType))
+	if err != nil {
+		return nil, err
+	}
+
+	return block.GetHeaderFromBytes(hub.marshaller, creator, blockData.HeaderBytes)
+}
+
+func createBlockNotification(blockData *outport.BlockData, header data.HeaderHandler) *BlockNotification {
+	return &BlockNotification{
+		Hash:      hex.EncodeToString(blockData.HeaderHash),
+		ShardID:   blockData.ShardID,
+		Nonce:     header.GetNonce(),
+		Round:     header.GetRound(),
+		Epoch:     header.GetEpoch(),
+		Timestamp: header.GetTimeStamp(),
+		NumTxs:    header.GetTxCount(),
+	}
+}
+
+func (hub *subscriptionsHub) notifyBlock(c *client, topic string, shardID uint32, notification interface{}) {
+	for _, sub := range c.subscriptionsOfTopic(topic) {
+		if sub.matchesShard(shardID) {
+			hub.notify(c, sub, notification)
+		}
+	}
+}
+
+func (hub *subscriptionsHub) notifyTransactions(c *client, transactions *blockTransactions) {
+	for _, sub := range c.subscriptionsOfTopic(TopicTransactions) {
+		for _, hash := range sortedKeys(sub.hashes) {
+			notification, found := transactions.get(hash)
+			if found {
+				hub.notify(c, sub, notification)
+			}
+		}
+	}
+}
+
+func (hub *subscriptionsHub) notifyAccounts(c *client, alteredAccounts map[string]*alteredAccount.AlteredAccount, tracked *trackedBlock) {
+	for _, sub := range c.subscriptionsOfTopic(TopicAccounts) {
+		for _, address := range sortedKeys(sub.addresses) {
+			account, found := alteredAccounts[address]
+			if !found {
+				continue
+			}
+
+			hub.notify(c, sub, &AccountNotification{
+				Account:    account,
+				BlockHash:  tracked.hash,
+				BlockNonce: tracked.nonce,
+			})
+		}
+	}
+}
+
+func (hub *subscriptionsHub) notifyEvents(c *client, events *blockEvents) {
+	for _, sub := range c.subscriptionsOfTopic(TopicEvents) {
+		for _, event := range events.get() {
+			if sub.matchesEvent(event.encodedAddress, event.event) {
+				hub.notify(c, sub, event.notification)
+			}
+		}
+	}
+}
+
+func (hub *subscriptionsHub) notify(c *client, sub *subscription, notification interface{}) {
+	message, err := json.Marshal(&Notification{
+		Subscription: sub.id,
+		Topic:        sub.topic,
+		Data:         notification,
+	})
+	if err != nil {
+		log.Warn("subscriptionsHub: cannot marshal notification", "topic", sub.topic, "error", err.Error())
+		return
+	}
+
+	if !c.trySend(message) {
+		log.Debug("subscriptionsHub: client buffer is full, disconnecting")
+		c.close()
+	}
+}
+
+// RevertIndexedBlock pushes the reverted block and the reverted watched transactions to the subscribed clients
+func (hub *subscriptionsHub) RevertIndexedBlock(blockData *outport.BlockData) error {
+	if blockData == nil {
+		return nil
+	}
+
+	header, err := hub.getHeader(blockData)
+	if err != nil {
+		log.Warn("subscriptionsHub.RevertIndexedBlock: cannot decode header", "error", err.Error())
+		return nil
+	}
+
+	blockNotification := createBlockNotification(blockData, header)
+	blockNotification.Reverted = true
+
+	hub.mutTrackedBlocks.Lock()
+	tracked, found := hub.trackedBlocks[blockNotification.Hash]
+	delete(hub.trackedBlocks, blockNotification.Hash)
+	hub.mutTrackedBlocks.Unlock()
+
+	revertedTransactions := make([]*TransactionNotification, 0)
+	if found {
+		for _, tx := range tracked.transactions {
+			revertedTransactions = append(revertedTransactions, &TransactionNotification{
+				Hash:       tx.Hash,
+				Status:     string(transaction.TxStatusPending),
+				BlockHash:  tx.BlockHash,
+				BlockNonce: tx.BlockNonce,
+				Reverted:   true,
+			})
+		}
+	}
+
+	hub.mutClients.RLock()
+	for c := range hub.clients {
+		hub.notifyBlock(c, TopicBlocks, blockNotification.ShardID, blockNotification)
+		hub.notifyTrackedTransactions(c, revertedTransactions)
+	}
+	hub.mutClients.RUnlock()
+
+	return nil
+}
+
+// FinalizedBlock pushes the finalized block and the finalized watched transactions to the subscribed clients.
+// The watched transactions of all the tracked blocks up to the finalized one are considered final
+func (hub *subscriptionsHub) FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error {
+	if finalizedBlock == nil {
+		return nil
+	}
+
+	blockNotification := &FinalizedBlockNotification{
+		Hash:    hex.EncodeToString(finalizedBlock.HeaderHash),
+		ShardID: finalizedBlock.ShardID,
+	}
+	finalizedTransactions := hub.popFinalizedTransactions(blockNotification.Hash)
+
+	hub.mutClients.RLock()
+	for c := range hub.clients {
+		hub.notifyBlock(c, TopicFinalizedBlocks, blockNotification.ShardID, blockNotification)
+		hub.notifyTrackedTransactions(c, finalizedTransactions)
+	}
+	hub.mutClients.RUnlock()
+
+	return nil
+}
+
+func (hub *subscriptionsHub) popFinalizedTransactions(finalizedHash string) []*TransactionNotification {
+	hub.mutTrackedBlocks.Lock()
+	defer hub.mutTrackedBlocks.Unlock()
+
+	finalized, found := hub.trackedBlocks[finalizedHash]
+	if !found {
+		return make([]*TransactionNotification, 0)
+	}
+
+	blocks := make([]*trackedBlock, 0)
+	for hash, tracked := range hub.trackedBlocks {
+		if tracked.nonce <= finalized.nonce {
+			blocks = append(blocks, tracked)
+			delete(hub.trackedBlocks, hash)
+		}
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].nonce < blocks[j].nonce
+	})
+
+	finalizedTransactions := make([]*TransactionNotification, 0)
+	for _, tracked := range blocks {
+		for _, tx := range tracked.transactions {
+			finalizedTx := *tx
+			finalizedTx.Finalized = true
+			finalizedTransactions = append(finalizedTransactions, &finalizedTx)
+		}
+	}
+
+	return finalizedTransactions
+}
+
+func (hub *subscriptionsHub) notifyTrackedTransactions(c *client, transactions []*TransactionNotification) {
+	if len(transactions) == 0 {
+		return
+	}
+
+	for _, sub := range c.subscriptionsOfTopic(TopicTransactions) {
+		for _, tx := range transactions {
+			if sub.matchesHash(tx.Hash) {
+				hub.notify(c, sub, tx)
+			}
+		}
+	}
+}
+
+// SaveRoundsInfo does nothing
+func (hub *subscriptionsHub) SaveRoundsInfo(_ *outport.RoundsInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys does nothing
+func (hub *subscriptionsHub) SaveValidatorsPubKeys(_ *outport.ValidatorsPubKeys) error {
+	return nil
+}
+
+// SaveValidatorsRating does nothing
+func (hub *subscriptionsHub) SaveValidatorsRating(_ *outport.ValidatorsRating) error {
+	return nil
+}
+
+// SaveAccounts does nothing
+func (hub *subscriptionsHub) SaveAccounts(_ *outport.Accounts) error {
+	return nil
+}
+
+// NewTransactionInPool does nothing
+func (hub *subscriptionsHub) NewTransactionInPool(_ interface{}) error {
+	return nil
+}
+
+// GetMarshaller returns the marshaller used to decode the headers bytes
+func (hub *subscriptionsHub) GetMarshaller() marshal.Marshalizer {
+	return hub.marshaller
+}
+
+// SetCurrentSettings does nothing
+func (hub *subscriptionsHub) SetCurrentSettings(_ outport.OutportConfig) error {
+	return nil
+}
+
+// RegisterHandler does nothing
+func (hub *subscriptionsHub) RegisterHandler(_ func() error, _ string) error {
+	return nil
+}
+
+// Close disconnects all the clients. New connections will be refused afterwards
+func (hub *subscriptionsHub) Close() error {
+	hub.mutClients.Lock()
+	defer hub.mutClients.Unlock()
+
+	hub.closed = true
+	for c := range hub.clients {
+		c.close()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hub *subscriptionsHub) IsInterfaceNil() bool {
+	return hub == nil
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
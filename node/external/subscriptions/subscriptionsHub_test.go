@@ -0,0 +1,655 @@
+package subscriptions_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/node/external/subscriptions"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const messageTimeout = time.Second
+
+var (
+	errConnectionClosed = errors.New("connection closed")
+	alice               = hex.EncodeToString([]byte("alice"))
+	bob                 = hex.EncodeToString([]byte("bob"))
+	pair                = hex.EncodeToString([]byte("pair"))
+	marshaller          = &marshal.GogoProtoMarshalizer{}
+)
+
+type wsClientMock struct {
+	requests  chan []byte
+	messages  chan []byte
+	chanClose chan struct{}
+	closeOnce sync.Once
+}
+
+type receivedNotification struct {
+	Subscription string          `json:"subscription"`
+	Topic        string          `json:"topic"`
+	Data         json.RawMessage `json:"data"`
+}
+
+func newWsClientMock() *wsClientMock {
+	return &wsClientMock{
+		requests:  make(chan []byte),
+		messages:  make(chan []byte),
+		chanClose: make(chan struct{}),
+	}
+}
+
+func (mock *wsClientMock) connection() *testscommon.WebSocketConnectionStub {
+	return &testscommon.WebSocketConnectionStub{
+		ReadMessageCalled: func() (int, []byte, error) {
+			select {
+			case request := <-mock.requests:
+				return websocket.TextMessage, request, nil
+			case <-mock.chanClose:
+				return -1, nil, errConnectionClosed
+			}
+		},
+		WriteMessageCalled: func(messageType int, data []byte) error {
+			select {
+			case mock.messages <- data:
+				return nil
+			case <-mock.chanClose:
+				return errConnectionClosed
+			}
+		},
+		CloseCalled: func() error {
+			mock.closeOnce.Do(func() {
+				close(mock.chanClose)
+			})
+			return nil
+		},
+	}
+}
+
+func (mock *wsClientMock) readMessage(t *testing.T) []byte {
+	select {
+	case message := <-mock.messages:
+		return message
+	case <-time.After(messageTimeout):
+		require.Fail(t, "timeout waiting for message")
+		return nil
+	}
+}
+
+func (mock *wsClientMock) request(t *testing.T, request *subscriptions.Request) *subscriptions.Response {
+	requestBytes, _ := json.Marshal(request)
+	return mock.rawRequest(t, requestBytes)
+}
+
+func (mock *wsClientMock) rawRequest(t *testing.T, requestBytes []byte) *subscriptions.Response {
+	mock.requests <- requestBytes
+
+	response := &subscriptions.Response{}
+	err := json.Unmarshal(mock.readMessage(t), response)
+	require.NoError(t, err)
+
+	return response
+}
+
+func (mock *wsClientMock) subscribe(t *testing.T, topic string, params *subscriptions.SubscriptionParams) string {
+	response := mock.request(t, &subscriptions.Request{
+		ID:     1,
+		Method: subscriptions.MethodSubscribe,
+		Topic:  topic,
+		Params: params,
+	})
+	require.Empty(t, response.Error)
+	require.NotEmpty(t, response.Subscription)
+
+	return response.Subscription
+}
+
+func (mock *wsClientMock) readNotification(t *testing.T, subscription string, topic string, data interface{}) {
+	notification := &receivedNotification{}
+	err := json.Unmarshal(mock.readMessage(t), notification)
+	require.NoError(t, err)
+	require.Equal(t, subscription, notification.Subscription)
+	require.Equal(t, topic, notification.Topic)
+
+	err = json.Unmarshal(notification.Data, data)
+	require.NoError(t, err)
+}
+
+func (mock *wsClientMock) requireNoMessage(t *testing.T) {
+	select {
+	case message := <-mock.messages:
+		require.Fail(t, "unexpected message", string(message))
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func (mock *wsClientMock) isClosed() bool {
+	select {
+	case <-mock.chanClose:
+		return true
+	default:
+		return false
+	}
+}
+
+func createMockArgsSubscriptionsHub() subscriptions.ArgsSubscriptionsHub {
+	container := block.NewEmptyBlockCreatorsContainer()
+	_ = container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
+
+	shardCoordinator := testscommon.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if string(address) == "bob" {
+			return 1
+		}
+		return 0
+	}
+
+	return subscriptions.ArgsSubscriptionsHub{
+		Config: config.ApiSubscriptionsConfig{
+			Enabled:                   true,
+			MaxClients:                2,
+			MaxSubscriptionsPerClient: 2,
+			MaxItemsPerSubscription:   2,
+			ClientBufferSize:          10,
+		},
+		Marshaller:             marshaller,
+		BlockContainer:         container,
+		ShardCoordinator:       shardCoordinator,
+		AddressPubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+	}
+}
+
+type connectionHandler interface {
+	HandleConnection(conn common.WebSocketConnection) error
+}
+
+func connectClient(t *testing.T, hub connectionHandler) *wsClientMock {
+	client := newWsClientMock()
+	go func() {
+		err := hub.HandleConnection(client.connection())
+		assert.NoError(t, err)
+	}()
+
+	return client
+}
+
+func createBlockData(t *testing.T, hash string, nonce uint64) *outport.BlockData {
+	headerBytes, err := marshaller.Marshal(&block.Header{
+		Nonce:     nonce,
+		Round:     nonce + 1,
+		Epoch:     2,
+		TimeStamp: 1000 + nonce,
+		TxCount:   3,
+	})
+	require.NoError(t, err)
+
+	return &outport.BlockData{
+		ShardID:     0,
+		HeaderBytes: headerBytes,
+		HeaderType:  string(core.ShardHeaderV1),
+		HeaderHash:  []byte(hash),
+	}
+}
+
+func createOutportBlock(t *testing.T, hash string, nonce uint64) *outport.OutportBlock {
+	return &outport.OutportBlock{
+		ShardID:   0,
+		BlockData: createBlockData(t, hash, nonce),
+		TransactionPool: &outport.TransactionPool{
+			Transactions: map[string]*outport.TxInfo{
+				"aa01": {Transaction: &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")}},
+				"aa02": {Transaction: &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")}},
+				"aa03": {Transaction: &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("pair")}},
+			},
+			InvalidTxs: map[string]*outport.TxInfo{
+				"aa04": {Transaction: &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")}},
+			},
+			Logs: []*outport.LogData{
+				{
+					TxHash: "aa03",
+					Log: &transaction.Log{
+						Events: []*transaction.Event{
+							{Address: []byte("pair"), Identifier: []byte("swap"), Topics: [][]byte{[]byte("WEGLD"), []byte("alice")}},
+							{Address: []byte("pair"), Identifier: []byte(core.SignalErrorOperation)},
+						},
+					},
+				},
+			},
+		},
+		AlteredAccounts: map[string]*alteredAccount.AlteredAccount{
+			alice: {Address: alice, Nonce: 7, Balance: "100"},
+			pair:  {Address: pair, Nonce: 1, Balance: "5"},
+		},
+	}
+}
+
+func TestNewSubscriptionsHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.Marshaller = nil
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		require.Equal(t, subscriptions.ErrNilMarshaller, err)
+		require.True(t, check.IfNil(hub))
+	})
+	t.Run("nil block container should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.BlockContainer = nil
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		require.Equal(t, subscriptions.ErrNilBlockContainerHandler, err)
+		require.True(t, check.IfNil(hub))
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.ShardCoordinator = nil
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		require.Equal(t, subscriptions.ErrNilShardCoordinator, err)
+		require.True(t, check.IfNil(hub))
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.AddressPubKeyConverter = nil
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		require.Equal(t, subscriptions.ErrNilPubKeyConverter, err)
+		require.True(t, check.IfNil(hub))
+	})
+	t.Run("invalid config values should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.Config.MaxClients = 0
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		require.True(t, errors.Is(err, subscriptions.ErrInvalidConfigValue))
+		require.True(t, strings.Contains(err.Error(), "MaxClients"))
+		require.True(t, check.IfNil(hub))
+
+		args = createMockArgsSubscriptionsHub()
+		args.Config.MaxSubscriptionsPerClient = 0
+		_, err = subscriptions.NewSubscriptionsHub(args)
+		require.True(t, errors.Is(err, subscriptions.ErrInvalidConfigValue))
+		require.True(t, strings.Contains(err.Error(), "MaxSubscriptionsPerClient"))
+
+		args = createMockArgsSubscriptionsHub()
+		args.Config.MaxItemsPerSubscription = 0
+		_, err = subscriptions.NewSubscriptionsHub(args)
+		require.True(t, errors.Is(err, subscriptions.ErrInvalidConfigValue))
+		require.True(t, strings.Contains(err.Error(), "MaxItemsPerSubscription"))
+
+		args = createMockArgsSubscriptionsHub()
+		args.Config.ClientBufferSize = 0
+		_, err = subscriptions.NewSubscriptionsHub(args)
+		require.True(t, errors.Is(err, subscriptions.ErrInvalidConfigValue))
+		require.True(t, strings.Contains(err.Error(), "ClientBufferSize"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hub, err := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		require.NoError(t, err)
+		require.False(t, check.IfNil(hub))
+		require.Equal(t, marshaller, hub.GetMarshaller())
+	})
+}
+
+func TestSubscriptionsHub_HandleConnection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil connection should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		err := hub.HandleConnection(nil)
+		require.Equal(t, subscriptions.ErrNilWebSocketConnection, err)
+	})
+	t.Run("too many clients should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		first := connectClient(t, hub)
+		first.subscribe(t, subscriptions.TopicBlocks, nil)
+		second := connectClient(t, hub)
+		second.subscribe(t, subscriptions.TopicBlocks, nil)
+
+		err := hub.HandleConnection(newWsClientMock().connection())
+		require.True(t, errors.Is(err, subscriptions.ErrTooManyClients))
+
+		_ = first.connection().Close()
+		require.Eventually(t, func() bool {
+			conn := newWsClientMock().connection()
+			_ = conn.Close()
+			return hub.HandleConnection(conn) == nil
+		}, messageTimeout, 10*time.Millisecond)
+	})
+	t.Run("closed hub should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		client := connectClient(t, hub)
+		client.subscribe(t, subscriptions.TopicBlocks, nil)
+
+		err := hub.Close()
+		require.NoError(t, err)
+		require.Eventually(t, client.isClosed, messageTimeout, 10*time.Millisecond)
+
+		err = hub.HandleConnection(newWsClientMock().connection())
+		require.Equal(t, subscriptions.ErrSubscriptionsHubClosed, err)
+	})
+}
+
+func TestSubscriptionsHub_Requests(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	client := connectClient(t, hub)
+
+	testRequestError := func(request *subscriptions.Request, expectedError string) {
+		response := client.request(t, request)
+		assert.Equal(t, request.ID, response.ID)
+		assert.Empty(t, response.Subscription)
+		assert.True(t, strings.Contains(response.Error, expectedError), response.Error)
+	}
+
+	response := client.rawRequest(t, []byte("not a json"))
+	assert.True(t, strings.Contains(response.Error, "invalid request"))
+
+	testRequestError(&subscriptions.Request{ID: 2, Method: "publish"}, "unknown method")
+	testRequestError(&subscriptions.Request{ID: 3, Method: subscriptions.MethodSubscribe, Topic: "votes"}, "unknown topic")
+	testRequestError(&subscriptions.Request{ID: 4, Method: subscriptions.MethodSubscribe, Topic: subscriptions.TopicTransactions}, "empty subscription params")
+	testRequestError(&subscriptions.Request{
+		ID:     5,
+		Method: subscriptions.MethodSubscribe,
+		Topic:  subscriptions.TopicTransactions,
+		Params: &subscriptions.SubscriptionParams{Hashes: []string{"aa01", "aa02", "aa03"}},
+	}, "too many items in subscription")
+	testRequestError(&subscriptions.Request{
+		ID:     6,
+		Method: subscriptions.MethodSubscribe,
+		Topic:  subscriptions.TopicTransactions,
+		Params: &subscriptions.SubscriptionParams{Hashes: []string{"not hex"}},
+	}, "invalid transaction hash")
+	testRequestError(&subscriptions.Request{
+		ID:     7,
+		Method: subscriptions.MethodSubscribe,
+		Topic:  subscriptions.TopicAccounts,
+		Params: &subscriptions.SubscriptionParams{Addresses: []string{"not hex"}},
+	}, "invalid address")
+	testRequestError(&subscriptions.Request{
+		ID:     8,
+		Method: subscriptions.MethodSubscribe,
+		Topic:  subscriptions.TopicEvents,
+		Params: &subscriptions.SubscriptionParams{Topics: [][]byte{nil, []byte("alice")}},
+	}, "empty subscription params")
+	testRequestError(&subscriptions.Request{ID: 9, Method: subscriptions.MethodUnsubscribe, Subscription: "100"}, "unknown subscription")
+
+	first := client.subscribe(t, subscriptions.TopicBlocks, nil)
+	client.subscribe(t, subscriptions.TopicFinalizedBlocks, nil)
+	testRequestError(&subscriptions.Request{ID: 10, Method: subscriptions.MethodSubscribe, Topic: subscriptions.TopicBlocks}, "too many subscriptions")
+
+	response = client.request(t, &subscriptions.Request{ID: 11, Method: subscriptions.MethodUnsubscribe, Subscription: first})
+	assert.Empty(t, response.Error)
+	assert.Equal(t, first, response.Subscription)
+	assert.Equal(t, uint64(11), response.ID)
+
+	_ = hub.SaveBlock(createOutportBlock(t, "hash", 5))
+	client.requireNoMessage(t)
+}
+
+func TestSubscriptionsHub_Blocks(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	client := connectClient(t, hub)
+	shard0 := uint32(0)
+	shard1 := uint32(1)
+	blocksOfShard0 := client.subscribe(t, subscriptions.TopicBlocks, &subscriptions.SubscriptionParams{Shard: &shard0})
+	finalizedOfShard1 := client.subscribe(t, subscriptions.TopicFinalizedBlocks, &subscriptions.SubscriptionParams{Shard: &shard1})
+
+	err := hub.SaveBlock(createOutportBlock(t, "hash", 5))
+	require.NoError(t, err)
+
+	blockNotification := &subscriptions.BlockNotification{}
+	client.readNotification(t, blocksOfShard0, subscriptions.TopicBlocks, blockNotification)
+	assert.Equal(t, &subscriptions.BlockNotification{
+		Hash:      hex.EncodeToString([]byte("hash")),
+		ShardID:   0,
+		Nonce:     5,
+		Round:     6,
+		Epoch:     2,
+		Timestamp: 1005,
+		NumTxs:    3,
+	}, blockNotification)
+
+	err = hub.RevertIndexedBlock(createBlockData(t, "hash", 5))
+	require.NoError(t, err)
+
+	blockNotification = &subscriptions.BlockNotification{}
+	client.readNotification(t, blocksOfShard0, subscriptions.TopicBlocks, blockNotification)
+	assert.Equal(t, uint64(5), blockNotification.Nonce)
+	assert.True(t, blockNotification.Reverted)
+
+	err = hub.FinalizedBlock(&outport.FinalizedBlock{ShardID: 0, HeaderHash: []byte("hash")})
+	require.NoError(t, err)
+	client.requireNoMessage(t)
+
+	err = hub.FinalizedBlock(&outport.FinalizedBlock{ShardID: 1, HeaderHash: []byte("other")})
+	require.NoError(t, err)
+
+	finalizedNotification := &subscriptions.FinalizedBlockNotification{}
+	client.readNotification(t, finalizedOfShard1, subscriptions.TopicFinalizedBlocks, finalizedNotification)
+	assert.Equal(t, &subscriptions.FinalizedBlockNotification{
+		Hash:    hex.EncodeToString([]byte("other")),
+		ShardID: 1,
+	}, finalizedNotification)
+
+	t.Run("undecodable header should not error", func(t *testing.T) {
+		blockData := createBlockData(t, "hash", 6)
+		blockData.HeaderType = "unknown"
+		require.NoError(t, hub.SaveBlock(&outport.OutportBlock{BlockData: blockData}))
+		require.NoError(t, hub.RevertIndexedBlock(blockData))
+		client.requireNoMessage(t)
+	})
+}
+
+func TestSubscriptionsHub_Transactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSubscriptionsHub()
+	args.Config.MaxItemsPerSubscription = 4
+	hub, _ := subscriptions.NewSubscriptionsHub(args)
+	client := connectClient(t, hub)
+	sub := client.subscribe(t, subscriptions.TopicTransactions, &subscriptions.SubscriptionParams{
+		Hashes: []string{"aa01", "aa02", "aa03", "aa04"},
+	})
+
+	_ = hub.SaveBlock(createOutportBlock(t, "hash5", 5))
+
+	blockHash := hex.EncodeToString([]byte("hash5"))
+	expectedStatuses := map[string]transaction.TxStatus{
+		"aa01": transaction.TxStatusSuccess,
+		"aa02": transaction.TxStatusPending,
+		"aa03": transaction.TxStatusFail,
+		"aa04": transaction.TxStatusInvalid,
+	}
+	for _, hash := range []string{"aa01", "aa02", "aa03", "aa04"} {
+		notification := &subscriptions.TransactionNotification{}
+		client.readNotification(t, sub, subscriptions.TopicTransactions, notification)
+		assert.Equal(t, &subscriptions.TransactionNotification{
+			Hash:       hash,
+			Status:     string(expectedStatuses[hash]),
+			BlockHash:  blockHash,
+			BlockNonce: 5,
+		}, notification)
+	}
+
+	t.Run("reverted block should notify the transactions as pending", func(t *testing.T) {
+		_ = hub.RevertIndexedBlock(createBlockData(t, "hash5", 5))
+		for _, hash := range []string{"aa01", "aa02", "aa03", "aa04"} {
+			notification := &subscriptions.TransactionNotification{}
+			client.readNotification(t, sub, subscriptions.TopicTransactions, notification)
+			assert.Equal(t, &subscriptions.TransactionNotification{
+				Hash:       hash,
+				Status:     string(transaction.TxStatusPending),
+				BlockHash:  blockHash,
+				BlockNonce: 5,
+				Reverted:   true,
+			}, notification)
+		}
+
+		_ = hub.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: []byte("hash5")})
+		client.requireNoMessage(t)
+	})
+	t.Run("finalized block should notify the transactions of all the previous blocks", func(t *testing.T) {
+		previousBlock := createOutportBlock(t, "hash6", 6)
+		delete(previousBlock.TransactionPool.Transactions, "aa02")
+		delete(previousBlock.TransactionPool.Transactions, "aa03")
+		previousBlock.TransactionPool.InvalidTxs = nil
+		_ = hub.SaveBlock(previousBlock)
+		client.readNotification(t, sub, subscriptions.TopicTransactions, &subscriptions.TransactionNotification{})
+
+		lastBlock := createOutportBlock(t, "hash7", 7)
+		lastBlock.TransactionPool.Transactions = map[string]*outport.TxInfo{
+			"aa02": {Transaction: &transaction.Transaction{SndAddr: []byte("bob"), RcvAddr: []byte("alice")}},
+		}
+		lastBlock.TransactionPool.InvalidTxs = nil
+		_ = hub.SaveBlock(lastBlock)
+		client.readNotification(t, sub, subscriptions.TopicTransactions, &subscriptions.TransactionNotification{})
+
+		_ = hub.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: []byte("hash7")})
+
+		notification := &subscriptions.TransactionNotification{}
+		client.readNotification(t, sub, subscriptions.TopicTransactions, notification)
+		assert.Equal(t, &subscriptions.TransactionNotification{
+			Hash:       "aa01",
+			Status:     string(transaction.TxStatusSuccess),
+			BlockHash:  hex.EncodeToString([]byte("hash6")),
+			BlockNonce: 6,
+			Finalized:  true,
+		}, notification)
+
+		notification = &subscriptions.TransactionNotification{}
+		client.readNotification(t, sub, subscriptions.TopicTransactions, notification)
+		assert.Equal(t, &subscriptions.TransactionNotification{
+			Hash:       "aa02",
+			Status:     string(transaction.TxStatusSuccess),
+			BlockHash:  hex.EncodeToString([]byte("hash7")),
+			BlockNonce: 7,
+			Finalized:  true,
+		}, notification)
+
+		_ = hub.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: []byte("hash6")})
+		client.requireNoMessage(t)
+	})
+}
+
+func TestSubscriptionsHub_Accounts(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	client := connectClient(t, hub)
+	sub := client.subscribe(t, subscriptions.TopicAccounts, &subscriptions.SubscriptionParams{
+		Addresses: []string{alice, bob},
+	})
+
+	_ = hub.SaveBlock(createOutportBlock(t, "hash", 5))
+
+	notification := &subscriptions.AccountNotification{}
+	client.readNotification(t, sub, subscriptions.TopicAccounts, notification)
+	assert.Equal(t, &subscriptions.AccountNotification{
+		Account:    &alteredAccount.AlteredAccount{Address: alice, Nonce: 7, Balance: "100"},
+		BlockHash:  hex.EncodeToString([]byte("hash")),
+		BlockNonce: 5,
+	}, notification)
+	client.requireNoMessage(t)
+}
+
+func TestSubscriptionsHub_Events(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	client := connectClient(t, hub)
+	matchingSub := client.subscribe(t, subscriptions.TopicEvents, &subscriptions.SubscriptionParams{
+		Address:    pair,
+		Identifier: "swap",
+		Topics:     [][]byte{nil, []byte("alice")},
+	})
+	client.subscribe(t, subscriptions.TopicEvents, &subscriptions.SubscriptionParams{
+		Identifier: "swap",
+		Topics:     [][]byte{nil, []byte("bob")},
+	})
+
+	_ = hub.SaveBlock(createOutportBlock(t, "hash", 5))
+
+	notification := &common.FilteredEventApiResponse{}
+	client.readNotification(t, matchingSub, subscriptions.TopicEvents, notification)
+	assert.Equal(t, &common.FilteredEventApiResponse{
+		Address:    pair,
+		Identifier: "swap",
+		Topics:     [][]byte{[]byte("WEGLD"), []byte("alice")},
+		TxHash:     "aa03",
+		EventIndex: 0,
+		Epoch:      2,
+		Round:      6,
+		BlockNonce: 5,
+		BlockHash:  hex.EncodeToString([]byte("hash")),
+	}, notification)
+	client.requireNoMessage(t)
+}
+
+func TestSubscriptionsHub_SlowClientShouldBeDisconnected(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSubscriptionsHub()
+	args.Config.ClientBufferSize = 1
+	hub, _ := subscriptions.NewSubscriptionsHub(args)
+	client := connectClient(t, hub)
+	client.subscribe(t, subscriptions.TopicBlocks, nil)
+
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		err := hub.SaveBlock(createOutportBlock(t, "hash", nonce))
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, client.isClosed, messageTimeout, 10*time.Millisecond)
+}
+
+func TestSubscriptionsHub_NoOperationMethods(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	require.NoError(t, hub.SaveBlock(nil))
+	require.NoError(t, hub.RevertIndexedBlock(nil))
+	require.NoError(t, hub.FinalizedBlock(nil))
+	require.NoError(t, hub.SaveRoundsInfo(&outport.RoundsInfo{}))
+	require.NoError(t, hub.SaveValidatorsPubKeys(&outport.ValidatorsPubKeys{}))
+	require.NoError(t, hub.SaveValidatorsRating(&outport.ValidatorsRating{}))
+	require.NoError(t, hub.SaveAccounts(&outport.Accounts{}))
+	require.NoError(t, hub.NewTransactionInPool(nil))
+	require.NoError(t, hub.SetCurrentSettings(outport.OutportConfig{}))
+	require.NoError(t, hub.RegisterHandler(nil, ""))
+}
@@ -0,0 +1,110 @@
+package transactionAPI
+
+import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// apiSelectionSession provides the blockchain information needed when inspecting the mempool selection on API calls.
+// It works on the API accounts (the latest committed state), without a transactions processor.
+type apiSelectionSession struct {
+	accountsAdapter       state.AccountsAdapter
+	guardedAccountHandler process.GuardedAccountHandler
+	txVersionChecker      process.TxVersionCheckerHandler
+
+	// Cache of accounts, held in the scope of a single API call.
+	// Not concurrency-safe, but never accessed concurrently.
+	ephemeralAccountsCache map[string]state.UserAccountHandler
+}
+
+func newAPISelectionSession(
+	accountsAdapter state.AccountsAdapter,
+	guardedAccountHandler process.GuardedAccountHandler,
+	txVersionChecker process.TxVersionCheckerHandler,
+) *apiSelectionSession {
+	return &apiSelectionSession{
+		accountsAdapter:        accountsAdapter,
+		guardedAccountHandler:  guardedAccountHandler,
+		txVersionChecker:       txVersionChecker,
+		ephemeralAccountsCache: make(map[string]state.UserAccountHandler),
+	}
+}
+
+// GetAccountState returns the state of an account
+func (session *apiSelectionSession) GetAccountState(address []byte) (*txcache.AccountState, error) {
+	userAccount, err := session.getExistingAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txcache.AccountState{
+		Nonce:   userAccount.GetNonce(),
+		Balance: userAccount.GetBalance(),
+	}, nil
+}
+
+func (session *apiSelectionSession) getExistingAccount(address []byte) (state.UserAccountHandler, error) {
+	userAccount, ok := session.ephemeralAccountsCache[string(address)]
+	if ok {
+		return userAccount, nil
+	}
+
+	account, err := session.accountsAdapter.GetExistingAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok = account.(state.UserAccountHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	session.ephemeralAccountsCache[string(address)] = userAccount
+	return userAccount, nil
+}
+
+// IsIncorrectlyGuarded checks if a transaction is incorrectly guarded (not executable).
+// It approximately mirrors "transactionsProcessor.VerifyGuardian()": for a guarded account, an unguarded SetGuardian call
+// is considered correct, without further checks on the built-in function.
+func (session *apiSelectionSession) IsIncorrectlyGuarded(tx data.TransactionHandler) bool {
+	txTyped, ok := tx.(*transaction.Transaction)
+	if !ok {
+		return false
+	}
+
+	userAccount, err := session.getExistingAccount(tx.GetSndAddr())
+	if err != nil {
+		return false
+	}
+
+	isTransactionGuarded := session.txVersionChecker.IsGuardedTransaction(txTyped)
+	if !userAccount.IsGuarded() {
+		return isTransactionGuarded
+	}
+	if !isTransactionGuarded {
+		return !process.IsSetGuardianCall(txTyped.GetData())
+	}
+
+	vmUserAccount, ok := userAccount.(vmcommon.UserAccountHandler)
+	if !ok {
+		return true
+	}
+
+	guardian, err := session.guardedAccountHandler.GetActiveGuardian(vmUserAccount)
+	if err != nil {
+		return true
+	}
+
+	return !bytes.Equal(guardian, txTyped.GetGuardianAddr())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (session *apiSelectionSession) IsInterfaceNil() bool {
+	return session == nil
+}
@@ -0,0 +1,136 @@
+package transactionAPI
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/guardianMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+func createSessionForAccount(account vmcommon.AccountHandler, activeGuardian []byte) (*apiSelectionSession, *int) {
+	numAccountLoads := 0
+	accounts := &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			numAccountLoads++
+			if account == nil {
+				return nil, errors.New("account not found")
+			}
+
+			return account, nil
+		},
+	}
+	guardedAccountHandler := &guardianMocks.GuardedAccountHandlerStub{
+		GetActiveGuardianCalled: func(handler vmcommon.UserAccountHandler) ([]byte, error) {
+			if activeGuardian == nil {
+				return nil, errors.New("no active guardian")
+			}
+
+			return activeGuardian, nil
+		},
+	}
+	txVersionChecker := &testscommon.TxVersionCheckerStub{
+		IsGuardedTransactionCalled: func(tx *transaction.Transaction) bool {
+			return len(tx.GuardianAddr) > 0
+		},
+	}
+
+	return newAPISelectionSession(accounts, guardedAccountHandler, txVersionChecker), &numAccountLoads
+}
+
+func TestApiSelectionSession_GetAccountState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing account should error", func(t *testing.T) {
+		t.Parallel()
+
+		session, _ := createSessionForAccount(nil, nil)
+		accountState, err := session.GetAccountState([]byte("alice"))
+		require.Nil(t, accountState)
+		require.NotNil(t, err)
+	})
+	t.Run("wrong account type should error", func(t *testing.T) {
+		t.Parallel()
+
+		session, _ := createSessionForAccount(&stateMock.PeerAccountHandlerMock{}, nil)
+		accountState, err := session.GetAccountState([]byte("alice"))
+		require.Nil(t, accountState)
+		require.Equal(t, process.ErrWrongTypeAssertion, err)
+	})
+	t.Run("should work and cache the account", func(t *testing.T) {
+		t.Parallel()
+
+		session, numAccountLoads := createSessionForAccount(&stateMock.UserAccountStub{
+			Nonce:   7,
+			Balance: big.NewInt(100),
+		}, nil)
+
+		for i := 0; i < 3; i++ {
+			accountState, err := session.GetAccountState([]byte("alice"))
+			require.Nil(t, err)
+			require.Equal(t, uint64(7), accountState.Nonce)
+			require.Equal(t, big.NewInt(100), accountState.Balance)
+		}
+		require.Equal(t, 1, *numAccountLoads)
+	})
+}
+
+func TestApiSelectionSession_IsIncorrectlyGuarded(t *testing.T) {
+	t.Parallel()
+
+	guardedAccount := &stateMock.UserAccountStub{
+		IsGuardedCalled: func() bool {
+			return true
+		},
+	}
+	unguardedTx := &transaction.Transaction{SndAddr: []byte("alice")}
+	guardedTx := &transaction.Transaction{SndAddr: []byte("alice"), GuardianAddr: []byte("guardian")}
+
+	t.Run("not a transaction should return false", func(t *testing.T) {
+		t.Parallel()
+
+		session, _ := createSessionForAccount(guardedAccount, nil)
+		require.False(t, session.IsIncorrectlyGuarded(&smartContractResult.SmartContractResult{}))
+	})
+	t.Run("missing account should return false", func(t *testing.T) {
+		t.Parallel()
+
+		session, _ := createSessionForAccount(nil, nil)
+		require.False(t, session.IsIncorrectlyGuarded(guardedTx))
+	})
+	t.Run("unguarded account", func(t *testing.T) {
+		t.Parallel()
+
+		session, _ := createSessionForAccount(&stateMock.UserAccountStub{}, nil)
+		require.False(t, session.IsIncorrectlyGuarded(unguardedTx))
+		require.True(t, session.IsIncorrectlyGuarded(guardedTx))
+	})
+	t.Run("guarded account with unguarded transaction", func(t *testing.T) {
+		t.Parallel()
+
+		session, _ := createSessionForAccount(guardedAccount, []byte("guardian"))
+		require.True(t, session.IsIncorrectlyGuarded(unguardedTx))
+
+		setGuardianTx := &transaction.Transaction{SndAddr: []byte("alice"), Data: []byte("SetGuardian@01@02")}
+		require.False(t, session.IsIncorrectlyGuarded(setGuardianTx))
+	})
+	t.Run("guarded account with guarded transaction", func(t *testing.T) {
+		t.Parallel()
+
+		session, _ := createSessionForAccount(guardedAccount, []byte("guardian"))
+		require.False(t, session.IsIncorrectlyGuarded(guardedTx))
+
+		session, _ = createSessionForAccount(guardedAccount, []byte("another guardian"))
+		require.True(t, session.IsIncorrectlyGuarded(guardedTx))
+
+		session, _ = createSessionForAccount(guardedAccount, nil)
+		require.True(t, session.IsIncorrectlyGuarded(guardedTx))
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
 )
 
 // ArgAPITransactionProcessor is structure that store components that are needed to create an api transaction processor
@@ -30,4 +31,7 @@ type ArgAPITransactionProcessor struct {
 	DataFieldParser          DataFieldParser
 	TxMarshaller             marshal.Marshalizer
 	EnableEpochsHandler      common.EnableEpochsHandler
+	AccountsAdapterAPI       state.AccountsAdapter
+	GuardedAccountHandler    process.GuardedAccountHandler
+	TxVersionChecker         process.TxVersionCheckerHandler
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
 	"sort"
 	"strings"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract"
 	"github.com/multiversx/mx-chain-go/process/txstatus"
 	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
@@ -46,6 +48,9 @@ type apiTransactionProcessor struct {
 	refundDetector              *refundDetector
 	gasUsedAndFeeProcessor      *gasUsedAndFeeProcessor
 	enableEpochsHandler         common.EnableEpochsHandler
+	accountsAdapterAPI          state.AccountsAdapter
+	guardedAccountHandler       process.GuardedAccountHandler
+	txVersionChecker            process.TxVersionCheckerHandler
 }
 
 // NewAPITransactionProcessor will create a new instance of apiTransactionProcessor
@@ -93,6 +98,9 @@ func NewAPITransactionProcessor(args *ArgAPITransactionProcessor) (*apiTransacti
 		refundDetector:              refundDetectorInstance,
 		gasUsedAndFeeProcessor:      gasUsedAndFeeProc,
 		enableEpochsHandler:         args.EnableEpochsHandler,
+		accountsAdapterAPI:          args.AccountsAdapterAPI,
+		guardedAccountHandler:       args.GuardedAccountHandler,
+		txVersionChecker:            args.TxVersionChecker,
 	}, nil
 }
 
@@ -304,6 +312,189 @@ func (atp *apiTransactionProcessor) GetTransactionsPoolNonceGapsForSender(sender
 	}, nil
 }
 
+// GetTransactionsPoolSenderDetails will return the transactions from pool for sender, checked against the selection rules,
+// together with the recently evicted or rejected transactions of the sender
+func (atp *apiTransactionProcessor) GetTransactionsPoolSenderDetails(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+	senderAddr, err := atp.addressPubKeyConverter.Decode(sender)
+	if err != nil {
+		return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+	}
+
+	senderShard := atp.shardCoordinator.ComputeId(senderAddr)
+	if senderShard != atp.shardCoordinator.SelfId() {
+		return nil, ErrSenderNotInSelfShard
+	}
+
+	session := atp.newSelectionSession()
+	accountState, err := session.GetAccountState(senderAddr)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %s", ErrCannotRetrieveSenderAccount, err.Error())
+	}
+
+	wrappedTxs := atp.fetchTxsForSender(string(senderAddr), senderShard)
+	results := txcache.AnalyzeSenderTransactions(wrappedTxs, accountState, session.IsIncorrectlyGuarded)
+
+	details := &common.TransactionsPoolSenderDetailsApiResponse{
+		Sender:          sender,
+		AccountNonce:    accountState.Nonce,
+		AccountBalance:  accountState.Balance.String(),
+		NumTransactions: len(results),
+		Transactions:    make([]common.PoolTransactionDetailsApiResponse, 0, len(results)),
+		Rejections:      atp.getRejectedTransactions(senderAddr),
+	}
+
+	totalFee := big.NewInt(0)
+	for _, result := range results {
+		wrappedTx := result.Tx
+		fee := big.NewInt(0)
+		if wrappedTx.Fee != nil {
+			fee.Set(wrappedTx.Fee)
+		}
+
+		totalFee.Add(totalFee, fee)
+		details.TotalBytes += wrappedTx.Size
+		details.TotalGasLimit += wrappedTx.Tx.GetGasLimit()
+		if wrappedTx.PricePerUnit > details.BestPricePerUnit {
+			details.BestPricePerUnit = wrappedTx.PricePerUnit
+		}
+
+		details.Transactions = append(details.Transactions, common.PoolTransactionDetailsApiResponse{
+			Hash:         hex.EncodeToString(wrappedTx.TxHash),
+			Nonce:        wrappedTx.Tx.GetNonce(),
+			GasLimit:     wrappedTx.Tx.GetGasLimit(),
+			GasPrice:     wrappedTx.Tx.GetGasPrice(),
+			Size:         wrappedTx.Size,
+			Fee:          fee.String(),
+			PricePerUnit: wrappedTx.PricePerUnit,
+			Selectable:   result.Selectable,
+			Reason:       string(result.Reason),
+		})
+	}
+	details.TotalFee = totalFee.String()
+
+	return details, nil
+}
+
+// GetTransactionsPoolSelection will return the transactions that would be picked from pool by the next selection,
+// given the latest committed state
+func (atp *apiTransactionProcessor) GetTransactionsPoolSelection(fields string) (*common.TransactionsPoolSelectionApiResponse, error) {
+	selfShard := atp.shardCoordinator.SelfId()
+	cacheId := process.ShardCacherIdentifier(selfShard, selfShard)
+	cache := atp.dataPool.Transactions().ShardDataStore(cacheId)
+	txCache, ok := cache.(*txcache.TxCache)
+	if !ok {
+		return nil, fmt.Errorf("%w, could not cast to TxCache", ErrCannotRetrieveTransactions)
+	}
+
+	selectedTxs, accumulatedGas := txCache.SelectTransactions(
+		atp.newSelectionSession(),
+		process.TxCacheSelectionGasRequested,
+		process.TxCacheSelectionMaxNumTxs,
+		process.TxCacheSelectionLoopMaximumDuration,
+	)
+
+	fields = strings.Trim(fields, " ")
+	requestedFieldsHandler := newFieldsHandler(fields)
+	selection := &common.TransactionsPoolSelectionApiResponse{
+		Transactions:  make([]common.Transaction, 0, len(selectedTxs)),
+		TotalGasLimit: accumulatedGas,
+	}
+	for _, wrappedTx := range selectedTxs {
+		tx := atp.extractRequestedTxInfo(wrappedTx, requestedFieldsHandler)
+		selection.Transactions = append(selection.Transactions, tx)
+	}
+
+	return selection, nil
+}
+
+// GetTransactionsPoolRejections will return the transactions recently evicted from pool or rejected at selection.
+// If a sender is provided, only its transactions are returned
+func (atp *apiTransactionProcessor) GetTransactionsPoolRejections(sender string) (*common.TransactionsPoolRejectionsApiResponse, error) {
+	if len(sender) == 0 {
+		return &common.TransactionsPoolRejectionsApiResponse{
+			Rejections: atp.getRejectedTransactions(nil),
+		}, nil
+	}
+
+	senderAddr, err := atp.addressPubKeyConverter.Decode(sender)
+	if err != nil {
+		return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+	}
+
+	return &common.TransactionsPoolRejectionsApiResponse{
+		Rejections: atp.getRejectedTransactions(senderAddr),
+	}, nil
+}
+
+// GetTransactionsForAddress will return a page of the transactions, smart contract results and rewards touching the
+// provided address, newest first. The returned cursor should be provided in order to fetch the next page
+func (atp *apiTransactionProcessor) GetTransactionsForAddress(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error) {
+	if !atp.historyRepository.IsEnabled() {
+		return nil, fmt.Errorf("cannot return the transactions of the address: %w", ErrDBLookExtensionIsNotEnabled)
+	}
+
+	decodedAddress, err := atp.addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+	}
+
+	page, err := atp.historyRepository.GetAddressHistory(decodedAddress, cursor, size)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.AddressTransactionsApiResponse{
+		Transactions:    make([]*transaction.ApiTransactionResult, 0, len(page.Entries)),
+		NumTransactions: page.NumEntries,
+		NextCursor:      page.NextCursor,
+	}
+	for _, entry := range page.Entries {
+		txHash := hex.EncodeToString(entry.TxHash)
+		tx, errGet := atp.GetTransaction(txHash, false)
+		if errGet != nil {
+			log.Debug("GetTransactionsForAddress: cannot get transaction", "hash", txHash, "error", errGet)
+			continue
+		}
+
+		response.Transactions = append(response.Transactions, tx)
+	}
+
+	return response, nil
+}
+
+func (atp *apiTransactionProcessor) newSelectionSession() *apiSelectionSession {
+	return newAPISelectionSession(atp.accountsAdapterAPI, atp.guardedAccountHandler, atp.txVersionChecker)
+}
+
+// getRejectedTransactions returns the recently rejected transactions of the provided sender or, if nil, of all the senders
+func (atp *apiTransactionProcessor) getRejectedTransactions(senderAddr []byte) []common.RejectedTransactionApiResponse {
+	provider, ok := atp.dataPool.Transactions().(rejectedTransactionsProvider)
+	if !ok {
+		log.Warn("getRejectedTransactions: the transactions pool does not record the rejected transactions")
+		return make([]common.RejectedTransactionApiResponse, 0)
+	}
+
+	var rejected []*txcache.RejectedTransaction
+	if senderAddr == nil {
+		rejected = provider.GetRejectedTransactions()
+	} else {
+		rejected = provider.GetRejectedTransactionsForSender(senderAddr)
+	}
+
+	rejections := make([]common.RejectedTransactionApiResponse, 0, len(rejected))
+	for _, entry := range rejected {
+		rejections = append(rejections, common.RejectedTransactionApiResponse{
+			Hash:      hex.EncodeToString(entry.TxHash),
+			Sender:    atp.addressPubKeyConverter.SilentEncode(entry.Sender, log),
+			Nonce:     entry.Nonce,
+			Reason:    string(entry.Reason),
+			Timestamp: entry.Timestamp.Unix(),
+		})
+	}
+
+	return rejections
+}
+
 func (atp *apiTransactionProcessor) extractRequestedTxInfoFromObj(txObj interface{}, txType transaction.TxType, txHash []byte, requestedFieldsHandler fieldsHandler) common.Transaction {
 	txResult := atp.getApiResultFromObj(txObj, txType)
 
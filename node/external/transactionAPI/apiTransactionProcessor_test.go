@@ -24,6 +24,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressHistory"
 	"github.com/multiversx/mx-chain-go/node/mock"
 	"github.com/multiversx/mx-chain-go/process"
 	processMocks "github.com/multiversx/mx-chain-go/process/mock"
@@ -34,9 +35,12 @@ import (
 	dblookupextMock "github.com/multiversx/mx-chain-go/testscommon/dblookupext"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/guardianMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/multiversx/mx-chain-go/testscommon/txcachemocks"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -61,8 +65,11 @@ func createMockArgAPITransactionProcessor() *ArgAPITransactionProcessor {
 				return &datafield.ResponseParseData{}
 			},
 		},
-		TxMarshaller:        &marshallerMock.MarshalizerMock{},
-		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+		TxMarshaller:          &marshallerMock.MarshalizerMock{},
+		EnableEpochsHandler:   enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+		AccountsAdapterAPI:    &stateMock.AccountsStub{},
+		GuardedAccountHandler: &guardianMocks.GuardedAccountHandlerStub{},
+		TxVersionChecker:      &testscommon.TxVersionCheckerStub{},
 	}
 }
 
@@ -203,6 +210,33 @@ func TestNewAPITransactionProcessor(t *testing.T) {
 		_, err := NewAPITransactionProcessor(arguments)
 		require.Equal(t, process.ErrNilEnableEpochsHandler, err)
 	})
+	t.Run("NilAccountsAdapterAPI", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createMockArgAPITransactionProcessor()
+		arguments.AccountsAdapterAPI = nil
+
+		_, err := NewAPITransactionProcessor(arguments)
+		require.Equal(t, process.ErrNilAccountsAdapter, err)
+	})
+	t.Run("NilGuardedAccountHandler", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createMockArgAPITransactionProcessor()
+		arguments.GuardedAccountHandler = nil
+
+		_, err := NewAPITransactionProcessor(arguments)
+		require.Equal(t, process.ErrNilGuardedAccountHandler, err)
+	})
+	t.Run("NilTxVersionChecker", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createMockArgAPITransactionProcessor()
+		arguments.TxVersionChecker = nil
+
+		_, err := NewAPITransactionProcessor(arguments)
+		require.Equal(t, process.ErrNilTransactionVersionChecker, err)
+	})
 }
 
 func TestNode_GetTransactionInvalidHashShouldErr(t *testing.T) {
@@ -360,8 +394,11 @@ func TestNode_GetSCRs(t *testing.T) {
 				return &datafield.ResponseParseData{}
 			},
 		},
-		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
-		TxMarshaller:        &mock.MarshalizerFake{},
+		EnableEpochsHandler:   enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+		TxMarshaller:          &mock.MarshalizerFake{},
+		AccountsAdapterAPI:    &stateMock.AccountsStub{},
+		GuardedAccountHandler: &guardianMocks.GuardedAccountHandlerStub{},
+		TxVersionChecker:      &testscommon.TxVersionCheckerStub{},
 	}
 	apiTransactionProc, _ := NewAPITransactionProcessor(args)
 
@@ -570,8 +607,11 @@ func TestNode_GetTransactionWithResultsFromStorage(t *testing.T) {
 				return &datafield.ResponseParseData{}
 			},
 		},
-		TxMarshaller:        &marshallerMock.MarshalizerMock{},
-		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+		TxMarshaller:          &marshallerMock.MarshalizerMock{},
+		EnableEpochsHandler:   enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+		AccountsAdapterAPI:    &stateMock.AccountsStub{},
+		GuardedAccountHandler: &guardianMocks.GuardedAccountHandlerStub{},
+		TxVersionChecker:      &testscommon.TxVersionCheckerStub{},
 	}
 	apiTransactionProc, _ := NewAPITransactionProcessor(args)
 
@@ -1115,6 +1155,350 @@ func TestApiTransactionProcessor_GetTransactionsPoolNonceGapsForSender(t *testin
 	}, res)
 }
 
+type shardedDataWithRejectionsStub struct {
+	testscommon.ShardedDataStub
+	rejected []*txcache.RejectedTransaction
+}
+
+// GetRejectedTransactions -
+func (stub *shardedDataWithRejectionsStub) GetRejectedTransactions() []*txcache.RejectedTransaction {
+	return stub.rejected
+}
+
+// GetRejectedTransactionsForSender -
+func (stub *shardedDataWithRejectionsStub) GetRejectedTransactionsForSender(sender []byte) []*txcache.RejectedTransaction {
+	rejected := make([]*txcache.RejectedTransaction, 0)
+	for _, entry := range stub.rejected {
+		if bytes.Equal(entry.Sender, sender) {
+			rejected = append(rejected, entry)
+		}
+	}
+
+	return rejected
+}
+
+func createMockArgsForTransactionsPoolInspection(t *testing.T, txs ...*txcache.WrappedTransaction) *ArgAPITransactionProcessor {
+	txCacheIntraShard, err := txcache.NewTxCache(txcache.ConfigSourceMe{
+		Name:                        "test",
+		NumChunks:                   4,
+		NumBytesThreshold:           1_048_576, // 1 MB
+		NumBytesPerSenderThreshold:  1_048_576, // 1 MB
+		CountThreshold:              math.MaxUint32,
+		CountPerSenderThreshold:     math.MaxUint32,
+		NumItemsToPreemptivelyEvict: 1,
+	}, txcachemocks.NewMempoolHostMock())
+	require.NoError(t, err)
+
+	for _, tx := range txs {
+		txCacheIntraShard.AddTx(tx)
+	}
+
+	pool := &shardedDataWithRejectionsStub{
+		ShardedDataStub: testscommon.ShardedDataStub{
+			ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+				return txCacheIntraShard
+			},
+		},
+		rejected: []*txcache.RejectedTransaction{
+			{TxHash: []byte("txHashA"), Sender: []byte("alice"), Nonce: 1, Reason: txcache.RejectionReasonNonceGap, Timestamp: time.Unix(100, 0)},
+			{TxHash: []byte("txHashB"), Sender: []byte("bob"), Nonce: 7, Reason: txcache.RejectionReasonEvictedUnderHighLoad, Timestamp: time.Unix(200, 0)},
+		},
+	}
+
+	args := createMockArgAPITransactionProcessor()
+	args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return pool
+		},
+	}
+	args.AddressPubKeyConverter = &testscommon.PubkeyConverterStub{
+		DecodeCalled: func(humanReadable string) ([]byte, error) {
+			return []byte(humanReadable), nil
+		},
+		SilentEncodeCalled: func(pkBytes []byte, log core.Logger) string {
+			return string(pkBytes)
+		},
+	}
+	args.ShardCoordinator = createShardCoordinator()
+	args.AccountsAdapterAPI = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			if !bytes.Equal(address, []byte("alice")) {
+				return nil, errors.New("account not found")
+			}
+
+			return &stateMock.UserAccountStub{
+				Nonce:   2,
+				Balance: big.NewInt(1_000_000),
+			}, nil
+		},
+	}
+
+	return args
+}
+
+func TestApiTransactionProcessor_GetTransactionsPoolSenderDetails(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForTransactionsPoolInspection(t)
+		expectedErr := errors.New("expected error")
+		args.AddressPubKeyConverter = &testscommon.PubkeyConverterStub{
+			DecodeCalled: func(humanReadable string) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsPoolSenderDetails("alice")
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("sender in another shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _ := NewAPITransactionProcessor(createMockArgsForTransactionsPoolInspection(t))
+
+		res, err := atp.GetTransactionsPoolSenderDetails("bob")
+		require.Nil(t, res)
+		require.Equal(t, ErrSenderNotInSelfShard, err)
+	})
+	t.Run("missing account should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForTransactionsPoolInspection(t)
+		args.AccountsAdapterAPI = &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return nil, errors.New("account not found")
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsPoolSenderDetails("alice")
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, ErrCannotRetrieveSenderAccount))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		txHash1, txHash2, txHash4 := []byte("txHash1"), []byte("txHash2"), []byte("txHash4")
+		args := createMockArgsForTransactionsPoolInspection(t,
+			createTx(txHash1, "alice", 1),
+			createTx(txHash2, "alice", 2),
+			createTx(txHash4, "alice", 4),
+		)
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsPoolSenderDetails("alice")
+		require.NoError(t, err)
+		require.Equal(t, "alice", res.Sender)
+		require.Equal(t, uint64(2), res.AccountNonce)
+		require.Equal(t, "1000000", res.AccountBalance)
+		require.Equal(t, 3, res.NumTransactions)
+		require.Equal(t, int64(3*128), res.TotalBytes)
+
+		require.Equal(t, 3, len(res.Transactions))
+		require.Equal(t, hex.EncodeToString(txHash1), res.Transactions[0].Hash)
+		require.False(t, res.Transactions[0].Selectable)
+		require.Equal(t, string(txcache.RejectionReasonLowerNonce), res.Transactions[0].Reason)
+		require.Equal(t, hex.EncodeToString(txHash2), res.Transactions[1].Hash)
+		require.True(t, res.Transactions[1].Selectable)
+		require.Empty(t, res.Transactions[1].Reason)
+		require.Equal(t, hex.EncodeToString(txHash4), res.Transactions[2].Hash)
+		require.False(t, res.Transactions[2].Selectable)
+		require.Equal(t, string(txcache.RejectionReasonNonceGap), res.Transactions[2].Reason)
+
+		require.Equal(t, []common.RejectedTransactionApiResponse{
+			{
+				Hash:      hex.EncodeToString([]byte("txHashA")),
+				Sender:    "alice",
+				Nonce:     1,
+				Reason:    string(txcache.RejectionReasonNonceGap),
+				Timestamp: 100,
+			},
+		}, res.Rejections)
+	})
+}
+
+func TestApiTransactionProcessor_GetTransactionsPoolSelection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cache of unknown type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &testscommon.ShardedDataStub{
+					ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+						return testscommon.NewCacherStub()
+					},
+				}
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsPoolSelection("")
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, ErrCannotRetrieveTransactions))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		txHash2, txHash3, txHash5 := []byte("txHash2"), []byte("txHash3"), []byte("txHash5")
+		args := createMockArgsForTransactionsPoolInspection(t,
+			createTx(txHash2, "alice", 2),
+			createTx(txHash3, "alice", 3),
+			createTx(txHash5, "alice", 5),
+		)
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsPoolSelection("hash,nonce")
+		require.NoError(t, err)
+		require.Equal(t, []common.Transaction{
+			{
+				TxFields: map[string]interface{}{
+					hashField:  hex.EncodeToString(txHash2),
+					nonceField: uint64(2),
+				},
+			},
+			{
+				TxFields: map[string]interface{}{
+					hashField:  hex.EncodeToString(txHash3),
+					nonceField: uint64(3),
+				},
+			},
+		}, res.Transactions)
+	})
+}
+
+func TestApiTransactionProcessor_GetTransactionsPoolRejections(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForTransactionsPoolInspection(t)
+		expectedErr := errors.New("expected error")
+		args.AddressPubKeyConverter = &testscommon.PubkeyConverterStub{
+			DecodeCalled: func(humanReadable string) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsPoolRejections("alice")
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("pool not recording rejections should return empty", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &testscommon.ShardedDataStub{}
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsPoolRejections("")
+		require.NoError(t, err)
+		require.Empty(t, res.Rejections)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _ := NewAPITransactionProcessor(createMockArgsForTransactionsPoolInspection(t))
+
+		res, err := atp.GetTransactionsPoolRejections("")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(res.Rejections))
+		require.Equal(t, "alice", res.Rejections[0].Sender)
+		require.Equal(t, "bob", res.Rejections[1].Sender)
+		require.Equal(t, string(txcache.RejectionReasonEvictedUnderHighLoad), res.Rejections[1].Reason)
+
+		res, err = atp.GetTransactionsPoolRejections("bob")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(res.Rejections))
+		require.Equal(t, hex.EncodeToString([]byte("txHashB")), res.Rejections[0].Hash)
+		require.Equal(t, uint64(7), res.Rejections[0].Nonce)
+		require.Equal(t, int64(200), res.Rejections[0].Timestamp)
+	})
+}
+
+func TestApiTransactionProcessor_GetTransactionsForAddress(t *testing.T) {
+	t.Parallel()
+
+	address := hex.EncodeToString([]byte("alice"))
+	t.Run("db lookup extensions not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 0, false)
+		res, err := atp.GetTransactionsForAddress(address, 0, 10)
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, ErrDBLookExtensionIsNotEnabled))
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, 0, true)
+		res, err := atp.GetTransactionsForAddress("not hex", 0, 10)
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, hex.InvalidByteError('n')))
+		require.True(t, strings.Contains(err.Error(), ErrInvalidAddress.Error()))
+	})
+	t.Run("address history error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		atp, _, _, historyRepo := createAPITransactionProc(t, 0, true)
+		historyRepo.GetAddressHistoryCalled = func(address []byte, cursor uint64, maxEntries int) (*addressHistory.AddressHistoryPage, error) {
+			return nil, expectedErr
+		}
+
+		res, err := atp.GetTransactionsForAddress(address, 0, 10)
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		atp, chainStorer, _, historyRepo := createAPITransactionProc(t, 42, true)
+		txA := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+		_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), txA, atp.marshalizer)
+		txB := &transaction.Transaction{Nonce: 8, SndAddr: []byte("alice"), RcvAddr: []byte("alice")}
+		_ = chainStorer.Transactions.PutWithMarshalizer([]byte("b"), txB, atp.marshalizer)
+		setupGetMiniblockMetadataByTxHash(historyRepo, block.TxBlock, 1, 1, 42, nil, 0)
+		historyRepo.GetAddressHistoryCalled = func(addr []byte, cursor uint64, maxEntries int) (*addressHistory.AddressHistoryPage, error) {
+			require.Equal(t, []byte("alice"), addr)
+			require.Equal(t, uint64(5), cursor)
+			require.Equal(t, 3, maxEntries)
+
+			return &addressHistory.AddressHistoryPage{
+				Entries: []*addressHistory.AddressHistoryEntry{
+					{TxHash: []byte("b")},
+					{TxHash: []byte("missing")},
+					{TxHash: []byte("a")},
+				},
+				NumEntries: 6,
+				NextCursor: 2,
+			}, nil
+		}
+
+		res, err := atp.GetTransactionsForAddress(address, 5, 3)
+		require.Nil(t, err)
+		require.Equal(t, uint64(6), res.NumTransactions)
+		require.Equal(t, uint64(2), res.NextCursor)
+		require.Equal(t, 2, len(res.Transactions))
+		require.Equal(t, hex.EncodeToString([]byte("b")), res.Transactions[0].Hash)
+		require.Equal(t, txB.Nonce, res.Transactions[0].Nonce)
+		require.Equal(t, hex.EncodeToString([]byte("a")), res.Transactions[1].Hash)
+		require.Equal(t, txA.Nonce, res.Transactions[1].Nonce)
+	})
+}
+
 func createAPITransactionProc(t *testing.T, epoch uint32, withDbLookupExt bool) (*apiTransactionProcessor, *genericMocks.ChainStorerMock, *dataRetrieverMock.PoolsHolderMock, *dblookupextMock.HistoryRepositoryStub) {
 	chainStorer := genericMocks.NewChainStorerMock(epoch)
 	dataPool := dataRetrieverMock.NewPoolsHolderMock()
@@ -1146,6 +1530,9 @@ func createAPITransactionProc(t *testing.T, epoch uint32, withDbLookupExt bool)
 		DataFieldParser:          dataFieldParser,
 		TxMarshaller:             &marshallerMock.MarshalizerMock{},
 		EnableEpochsHandler:      enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+		AccountsAdapterAPI:       &stateMock.AccountsStub{},
+		GuardedAccountHandler:    &guardianMocks.GuardedAccountHandlerStub{},
+		TxVersionChecker:         &testscommon.TxVersionCheckerStub{},
 	}
 	apiTransactionProc, err := NewAPITransactionProcessor(args)
 	require.Nil(t, err)
@@ -50,6 +50,15 @@ func checkNilArgs(arg *ArgAPITransactionProcessor) error {
 	if check.IfNil(arg.EnableEpochsHandler) {
 		return process.ErrNilEnableEpochsHandler
 	}
+	if check.IfNil(arg.AccountsAdapterAPI) {
+		return process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(arg.GuardedAccountHandler) {
+		return process.ErrNilGuardedAccountHandler
+	}
+	if check.IfNil(arg.TxVersionChecker) {
+		return process.ErrNilTransactionVersionChecker
+	}
 
 	return nil
 }
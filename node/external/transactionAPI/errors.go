@@ -34,3 +34,9 @@ var ErrInvalidAddress = errors.New("invalid address")
 
 // ErrDBLookExtensionIsNotEnabled signals that the db look extension is not enabled
 var ErrDBLookExtensionIsNotEnabled = errors.New("db look extension is not enabled")
+
+// ErrSenderNotInSelfShard signals that the sender does not belong to the shard of the node
+var ErrSenderNotInSelfShard = errors.New("sender does not belong to the self shard")
+
+// ErrCannotRetrieveSenderAccount signals that the account of the sender cannot be retrieved
+var ErrCannotRetrieveSenderAccount = errors.New("sender account cannot be retrieved")
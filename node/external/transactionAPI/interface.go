@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 )
 
@@ -30,3 +31,9 @@ type LogsFacade interface {
 type DataFieldParser interface {
 	Parse(dataField []byte, sender, receiver []byte, numOfShards uint32) *datafield.ResponseParseData
 }
+
+// rejectedTransactionsProvider defines what a transactions pool able to record the rejected transactions should do
+type rejectedTransactionsProvider interface {
+	GetRejectedTransactions() []*txcache.RejectedTransaction
+	GetRejectedTransactionsForSender(sender []byte) []*txcache.RejectedTransaction
+}
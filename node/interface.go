@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/update"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -62,3 +63,9 @@ type accountHandlerWithDataTrieMigrationStatus interface {
 	vmcommon.AccountHandler
 	IsDataTrieMigrated() (bool, error)
 }
+
+// transactionsPoolWithReplacementCheck defines a pool able to tell whether a transaction would be rejected as an
+// underpriced replacement of a transaction with the same sender and nonce
+type transactionsPoolWithReplacementCheck interface {
+	CheckReplacement(key []byte, tx data.TransactionHandler, cacheID string) error
+}
@@ -52,6 +52,9 @@ func InitBaseMetrics(appStatusHandler core.AppStatusHandler) error {
 	appStatusHandler.SetUInt64Value(common.MetricAccountsSnapshotNumNodes, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricTrieSyncNumProcessedNodes, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricTrieSyncNumReceivedBytes, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricTrieSyncNumPendingNodes, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricTrieSyncProgressPercent, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricTrieSyncEstimatedTimeLeftInSec, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricAccountsSnapshotInProgress, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricPeersSnapshotInProgress, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricNonceAtEpochStart, initUint)
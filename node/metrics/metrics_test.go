@@ -65,6 +65,9 @@ func TestInitBaseMetrics(t *testing.T) {
 		common.MetricAccountsSnapshotNumNodes,
 		common.MetricTrieSyncNumProcessedNodes,
 		common.MetricTrieSyncNumReceivedBytes,
+		common.MetricTrieSyncNumPendingNodes,
+		common.MetricTrieSyncProgressPercent,
+		common.MetricTrieSyncEstimatedTimeLeftInSec,
 		common.MetricRoundAtEpochStart,
 		common.MetricNonceAtEpochStart,
 	}
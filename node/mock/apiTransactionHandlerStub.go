@@ -12,6 +12,10 @@ type TransactionAPIHandlerStub struct {
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolSenderDetailsCalled      func(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error)
+	GetTransactionsPoolSelectionCalled          func(fields string) (*common.TransactionsPoolSelectionApiResponse, error)
+	GetTransactionsPoolRejectionsCalled         func(sender string) (*common.TransactionsPoolRejectionsApiResponse, error)
+	GetTransactionsForAddressCalled             func(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error)
 	UnmarshalTransactionCalled                  func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	UnmarshalReceiptCalled                      func(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	PopulateComputedFieldsCalled                func(tx *transaction.ApiTransactionResult)
@@ -72,6 +76,42 @@ func (tas *TransactionAPIHandlerStub) GetTransactionsPoolNonceGapsForSender(send
 	return nil, nil
 }
 
+// GetTransactionsPoolSenderDetails -
+func (tas *TransactionAPIHandlerStub) GetTransactionsPoolSenderDetails(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+	if tas.GetTransactionsPoolSenderDetailsCalled != nil {
+		return tas.GetTransactionsPoolSenderDetailsCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolSelection -
+func (tas *TransactionAPIHandlerStub) GetTransactionsPoolSelection(fields string) (*common.TransactionsPoolSelectionApiResponse, error) {
+	if tas.GetTransactionsPoolSelectionCalled != nil {
+		return tas.GetTransactionsPoolSelectionCalled(fields)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolRejections -
+func (tas *TransactionAPIHandlerStub) GetTransactionsPoolRejections(sender string) (*common.TransactionsPoolRejectionsApiResponse, error) {
+	if tas.GetTransactionsPoolRejectionsCalled != nil {
+		return tas.GetTransactionsPoolRejectionsCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsForAddress -
+func (tas *TransactionAPIHandlerStub) GetTransactionsForAddress(address string, cursor uint64, size int) (*common.AddressTransactionsApiResponse, error) {
+	if tas.GetTransactionsForAddressCalled != nil {
+		return tas.GetTransactionsForAddressCalled(address, cursor, size)
+	}
+
+	return nil, nil
+}
+
 // UnmarshalTransaction -
 func (tas *TransactionAPIHandlerStub) UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error) {
 	if tas.UnmarshalTransactionCalled != nil {
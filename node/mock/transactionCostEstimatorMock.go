@@ -8,7 +8,7 @@ import (
 // TransactionCostEstimatorMock  -
 type TransactionCostEstimatorMock struct {
 	ComputeTransactionGasLimitCalled   func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecutionCalled func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecutionCalled func(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
 }
 
 // ComputeTransactionGasLimit -
@@ -20,9 +20,9 @@ func (tcem *TransactionCostEstimatorMock) ComputeTransactionGasLimit(tx *transac
 }
 
 // SimulateTransactionExecution -
-func (tcem *TransactionCostEstimatorMock) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (tcem *TransactionCostEstimatorMock) SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if tcem.SimulateTransactionExecutionCalled != nil {
-		return tcem.SimulateTransactionExecutionCalled(tx)
+		return tcem.SimulateTransactionExecutionCalled(tx, withTrace)
 	}
 
 	return &txSimData.SimulationResultsWithVMOutput{}, nil
@@ -38,6 +38,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract"
 	procTx "github.com/multiversx/mx-chain-go/process/transaction"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/proofBundle"
 	"github.com/multiversx/mx-chain-go/trie"
 	"github.com/multiversx/mx-chain-go/vm"
 	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts"
@@ -739,8 +740,32 @@ func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
 			n.coreComponents.AddressPubKeyConverter().SilentEncode(tx.SndAddr, log),
 		)
 	}
+	if err != nil {
+		return err
+	}
 
-	return err
+	return n.checkReplacement(tx, intTx)
+}
+
+// checkReplacement rejects, before being broadcast, a transaction which would not replace the ones with the same
+// sender and nonce, already in the pool
+func (n *Node) checkReplacement(tx *transaction.Transaction, intTx process.InterceptedTransactionHandler) error {
+	if check.IfNil(n.dataComponents) || check.IfNil(n.dataComponents.Datapool()) {
+		return nil
+	}
+
+	txPool, ok := n.dataComponents.Datapool().Transactions().(transactionsPoolWithReplacementCheck)
+	if !ok {
+		return nil
+	}
+
+	txHash, err := core.CalculateHash(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher(), tx)
+	if err != nil {
+		return err
+	}
+
+	cacheID := process.ShardCacherIdentifier(intTx.SenderShardId(), intTx.ReceiverShardId())
+	return txPool.CheckReplacement(txHash, tx, cacheID)
 }
 
 // ValidateTransactionForSimulation will validate a transaction for use in transaction simulation process
@@ -1458,6 +1483,73 @@ func (n *Node) VerifyProof(rootHash string, address string, proof [][]byte) (boo
 	return mpv.VerifyProof(rootHashBytes, key, proof)
 }
 
+// GetProofBundle returns the Merkle proof for the given address, and optionally the one for the given key of its data
+// trie, bundled with the current block header committing to the proven state and the aggregated signature over it.
+// This allows a light client to verify the proofs without trusting this node
+func (n *Node) GetProofBundle(address string, key string) (*proofBundle.Bundle, error) {
+	header := n.dataComponents.Blockchain().GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		return nil, process.ErrNilBlockHeader
+	}
+
+	addressBytes, err := n.getKeyBytes(address)
+	if err != nil {
+		return nil, err
+	}
+
+	headerBytes, err := n.coreComponents.InternalMarshalizer().Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	// the hash is computed on the marshalled header instead of being read separately from the blockchain, which might
+	// have committed another block in the meantime
+	headerHash := n.coreComponents.Hasher().Compute(string(headerBytes))
+
+	rootHash := proofBundle.GetStateRootHash(header)
+	accountProof, err := n.getProof(rootHash, addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &proofBundle.Bundle{
+		ShardID:             header.GetShardID(),
+		HeaderHash:          hex.EncodeToString(headerHash),
+		Header:              hex.EncodeToString(headerBytes),
+		AggregatedSignature: hex.EncodeToString(header.GetSignature()),
+		PubKeysBitmap:       hex.EncodeToString(header.GetPubKeysBitmap()),
+		RootHash:            hex.EncodeToString(rootHash),
+		Address:             hex.EncodeToString(addressBytes),
+		AccountProof:        proofToHex(accountProof.Proof),
+	}
+	if len(key) == 0 {
+		return bundle, nil
+	}
+
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+
+	dataTrieRootHash, _, err := n.getAccountRootHashAndVal(addressBytes, accountProof.Value, keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	dataTrieKey := n.coreComponents.Hasher().Compute(string(keyBytes))
+	dataTrieProof, err := n.getProof(dataTrieRootHash, dataTrieKey)
+	if err != nil {
+		dataTrieProof, err = n.getProof(dataTrieRootHash, keyBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bundle.Key = hex.EncodeToString(keyBytes)
+	bundle.DataTrieProof = proofToHex(dataTrieProof.Proof)
+
+	return bundle, nil
+}
+
 // IsDataTrieMigrated returns true if the data trie for the given address is migrated
 func (n *Node) IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error) {
 	accountHandler, _, err := n.loadUserAccountHandlerByAddress(address, options)
@@ -1529,6 +1621,15 @@ func (n *Node) getProof(rootHash []byte, key []byte) (*common.GetProofResponse,
 	}, nil
 }
 
+func proofToHex(proof [][]byte) []string {
+	encodedProof := make([]string, 0, len(proof))
+	for _, encodedNode := range proof {
+		encodedProof = append(encodedProof, hex.EncodeToString(encodedNode))
+	}
+
+	return encodedProof
+}
+
 func (n *Node) getKeyBytes(key string) ([]byte, error) {
 	addressBytes, err := n.DecodeAddressPubkey(key)
 	if err == nil {
@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"math/big"
@@ -52,6 +53,7 @@ import (
 	"github.com/multiversx/mx-chain-go/health"
 	"github.com/multiversx/mx-chain-go/node/metrics"
 	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/replay"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
@@ -459,6 +461,33 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	if configs.OutportReplayConfig != nil && configs.OutportReplayConfig.IsReplayMode {
+		err = nr.replayOutport(
+			managedCoreComponents,
+			managedBootstrapComponents,
+			managedDataComponents,
+			managedStateComponents,
+			managedProcessComponents,
+			managedStatusComponents,
+			nodesCoordinatorInstance,
+		)
+		closeComponentsAfterOutportReplay(
+			healthService,
+			webServerHandler,
+			managedProcessComponents,
+			managedStatusComponents,
+			managedStateComponents,
+			managedDataComponents,
+			managedBootstrapComponents,
+			managedNetworkComponents,
+			managedCryptoComponents,
+			managedStatusCoreComponents,
+			managedCoreComponents,
+		)
+
+		return true, err
+	}
+
 	hardforkTrigger := managedProcessComponents.HardforkTrigger()
 	err = hardforkTrigger.AddCloser(nodesShufflerOut)
 	if err != nil {
@@ -575,6 +604,70 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 	return nextOperation == nextOperationShouldStop, nil
 }
 
+// replayOutport pushes the blocks stored in the local database to the configured outport drivers. It blocks until the
+// replay is finished or until the node is requested to stop
+func (nr *nodeRunner) replayOutport(
+	coreComponents mainFactory.CoreComponentsHolder,
+	bootstrapComponents mainFactory.BootstrapComponentsHolder,
+	dataComponents mainFactory.DataComponentsHolder,
+	stateComponents mainFactory.StateComponentsHolder,
+	processComponents mainFactory.ProcessComponentsHolder,
+	statusComponents mainFactory.StatusComponentsHolder,
+	nodesCoordinatorInstance nodesCoordinator.NodesCoordinator,
+) error {
+	replayer, err := replay.NewOutportReplayer(replay.ArgsOutportReplayer{
+		Config:                 *nr.configs.OutportReplayConfig,
+		ShardCoordinator:       bootstrapComponents.ShardCoordinator(),
+		StorageService:         dataComponents.StorageService(),
+		Marshaller:             coreComponents.InternalMarshalizer(),
+		Hasher:                 coreComponents.Hasher(),
+		Uint64Converter:        coreComponents.Uint64ByteSliceConverter(),
+		AddressConverter:       coreComponents.AddressPubKeyConverter(),
+		AccountsDB:             stateComponents.AccountsAdapter(),
+		EsdtDataStorageHandler: processComponents.ESDTDataStorageHandlerForAPI(),
+		NodesCoordinator:       nodesCoordinatorInstance,
+		EconomicsData:          coreComponents.EconomicsData(),
+		EnableEpochsHandler:    coreComponents.EnableEpochsHandler(),
+		OutportHandler:         statusComponents.OutportHandler(),
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+
+	go func() {
+		select {
+		case sig := <-sigs:
+			log.Info("terminating outport replay at user's signal...", "signal", sig)
+		case arg := <-coreComponents.ChanStopNodeProcess():
+			log.Info("terminating outport replay...", "description", arg.Description)
+		case <-ctx.Done():
+			return
+		}
+		cancel()
+	}()
+
+	return replayer.Replay(ctx)
+}
+
+func closeComponentsAfterOutportReplay(healthService io.Closer, httpServer shared.UpgradeableHttpServerHandler, components ...mainFactory.Closer) {
+	log.Debug("closing health service...")
+	log.LogIfError(healthService.Close())
+
+	log.Debug("closing http server")
+	log.LogIfError(httpServer.Close())
+
+	for _, component := range components {
+		log.LogIfError(component.Close())
+	}
+}
+
 func addSyncersToAccountsDB(
 	config *config.Config,
 	coreComponents mainFactory.CoreComponentsHolder,
@@ -703,6 +796,7 @@ func getBaseAccountSyncerArgs(
 		MaxTrieLevelInMemory:              maxTrieLevelInMemory,
 		MaxHardCapForMissingNodes:         config.TrieSync.MaxHardCapForMissingNodes,
 		TrieSyncerVersion:                 config.TrieSync.TrieSyncerVersion,
+		NumSyncWorkers:                    config.TrieSync.NumParallelSyncWorkers,
 		CheckNodesOnDisk:                  true,
 		UserAccountsSyncStatisticsHandler: trieStatistics.NewTrieSyncStatistics(),
 		AppStatusHandler:                  disabled.NewAppStatusHandler(),
@@ -1216,6 +1310,7 @@ func (nr *nodeRunner) CreateManagedProcessComponents(
 		Marshalizer:              coreComponents.InternalMarshalizer(),
 		Store:                    dataComponents.StorageService(),
 		Uint64ByteSliceConverter: coreComponents.Uint64ByteSliceConverter(),
+		ShardCoordinator:         bootstrapComponents.ShardCoordinator(),
 	}
 	historyRepositoryFactory, err := dbLookupFactory.NewHistoryRepositoryFactory(historyRepoFactoryArgs)
 	if err != nil {
@@ -0,0 +1,86 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/stateDiff"
+)
+
+// GetStateDiff returns a page of the accounts which differ between the two states described by the request
+func (n *Node) GetStateDiff(request stateDiff.Request, ctx context.Context) (*stateDiff.StateDiff, error) {
+	fromRootHash, err := n.getStateDiffRootHash(request.FromRootHash, request.FromBlockNonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the old state", err)
+	}
+	toRootHash, err := n.getStateDiffRootHash(request.ToRootHash, request.ToBlockNonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the new state", err)
+	}
+
+	var startAddress []byte
+	if len(request.StartAddress) > 0 {
+		startAddress, err = n.getKeyBytes(request.StartAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// recreating the tries makes sure that both states are available before starting the diff
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(fromRootHash)
+	if err != nil {
+		return nil, err
+	}
+	_, err = n.stateComponents.AccountsAdapterAPI().GetTrie(toRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	diffComputer, err := stateDiff.NewStateDiffComputer(stateDiff.ArgsStateDiffComputer{
+		Storer:                 tr.GetStorageManager(),
+		Marshaller:             n.coreComponents.InternalMarshalizer(),
+		Hasher:                 n.coreComponents.Hasher(),
+		AddressPubkeyConverter: n.coreComponents.AddressPubKeyConverter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := diffComputer.ComputeDiff(
+		ctx,
+		fromRootHash,
+		toRootHash,
+		startAddress,
+		request.MaxAccounts,
+		request.MaxDataTrieChangesPerAccount,
+	)
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+func (n *Node) getStateDiffRootHash(rootHash string, blockNonce core.OptionalUint64) ([]byte, error) {
+	hasRootHash := len(rootHash) > 0
+	if hasRootHash == blockNonce.HasValue {
+		return nil, ErrInvalidStateDiffBoundary
+	}
+
+	if hasRootHash {
+		return hex.DecodeString(rootHash)
+	}
+
+	header, headerHash, err := n.getBlockHeaderByNonce(blockNonce.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getBlockRootHash(headerHash, header), nil
+}
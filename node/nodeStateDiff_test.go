@@ -0,0 +1,178 @@
+package node_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/stateDiff"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	storageMocks "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+
+	t.Run("missing state boundary should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithStateComponents(getDefaultStateComponents()),
+		)
+
+		diff, err := n.GetStateDiff(stateDiff.Request{ToRootHash: "aa"}, context.Background())
+		assert.Nil(t, diff)
+		assert.True(t, errors.Is(err, node.ErrInvalidStateDiffBoundary))
+
+		diff, err = n.GetStateDiff(stateDiff.Request{
+			FromRootHash:   "aa",
+			FromBlockNonce: core.OptionalUint64{Value: 1, HasValue: true},
+		}, context.Background())
+		assert.Nil(t, diff)
+		assert.True(t, errors.Is(err, node.ErrInvalidStateDiffBoundary))
+	})
+	t.Run("unknown block nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		dataComponents := getDefaultDataComponents()
+		dataComponents.Store = &storageMocks.ChainStorerStub{
+			GetStorerCalled: func(_ dataRetriever.UnitType) (storage.Storer, error) {
+				return nil, expectedErr
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithDataComponents(dataComponents),
+			node.WithProcessComponents(getDefaultProcessComponents()),
+		)
+
+		diff, err := n.GetStateDiff(stateDiff.Request{
+			FromBlockNonce: core.OptionalUint64{Value: 1, HasValue: true},
+			ToRootHash:     "aa",
+		}, context.Background())
+		assert.Nil(t, diff)
+		assert.NotNil(t, err)
+	})
+	t.Run("missing state should error", func(t *testing.T) {
+		t.Parallel()
+
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return nil, expectedErr
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithStateComponents(stateComponents),
+		)
+
+		diff, err := n.GetStateDiff(stateDiff.Request{FromRootHash: "aa", ToRootHash: "bb", MaxAccounts: 1}, context.Background())
+		assert.Nil(t, diff)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, fromRootHash, toRootHash := createNodeWithStatesForDiff(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		diff, err := n.GetStateDiff(stateDiff.Request{
+			FromRootHash:                 hex.EncodeToString(fromRootHash),
+			ToRootHash:                   hex.EncodeToString(toRootHash),
+			MaxAccounts:                  10,
+			MaxDataTrieChangesPerAccount: 10,
+		}, ctx)
+		assert.Nil(t, diff)
+		assert.Equal(t, node.ErrTrieOperationsTimeout, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		n, fromRootHash, toRootHash := createNodeWithStatesForDiff(t)
+		diff, err := n.GetStateDiff(stateDiff.Request{
+			FromRootHash:                 hex.EncodeToString(fromRootHash),
+			ToRootHash:                   hex.EncodeToString(toRootHash),
+			MaxAccounts:                  10,
+			MaxDataTrieChangesPerAccount: 10,
+		}, context.Background())
+		require.Nil(t, err)
+		require.Equal(t, 1, len(diff.Accounts))
+		assert.Equal(t, stateDiff.Modified, diff.Accounts[0].Change)
+		assert.Equal(t, []string{stateDiff.BalanceField}, diff.Accounts[0].ChangedFields)
+		assert.Equal(t, "10", diff.Accounts[0].Old.Balance)
+		assert.Equal(t, "20", diff.Accounts[0].New.Balance)
+		assert.Empty(t, diff.NextAddress)
+	})
+}
+
+// createNodeWithStatesForDiff returns a node whose accounts adapter holds two states, the second one having the
+// balance of an account changed
+func createNodeWithStatesForDiff(t *testing.T) (*node.Node, []byte, []byte) {
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := blake2b.NewBlake2b()
+
+	args := storageMocks.GetStorageManagerArgs()
+	args.Marshalizer = marshaller
+	args.Hasher = hasher
+	storageManager, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	tr, err := trie.NewTrie(storageManager, marshaller, hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+
+	saveAccount := func(address []byte, balance int64) []byte {
+		accountBytes, errMarshal := marshaller.Marshal(&accounts.UserAccountData{
+			Nonce:   1,
+			Balance: big.NewInt(balance),
+			Address: address,
+		})
+		require.Nil(t, errMarshal)
+		require.Nil(t, tr.Update(address, accountBytes))
+		require.Nil(t, tr.Commit())
+
+		rootHash, errRootHash := tr.RootHash()
+		require.Nil(t, errRootHash)
+
+		return rootHash
+	}
+	_ = saveAccount(hasher.Compute("alice"), 5)
+	fromRootHash := saveAccount(hasher.Compute("bob"), 10)
+	toRootHash := saveAccount(hasher.Compute("bob"), 20)
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = marshaller
+	coreComponents.Hash = hasher
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return tr, nil
+		},
+	}
+	n, err := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+	)
+	require.Nil(t, err)
+
+	return n, fromRootHash, toRootHash
+}
@@ -0,0 +1,43 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/triesStatistics"
+)
+
+// GetTriesStatistics returns the statistics of the accounts trie with the given root hash and of its data tries,
+// together with the numTopAccounts accounts holding the largest data tries
+func (n *Node) GetTriesStatistics(rootHash string, numTopAccounts int, ctx context.Context) (*triesStatistics.Report, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHashBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	statisticsComputer, err := triesStatistics.NewTriesStatisticsComputer(triesStatistics.ArgsTriesStatisticsComputer{
+		Storer:                 tr.GetStorageManager(),
+		Marshaller:             n.coreComponents.InternalMarshalizer(),
+		Hasher:                 n.coreComponents.Hasher(),
+		AddressPubkeyConverter: n.coreComponents.AddressPubKeyConverter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := statisticsComputer.ComputeStatistics(ctx, rootHashBytes, numTopAccounts)
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
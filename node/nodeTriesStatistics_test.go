@@ -0,0 +1,74 @@
+package node_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetTriesStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithStateComponents(getDefaultStateComponents()),
+		)
+
+		report, err := n.GetTriesStatistics("not hex", 10, context.Background())
+		assert.Nil(t, report)
+		assert.NotNil(t, err)
+	})
+	t.Run("missing state should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return nil, expectedErr
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithStateComponents(stateComponents),
+		)
+
+		report, err := n.GetTriesStatistics("aa", 10, context.Background())
+		assert.Nil(t, report)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _, rootHash := createNodeWithStatesForDiff(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		report, err := n.GetTriesStatistics(hex.EncodeToString(rootHash), 10, ctx)
+		assert.Nil(t, report)
+		assert.Equal(t, node.ErrTrieOperationsTimeout, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		n, _, rootHash := createNodeWithStatesForDiff(t)
+		report, err := n.GetTriesStatistics(hex.EncodeToString(rootHash), 10, context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString(rootHash), report.RootHash)
+		assert.Equal(t, uint64(2), report.NumAccounts)
+		assert.True(t, report.IsComplete)
+		assert.Equal(t, uint64(2), report.TriesByType[common.MainTrie].NumLeaves)
+		assert.Equal(t, uint64(0), report.TriesByType[common.DataTrie].NumTries)
+		assert.Empty(t, report.TopAccountsByDataTrieSize)
+	})
+}
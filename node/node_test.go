@@ -33,6 +33,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dataRetriever/txpool"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 	"github.com/multiversx/mx-chain-go/factory"
 	factoryMock "github.com/multiversx/mx-chain-go/factory/mock"
@@ -48,6 +49,8 @@ import (
 	"github.com/multiversx/mx-chain-go/state/parsers"
 	"github.com/multiversx/mx-chain-go/state/trackableDataTrie"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/bootstrapMocks"
 	dataRetrieverMock "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
@@ -68,6 +71,7 @@ import (
 	mockStorage "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
 	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	"github.com/multiversx/mx-chain-go/testscommon/txcachemocks"
 	"github.com/multiversx/mx-chain-go/testscommon/txsSenderMock"
 	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -2839,6 +2843,218 @@ func TestCreateTransaction_OkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+type shardedDataStubWithReplacementCheck struct {
+	*testscommon.ShardedDataStub
+	checkReplacementCalled func(key []byte, tx data.TransactionHandler, cacheID string) error
+}
+
+func (stub *shardedDataStubWithReplacementCheck) CheckReplacement(key []byte, tx data.TransactionHandler, cacheID string) error {
+	return stub.checkReplacementCalled(key, tx, cacheID)
+}
+
+func TestNode_ValidateTransactionUnderpricedReplacementShouldErr(t *testing.T) {
+	t.Parallel()
+
+	version := uint32(1)
+	expectedHash := []byte("expected hash")
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = getMarshalizer()
+	coreComponents.VmMarsh = getMarshalizer()
+	coreComponents.TxMarsh = getMarshalizer()
+	coreComponents.Hash = mock.HasherMock{
+		ComputeCalled: func(s string) []byte {
+			return expectedHash
+		},
+	}
+	coreComponents.TxVersionCheckHandler = versioning.NewTxVersionChecker(version)
+	coreComponents.AddrPubKeyConv = &testscommon.PubkeyConverterStub{
+		DecodeCalled: func(hexAddress string) ([]byte, error) {
+			return []byte(hexAddress), nil
+		},
+		EncodeCalled: func(pkBytes []byte) (string, error) {
+			return string(pkBytes), nil
+		},
+		LenCalled: func() int {
+			return 3
+		},
+	}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+			return createAcc([]byte("address")), nil
+		},
+	}
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.EpochTrigger = &mock.EpochStartTriggerStub{
+		EpochCalled: func() uint32 {
+			return 1
+		},
+	}
+
+	networkComponents := getDefaultNetworkComponents()
+	cryptoComponents := getDefaultCryptoComponents()
+	bootstrapComponents := getDefaultBootstrapComponents()
+	bootstrapComponents.ShCoordinator = processComponents.ShardCoordinator()
+	bootstrapComponents.HdrIntegrityVerifier = processComponents.HeaderIntegrVerif
+	var checkedCacheID string
+	dataComponents := getDefaultDataComponents()
+	dataComponents.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &shardedDataStubWithReplacementCheck{
+				ShardedDataStub: testscommon.NewShardedDataStub(),
+				checkReplacementCalled: func(key []byte, tx data.TransactionHandler, cacheID string) error {
+					checkedCacheID = cacheID
+					return txcache.ErrReplacementUnderpriced
+				},
+			}
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithProcessComponents(processComponents),
+		node.WithNetworkComponents(networkComponents),
+		node.WithCryptoComponents(cryptoComponents),
+		node.WithBootstrapComponents(bootstrapComponents),
+		node.WithAddressSignatureSize(10),
+	)
+
+	nonce := uint64(0)
+	value := new(big.Int).SetInt64(10)
+	receiver := "rcv"
+
+	txArgs := getDefaultTransactionArgs()
+	txArgs.Receiver = receiver
+	txArgs.Nonce = nonce
+	txArgs.Value = value.String()
+	txArgs.ChainID = coreComponents.ChainID()
+	txArgs.Version = coreComponents.MinTransactionVersion()
+
+	tx, _, err := n.CreateTransaction(txArgs)
+	require.Nil(t, err)
+
+	err = n.ValidateTransaction(tx)
+	assert.Equal(t, txcache.ErrReplacementUnderpriced, err)
+	assert.Equal(t, process.ShardCacherIdentifier(0, 0), checkedCacheID)
+}
+
+func TestNode_ValidateTransactionSameNonceReplacementShouldCheckGasPriceBump(t *testing.T) {
+	t.Parallel()
+
+	version := uint32(1)
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = getMarshalizer()
+	coreComponents.VmMarsh = getMarshalizer()
+	coreComponents.TxMarsh = getMarshalizer()
+	coreComponents.Hash = mock.HasherMock{
+		ComputeCalled: func(s string) []byte {
+			return []byte("incoming hash")
+		},
+	}
+	coreComponents.TxVersionCheckHandler = versioning.NewTxVersionChecker(version)
+	coreComponents.AddrPubKeyConv = &testscommon.PubkeyConverterStub{
+		DecodeCalled: func(hexAddress string) ([]byte, error) {
+			return []byte(hexAddress), nil
+		},
+		EncodeCalled: func(pkBytes []byte) (string, error) {
+			return string(pkBytes), nil
+		},
+		LenCalled: func() int {
+			return 3
+		},
+	}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+			return createAcc([]byte("address")), nil
+		},
+	}
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.EpochTrigger = &mock.EpochStartTriggerStub{
+		EpochCalled: func() uint32 {
+			return 1
+		},
+	}
+
+	networkComponents := getDefaultNetworkComponents()
+	cryptoComponents := getDefaultCryptoComponents()
+	bootstrapComponents := getDefaultBootstrapComponents()
+	bootstrapComponents.ShCoordinator = processComponents.ShardCoordinator()
+	bootstrapComponents.HdrIntegrityVerifier = processComponents.HeaderIntegrVerif
+
+	txPool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
+		Config: storageunit.CacheConfig{
+			Capacity:             100_000,
+			SizePerSender:        1_000_000_000,
+			SizeInBytes:          1_000_000_000,
+			SizeInBytesPerSender: 33_554_432,
+			Shards:               16,
+		},
+		NumberOfShards:                       1,
+		SelfShardID:                          0,
+		TxGasHandler:                         txcachemocks.NewTxGasHandlerMock(),
+		Marshalizer:                          &marshal.GogoProtoMarshalizer{},
+		MinGasPriceBumpPercentForReplacement: 10,
+	})
+	require.Nil(t, err)
+
+	dataComponents := getDefaultDataComponents()
+	dataComponents.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return txPool
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithProcessComponents(processComponents),
+		node.WithNetworkComponents(networkComponents),
+		node.WithCryptoComponents(cryptoComponents),
+		node.WithBootstrapComponents(bootstrapComponents),
+		node.WithAddressSignatureSize(10),
+	)
+
+	txArgs := getDefaultTransactionArgs()
+	txArgs.Receiver = "rcv"
+	txArgs.Nonce = 0
+	txArgs.Value = "10"
+	txArgs.ChainID = coreComponents.ChainID()
+	txArgs.Version = coreComponents.MinTransactionVersion()
+
+	tx, _, err := n.CreateTransaction(txArgs)
+	require.Nil(t, err)
+
+	// a transaction with the same sender and nonce is already in the pool
+	cacheID := process.ShardCacherIdentifier(0, 0)
+	existingTx := *tx
+	txPool.AddData([]byte("existing hash"), &existingTx, existingTx.Size(), cacheID)
+	require.Equal(t, 1, txPool.ShardDataStore(cacheID).Len())
+
+	t.Run("same gas price should err", func(t *testing.T) {
+		incomingTx := *tx
+		err := n.ValidateTransaction(&incomingTx)
+		assert.Equal(t, txcache.ErrReplacementUnderpriced, err)
+	})
+	t.Run("insufficient gas price bump should err", func(t *testing.T) {
+		incomingTx := *tx
+		incomingTx.GasPrice = tx.GasPrice + tx.GasPrice/20
+		err := n.ValidateTransaction(&incomingTx)
+		assert.Equal(t, txcache.ErrReplacementUnderpriced, err)
+	})
+	t.Run("sufficient gas price bump should work", func(t *testing.T) {
+		incomingTx := *tx
+		incomingTx.GasPrice = tx.GasPrice + tx.GasPrice/10
+		err := n.ValidateTransaction(&incomingTx)
+		assert.Nil(t, err)
+	})
+}
+
 func TestCreateTransaction_TxSignedWithHashShouldErrVersionShoudBe2(t *testing.T) {
 	t.Parallel()
 
@@ -4360,6 +4576,113 @@ func TestNode_GetProofDataTrieShouldWork(t *testing.T) {
 	assert.Equal(t, hex.EncodeToString(dataTrieRootHash), dataTrieResponse.RootHash)
 }
 
+func TestNode_GetProofBundle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil current header should error", func(t *testing.T) {
+		t.Parallel()
+
+		dataComponents := getDefaultDataComponents()
+		dataComponents.BlockChain = &testscommon.ChainHandlerStub{}
+		n, _ := node.NewNode(
+			node.WithDataComponents(dataComponents),
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		bundle, err := n.GetProofBundle("0123", "")
+		assert.Nil(t, bundle)
+		assert.Equal(t, process.ErrNilBlockHeader, err)
+	})
+	t.Run("invalid key should error", func(t *testing.T) {
+		t.Parallel()
+
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{}, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithDataComponents(getDefaultDataComponents()),
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		bundle, err := n.GetProofBundle("0123", "key")
+		assert.Nil(t, bundle)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := getDefaultCoreComponents()
+		header := &block.Header{
+			ShardID:       1,
+			Nonce:         42,
+			RootHash:      []byte("root hash"),
+			Signature:     []byte("signature"),
+			PubKeysBitmap: []byte{0x07},
+		}
+		dataComponents := getDefaultDataComponents()
+		dataComponents.BlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return header
+			},
+			GetCurrentBlockHeaderHashCalled: func() []byte {
+				// hash of a block committed after the header was read, it should not be used
+				return []byte("next header hash")
+			},
+		}
+		mainTrieProof := [][]byte{[]byte("main"), []byte("proof")}
+		dataTrieProof := [][]byte{[]byte("data"), []byte("proof")}
+		dataTrieKey := coreComponents.Hash.Compute(string([]byte("key")))
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{
+					GetProofCalled: func(key []byte) ([][]byte, []byte, error) {
+						if bytes.Equal(rootHash, header.RootHash) && hex.EncodeToString(key) == "0123" {
+							return mainTrieProof, []byte("account"), nil
+						}
+						if bytes.Equal(rootHash, []byte("dataTrieRoot")) && bytes.Equal(key, dataTrieKey) {
+							return dataTrieProof, []byte("value"), nil
+						}
+
+						return nil, nil, fmt.Errorf("key not found")
+					},
+				}, nil
+			},
+			GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+				acc := &stateMock.AccountWrapMock{}
+				acc.SetTrackableDataTrie(&trieMock.DataTrieTrackerStub{})
+				acc.SetRootHash([]byte("dataTrieRoot"))
+				return acc, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithDataComponents(dataComponents),
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(coreComponents),
+		)
+
+		bundle, err := n.GetProofBundle("0123", hex.EncodeToString([]byte("key")))
+		require.Nil(t, err)
+
+		headerBytes, _ := coreComponents.IntMarsh.Marshal(header)
+		assert.Equal(t, uint32(1), bundle.ShardID)
+		assert.Equal(t, hex.EncodeToString(coreComponents.Hash.Compute(string(headerBytes))), bundle.HeaderHash)
+		assert.Equal(t, hex.EncodeToString(headerBytes), bundle.Header)
+		assert.Equal(t, hex.EncodeToString(header.Signature), bundle.AggregatedSignature)
+		assert.Equal(t, "07", bundle.PubKeysBitmap)
+		assert.Equal(t, hex.EncodeToString(header.RootHash), bundle.RootHash)
+		assert.Equal(t, "0123", bundle.Address)
+		assert.Equal(t, []string{hex.EncodeToString([]byte("main")), hex.EncodeToString([]byte("proof"))}, bundle.AccountProof)
+		assert.Equal(t, hex.EncodeToString([]byte("key")), bundle.Key)
+		assert.Equal(t, []string{hex.EncodeToString([]byte("data")), hex.EncodeToString([]byte("proof"))}, bundle.DataTrieProof)
+	})
+}
+
 func TestNode_VerifyProofInvalidRootHash(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,22 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/filedriver"
+)
+
+// ArgsFileDriverFactory holds the arguments needed for creating a new file driver
+type ArgsFileDriverFactory struct {
+	Config     config.FileDriverConfig
+	Marshaller marshal.Marshalizer
+}
+
+// CreateFileDriver will create a new instance of outport.Driver that appends the data to segment files
+func CreateFileDriver(args ArgsFileDriverFactory) (outport.Driver, error) {
+	return filedriver.NewFileDriver(filedriver.ArgsFileDriver{
+		Config:     args.Config,
+		Marshaller: args.Marshaller,
+	})
+}
@@ -0,0 +1,30 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateFileDriver(t *testing.T) {
+	t.Parallel()
+
+	args := ArgsFileDriverFactory{
+		Config: config.FileDriverConfig{
+			Enabled:            true,
+			Path:               t.TempDir(),
+			MarshallerType:     "json",
+			MaxSegmentSizeInMB: 1,
+		},
+		Marshaller: &marshallerMock.MarshalizerStub{},
+	}
+
+	driver, err := CreateFileDriver(args)
+	require.Nil(t, err)
+	require.NotNil(t, driver)
+	require.Equal(t, "*filedriver.fileDriver", fmt.Sprintf("%T", driver))
+	require.Nil(t, driver.Close())
+}
@@ -18,6 +18,7 @@ type OutportFactoryArgs struct {
 	ElasticIndexerFactoryArgs indexerFactory.ArgsIndexerFactory
 	EventNotifierFactoryArgs  *EventNotifierFactoryArgs
 	HostDriversArgs           []ArgsHostDriverFactory
+	FileDriverArgs            ArgsFileDriverFactory
 	ChainHandler              data.ChainHandler
 }
 
@@ -64,7 +65,7 @@ func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFact
 		}
 	}
 
-	return nil
+	return createAndSubscribeFileDriverIfNeeded(outport, args.FileDriverArgs)
 }
 
 func createAndSubscribeElasticDriverIfNeeded(
@@ -123,3 +124,19 @@ func createAndSubscribeHostDriverIfNeeded(
 
 	return outport.SubscribeDriver(hostDriver)
 }
+
+func createAndSubscribeFileDriverIfNeeded(
+	outport outport.OutportHandler,
+	args ArgsFileDriverFactory,
+) error {
+	if !args.Config.Enabled {
+		return nil
+	}
+
+	fileDriver, err := CreateFileDriver(args)
+	if err != nil {
+		return err
+	}
+
+	return outport.SubscribeDriver(fileDriver)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/outport/factory"
 	notifierFactory "github.com/multiversx/mx-chain-go/outport/factory"
+	"github.com/multiversx/mx-chain-go/outport/filedriver"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-storage-go/testscommon"
 	"github.com/stretchr/testify/require"
@@ -188,3 +189,40 @@ func TestCreateAndSubscribeDriversShouldReturnError(t *testing.T) {
 	require.Nil(t, outPort)
 	require.ErrorIs(t, err, data.ErrInvalidWebSocketHostMode)
 }
+
+func TestCreateOutport_SubscribeFileDriver(t *testing.T) {
+	t.Run("invalid file driver config should error", func(t *testing.T) {
+		args := createMockArgsOutportHandler(false, false)
+		args.FileDriverArgs = notifierFactory.ArgsFileDriverFactory{
+			Marshaller: &testscommon.MarshalizerMock{},
+			Config: config.FileDriverConfig{
+				Enabled: true,
+				Path:    t.TempDir(),
+			},
+		}
+
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, outPort)
+		require.Equal(t, filedriver.ErrInvalidMaxSegmentSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		args := createMockArgsOutportHandler(false, false)
+		args.FileDriverArgs = notifierFactory.ArgsFileDriverFactory{
+			Marshaller: &testscommon.MarshalizerMock{},
+			Config: config.FileDriverConfig{
+				Enabled:            true,
+				Path:               t.TempDir(),
+				MaxSegmentSizeInMB: 1,
+			},
+		}
+
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, err)
+
+		defer func() {
+			_ = outPort.Close()
+		}()
+
+		require.True(t, outPort.HasDrivers())
+	})
+}
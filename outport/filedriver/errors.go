@@ -0,0 +1,42 @@
+package filedriver
+
+import "errors"
+
+// ErrDriverIsClosed signals that the file driver was closed while trying to perform actions
+var ErrDriverIsClosed = errors.New("file driver is closed")
+
+// ErrReaderIsClosed signals that the segments reader was closed while trying to perform actions
+var ErrReaderIsClosed = errors.New("segments reader is closed")
+
+// ErrEmptyPath signals that an empty path has been provided
+var ErrEmptyPath = errors.New("empty path")
+
+// ErrEmptyCheckpointFileName signals that an empty checkpoint file name has been provided
+var ErrEmptyCheckpointFileName = errors.New("empty checkpoint file name")
+
+// ErrInvalidMaxSegmentSize signals that an invalid maximum segment size has been provided
+var ErrInvalidMaxSegmentSize = errors.New("invalid maximum segment size")
+
+// ErrInvalidMaxUnacknowledgedRecords signals that an invalid maximum number of unacknowledged records has been provided
+var ErrInvalidMaxUnacknowledgedRecords = errors.New("invalid maximum number of unacknowledged records")
+
+// ErrInvalidPollInterval signals that an invalid poll interval has been provided
+var ErrInvalidPollInterval = errors.New("invalid poll interval")
+
+// ErrReaderIsLagging signals that the segments reader did not acknowledge enough of the written records
+var ErrReaderIsLagging = errors.New("segments reader is lagging behind")
+
+// ErrCorruptedRecord signals that a complete record failed the integrity checks
+var ErrCorruptedRecord = errors.New("corrupted record")
+
+// ErrSegmentNotFound signals that a segment file needed by the reader does not exist anymore
+var ErrSegmentNotFound = errors.New("segment file not found")
+
+// ErrUnknownSequence signals that the sequence to be committed was not delivered by the reader
+var ErrUnknownSequence = errors.New("unknown sequence")
+
+// errIncompleteRecord signals that the record at the read position is not fully written yet
+var errIncompleteRecord = errors.New("incomplete record")
+
+// errNoRecordAvailable signals that there is no new record to be delivered yet
+var errNoRecordAvailable = errors.New("no record available")
@@ -0,0 +1,405 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. fileDriver.proto
+
+package filedriver
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const bytesInMB = 1024 * 1024
+
+var log = logger.GetOrCreate("outport/filedriver")
+
+// ArgsFileDriver holds the arguments needed for creating a new fileDriver
+type ArgsFileDriver struct {
+	Config     config.FileDriverConfig
+	Marshaller marshal.Marshalizer
+}
+
+type fileDriver struct {
+	config           config.FileDriverConfig
+	marshaller       marshal.Marshalizer
+	recordMarshaller marshal.Marshalizer
+	checkpointPath   string
+	maxSegmentSize   int64
+
+	mut          sync.Mutex
+	isClosed     bool
+	segments     []uint64
+	currentFile  *os.File
+	currentSize  int64
+	nextSequence uint64
+}
+
+// NewFileDriver will create a new instance of fileDriver. The last segment file found in the configured path is
+// recovered: a partially written record left by a crash is truncated and the writing resumes after the last valid record
+func NewFileDriver(args ArgsFileDriver) (*fileDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(args.Config.Path, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	fd := &fileDriver{
+		config:           args.Config,
+		marshaller:       args.Marshaller,
+		recordMarshaller: &marshal.GogoProtoMarshalizer{},
+		checkpointPath:   filepath.Join(args.Config.Path, args.Config.CheckpointFileName),
+		maxSegmentSize:   int64(args.Config.MaxSegmentSizeInMB * bytesInMB),
+	}
+
+	err = fd.recoverLastSegment()
+	if err != nil {
+		return nil, err
+	}
+
+	return fd, nil
+}
+
+func checkArgs(args ArgsFileDriver) error {
+	if check.IfNil(args.Marshaller) {
+		return core.ErrNilMarshalizer
+	}
+	if len(args.Config.Path) == 0 {
+		return ErrEmptyPath
+	}
+	if args.Config.MaxSegmentSizeInMB == 0 {
+		return ErrInvalidMaxSegmentSize
+	}
+	if !args.Config.WithAcknowledge {
+		return nil
+	}
+	if len(args.Config.CheckpointFileName) == 0 {
+		return ErrEmptyCheckpointFileName
+	}
+	if args.Config.MaxUnacknowledgedRecords == 0 {
+		return ErrInvalidMaxUnacknowledgedRecords
+	}
+
+	return nil
+}
+
+func (fd *fileDriver) recoverLastSegment() error {
+	segments, err := listSegments(fd.config.Path)
+	if err != nil {
+		return err
+	}
+
+	fd.segments = segments
+	if len(segments) == 0 {
+		return fd.createSegment(1)
+	}
+
+	lastSegment := segments[len(segments)-1]
+	file, err := os.OpenFile(segmentFilePath(fd.config.Path, lastSegment), os.O_RDWR, filesPermissions)
+	if err != nil {
+		return err
+	}
+
+	nextSequence := lastSegment
+	offset := int64(0)
+	for {
+		recordBytes, frameSize, errRead := readFrame(file, offset)
+		if errRead != nil {
+			break
+		}
+
+		record := &Record{}
+		errRead = fd.recordMarshaller.Unmarshal(record, recordBytes)
+		if errRead != nil {
+			break
+		}
+
+		nextSequence = record.Sequence + 1
+		offset += frameSize
+	}
+
+	err = fd.truncateTail(file, offset)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	fd.currentFile = file
+	fd.currentSize = offset
+	fd.nextSequence = nextSequence
+
+	log.Debug("file driver: recovered last segment", "segment", lastSegment, "size", offset, "next sequence", nextSequence)
+
+	return nil
+}
+
+func (fd *fileDriver) truncateTail(file *os.File, validSize int64) error {
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	if info.Size() > validSize {
+		log.Warn("file driver: truncating incomplete or corrupted records",
+			"file", file.Name(), "size", info.Size(), "valid size", validSize)
+
+		err = file.Truncate(validSize)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = file.Seek(validSize, io.SeekStart)
+
+	return err
+}
+
+func (fd *fileDriver) createSegment(firstSequence uint64) error {
+	file, err := os.OpenFile(segmentFilePath(fd.config.Path, firstSequence), os.O_CREATE|os.O_RDWR|os.O_TRUNC, filesPermissions)
+	if err != nil {
+		return err
+	}
+
+	fd.currentFile = file
+	fd.currentSize = 0
+	fd.nextSequence = firstSequence
+	if len(fd.segments) == 0 || fd.segments[len(fd.segments)-1] != firstSequence {
+		fd.segments = append(fd.segments, firstSequence)
+	}
+
+	return nil
+}
+
+// SaveBlock will handle the saving of block
+func (fd *fileDriver) SaveBlock(outportBlock *outport.OutportBlock) error {
+	return fd.handleAction(outportBlock, outport.TopicSaveBlock)
+}
+
+// RevertIndexedBlock will handle the action of reverting the indexed block
+func (fd *fileDriver) RevertIndexedBlock(blockData *outport.BlockData) error {
+	return fd.handleAction(blockData, outport.TopicRevertIndexedBlock)
+}
+
+// SaveRoundsInfo will handle the saving of rounds
+func (fd *fileDriver) SaveRoundsInfo(roundsInfos *outport.RoundsInfo) error {
+	return fd.handleAction(roundsInfos, outport.TopicSaveRoundsInfo)
+}
+
+// SaveValidatorsPubKeys will handle the saving of the validators' public keys
+func (fd *fileDriver) SaveValidatorsPubKeys(validatorsPubKeys *outport.ValidatorsPubKeys) error {
+	return fd.handleAction(validatorsPubKeys, outport.TopicSaveValidatorsPubKeys)
+}
+
+// SaveValidatorsRating will handle the saving of the validators' rating
+func (fd *fileDriver) SaveValidatorsRating(validatorsRating *outport.ValidatorsRating) error {
+	return fd.handleAction(validatorsRating, outport.TopicSaveValidatorsRating)
+}
+
+// SaveAccounts will handle the accounts' saving
+func (fd *fileDriver) SaveAccounts(accounts *outport.Accounts) error {
+	return fd.handleAction(accounts, outport.TopicSaveAccounts)
+}
+
+// FinalizedBlock will handle the finalized block
+func (fd *fileDriver) FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error {
+	return fd.handleAction(finalizedBlock, outport.TopicFinalizedBlock)
+}
+
+// NewTransactionInPool does nothing, as the pool transactions are not persisted
+func (fd *fileDriver) NewTransactionInPool(_ interface{}) error {
+	return nil
+}
+
+// SetCurrentSettings will append the current settings
+func (fd *fileDriver) SetCurrentSettings(config outport.OutportConfig) error {
+	return fd.handleAction(&config, outport.TopicSettings)
+}
+
+// RegisterHandler does nothing, as the acknowledgements are read from the reader's checkpoint file
+func (fd *fileDriver) RegisterHandler(_ func() error, _ string) error {
+	return nil
+}
+
+// GetMarshaller returns the internal marshaller
+func (fd *fileDriver) GetMarshaller() marshal.Marshalizer {
+	return fd.marshaller
+}
+
+func (fd *fileDriver) handleAction(args interface{}, topic string) error {
+	marshalledPayload, err := fd.marshaller.Marshal(args)
+	if err != nil {
+		return fmt.Errorf("%w while marshaling payload for topic %s", err, topic)
+	}
+
+	fd.mut.Lock()
+	defer fd.mut.Unlock()
+
+	if fd.isClosed {
+		return ErrDriverIsClosed
+	}
+
+	err = fd.checkReaderProgress()
+	if err != nil {
+		return err
+	}
+
+	err = fd.appendRecord(topic, marshalledPayload)
+	if err != nil {
+		return fmt.Errorf("%w while appending record for topic %s", err, topic)
+	}
+
+	return nil
+}
+
+// checkReaderProgress returns an error if the reader lags behind with too many records. The error makes the outport
+// retry the call, so the node blocks until the reader catches up
+func (fd *fileDriver) checkReaderProgress() error {
+	if !fd.config.WithAcknowledge {
+		return nil
+	}
+
+	checkpoint, err := readCheckpoint(fd.checkpointPath, fd.recordMarshaller)
+	if err != nil {
+		return err
+	}
+
+	lastWritten := fd.nextSequence - 1
+	if checkpoint.Sequence >= lastWritten {
+		return nil
+	}
+	if lastWritten-checkpoint.Sequence >= fd.config.MaxUnacknowledgedRecords {
+		return fmt.Errorf("%w: last written record %d, last acknowledged record %d",
+			ErrReaderIsLagging, lastWritten, checkpoint.Sequence)
+	}
+
+	return nil
+}
+
+func (fd *fileDriver) appendRecord(topic string, payload []byte) error {
+	record := &Record{
+		Sequence: fd.nextSequence,
+		Topic:    topic,
+		Payload:  payload,
+	}
+	recordBytes, err := fd.recordMarshaller.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	frame := encodeFrame(recordBytes)
+	err = fd.rotateIfNeeded(int64(len(frame)))
+	if err != nil {
+		return err
+	}
+
+	_, err = fd.currentFile.Write(frame)
+	if err == nil && fd.config.SyncOnWrite {
+		err = fd.currentFile.Sync()
+	}
+	if err != nil {
+		errTruncate := fd.truncateTail(fd.currentFile, fd.currentSize)
+		if errTruncate != nil {
+			log.Error("file driver: cannot truncate the partially written record",
+				"file", fd.currentFile.Name(), "error", errTruncate.Error())
+		}
+
+		return err
+	}
+
+	fd.currentSize += int64(len(frame))
+	fd.nextSequence++
+
+	return nil
+}
+
+func (fd *fileDriver) rotateIfNeeded(frameSize int64) error {
+	if fd.currentSize == 0 || fd.currentSize+frameSize <= fd.maxSegmentSize {
+		return nil
+	}
+
+	err := fd.currentFile.Sync()
+	if err != nil {
+		return err
+	}
+	err = fd.currentFile.Close()
+	if err != nil {
+		return err
+	}
+
+	err = fd.createSegment(fd.nextSequence)
+	if err != nil {
+		return err
+	}
+
+	fd.removeOldSegments()
+
+	return nil
+}
+
+// removeOldSegments keeps at most MaxSegmentFiles segment files, never removing the ones holding unacknowledged records
+func (fd *fileDriver) removeOldSegments() {
+	maxSegmentFiles := fd.config.MaxSegmentFiles
+	if maxSegmentFiles <= 0 || len(fd.segments) <= maxSegmentFiles {
+		return
+	}
+
+	lastAcknowledged := uint64(0)
+	if fd.config.WithAcknowledge {
+		checkpoint, err := readCheckpoint(fd.checkpointPath, fd.recordMarshaller)
+		if err != nil {
+			log.Warn("file driver: cannot read checkpoint, old segments are kept", "error", err.Error())
+			return
+		}
+		lastAcknowledged = checkpoint.Sequence
+	}
+
+	for len(fd.segments) > maxSegmentFiles {
+		lastSequenceInSegment := fd.segments[1] - 1
+		if fd.config.WithAcknowledge && lastSequenceInSegment > lastAcknowledged {
+			return
+		}
+
+		err := os.Remove(segmentFilePath(fd.config.Path, fd.segments[0]))
+		if err != nil {
+			log.Warn("file driver: cannot remove old segment", "segment", fd.segments[0], "error", err.Error())
+			return
+		}
+
+		fd.segments = fd.segments[1:]
+	}
+}
+
+// Close will sync and close the current segment file
+func (fd *fileDriver) Close() error {
+	fd.mut.Lock()
+	defer fd.mut.Unlock()
+
+	if fd.isClosed {
+		return nil
+	}
+	fd.isClosed = true
+
+	err := fd.currentFile.Sync()
+	errClose := fd.currentFile.Close()
+	if err != nil {
+		return err
+	}
+
+	return errClose
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fd *fileDriver) IsInterfaceNil() bool {
+	return fd == nil
+}
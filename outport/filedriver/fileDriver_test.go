@@ -0,0 +1,324 @@
+package filedriver
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsFileDriver(path string) ArgsFileDriver {
+	return ArgsFileDriver{
+		Config: config.FileDriverConfig{
+			Enabled:                  true,
+			SyncOnWrite:              true,
+			Path:                     path,
+			MarshallerType:           "gogo protobuf",
+			CheckpointFileName:       "reader.checkpoint",
+			MaxSegmentSizeInMB:       1,
+			MaxUnacknowledgedRecords: 3,
+		},
+		Marshaller: &marshal.GogoProtoMarshalizer{},
+	}
+}
+
+func TestNewFileDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t.TempDir())
+		args.Marshaller = nil
+		fd, err := NewFileDriver(args)
+		require.Nil(t, fd)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("empty path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver("")
+		fd, err := NewFileDriver(args)
+		require.Nil(t, fd)
+		require.Equal(t, ErrEmptyPath, err)
+	})
+	t.Run("invalid max segment size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t.TempDir())
+		args.Config.MaxSegmentSizeInMB = 0
+		fd, err := NewFileDriver(args)
+		require.Nil(t, fd)
+		require.Equal(t, ErrInvalidMaxSegmentSize, err)
+	})
+	t.Run("empty checkpoint file name with acknowledge should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t.TempDir())
+		args.Config.WithAcknowledge = true
+		args.Config.CheckpointFileName = ""
+		fd, err := NewFileDriver(args)
+		require.Nil(t, fd)
+		require.Equal(t, ErrEmptyCheckpointFileName, err)
+	})
+	t.Run("invalid max unacknowledged records should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t.TempDir())
+		args.Config.WithAcknowledge = true
+		args.Config.MaxUnacknowledgedRecords = 0
+		fd, err := NewFileDriver(args)
+		require.Nil(t, fd)
+		require.Equal(t, ErrInvalidMaxUnacknowledgedRecords, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		path := t.TempDir()
+		fd, err := NewFileDriver(createMockArgsFileDriver(path))
+		require.Nil(t, err)
+		require.False(t, fd.IsInterfaceNil())
+
+		segments, _ := listSegments(path)
+		require.Equal(t, []uint64{1}, segments)
+		require.Nil(t, fd.Close())
+	})
+}
+
+func TestFileDriver_ActionsShouldAppendRecords(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	args := createMockArgsFileDriver(path)
+	fd, _ := NewFileDriver(args)
+
+	require.Nil(t, fd.SaveBlock(&outport.OutportBlock{ShardID: 1}))
+	require.Nil(t, fd.RevertIndexedBlock(&outport.BlockData{ShardID: 1}))
+	require.Nil(t, fd.SaveRoundsInfo(&outport.RoundsInfo{ShardID: 1}))
+	require.Nil(t, fd.SaveValidatorsPubKeys(&outport.ValidatorsPubKeys{ShardID: 1}))
+	require.Nil(t, fd.SaveValidatorsRating(&outport.ValidatorsRating{ShardID: 1}))
+	require.Nil(t, fd.SaveAccounts(&outport.Accounts{ShardID: 1}))
+	require.Nil(t, fd.FinalizedBlock(&outport.FinalizedBlock{ShardID: 1}))
+	require.Nil(t, fd.SetCurrentSettings(outport.OutportConfig{IsInImportDBMode: true}))
+	require.Nil(t, fd.NewTransactionInPool(nil))
+	require.Nil(t, fd.RegisterHandler(nil, ""))
+	require.Nil(t, fd.Close())
+
+	records := readAllRecords(t, path)
+	expectedTopics := []string{
+		outport.TopicSaveBlock,
+		outport.TopicRevertIndexedBlock,
+		outport.TopicSaveRoundsInfo,
+		outport.TopicSaveValidatorsPubKeys,
+		outport.TopicSaveValidatorsRating,
+		outport.TopicSaveAccounts,
+		outport.TopicFinalizedBlock,
+		outport.TopicSettings,
+	}
+	require.Len(t, records, len(expectedTopics))
+	for index, record := range records {
+		require.Equal(t, uint64(index+1), record.Sequence)
+		require.Equal(t, expectedTopics[index], record.Topic)
+	}
+
+	outportBlock := &outport.OutportBlock{}
+	require.Nil(t, args.Marshaller.Unmarshal(outportBlock, records[0].Payload))
+	require.Equal(t, uint32(1), outportBlock.ShardID)
+}
+
+func TestFileDriver_MarshalErrorShouldNotAppend(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	args := createMockArgsFileDriver(path)
+	args.Marshaller = &marshallerMock.MarshalizerStub{
+		MarshalCalled: func(obj interface{}) ([]byte, error) {
+			return nil, errors.New("marshal error")
+		},
+	}
+	fd, _ := NewFileDriver(args)
+
+	err := fd.SaveBlock(&outport.OutportBlock{})
+	require.ErrorContains(t, err, "marshal error")
+	require.Nil(t, fd.Close())
+	require.Empty(t, readAllRecords(t, path))
+}
+
+func TestFileDriver_ClosedDriverShouldError(t *testing.T) {
+	t.Parallel()
+
+	fd, _ := NewFileDriver(createMockArgsFileDriver(t.TempDir()))
+	require.Nil(t, fd.Close())
+	require.Nil(t, fd.Close())
+
+	err := fd.SaveBlock(&outport.OutportBlock{})
+	require.Equal(t, ErrDriverIsClosed, err)
+}
+
+func TestFileDriver_ShouldRotateSegments(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	fd, _ := NewFileDriver(createMockArgsFileDriver(path))
+
+	for i := 0; i < 5; i++ {
+		require.Nil(t, fd.appendRecordForTest(make([]byte, bytesInMB/2)))
+	}
+	require.Nil(t, fd.Close())
+
+	segments, _ := listSegments(path)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, segments)
+
+	records := readAllRecords(t, path)
+	require.Len(t, records, 5)
+	for index, record := range records {
+		require.Equal(t, uint64(index+1), record.Sequence)
+	}
+}
+
+func TestFileDriver_ShouldRemoveOldSegments(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without acknowledge", func(t *testing.T) {
+		t.Parallel()
+
+		path := t.TempDir()
+		args := createMockArgsFileDriver(path)
+		args.Config.MaxSegmentFiles = 2
+		fd, _ := NewFileDriver(args)
+
+		for i := 0; i < 6; i++ {
+			require.Nil(t, fd.appendRecordForTest(make([]byte, bytesInMB*3/4)))
+		}
+		require.Nil(t, fd.Close())
+
+		segments, _ := listSegments(path)
+		require.Equal(t, []uint64{5, 6}, segments)
+	})
+	t.Run("with acknowledge should keep unacknowledged segments", func(t *testing.T) {
+		t.Parallel()
+
+		path := t.TempDir()
+		args := createMockArgsFileDriver(path)
+		args.Config.MaxSegmentFiles = 1
+		args.Config.WithAcknowledge = true
+		args.Config.MaxUnacknowledgedRecords = 100
+		fd, _ := NewFileDriver(args)
+
+		require.Nil(t, writeCheckpoint(fd.checkpointPath, &Checkpoint{Sequence: 2}, fd.recordMarshaller))
+		for i := 0; i < 5; i++ {
+			require.Nil(t, fd.appendRecordForTest(make([]byte, bytesInMB*3/4)))
+		}
+		require.Nil(t, fd.Close())
+
+		segments, _ := listSegments(path)
+		require.Equal(t, []uint64{3, 4, 5}, segments)
+	})
+}
+
+func TestFileDriver_ShouldBlockWhenReaderIsLagging(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	args := createMockArgsFileDriver(path)
+	args.Config.WithAcknowledge = true
+	fd, _ := NewFileDriver(args)
+
+	for i := 0; i < 3; i++ {
+		require.Nil(t, fd.SaveBlock(&outport.OutportBlock{}))
+	}
+
+	err := fd.SaveBlock(&outport.OutportBlock{})
+	require.True(t, errors.Is(err, ErrReaderIsLagging))
+
+	require.Nil(t, writeCheckpoint(fd.checkpointPath, &Checkpoint{Sequence: 1}, fd.recordMarshaller))
+	require.Nil(t, fd.SaveBlock(&outport.OutportBlock{}))
+	require.Nil(t, fd.Close())
+
+	require.Len(t, readAllRecords(t, path), 4)
+}
+
+func TestFileDriver_ShouldRecoverAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should continue the sequence", func(t *testing.T) {
+		t.Parallel()
+
+		path := t.TempDir()
+		fd, _ := NewFileDriver(createMockArgsFileDriver(path))
+		require.Nil(t, fd.SaveBlock(&outport.OutportBlock{}))
+		require.Nil(t, fd.SaveBlock(&outport.OutportBlock{}))
+		require.Nil(t, fd.Close())
+
+		fd, _ = NewFileDriver(createMockArgsFileDriver(path))
+		require.Nil(t, fd.SaveBlock(&outport.OutportBlock{}))
+		require.Nil(t, fd.Close())
+
+		records := readAllRecords(t, path)
+		require.Len(t, records, 3)
+		require.Equal(t, uint64(3), records[2].Sequence)
+	})
+	t.Run("should truncate a partially written record", func(t *testing.T) {
+		t.Parallel()
+
+		path := t.TempDir()
+		fd, _ := NewFileDriver(createMockArgsFileDriver(path))
+		require.Nil(t, fd.SaveBlock(&outport.OutportBlock{}))
+		require.Nil(t, fd.Close())
+
+		file, _ := os.OpenFile(segmentFilePath(path, 1), os.O_APPEND|os.O_WRONLY, filesPermissions)
+		_, _ = file.Write([]byte{0, 0, 0, 100, 1, 2})
+		_ = file.Close()
+
+		fd, err := NewFileDriver(createMockArgsFileDriver(path))
+		require.Nil(t, err)
+		require.Nil(t, fd.SaveBlock(&outport.OutportBlock{}))
+		require.Nil(t, fd.Close())
+
+		records := readAllRecords(t, path)
+		require.Len(t, records, 2)
+		require.Equal(t, uint64(2), records[1].Sequence)
+	})
+}
+
+func (fd *fileDriver) appendRecordForTest(payload []byte) error {
+	fd.mut.Lock()
+	defer fd.mut.Unlock()
+
+	return fd.appendRecord(outport.TopicSaveBlock, payload)
+}
+
+func readAllRecords(t *testing.T, path string) []*Record {
+	segments, err := listSegments(path)
+	require.Nil(t, err)
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	records := make([]*Record, 0)
+	for _, segment := range segments {
+		file, errOpen := os.Open(segmentFilePath(path, segment))
+		require.Nil(t, errOpen)
+
+		offset := int64(0)
+		for {
+			recordBytes, frameSize, errRead := readFrame(file, offset)
+			if errors.Is(errRead, errIncompleteRecord) {
+				break
+			}
+			require.Nil(t, errRead)
+
+			record := &Record{}
+			require.Nil(t, marshaller.Unmarshal(record, recordBytes))
+			records = append(records, record)
+			offset += frameSize
+		}
+		_ = file.Close()
+	}
+
+	return records
+}
@@ -0,0 +1,155 @@
+package filedriver
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+const (
+	segmentFileExtension = ".segment"
+	segmentFileFormat    = "%020d" + segmentFileExtension
+	frameHeaderSize      = 8
+	maxRecordSize        = 1 << 30
+	filesPermissions     = 0644
+	tempFileSuffix       = ".tmp"
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// segmentFilePath returns the path of the segment file starting with the provided sequence
+func segmentFilePath(directory string, firstSequence uint64) string {
+	return filepath.Join(directory, fmt.Sprintf(segmentFileFormat, firstSequence))
+}
+
+// listSegments returns the first sequences of the segment files found in the provided directory, in ascending order
+func listSegments(directory string) ([]uint64, error) {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentFileExtension) {
+			continue
+		}
+
+		firstSequence, errParse := strconv.ParseUint(strings.TrimSuffix(name, segmentFileExtension), 10, 64)
+		if errParse != nil {
+			continue
+		}
+
+		segments = append(segments, firstSequence)
+	}
+
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i] < segments[j]
+	})
+
+	return segments, nil
+}
+
+// encodeFrame prefixes the record bytes with their length and their crc32 (Castagnoli) checksum
+func encodeFrame(recordBytes []byte) []byte {
+	frame := make([]byte, frameHeaderSize+len(recordBytes))
+	binary.BigEndian.PutUint32(frame[0:4], uint32(len(recordBytes)))
+	binary.BigEndian.PutUint32(frame[4:8], crc32.Checksum(recordBytes, crcTable))
+	copy(frame[frameHeaderSize:], recordBytes)
+
+	return frame
+}
+
+// readFrame reads the frame starting at the provided offset, returning the record bytes and the frame size.
+// errIncompleteRecord is returned if the frame is not fully written
+func readFrame(file *os.File, offset int64) ([]byte, int64, error) {
+	header := make([]byte, frameHeaderSize)
+	err := readFull(file, header, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	length := binary.BigEndian.Uint32(header[0:4])
+	checksum := binary.BigEndian.Uint32(header[4:8])
+	if length > maxRecordSize {
+		return nil, 0, fmt.Errorf("%w: record length %d at offset %d", ErrCorruptedRecord, length, offset)
+	}
+
+	recordBytes := make([]byte, length)
+	err = readFull(file, recordBytes, offset+frameHeaderSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if crc32.Checksum(recordBytes, crcTable) != checksum {
+		return nil, 0, fmt.Errorf("%w: checksum mismatch at offset %d", ErrCorruptedRecord, offset)
+	}
+
+	return recordBytes, frameHeaderSize + int64(length), nil
+}
+
+func readFull(file *os.File, buff []byte, offset int64) error {
+	_, err := file.ReadAt(buff, offset)
+	if errors.Is(err, io.EOF) {
+		return errIncompleteRecord
+	}
+
+	return err
+}
+
+// readCheckpoint loads the checkpoint file, returning an empty checkpoint if the file does not exist
+func readCheckpoint(checkpointPath string, marshaller marshal.Marshalizer) (*Checkpoint, error) {
+	buff, err := os.ReadFile(checkpointPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Checkpoint{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := &Checkpoint{}
+	err = marshaller.Unmarshal(checkpoint, buff)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading checkpoint file %s", err, checkpointPath)
+	}
+
+	return checkpoint, nil
+}
+
+// writeCheckpoint atomically replaces the checkpoint file
+func writeCheckpoint(checkpointPath string, checkpoint *Checkpoint, marshaller marshal.Marshalizer) error {
+	buff, err := marshaller.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	tempPath := checkpointPath + tempFileSuffix
+	file, err := os.OpenFile(tempPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, filesPermissions)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(buff)
+	if err == nil {
+		err = file.Sync()
+	}
+	errClose := file.Close()
+	if err != nil {
+		return err
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	return os.Rename(tempPath, checkpointPath)
+}
@@ -0,0 +1,263 @@
+package filedriver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+// ArgsSegmentsReader holds the arguments needed for creating a new segmentsReader
+type ArgsSegmentsReader struct {
+	Path               string
+	CheckpointFileName string
+	PollInterval       time.Duration
+}
+
+type deliveredRecord struct {
+	sequence  uint64
+	segment   uint64
+	endOffset int64
+}
+
+type segmentsReader struct {
+	path           string
+	checkpointPath string
+	pollInterval   time.Duration
+	marshaller     marshal.Marshalizer
+	chanClose      chan struct{}
+	closeOnce      sync.Once
+
+	mut            sync.Mutex
+	currentSegment uint64
+	currentFile    *os.File
+	offset         int64
+	delivered      []deliveredRecord
+}
+
+// NewSegmentsReader will create a reader that tails the segment files written by the file driver. The reading
+// starts right after the record stored in the checkpoint file, so every record delivered but not committed before
+// a restart is delivered again (at-least-once semantics)
+func NewSegmentsReader(args ArgsSegmentsReader) (*segmentsReader, error) {
+	if len(args.Path) == 0 {
+		return nil, ErrEmptyPath
+	}
+	if len(args.CheckpointFileName) == 0 {
+		return nil, ErrEmptyCheckpointFileName
+	}
+	if args.PollInterval <= 0 {
+		return nil, ErrInvalidPollInterval
+	}
+
+	sr := &segmentsReader{
+		path:           args.Path,
+		checkpointPath: filepath.Join(args.Path, args.CheckpointFileName),
+		pollInterval:   args.PollInterval,
+		marshaller:     &marshal.GogoProtoMarshalizer{},
+		chanClose:      make(chan struct{}),
+	}
+
+	checkpoint, err := readCheckpoint(sr.checkpointPath, sr.marshaller)
+	if err != nil {
+		return nil, err
+	}
+
+	sr.currentSegment = checkpoint.SegmentFirstSequence
+	sr.offset = checkpoint.Offset
+
+	return sr, nil
+}
+
+// Next returns the next record, waiting for it to be written if needed. It returns an error when the provided
+// context is done, when the reader is closed or when the segment files can not be read
+func (sr *segmentsReader) Next(ctx context.Context) (*Record, error) {
+	for {
+		record, err := sr.tryRead()
+		if err == nil {
+			return record, nil
+		}
+		if !errors.Is(err, errNoRecordAvailable) {
+			return nil, err
+		}
+
+		timer := time.NewTimer(sr.pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-sr.chanClose:
+			timer.Stop()
+			return nil, ErrReaderIsClosed
+		case <-timer.C:
+		}
+	}
+}
+
+func (sr *segmentsReader) tryRead() (*Record, error) {
+	sr.mut.Lock()
+	defer sr.mut.Unlock()
+
+	select {
+	case <-sr.chanClose:
+		return nil, ErrReaderIsClosed
+	default:
+	}
+
+	for {
+		if sr.currentFile == nil {
+			err := sr.openCurrentSegment()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		recordBytes, frameSize, err := readFrame(sr.currentFile, sr.offset)
+		if errors.Is(err, errIncompleteRecord) {
+			err = sr.moveToNextSegment()
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w in segment %d", err, sr.currentSegment)
+		}
+
+		record := &Record{}
+		err = sr.marshaller.Unmarshal(record, recordBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s at offset %d in segment %d", ErrCorruptedRecord, err.Error(), sr.offset, sr.currentSegment)
+		}
+
+		sr.offset += frameSize
+		sr.delivered = append(sr.delivered, deliveredRecord{
+			sequence:  record.Sequence,
+			segment:   sr.currentSegment,
+			endOffset: sr.offset,
+		})
+
+		return record, nil
+	}
+}
+
+// openCurrentSegment opens the segment to be read. Without a checkpoint, the oldest segment on disk is used
+func (sr *segmentsReader) openCurrentSegment() error {
+	if sr.currentSegment == 0 {
+		segments, err := listSegments(sr.path)
+		if errors.Is(err, os.ErrNotExist) || (err == nil && len(segments) == 0) {
+			return errNoRecordAvailable
+		}
+		if err != nil {
+			return err
+		}
+
+		sr.currentSegment = segments[0]
+		sr.offset = 0
+	}
+
+	file, err := os.Open(segmentFilePath(sr.path, sr.currentSegment))
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w: segment %d", ErrSegmentNotFound, sr.currentSegment)
+	}
+	if err != nil {
+		return err
+	}
+
+	sr.currentFile = file
+
+	return nil
+}
+
+// moveToNextSegment switches to the following segment if the current one was completely read and a newer one
+// exists, otherwise errNoRecordAvailable is returned
+func (sr *segmentsReader) moveToNextSegment() error {
+	segments, err := listSegments(sr.path)
+	if err != nil {
+		return err
+	}
+
+	nextSegment := uint64(0)
+	for _, segment := range segments {
+		if segment > sr.currentSegment {
+			nextSegment = segment
+			break
+		}
+	}
+	if nextSegment == 0 {
+		return errNoRecordAvailable
+	}
+
+	// the writer never appends to a segment once a newer one exists, so the current segment must end at the read offset
+	info, err := sr.currentFile.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() != sr.offset {
+		return fmt.Errorf("%w: segment %d has %d trailing bytes", ErrCorruptedRecord, sr.currentSegment, info.Size()-sr.offset)
+	}
+
+	_ = sr.currentFile.Close()
+	sr.currentFile = nil
+	sr.currentSegment = nextSegment
+	sr.offset = 0
+
+	return nil
+}
+
+// Commit acknowledges all the delivered records up to and including the provided sequence, by atomically
+// replacing the checkpoint file
+func (sr *segmentsReader) Commit(sequence uint64) error {
+	sr.mut.Lock()
+	defer sr.mut.Unlock()
+
+	for index, record := range sr.delivered {
+		if record.sequence != sequence {
+			continue
+		}
+
+		checkpoint := &Checkpoint{
+			Sequence:             record.sequence,
+			SegmentFirstSequence: record.segment,
+			Offset:               record.endOffset,
+		}
+		err := writeCheckpoint(sr.checkpointPath, checkpoint, sr.marshaller)
+		if err != nil {
+			return err
+		}
+
+		sr.delivered = sr.delivered[index+1:]
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: %d", ErrUnknownSequence, sequence)
+}
+
+// Close will stop any pending Next call and close the current segment file
+func (sr *segmentsReader) Close() error {
+	sr.closeOnce.Do(func() {
+		close(sr.chanClose)
+	})
+
+	sr.mut.Lock()
+	defer sr.mut.Unlock()
+
+	if sr.currentFile == nil {
+		return nil
+	}
+
+	err := sr.currentFile.Close()
+	sr.currentFile = nil
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sr *segmentsReader) IsInterfaceNil() bool {
+	return sr == nil
+}
@@ -0,0 +1,217 @@
+package filedriver
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSegmentsReader(path string) ArgsSegmentsReader {
+	return ArgsSegmentsReader{
+		Path:               path,
+		CheckpointFileName: "reader.checkpoint",
+		PollInterval:       time.Millisecond * 10,
+	}
+}
+
+func TestNewSegmentsReader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty path should error", func(t *testing.T) {
+		t.Parallel()
+
+		sr, err := NewSegmentsReader(createMockArgsSegmentsReader(""))
+		require.Nil(t, sr)
+		require.Equal(t, ErrEmptyPath, err)
+	})
+	t.Run("empty checkpoint file name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSegmentsReader(t.TempDir())
+		args.CheckpointFileName = ""
+		sr, err := NewSegmentsReader(args)
+		require.Nil(t, sr)
+		require.Equal(t, ErrEmptyCheckpointFileName, err)
+	})
+	t.Run("invalid poll interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSegmentsReader(t.TempDir())
+		args.PollInterval = 0
+		sr, err := NewSegmentsReader(args)
+		require.Nil(t, sr)
+		require.Equal(t, ErrInvalidPollInterval, err)
+	})
+	t.Run("invalid checkpoint file should error", func(t *testing.T) {
+		t.Parallel()
+
+		path := t.TempDir()
+		args := createMockArgsSegmentsReader(path)
+		require.Nil(t, os.WriteFile(path+"/"+args.CheckpointFileName, []byte("invalid"), filesPermissions))
+		sr, err := NewSegmentsReader(args)
+		require.Nil(t, sr)
+		require.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sr, err := NewSegmentsReader(createMockArgsSegmentsReader(t.TempDir()))
+		require.Nil(t, err)
+		require.False(t, sr.IsInterfaceNil())
+		require.Nil(t, sr.Close())
+	})
+}
+
+func TestSegmentsReader_ShouldReadAcrossSegments(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	fd, _ := NewFileDriver(createMockArgsFileDriver(path))
+	for i := 0; i < 4; i++ {
+		require.Nil(t, fd.appendRecordForTest(make([]byte, bytesInMB/2)))
+	}
+
+	sr, _ := NewSegmentsReader(createMockArgsSegmentsReader(path))
+	for i := 0; i < 4; i++ {
+		record, err := sr.Next(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, uint64(i+1), record.Sequence)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	record, err := sr.Next(ctx)
+	require.Nil(t, record)
+	require.Equal(t, context.DeadlineExceeded, err)
+
+	require.Nil(t, fd.Close())
+	require.Nil(t, sr.Close())
+}
+
+func TestSegmentsReader_ShouldTailNewRecords(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	sr, _ := NewSegmentsReader(createMockArgsSegmentsReader(path))
+
+	chanRecord := make(chan *Record, 1)
+	go func() {
+		record, _ := sr.Next(context.Background())
+		chanRecord <- record
+	}()
+
+	time.Sleep(time.Millisecond * 30)
+	fd, _ := NewFileDriver(createMockArgsFileDriver(path))
+	require.Nil(t, fd.SaveBlock(&outport.OutportBlock{}))
+
+	select {
+	case record := <-chanRecord:
+		require.Equal(t, uint64(1), record.Sequence)
+		require.Equal(t, outport.TopicSaveBlock, record.Topic)
+	case <-time.After(time.Second):
+		require.Fail(t, "timeout waiting for the record")
+	}
+
+	require.Nil(t, fd.Close())
+	require.Nil(t, sr.Close())
+}
+
+func TestSegmentsReader_CloseShouldStopNext(t *testing.T) {
+	t.Parallel()
+
+	sr, _ := NewSegmentsReader(createMockArgsSegmentsReader(t.TempDir()))
+
+	chanErr := make(chan error, 1)
+	go func() {
+		_, err := sr.Next(context.Background())
+		chanErr <- err
+	}()
+
+	time.Sleep(time.Millisecond * 30)
+	require.Nil(t, sr.Close())
+
+	select {
+	case err := <-chanErr:
+		require.Equal(t, ErrReaderIsClosed, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "timeout waiting for Next to return")
+	}
+}
+
+func TestSegmentsReader_ShouldResumeFromCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	fd, _ := NewFileDriver(createMockArgsFileDriver(path))
+	for i := 0; i < 3; i++ {
+		require.Nil(t, fd.appendRecordForTest(make([]byte, bytesInMB/2)))
+	}
+	require.Nil(t, fd.Close())
+
+	sr, _ := NewSegmentsReader(createMockArgsSegmentsReader(path))
+	for i := 0; i < 3; i++ {
+		_, err := sr.Next(context.Background())
+		require.Nil(t, err)
+	}
+
+	err := sr.Commit(5)
+	require.True(t, errors.Is(err, ErrUnknownSequence))
+	require.Nil(t, sr.Commit(2))
+	require.Nil(t, sr.Close())
+
+	// record 3 was delivered but not committed, so it must be delivered again
+	sr, _ = NewSegmentsReader(createMockArgsSegmentsReader(path))
+	record, err := sr.Next(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), record.Sequence)
+	require.Nil(t, sr.Close())
+}
+
+func TestSegmentsReader_RemovedSegmentShouldError(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	fd, _ := NewFileDriver(createMockArgsFileDriver(path))
+	for i := 0; i < 3; i++ {
+		require.Nil(t, fd.appendRecordForTest(make([]byte, bytesInMB/2)))
+	}
+	require.Nil(t, fd.Close())
+
+	sr, _ := NewSegmentsReader(createMockArgsSegmentsReader(path))
+	_, _ = sr.Next(context.Background())
+	require.Nil(t, sr.Commit(1))
+	require.Nil(t, sr.Close())
+
+	require.Nil(t, os.Remove(segmentFilePath(path, 1)))
+
+	sr, _ = NewSegmentsReader(createMockArgsSegmentsReader(path))
+	record, err := sr.Next(context.Background())
+	require.Nil(t, record)
+	require.True(t, errors.Is(err, ErrSegmentNotFound))
+	require.Nil(t, sr.Close())
+}
+
+func TestSegmentsReader_CorruptedRecordShouldError(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	fd, _ := NewFileDriver(createMockArgsFileDriver(path))
+	require.Nil(t, fd.SaveBlock(&outport.OutportBlock{ShardID: 1}))
+	require.Nil(t, fd.Close())
+
+	segmentPath := segmentFilePath(path, 1)
+	buff, _ := os.ReadFile(segmentPath)
+	buff[len(buff)-1]++
+	require.Nil(t, os.WriteFile(segmentPath, buff, filesPermissions))
+
+	sr, _ := NewSegmentsReader(createMockArgsSegmentsReader(path))
+	record, err := sr.Next(context.Background())
+	require.Nil(t, record)
+	require.True(t, errors.Is(err, ErrCorruptedRecord))
+	require.Nil(t, sr.Close())
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts"
 	"github.com/multiversx/mx-chain-go/outport/process/disabled"
 	"github.com/multiversx/mx-chain-go/outport/process/transactionsfee"
-	processTxs "github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
@@ -29,7 +28,7 @@ type ArgOutportDataProviderFactory struct {
 	EsdtDataStorageHandler vmcommon.ESDTNFTStorageHandler
 	TransactionsStorer     storage.Storer
 	ShardCoordinator       sharding.Coordinator
-	TxCoordinator          processTxs.TransactionCoordinator
+	TxCoordinator          process.TransactionsProvider
 	NodesCoordinator       nodesCoordinator.NodesCoordinator
 	GasConsumedProvider    process.GasConsumedProvider
 	EconomicsData          process.EconomicsDataHandler
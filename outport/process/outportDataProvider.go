@@ -18,7 +18,6 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts/shared"
-	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -32,7 +31,7 @@ type ArgOutportDataProvider struct {
 	ShardCoordinator         sharding.Coordinator
 	AlteredAccountsProvider  AlteredAccountsProviderHandler
 	TransactionsFeeProcessor TransactionsFeeHandler
-	TxCoordinator            process.TransactionCoordinator
+	TxCoordinator            TransactionsProvider
 	NodesCoordinator         nodesCoordinator.NodesCoordinator
 	GasConsumedProvider      GasConsumedProvider
 	EconomicsData            EconomicsDataHandler
@@ -60,7 +59,7 @@ type outportDataProvider struct {
 	numOfShards              uint32
 	alteredAccountsProvider  AlteredAccountsProviderHandler
 	transactionsFeeProcessor TransactionsFeeHandler
-	txCoordinator            process.TransactionCoordinator
+	txCoordinator            TransactionsProvider
 	nodesCoordinator         nodesCoordinator.NodesCoordinator
 	gasConsumedProvider      GasConsumedProvider
 	economicsData            EconomicsDataHandler
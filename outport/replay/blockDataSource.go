@@ -0,0 +1,97 @@
+package replay
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/ordering"
+)
+
+// blockDataSource feeds the outport data provider with the data of a block loaded from storage, replacing the
+// transactions coordinator and the gas handler used during live processing.
+// The gas refunded and penalized values are not persisted, so they are reported as 0, while the gas provided is
+// computed from the gas limits of the executed transactions
+type blockDataSource struct {
+	mut            sync.RWMutex
+	loadedBlock    *loadedBlock
+	executionOrder common.TxExecutionOrderHandler
+}
+
+func newBlockDataSource() *blockDataSource {
+	return &blockDataSource{
+		loadedBlock: &loadedBlock{
+			txs: make(map[block.Type]map[string]data.TransactionHandler),
+		},
+		executionOrder: ordering.NewOrderedCollection(),
+	}
+}
+
+func (bds *blockDataSource) setLoadedBlock(lb *loadedBlock) {
+	bds.mut.Lock()
+	defer bds.mut.Unlock()
+
+	bds.loadedBlock = lb
+	bds.executionOrder.Clear()
+	for _, txHash := range lb.orderedTxHashes {
+		bds.executionOrder.Add(txHash)
+	}
+}
+
+// GetAllCurrentUsedTxs returns the transactions of the provided type from the loaded block
+func (bds *blockDataSource) GetAllCurrentUsedTxs(blockType block.Type) map[string]data.TransactionHandler {
+	bds.mut.RLock()
+	defer bds.mut.RUnlock()
+
+	txs := make(map[string]data.TransactionHandler, len(bds.loadedBlock.txs[blockType]))
+	for txHash, tx := range bds.loadedBlock.txs[blockType] {
+		txs[txHash] = tx
+	}
+
+	return txs
+}
+
+// GetAllCurrentLogs returns the logs of the loaded block
+func (bds *blockDataSource) GetAllCurrentLogs() []*data.LogData {
+	bds.mut.RLock()
+	defer bds.mut.RUnlock()
+
+	return bds.loadedBlock.logs
+}
+
+// GetCreatedInShardMiniBlocks returns the miniblocks created in shard by the loaded block
+func (bds *blockDataSource) GetCreatedInShardMiniBlocks() []*block.MiniBlock {
+	bds.mut.RLock()
+	defer bds.mut.RUnlock()
+
+	return bds.loadedBlock.createdInShardMiniBlocks
+}
+
+// TotalGasProvided returns the sum of the gas limits of the executed transactions
+func (bds *blockDataSource) TotalGasProvided() uint64 {
+	bds.mut.RLock()
+	defer bds.mut.RUnlock()
+
+	return bds.loadedBlock.gasProvided
+}
+
+// TotalGasProvidedWithScheduled returns the same value as TotalGasProvided
+func (bds *blockDataSource) TotalGasProvidedWithScheduled() uint64 {
+	return bds.TotalGasProvided()
+}
+
+// TotalGasRefunded returns 0 as the value is not persisted
+func (bds *blockDataSource) TotalGasRefunded() uint64 {
+	return 0
+}
+
+// TotalGasPenalized returns 0 as the value is not persisted
+func (bds *blockDataSource) TotalGasPenalized() uint64 {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bds *blockDataSource) IsInterfaceNil() bool {
+	return bds == nil
+}
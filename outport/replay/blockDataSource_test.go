@@ -0,0 +1,46 @@
+package replay
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockDataSource_SetLoadedBlock(t *testing.T) {
+	t.Parallel()
+
+	bds := newBlockDataSource()
+	require.False(t, bds.IsInterfaceNil())
+	require.Empty(t, bds.GetAllCurrentUsedTxs(block.TxBlock))
+	require.Zero(t, bds.executionOrder.Len())
+
+	tx := &transaction.Transaction{Nonce: 1}
+	logs := []*data.LogData{{TxHash: "tx"}}
+	miniBlocks := []*block.MiniBlock{{Type: block.SmartContractResultBlock}}
+	bds.setLoadedBlock(&loadedBlock{
+		txs:                      map[block.Type]map[string]data.TransactionHandler{block.TxBlock: {"tx": tx}},
+		logs:                     logs,
+		createdInShardMiniBlocks: miniBlocks,
+		orderedTxHashes:          [][]byte{[]byte("tx"), []byte("scr")},
+		gasProvided:              100,
+	})
+
+	txs := bds.GetAllCurrentUsedTxs(block.TxBlock)
+	require.Equal(t, map[string]data.TransactionHandler{"tx": tx}, txs)
+	delete(txs, "tx")
+	require.Len(t, bds.GetAllCurrentUsedTxs(block.TxBlock), 1)
+
+	require.Equal(t, logs, bds.GetAllCurrentLogs())
+	require.Equal(t, miniBlocks, bds.GetCreatedInShardMiniBlocks())
+	require.Equal(t, uint64(100), bds.TotalGasProvided())
+	require.Equal(t, uint64(100), bds.TotalGasProvidedWithScheduled())
+	require.Zero(t, bds.TotalGasRefunded())
+	require.Zero(t, bds.TotalGasPenalized())
+	require.Equal(t, [][]byte{[]byte("tx"), []byte("scr")}, bds.executionOrder.GetItems())
+
+	bds.setLoadedBlock(&loadedBlock{orderedTxHashes: [][]byte{[]byte("tx2")}})
+	require.Equal(t, [][]byte{[]byte("tx2")}, bds.executionOrder.GetItems())
+}
@@ -0,0 +1,290 @@
+package replay
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// loadedBlock holds all the data of a committed block, as read from storage
+type loadedBlock struct {
+	header                   data.HeaderHandler
+	headerHash               []byte
+	body                     *block.Body
+	createdInShardMiniBlocks []*block.MiniBlock
+	txs                      map[block.Type]map[string]data.TransactionHandler
+	logs                     []*data.LogData
+	// orderedTxHashes holds the hashes of the executed transactions, in the order they were executed, followed by
+	// the hashes of the results created in shard. Receipts are not part of the execution order
+	orderedTxHashes [][]byte
+	gasProvided     uint64
+}
+
+type receiptsLoader interface {
+	LoadReceipts(header data.HeaderHandler, headerHash []byte) (common.ReceiptsHolder, error)
+}
+
+// blocksLoader reads the headers, bodies, transactions and logs of committed blocks from the node's storage
+type blocksLoader struct {
+	shardID         uint32
+	storageService  dataRetriever.StorageService
+	marshaller      marshal.Marshalizer
+	uint64Converter typeConverters.Uint64ByteSliceConverter
+	receipts        receiptsLoader
+}
+
+func (bl *blocksLoader) headerUnit() dataRetriever.UnitType {
+	if bl.shardID == core.MetachainShardId {
+		return dataRetriever.MetaBlockUnit
+	}
+
+	return dataRetriever.BlockHeaderUnit
+}
+
+func (bl *blocksLoader) nonceToHashUnit() dataRetriever.UnitType {
+	if bl.shardID == core.MetachainShardId {
+		return dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	return dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(bl.shardID)
+}
+
+// headerByNonce returns the header with the provided nonce. The header is searched in the hinted epoch and in the
+// following one, falling back to the opened persisters
+func (bl *blocksLoader) headerByNonce(nonce uint64, epochHint uint32) (data.HeaderHandler, []byte, error) {
+	nonceKey := bl.uint64Converter.ToByteSlice(nonce)
+	headerHash, err := bl.getFromUnit(bl.nonceToHashUnit(), nonceKey, epochHint, epochHint+1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: nonce %d", ErrHeaderNotFound, nonce)
+	}
+
+	header, err := bl.headerByHash(headerHash, epochHint, epochHint+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return header, headerHash, nil
+}
+
+func (bl *blocksLoader) headerByHash(headerHash []byte, epochs ...uint32) (data.HeaderHandler, error) {
+	headerBytes, err := bl.getFromUnit(bl.headerUnit(), headerHash, epochs...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: hash %x", ErrHeaderNotFound, headerHash)
+	}
+
+	return process.UnmarshalHeader(bl.shardID, bl.marshaller, headerBytes)
+}
+
+// epochStartHeader returns the first header of the provided epoch
+func (bl *blocksLoader) epochStartHeader(epoch uint32) (data.HeaderHandler, error) {
+	key := []byte(core.EpochStartIdentifier(epoch))
+	headerBytes, err := bl.getFromUnit(bl.headerUnit(), key, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: start of epoch %d", ErrHeaderNotFound, epoch)
+	}
+
+	return process.UnmarshalHeader(bl.shardID, bl.marshaller, headerBytes)
+}
+
+// loadBlock reads from storage the body, the transactions and the logs of the provided header
+func (bl *blocksLoader) loadBlock(header data.HeaderHandler, headerHash []byte) (*loadedBlock, error) {
+	body, err := bl.loadBody(header)
+	if err != nil {
+		return nil, err
+	}
+
+	receiptsHolder, err := bl.receipts.LoadReceipts(header, headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	lb := &loadedBlock{
+		header:                   header,
+		headerHash:               headerHash,
+		body:                     body,
+		createdInShardMiniBlocks: receiptsHolder.GetMiniblocks(),
+		txs:                      make(map[block.Type]map[string]data.TransactionHandler),
+		orderedTxHashes:          make([][]byte, 0),
+	}
+
+	mbHeaders := header.GetMiniBlockHeaderHandlers()
+	for index, miniBlock := range body.MiniBlocks {
+		if mbHeaders[index].GetProcessingType() == int32(block.Processed) {
+			continue
+		}
+
+		first, last := int(mbHeaders[index].GetIndexOfFirstTxProcessed()), int(mbHeaders[index].GetIndexOfLastTxProcessed())
+		if last >= len(miniBlock.TxHashes) {
+			last = len(miniBlock.TxHashes) - 1
+		}
+		if first > last {
+			continue
+		}
+
+		err = bl.loadTransactions(lb, miniBlock.Type, miniBlock.TxHashes[first:last+1], header.GetEpoch())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, miniBlock := range lb.createdInShardMiniBlocks {
+		err = bl.loadTransactions(lb, miniBlock.Type, miniBlock.TxHashes, header.GetEpoch())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = bl.loadLogs(lb)
+	if err != nil {
+		return nil, err
+	}
+
+	return lb, nil
+}
+
+func (bl *blocksLoader) loadBody(header data.HeaderHandler) (*block.Body, error) {
+	mbHeaders := header.GetMiniBlockHeaderHandlers()
+	body := &block.Body{
+		MiniBlocks: make([]*block.MiniBlock, 0, len(mbHeaders)),
+	}
+
+	for _, mbHeader := range mbHeaders {
+		miniBlockBytes, err := bl.getFromUnit(dataRetriever.MiniBlockUnit, mbHeader.GetHash(), header.GetEpoch())
+		if err != nil {
+			return nil, fmt.Errorf("%w: hash %x, header nonce %d", ErrMiniBlockNotFound, mbHeader.GetHash(), header.GetNonce())
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = bl.marshaller.Unmarshal(miniBlock, miniBlockBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	return body, nil
+}
+
+func (bl *blocksLoader) loadTransactions(lb *loadedBlock, blockType block.Type, txHashes [][]byte, epoch uint32) error {
+	unit, isTransactionsBlock := transactionsUnit(blockType)
+	if !isTransactionsBlock {
+		return nil
+	}
+
+	txs, found := lb.txs[blockType]
+	if !found {
+		txs = make(map[string]data.TransactionHandler)
+		lb.txs[blockType] = txs
+	}
+
+	for _, txHash := range txHashes {
+		_, alreadyLoaded := txs[string(txHash)]
+		if alreadyLoaded {
+			continue
+		}
+
+		txBytes, err := bl.getFromUnit(unit, txHash, epoch)
+		if err != nil {
+			return fmt.Errorf("%w: hash %x, type %s", ErrTransactionNotFound, txHash, blockType.String())
+		}
+
+		tx := newTransactionOfType(blockType)
+		err = bl.marshaller.Unmarshal(tx, txBytes)
+		if err != nil {
+			return err
+		}
+
+		txs[string(txHash)] = tx
+		if blockType != block.ReceiptBlock {
+			lb.orderedTxHashes = append(lb.orderedTxHashes, txHash)
+		}
+		if blockType == block.TxBlock {
+			lb.gasProvided += tx.GetGasLimit()
+		}
+	}
+
+	return nil
+}
+
+func (bl *blocksLoader) loadLogs(lb *loadedBlock) error {
+	logsStorer, err := bl.storageService.GetStorer(dataRetriever.TxLogsUnit)
+	if err != nil {
+		return err
+	}
+
+	lb.logs = make([]*data.LogData, 0)
+	for _, txHash := range lb.orderedTxHashes {
+		logBytes, errGet := logsStorer.GetFromEpoch(txHash, lb.header.GetEpoch())
+		if errGet != nil {
+			continue
+		}
+
+		txLog := &transaction.Log{}
+		err = bl.marshaller.Unmarshal(txLog, logBytes)
+		if err != nil {
+			return err
+		}
+
+		lb.logs = append(lb.logs, &data.LogData{
+			LogHandler: txLog,
+			TxHash:     string(txHash),
+		})
+	}
+
+	return nil
+}
+
+// getFromUnit searches the key in the provided epochs, then in all the opened persisters of the unit
+func (bl *blocksLoader) getFromUnit(unit dataRetriever.UnitType, key []byte, epochs ...uint32) ([]byte, error) {
+	storer, err := bl.storageService.GetStorer(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, epoch := range epochs {
+		value, errGet := storer.GetFromEpoch(key, epoch)
+		if errGet == nil {
+			return value, nil
+		}
+	}
+
+	return storer.SearchFirst(key)
+}
+
+func transactionsUnit(blockType block.Type) (dataRetriever.UnitType, bool) {
+	switch blockType {
+	case block.TxBlock, block.InvalidBlock:
+		return dataRetriever.TransactionUnit, true
+	case block.SmartContractResultBlock, block.ReceiptBlock:
+		return dataRetriever.UnsignedTransactionUnit, true
+	case block.RewardsBlock:
+		return dataRetriever.RewardTransactionUnit, true
+	default:
+		return 0, false
+	}
+}
+
+func newTransactionOfType(blockType block.Type) data.TransactionHandler {
+	switch blockType {
+	case block.SmartContractResultBlock:
+		return &smartContractResult.SmartContractResult{}
+	case block.ReceiptBlock:
+		return &receipt.Receipt{}
+	case block.RewardsBlock:
+		return &rewardTx.RewardTx{}
+	default:
+		return &transaction.Transaction{}
+	}
+}
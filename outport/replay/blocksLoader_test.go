@@ -0,0 +1,171 @@
+package replay
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+type receiptsLoaderStub struct {
+	miniBlocks []*block.MiniBlock
+}
+
+func (stub *receiptsLoaderStub) LoadReceipts(_ data.HeaderHandler, _ []byte) (common.ReceiptsHolder, error) {
+	return holders.NewReceiptsHolder(stub.miniBlocks), nil
+}
+
+func createTestBlocksLoader(storageService *genericMocks.ChainStorerMock) *blocksLoader {
+	return &blocksLoader{
+		shardID:         0,
+		storageService:  storageService,
+		marshaller:      &marshallerMock.MarshalizerMock{},
+		uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
+		receipts:        &receiptsLoaderStub{},
+	}
+}
+
+func putMarshalled(t *testing.T, storer *genericMocks.StorerMock, key []byte, obj interface{}, epoch uint32) {
+	buff, err := (&marshallerMock.MarshalizerMock{}).Marshal(obj)
+	require.Nil(t, err)
+	require.Nil(t, storer.PutInEpoch(key, buff, epoch))
+}
+
+// putShardHeader stores the header, together with its nonce to hash mapping, and returns the header hash
+func putShardHeader(t *testing.T, storageService *genericMocks.ChainStorerMock, header *block.Header) []byte {
+	headerHash := []byte{byte(header.Nonce), 'h'}
+	putMarshalled(t, storageService.BlockHeaders, headerHash, header, header.Epoch)
+	nonceKey := uint64ByteSlice.NewBigEndianConverter().ToByteSlice(header.Nonce)
+	require.Nil(t, storageService.ShardHdrNonce.PutInEpoch(nonceKey, headerHash, header.Epoch))
+
+	return headerHash
+}
+
+func TestBlocksLoader_HeaderByNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		bl := createTestBlocksLoader(genericMocks.NewChainStorerMock(0))
+		header, headerHash, err := bl.headerByNonce(1, 0)
+		require.True(t, check.IfNil(header))
+		require.Nil(t, headerHash)
+		require.True(t, errors.Is(err, ErrHeaderNotFound))
+	})
+	t.Run("missing header should error", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := genericMocks.NewChainStorerMock(0)
+		nonceKey := uint64ByteSlice.NewBigEndianConverter().ToByteSlice(1)
+		require.Nil(t, storageService.ShardHdrNonce.PutInEpoch(nonceKey, []byte("hash"), 0))
+
+		bl := createTestBlocksLoader(storageService)
+		_, _, err := bl.headerByNonce(1, 0)
+		require.True(t, errors.Is(err, ErrHeaderNotFound))
+	})
+	t.Run("should find the header in the following epoch", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := genericMocks.NewChainStorerMock(0)
+		expectedHash := putShardHeader(t, storageService, &block.Header{Nonce: 7, Epoch: 3})
+
+		bl := createTestBlocksLoader(storageService)
+		header, headerHash, err := bl.headerByNonce(7, 2)
+		require.Nil(t, err)
+		require.Equal(t, expectedHash, headerHash)
+		require.Equal(t, uint64(7), header.GetNonce())
+	})
+}
+
+func TestBlocksLoader_EpochStartHeader(t *testing.T) {
+	t.Parallel()
+
+	storageService := genericMocks.NewChainStorerMock(0)
+	putMarshalled(t, storageService.BlockHeaders, []byte(core.EpochStartIdentifier(2)), &block.Header{Nonce: 40, Epoch: 2}, 2)
+	bl := createTestBlocksLoader(storageService)
+
+	header, err := bl.epochStartHeader(2)
+	require.Nil(t, err)
+	require.Equal(t, uint64(40), header.GetNonce())
+
+	header, err = bl.epochStartHeader(3)
+	require.Nil(t, header)
+	require.True(t, errors.Is(err, ErrHeaderNotFound))
+}
+
+func TestBlocksLoader_LoadBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing miniblock should error", func(t *testing.T) {
+		t.Parallel()
+
+		bl := createTestBlocksLoader(genericMocks.NewChainStorerMock(0))
+		header := &block.Header{Nonce: 1, MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mb")}}}
+		lb, err := bl.loadBlock(header, []byte("hash"))
+		require.Nil(t, lb)
+		require.True(t, errors.Is(err, ErrMiniBlockNotFound))
+	})
+	t.Run("missing transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := genericMocks.NewChainStorerMock(0)
+		putMarshalled(t, storageService.Miniblocks, []byte("mb"), &block.MiniBlock{TxHashes: [][]byte{[]byte("tx")}}, 0)
+
+		bl := createTestBlocksLoader(storageService)
+		header := &block.Header{Nonce: 1, MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mb"), TxCount: 1}}}
+		lb, err := bl.loadBlock(header, []byte("hash"))
+		require.Nil(t, lb)
+		require.True(t, errors.Is(err, ErrTransactionNotFound))
+	})
+	t.Run("should load the executed transactions, the created in shard results and the logs", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := genericMocks.NewChainStorerMock(0)
+		txMiniBlock := &block.MiniBlock{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx1"), []byte("tx2"), []byte("tx3")}}
+		peerMiniBlock := &block.MiniBlock{Type: block.PeerBlock, TxHashes: [][]byte{[]byte("peer")}}
+		putMarshalled(t, storageService.Miniblocks, []byte("txMb"), txMiniBlock, 1)
+		putMarshalled(t, storageService.Miniblocks, []byte("peerMb"), peerMiniBlock, 1)
+		putMarshalled(t, storageService.Transactions, []byte("tx1"), &transaction.Transaction{Nonce: 1, GasLimit: 10}, 1)
+		putMarshalled(t, storageService.Transactions, []byte("tx2"), &transaction.Transaction{Nonce: 2, GasLimit: 20}, 1)
+		putMarshalled(t, storageService.Unsigned, []byte("scr"), &smartContractResult.SmartContractResult{Nonce: 3}, 1)
+		putMarshalled(t, storageService.Logs, []byte("tx2"), &transaction.Log{Address: []byte("addr")}, 1)
+
+		// only the first two transactions of the miniblock were executed in this block
+		txMbHeader := block.MiniBlockHeader{Hash: []byte("txMb"), TxCount: 3}
+		require.Nil(t, txMbHeader.SetIndexOfLastTxProcessed(1))
+		header := &block.Header{
+			Nonce:            5,
+			Epoch:            1,
+			MiniBlockHeaders: []block.MiniBlockHeader{txMbHeader, {Hash: []byte("peerMb"), TxCount: 1}},
+		}
+
+		bl := createTestBlocksLoader(storageService)
+		bl.receipts = &receiptsLoaderStub{
+			miniBlocks: []*block.MiniBlock{{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scr")}}},
+		}
+
+		lb, err := bl.loadBlock(header, []byte("hash"))
+		require.Nil(t, err)
+		require.Len(t, lb.body.MiniBlocks, 2)
+		require.Len(t, lb.createdInShardMiniBlocks, 1)
+		require.Len(t, lb.txs[block.TxBlock], 2)
+		require.Len(t, lb.txs[block.SmartContractResultBlock], 1)
+		require.Empty(t, lb.txs[block.PeerBlock])
+		require.Equal(t, [][]byte{[]byte("tx1"), []byte("tx2"), []byte("scr")}, lb.orderedTxHashes)
+		require.Equal(t, uint64(30), lb.gasProvided)
+		require.Len(t, lb.logs, 1)
+		require.Equal(t, "tx2", lb.logs[0].TxHash)
+	})
+}
@@ -0,0 +1,69 @@
+package replay
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+)
+
+const checkpointFilePermissions = 0644
+
+// replayCheckpoint holds the last block successfully pushed to the outport drivers
+type replayCheckpoint struct {
+	ShardID uint32 `json:"shardID"`
+	Nonce   uint64 `json:"nonce"`
+	Hash    string `json:"hash"`
+	Epoch   uint32 `json:"epoch"`
+}
+
+// readReplayCheckpoint loads the checkpoint from the provided file. It returns nil if the file does not exist
+func readReplayCheckpoint(filePath string) (*replayCheckpoint, error) {
+	buff, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := &replayCheckpoint{}
+	err = json.Unmarshal(buff, checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkpoint, nil
+}
+
+// writeReplayCheckpoint atomically replaces the checkpoint file, so a crash never leaves it partially written
+func writeReplayCheckpoint(filePath string, checkpoint *replayCheckpoint) error {
+	buff, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	tempFilePath := filePath + ".tmp"
+	file, err := os.OpenFile(tempFilePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, checkpointFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(buff)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Sync()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, filePath)
+}
@@ -0,0 +1,38 @@
+package replay
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayCheckpoint_ReadWrite(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), "replay.checkpoint")
+	checkpoint, err := readReplayCheckpoint(filePath)
+	require.Nil(t, err)
+	require.Nil(t, checkpoint)
+
+	expectedCheckpoint := &replayCheckpoint{
+		ShardID: 1,
+		Nonce:   37,
+		Hash:    "aabb",
+		Epoch:   2,
+	}
+	require.Nil(t, writeReplayCheckpoint(filePath, expectedCheckpoint))
+
+	checkpoint, err = readReplayCheckpoint(filePath)
+	require.Nil(t, err)
+	require.Equal(t, expectedCheckpoint, checkpoint)
+
+	_, err = os.Stat(filePath + ".tmp")
+	require.True(t, os.IsNotExist(err))
+
+	require.Nil(t, os.WriteFile(filePath, []byte("invalid"), checkpointFilePermissions))
+	checkpoint, err = readReplayCheckpoint(filePath)
+	require.Nil(t, checkpoint)
+	require.NotNil(t, err)
+}
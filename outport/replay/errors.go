@@ -0,0 +1,36 @@
+package replay
+
+import "errors"
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilUint64Converter signals that a nil uint64 converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 converter")
+
+// ErrNilOutportHandler signals that a nil outport handler has been provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
+
+// ErrOutportHasNoDrivers signals that the outport has no driver to replay the blocks to
+var ErrOutportHasNoDrivers = errors.New("outport has no drivers")
+
+// ErrInvalidNoncesRange signals that an invalid nonces range has been provided
+var ErrInvalidNoncesRange = errors.New("invalid nonces range")
+
+// ErrInvalidEpochsRange signals that an invalid epochs range has been provided
+var ErrInvalidEpochsRange = errors.New("invalid epochs range")
+
+// ErrHeaderNotFound signals that a header could not be found in storage
+var ErrHeaderNotFound = errors.New("header not found in storage")
+
+// ErrMiniBlockNotFound signals that a miniblock could not be found in storage
+var ErrMiniBlockNotFound = errors.New("miniblock not found in storage")
+
+// ErrTransactionNotFound signals that a transaction could not be found in storage
+var ErrTransactionNotFound = errors.New("transaction not found in storage")
+
+// ErrCheckpointShardMismatch signals that the checkpoint file was written by a node in a different shard
+var ErrCheckpointShardMismatch = errors.New("checkpoint shard mismatch")
@@ -0,0 +1,45 @@
+package replay
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+)
+
+// historicalNodesCoordinator wraps the node's nodes coordinator so that blocks from epochs whose nodes
+// configuration is no longer kept in memory can still be exported, without signers indexes
+type historicalNodesCoordinator struct {
+	nodesCoordinator.NodesCoordinator
+}
+
+// GetConsensusValidatorsPublicKeys returns the consensus public keys, or an empty list if the epoch configuration
+// is not available anymore
+func (hnc *historicalNodesCoordinator) GetConsensusValidatorsPublicKeys(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+	pubKeys, err := hnc.NodesCoordinator.GetConsensusValidatorsPublicKeys(randomness, round, shardId, epoch)
+	if errors.Is(err, nodesCoordinator.ErrEpochNodesConfigDoesNotExist) {
+		log.Trace("historicalNodesCoordinator: missing nodes config, exporting without signers", "epoch", epoch, "round", round)
+		return make([]string, 0), nil
+	}
+
+	return pubKeys, err
+}
+
+// GetValidatorsIndexes returns the validators indexes, or an empty list if the epoch configuration is not
+// available anymore
+func (hnc *historicalNodesCoordinator) GetValidatorsIndexes(publicKeys []string, epoch uint32) ([]uint64, error) {
+	if len(publicKeys) == 0 {
+		return make([]uint64, 0), nil
+	}
+
+	indexes, err := hnc.NodesCoordinator.GetValidatorsIndexes(publicKeys, epoch)
+	if errors.Is(err, nodesCoordinator.ErrEpochNodesConfigDoesNotExist) {
+		return make([]uint64, 0), nil
+	}
+
+	return indexes, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hnc *historicalNodesCoordinator) IsInterfaceNil() bool {
+	return hnc == nil || hnc.NodesCoordinator == nil
+}
@@ -0,0 +1,64 @@
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoricalNodesCoordinator_GetConsensusValidatorsPublicKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing epoch config should return empty", func(t *testing.T) {
+		t.Parallel()
+
+		hnc := &historicalNodesCoordinator{NodesCoordinator: &shardingMocks.NodesCoordinatorStub{
+			GetValidatorsPublicKeysCalled: func(_ []byte, _ uint64, _ uint32, epoch uint32) ([]string, error) {
+				return nil, fmt.Errorf("%w epoch=%d", nodesCoordinator.ErrEpochNodesConfigDoesNotExist, epoch)
+			},
+		}}
+		pubKeys, err := hnc.GetConsensusValidatorsPublicKeys(nil, 1, 0, 2)
+		require.Nil(t, err)
+		require.Empty(t, pubKeys)
+	})
+	t.Run("other errors should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		hnc := &historicalNodesCoordinator{NodesCoordinator: &shardingMocks.NodesCoordinatorStub{
+			GetValidatorsPublicKeysCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]string, error) {
+				return nil, expectedErr
+			},
+		}}
+		_, err := hnc.GetConsensusValidatorsPublicKeys(nil, 1, 0, 2)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hnc := &historicalNodesCoordinator{NodesCoordinator: &shardingMocks.NodesCoordinatorStub{
+			GetValidatorsPublicKeysCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]string, error) {
+				return []string{"a", "b"}, nil
+			},
+		}}
+		pubKeys, err := hnc.GetConsensusValidatorsPublicKeys(nil, 1, 0, 2)
+		require.Nil(t, err)
+		require.Equal(t, []string{"a", "b"}, pubKeys)
+	})
+}
+
+func TestHistoricalNodesCoordinator_GetValidatorsIndexes(t *testing.T) {
+	t.Parallel()
+
+	hnc := &historicalNodesCoordinator{NodesCoordinator: &shardingMocks.NodesCoordinatorMock{}}
+	indexes, err := hnc.GetValidatorsIndexes(nil, 2)
+	require.Nil(t, err)
+	require.Empty(t, indexes)
+
+	require.True(t, (&historicalNodesCoordinator{}).IsInterfaceNil())
+	require.False(t, hnc.IsInterfaceNil())
+}
@@ -0,0 +1,383 @@
+package replay
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/outport"
+	outportProcess "github.com/multiversx/mx-chain-go/outport/process"
+	"github.com/multiversx/mx-chain-go/outport/process/factory"
+	"github.com/multiversx/mx-chain-go/process"
+	processBlock "github.com/multiversx/mx-chain-go/process/block"
+	"github.com/multiversx/mx-chain-go/process/receipts"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/state"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+var log = logger.GetOrCreate("outport/replay")
+
+// ArgsOutportReplayer holds the arguments needed for creating a new outport replayer
+type ArgsOutportReplayer struct {
+	Config                 config.OutportReplayConfig
+	ShardCoordinator       sharding.Coordinator
+	StorageService         dataRetriever.StorageService
+	Marshaller             marshal.Marshalizer
+	Hasher                 hashing.Hasher
+	Uint64Converter        typeConverters.Uint64ByteSliceConverter
+	AddressConverter       core.PubkeyConverter
+	AccountsDB             state.AccountsAdapter
+	EsdtDataStorageHandler vmcommon.ESDTNFTStorageHandler
+	NodesCoordinator       nodesCoordinator.NodesCoordinator
+	EconomicsData          outportProcess.EconomicsDataHandler
+	EnableEpochsHandler    common.EnableEpochsHandler
+	OutportHandler         outport.OutportHandler
+}
+
+type outportReplayer struct {
+	config           config.OutportReplayConfig
+	shardID          uint32
+	loader           *blocksLoader
+	dataSource       *blockDataSource
+	dataProvider     outport.DataProviderOutport
+	accountsDB       state.AccountsAdapter
+	nodesCoordinator nodesCoordinator.NodesCoordinator
+	outportHandler   outport.OutportHandler
+}
+
+// NewOutportReplayer creates a component able to re-export the blocks committed in the node's storage to the
+// configured outport drivers, the same way the blocks were exported during live processing
+func NewOutportReplayer(args ArgsOutportReplayer) (*outportReplayer, error) {
+	err := checkArgsOutportReplayer(args)
+	if err != nil {
+		return nil, err
+	}
+
+	receiptsRepository, err := receipts.NewReceiptsRepository(receipts.ArgsNewReceiptsRepository{
+		Marshaller: args.Marshaller,
+		Hasher:     args.Hasher,
+		Store:      args.StorageService,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transactionsStorer, err := args.StorageService.GetStorer(dataRetriever.TransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+	miniBlocksStorer, err := args.StorageService.GetStorer(dataRetriever.MiniBlockUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	dataSource := newBlockDataSource()
+	historicalCoordinator := &historicalNodesCoordinator{NodesCoordinator: args.NodesCoordinator}
+	dataProvider, err := factory.CreateOutportDataProvider(factory.ArgOutportDataProviderFactory{
+		HasDrivers:             true,
+		AddressConverter:       args.AddressConverter,
+		AccountsDB:             args.AccountsDB,
+		Marshaller:             args.Marshaller,
+		EsdtDataStorageHandler: args.EsdtDataStorageHandler,
+		TransactionsStorer:     transactionsStorer,
+		ShardCoordinator:       args.ShardCoordinator,
+		TxCoordinator:          dataSource,
+		NodesCoordinator:       historicalCoordinator,
+		GasConsumedProvider:    dataSource,
+		EconomicsData:          args.EconomicsData,
+		Hasher:                 args.Hasher,
+		MbsStorer:              miniBlocksStorer,
+		EnableEpochsHandler:    args.EnableEpochsHandler,
+		ExecutionOrderGetter:   dataSource.executionOrder,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	shardID := args.ShardCoordinator.SelfId()
+
+	return &outportReplayer{
+		config:  args.Config,
+		shardID: shardID,
+		loader: &blocksLoader{
+			shardID:         shardID,
+			storageService:  args.StorageService,
+			marshaller:      args.Marshaller,
+			uint64Converter: args.Uint64Converter,
+			receipts:        receiptsRepository,
+		},
+		dataSource:       dataSource,
+		dataProvider:     dataProvider,
+		accountsDB:       args.AccountsDB,
+		nodesCoordinator: historicalCoordinator,
+		outportHandler:   args.OutportHandler,
+	}, nil
+}
+
+func checkArgsOutportReplayer(args ArgsOutportReplayer) error {
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.StorageService) {
+		return ErrNilStorageService
+	}
+	if check.IfNil(args.Marshaller) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return process.ErrNilHasher
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return ErrNilUint64Converter
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return process.ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.OutportHandler) {
+		return ErrNilOutportHandler
+	}
+	if !args.OutportHandler.HasDrivers() {
+		return ErrOutportHasNoDrivers
+	}
+	if args.Config.IsEpochRange && args.Config.StartEpoch > args.Config.EndEpoch {
+		return fmt.Errorf("%w: start epoch %d, end epoch %d", ErrInvalidEpochsRange, args.Config.StartEpoch, args.Config.EndEpoch)
+	}
+	if !args.Config.IsEpochRange && args.Config.StartNonce > args.Config.EndNonce {
+		return fmt.Errorf("%w: start nonce %d, end nonce %d", ErrInvalidNoncesRange, args.Config.StartNonce, args.Config.EndNonce)
+	}
+
+	return nil
+}
+
+// Replay pushes the stored blocks from the configured range to the outport drivers, one at a time and in
+// ascending nonce order. After each block, the checkpoint file (if configured) is updated, so a later run resumes
+// from the following block. The replay stops at the end of the range, when the next block is not found in storage
+// or when the provided context is done
+func (or *outportReplayer) Replay(ctx context.Context) error {
+	startNonce, epochHint, err := or.computeStart()
+	if err != nil {
+		return err
+	}
+
+	var previousHeader data.HeaderHandler
+	if startNonce > 1 {
+		previousHeader, _, err = or.loader.headerByNonce(startNonce-1, epochHint)
+		if err != nil {
+			log.Debug("outportReplayer.Replay: previous header not found, rounds info will not include missed rounds",
+				"nonce", startNonce-1, "error", err)
+		}
+	}
+
+	log.Info("starting outport replay", "shard", or.shardID, "start nonce", startNonce, "start epoch", epochHint)
+
+	pace := or.createPaceTicker()
+	if pace != nil {
+		defer pace.Stop()
+	}
+
+	numReplayed := 0
+	for nonce := startNonce; ; nonce++ {
+		if !or.config.IsEpochRange && nonce > or.config.EndNonce {
+			break
+		}
+
+		header, headerHash, errLoad := or.loader.headerByNonce(nonce, epochHint)
+		if errors.Is(errLoad, ErrHeaderNotFound) {
+			log.Info("outport replay reached the last block available in storage", "nonce", nonce)
+			break
+		}
+		if errLoad != nil {
+			return errLoad
+		}
+		if or.config.IsEpochRange && header.GetEpoch() > or.config.EndEpoch {
+			break
+		}
+
+		err = or.waitPace(ctx, pace)
+		if err != nil {
+			return err
+		}
+
+		err = or.replayBlock(header, headerHash, previousHeader)
+		if err != nil {
+			return fmt.Errorf("%w while replaying block with nonce %d, hash %s", err, nonce, hex.EncodeToString(headerHash))
+		}
+
+		previousHeader = header
+		epochHint = header.GetEpoch()
+		numReplayed++
+	}
+
+	log.Info("outport replay finished", "shard", or.shardID, "replayed blocks", numReplayed)
+
+	return nil
+}
+
+// computeStart returns the first nonce to be replayed, together with the epoch in which it is expected to be found
+func (or *outportReplayer) computeStart() (uint64, uint32, error) {
+	startNonce, epochHint := or.config.StartNonce, uint32(0)
+	if or.config.IsEpochRange {
+		epochHint = or.config.StartEpoch
+		startNonce = 1
+		if epochHint > 0 {
+			header, err := or.loader.epochStartHeader(epochHint)
+			if err != nil {
+				return 0, 0, err
+			}
+			startNonce = header.GetNonce()
+		}
+	}
+	if startNonce == 0 {
+		startNonce = 1
+	}
+
+	if len(or.config.CheckpointFilePath) == 0 {
+		return startNonce, epochHint, nil
+	}
+
+	checkpoint, err := readReplayCheckpoint(or.config.CheckpointFilePath)
+	if err != nil {
+		return 0, 0, err
+	}
+	if checkpoint == nil {
+		return startNonce, epochHint, nil
+	}
+	if checkpoint.ShardID != or.shardID {
+		return 0, 0, fmt.Errorf("%w: checkpoint shard %d, node shard %d", ErrCheckpointShardMismatch, checkpoint.ShardID, or.shardID)
+	}
+	if checkpoint.Nonce+1 <= startNonce {
+		return startNonce, epochHint, nil
+	}
+
+	log.Info("resuming outport replay from checkpoint", "nonce", checkpoint.Nonce, "hash", checkpoint.Hash)
+
+	return checkpoint.Nonce + 1, checkpoint.Epoch, nil
+}
+
+func (or *outportReplayer) replayBlock(header data.HeaderHandler, headerHash []byte, previousHeader data.HeaderHandler) error {
+	lb, err := or.loader.loadBlock(header, headerHash)
+	if err != nil {
+		return err
+	}
+
+	or.dataSource.setLoadedBlock(lb)
+
+	// the altered accounts are read from the accounts state as it was after the block was committed
+	epoch := core.OptionalUint32{Value: header.GetEpoch(), HasValue: true}
+	err = or.accountsDB.RecreateTrie(holders.NewRootHashHolder(header.GetRootHash(), epoch))
+	if err != nil {
+		return err
+	}
+
+	argSaveBlock, err := or.dataProvider.PrepareOutportSaveBlockData(outportProcess.ArgPrepareOutportSaveBlockData{
+		HeaderHash:             headerHash,
+		Header:                 header,
+		Body:                   lb.body,
+		PreviousHeader:         previousHeader,
+		RewardsTxs:             or.rewardsTxs(lb),
+		NotarizedHeadersHashes: or.notarizedHeadersHashes(header),
+		HighestFinalBlockNonce: header.GetNonce(),
+		HighestFinalBlockHash:  headerHash,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = or.outportHandler.SaveBlock(argSaveBlock)
+	if err != nil {
+		return err
+	}
+
+	processBlock.IndexRoundInfo(or.outportHandler, or.nodesCoordinator, or.shardID, header, previousHeader, argSaveBlock.SignersIndexes)
+	or.outportHandler.FinalizedBlock(&outportcore.FinalizedBlock{ShardID: or.shardID, HeaderHash: headerHash})
+
+	log.Debug("replayed block", "nonce", header.GetNonce(), "round", header.GetRound(), "hash", headerHash)
+
+	if len(or.config.CheckpointFilePath) == 0 {
+		return nil
+	}
+
+	return writeReplayCheckpoint(or.config.CheckpointFilePath, &replayCheckpoint{
+		ShardID: or.shardID,
+		Nonce:   header.GetNonce(),
+		Hash:    hex.EncodeToString(headerHash),
+		Epoch:   header.GetEpoch(),
+	})
+}
+
+// rewardsTxs returns the rewards created by an epoch start metablock, as the metachain does not export them as
+// part of the used transactions
+func (or *outportReplayer) rewardsTxs(lb *loadedBlock) map[string]data.TransactionHandler {
+	if or.shardID != core.MetachainShardId || !lb.header.IsStartOfEpochBlock() {
+		return nil
+	}
+
+	return lb.txs[block.RewardsBlock]
+}
+
+func (or *outportReplayer) notarizedHeadersHashes(header data.HeaderHandler) []string {
+	metaHeader, ok := header.(data.MetaHeaderHandler)
+	if !ok {
+		return nil
+	}
+
+	notarizedHeadersHashes := make([]string, 0, len(metaHeader.GetShardInfoHandlers()))
+	for _, shardData := range metaHeader.GetShardInfoHandlers() {
+		notarizedHeadersHashes = append(notarizedHeadersHashes, hex.EncodeToString(shardData.GetHeaderHash()))
+	}
+
+	return notarizedHeadersHashes
+}
+
+func (or *outportReplayer) createPaceTicker() *time.Ticker {
+	if or.config.MaxBlocksPerSecond == 0 {
+		return nil
+	}
+
+	interval := time.Second / time.Duration(or.config.MaxBlocksPerSecond)
+	if interval <= 0 {
+		return nil
+	}
+
+	return time.NewTicker(interval)
+}
+
+func (or *outportReplayer) waitPace(ctx context.Context, pace *time.Ticker) error {
+	if pace == nil {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+			return nil
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-pace.C:
+		return nil
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (or *outportReplayer) IsInterfaceNil() bool {
+	return or == nil
+}
@@ -0,0 +1,365 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	outportStub "github.com/multiversx/mx-chain-go/testscommon/outport"
+	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsOutportReplayer(storageService *genericMocks.ChainStorerMock) ArgsOutportReplayer {
+	storageService.Receipts = genericMocks.NewStorerMockWithErrKeyNotFound(0)
+
+	return ArgsOutportReplayer{
+		Config: config.OutportReplayConfig{
+			IsReplayMode: true,
+			StartNonce:   1,
+			EndNonce:     10,
+		},
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(2),
+		StorageService:   storageService,
+		Marshaller:       &marshallerMock.MarshalizerMock{},
+		Hasher:           &hashingMocks.HasherMock{},
+		Uint64Converter:  uint64ByteSlice.NewBigEndianConverter(),
+		AddressConverter: testscommon.NewPubkeyConverterMock(32),
+		AccountsDB: &state.AccountsStub{
+			RecreateTrieCalled: func(_ common.RootHashHolder) error {
+				return nil
+			},
+		},
+		EsdtDataStorageHandler: &testscommon.EsdtStorageHandlerStub{},
+		NodesCoordinator:       &shardingMocks.NodesCoordinatorStub{},
+		EconomicsData:          &economicsmocks.EconomicsHandlerMock{},
+		EnableEpochsHandler:    &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+		OutportHandler: &outportStub.OutportStub{
+			HasDriversCalled: func() bool {
+				return true
+			},
+		},
+	}
+}
+
+// putShardHeaders stores empty shard headers with nonces in the [1, numHeaders] range, the first header of each epoch
+// being an epoch start block
+func putShardHeaders(t *testing.T, storageService *genericMocks.ChainStorerMock, numHeaders uint64, headersPerEpoch uint64) {
+	for nonce := uint64(1); nonce <= numHeaders; nonce++ {
+		header := &block.Header{
+			Nonce:    nonce,
+			Round:    nonce,
+			Epoch:    uint32((nonce - 1) / headersPerEpoch),
+			RootHash: []byte{byte(nonce)},
+		}
+		if nonce > 1 && (nonce-1)%headersPerEpoch == 0 {
+			header.EpochStartMetaHash = []byte("epoch start")
+			putMarshalled(t, storageService.BlockHeaders, []byte(core.EpochStartIdentifier(header.Epoch)), header, header.Epoch)
+		}
+
+		putShardHeader(t, storageService, header)
+	}
+}
+
+func TestNewOutportReplayer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer(genericMocks.NewChainStorerMock(0))
+		args.ShardCoordinator = nil
+		replayer, err := NewOutportReplayer(args)
+		require.Nil(t, replayer)
+		require.Equal(t, ErrNilShardCoordinator, err)
+	})
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer(genericMocks.NewChainStorerMock(0))
+		args.StorageService = nil
+		replayer, err := NewOutportReplayer(args)
+		require.Nil(t, replayer)
+		require.Equal(t, ErrNilStorageService, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer(genericMocks.NewChainStorerMock(0))
+		args.Marshaller = nil
+		replayer, err := NewOutportReplayer(args)
+		require.Nil(t, replayer)
+		require.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer(genericMocks.NewChainStorerMock(0))
+		args.Hasher = nil
+		replayer, err := NewOutportReplayer(args)
+		require.Nil(t, replayer)
+		require.Equal(t, process.ErrNilHasher, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer(genericMocks.NewChainStorerMock(0))
+		args.Uint64Converter = nil
+		replayer, err := NewOutportReplayer(args)
+		require.Nil(t, replayer)
+		require.Equal(t, ErrNilUint64Converter, err)
+	})
+	t.Run("nil nodes coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer(genericMocks.NewChainStorerMock(0))
+		args.NodesCoordinator = nil
+		replayer, err := NewOutportReplayer(args)
+		require.Nil(t, replayer)
+		require.Equal(t, process.ErrNilNodesCoordinator, err)
+	})
+	t.Run("nil outport handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer(genericMocks.NewChainStorerMock(0))
+		args.OutportHandler = nil
+		replayer, err := NewOutportReplayer(args)
+		require.Nil(t, replayer)
+		require.Equal(t, ErrNilOutportHandler, err)
+	})
+	t.Run("outport without drivers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer(genericMocks.NewChainStorerMock(0))
+		args.OutportHandler = &outportStub.OutportStub{}
+		replayer, err := NewOutportReplayer(args)
+		require.Nil(t, replayer)
+		require.Equal(t, ErrOutportHasNoDrivers, err)
+	})
+	t.Run("invalid nonces range should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer(genericMocks.NewChainStorerMock(0))
+		args.Config.StartNonce = 11
+		replayer, err := NewOutportReplayer(args)
+		require.Nil(t, replayer)
+		require.True(t, errors.Is(err, ErrInvalidNoncesRange))
+	})
+	t.Run("invalid epochs range should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer(genericMocks.NewChainStorerMock(0))
+		args.Config.IsEpochRange = true
+		args.Config.StartEpoch = 3
+		args.Config.EndEpoch = 2
+		replayer, err := NewOutportReplayer(args)
+		require.Nil(t, replayer)
+		require.True(t, errors.Is(err, ErrInvalidEpochsRange))
+	})
+	t.Run("invalid data provider arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer(genericMocks.NewChainStorerMock(0))
+		args.AccountsDB = nil
+		replayer, err := NewOutportReplayer(args)
+		require.Nil(t, replayer)
+		require.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, err := NewOutportReplayer(createMockArgsOutportReplayer(genericMocks.NewChainStorerMock(0)))
+		require.Nil(t, err)
+		require.False(t, check.IfNil(replayer))
+	})
+}
+
+func TestOutportReplayer_Replay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should replay the nonces range", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := genericMocks.NewChainStorerMock(0)
+		putShardHeaders(t, storageService, 6, 10)
+
+		savedNonces := make([]uint64, 0)
+		finalizedHashes := make([][]byte, 0)
+		recreatedRootHashes := make([][]byte, 0)
+		numRoundsInfo := 0
+		args := createMockArgsOutportReplayer(storageService)
+		args.Config.StartNonce = 2
+		args.Config.EndNonce = 4
+		args.AccountsDB = &state.AccountsStub{
+			RecreateTrieCalled: func(options common.RootHashHolder) error {
+				recreatedRootHashes = append(recreatedRootHashes, options.GetRootHash())
+				return nil
+			},
+		}
+		args.OutportHandler = &outportStub.OutportStub{
+			HasDriversCalled: func() bool {
+				return true
+			},
+			SaveBlockCalled: func(args *outportcore.OutportBlockWithHeaderAndBody) error {
+				savedNonces = append(savedNonces, args.HeaderDataWithBody.Header.GetNonce())
+				require.Equal(t, args.HeaderDataWithBody.HeaderHash, args.OutportBlock.HighestFinalBlockHash)
+				return nil
+			},
+			SaveRoundsInfoCalled: func(_ *outportcore.RoundsInfo) {
+				numRoundsInfo++
+			},
+			FinalizedBlockCalled: func(finalizedBlock *outportcore.FinalizedBlock) {
+				finalizedHashes = append(finalizedHashes, finalizedBlock.HeaderHash)
+			},
+		}
+
+		replayer, _ := NewOutportReplayer(args)
+		err := replayer.Replay(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, []uint64{2, 3, 4}, savedNonces)
+		require.Equal(t, [][]byte{{2}, {3}, {4}}, recreatedRootHashes)
+		require.Equal(t, [][]byte{{2, 'h'}, {3, 'h'}, {4, 'h'}}, finalizedHashes)
+		require.Equal(t, 3, numRoundsInfo)
+	})
+	t.Run("should stop at the last block in storage", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := genericMocks.NewChainStorerMock(0)
+		putShardHeaders(t, storageService, 3, 10)
+
+		numSaved := 0
+		args := createMockArgsOutportReplayer(storageService)
+		args.OutportHandler = &outportStub.OutportStub{
+			HasDriversCalled: func() bool {
+				return true
+			},
+			SaveBlockCalled: func(_ *outportcore.OutportBlockWithHeaderAndBody) error {
+				numSaved++
+				return nil
+			},
+		}
+
+		replayer, _ := NewOutportReplayer(args)
+		require.Nil(t, replayer.Replay(context.Background()))
+		require.Equal(t, 3, numSaved)
+	})
+	t.Run("should replay the epochs range", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := genericMocks.NewChainStorerMock(0)
+		putShardHeaders(t, storageService, 12, 3)
+
+		savedNonces := make([]uint64, 0)
+		args := createMockArgsOutportReplayer(storageService)
+		args.Config.IsEpochRange = true
+		args.Config.StartEpoch = 1
+		args.Config.EndEpoch = 2
+		args.OutportHandler = &outportStub.OutportStub{
+			HasDriversCalled: func() bool {
+				return true
+			},
+			SaveBlockCalled: func(args *outportcore.OutportBlockWithHeaderAndBody) error {
+				savedNonces = append(savedNonces, args.HeaderDataWithBody.Header.GetNonce())
+				return nil
+			},
+		}
+
+		replayer, _ := NewOutportReplayer(args)
+		require.Nil(t, replayer.Replay(context.Background()))
+		require.Equal(t, []uint64{4, 5, 6, 7, 8, 9}, savedNonces)
+	})
+	t.Run("should resume from checkpoint", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := genericMocks.NewChainStorerMock(0)
+		putShardHeaders(t, storageService, 6, 10)
+
+		savedNonces := make([]uint64, 0)
+		args := createMockArgsOutportReplayer(storageService)
+		args.Config.CheckpointFilePath = filepath.Join(t.TempDir(), "replay.checkpoint")
+		args.Config.EndNonce = 3
+		args.OutportHandler = &outportStub.OutportStub{
+			HasDriversCalled: func() bool {
+				return true
+			},
+			SaveBlockCalled: func(args *outportcore.OutportBlockWithHeaderAndBody) error {
+				savedNonces = append(savedNonces, args.HeaderDataWithBody.Header.GetNonce())
+				return nil
+			},
+		}
+
+		replayer, _ := NewOutportReplayer(args)
+		require.Nil(t, replayer.Replay(context.Background()))
+
+		checkpoint, _ := readReplayCheckpoint(args.Config.CheckpointFilePath)
+		require.Equal(t, uint64(3), checkpoint.Nonce)
+
+		args.Config.EndNonce = 10
+		replayer, _ = NewOutportReplayer(args)
+		require.Nil(t, replayer.Replay(context.Background()))
+		require.Equal(t, []uint64{1, 2, 3, 4, 5, 6}, savedNonces)
+	})
+	t.Run("checkpoint from another shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReplayer(genericMocks.NewChainStorerMock(0))
+		args.Config.CheckpointFilePath = filepath.Join(t.TempDir(), "replay.checkpoint")
+		require.Nil(t, writeReplayCheckpoint(args.Config.CheckpointFilePath, &replayCheckpoint{ShardID: 1, Nonce: 5}))
+
+		replayer, _ := NewOutportReplayer(args)
+		err := replayer.Replay(context.Background())
+		require.True(t, errors.Is(err, ErrCheckpointShardMismatch))
+	})
+	t.Run("save block error should stop the replay", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := genericMocks.NewChainStorerMock(0)
+		putShardHeaders(t, storageService, 3, 10)
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsOutportReplayer(storageService)
+		args.OutportHandler = &outportStub.OutportStub{
+			HasDriversCalled: func() bool {
+				return true
+			},
+			SaveBlockCalled: func(_ *outportcore.OutportBlockWithHeaderAndBody) error {
+				return expectedErr
+			},
+		}
+
+		replayer, _ := NewOutportReplayer(args)
+		err := replayer.Replay(context.Background())
+		require.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("closed context should stop the replay", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := genericMocks.NewChainStorerMock(0)
+		putShardHeaders(t, storageService, 3, 10)
+
+		args := createMockArgsOutportReplayer(storageService)
+		args.Config.MaxBlocksPerSecond = 1
+		replayer, _ := NewOutportReplayer(args)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := replayer.Replay(ctx)
+		require.Equal(t, context.Canceled, err)
+	})
+}
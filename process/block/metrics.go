@@ -155,7 +155,9 @@ func incrementMetricCountConsensusAcceptedBlocks(
 	}
 }
 
-func indexRoundInfo(
+// IndexRoundInfo sends to the outport the info of the round in which the header was proposed, together with the
+// info of the rounds without a proposed block since the last header
+func IndexRoundInfo(
 	outportHandler outport.OutportHandler,
 	nodesCoordinator nodesCoordinator.NodesCoordinator,
 	shardId uint32,
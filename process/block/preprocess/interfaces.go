@@ -19,6 +19,11 @@ type TxCache interface {
 	IsInterfaceNil() bool
 }
 
+// rejectedTransactionsRecorder defines the functionality of a transactions pool able to record the rejected transactions
+type rejectedTransactionsRecorder interface {
+	RecordRejectedTransactions(rejected []*txcache.RejectedTransaction)
+}
+
 // BlockTracker defines the functionality for node to track the blocks which are received from network
 type BlockTracker interface {
 	IsShardStuck(shardID uint32) bool
@@ -0,0 +1,31 @@
+package preprocess
+
+import (
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+)
+
+// rejectionsRecordingSession wraps a selection session, collecting the transactions rejected by the mempool
+// selection, along with the reason of the rejection, as reported by the selection itself.
+type rejectionsRecordingSession struct {
+	txcache.SelectionSession
+
+	// Not concurrency-safe, but never accessed concurrently (the session is used by a single selection).
+	rejected []*txcache.RejectedTransaction
+}
+
+func newRejectionsRecordingSession(session txcache.SelectionSession) *rejectionsRecordingSession {
+	return &rejectionsRecordingSession{
+		SelectionSession: session,
+		rejected:         make([]*txcache.RejectedTransaction, 0),
+	}
+}
+
+// RecordRejectedTransaction records a transaction rejected by the selection
+func (session *rejectionsRecordingSession) RecordRejectedTransaction(tx *txcache.WrappedTransaction, reason txcache.RejectionReason) {
+	session.rejected = append(session.rejected, txcache.NewRejectedTransaction(tx, reason))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (session *rejectionsRecordingSession) IsInterfaceNil() bool {
+	return session == nil
+}
@@ -0,0 +1,47 @@
+package preprocess
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+	storageMocks "github.com/multiversx/mx-chain-storage-go/testscommon/txcachemocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createWrappedTxForRejections(hash string, sender string, nonce uint64) *txcache.WrappedTransaction {
+	return &txcache.WrappedTransaction{
+		Tx: &transaction.Transaction{
+			SndAddr: []byte(sender),
+			Nonce:   nonce,
+		},
+		TxHash:           []byte(hash),
+		Fee:              big.NewInt(10),
+		TransferredValue: big.NewInt(0),
+	}
+}
+
+func TestRejectionsRecordingSession_RecordRejectedTransaction(t *testing.T) {
+	t.Parallel()
+
+	innerSession := storageMocks.NewSelectionSessionMock()
+	innerSession.SetNonce([]byte("alice"), 3)
+
+	session := newRejectionsRecordingSession(innerSession)
+	require.False(t, session.IsInterfaceNil())
+	require.Implements(t, (*txcache.RejectionsRecordingSession)(nil), session)
+
+	accountState, err := session.GetAccountState([]byte("alice"))
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), accountState.Nonce)
+
+	session.RecordRejectedTransaction(createWrappedTxForRejections("alice-2", "alice", 2), txcache.RejectionReasonLowerNonce)
+	session.RecordRejectedTransaction(createWrappedTxForRejections("alice-5", "alice", 5), txcache.RejectionReasonNonceGap)
+
+	require.Equal(t, 2, len(session.rejected))
+	require.Equal(t, []byte("alice-2"), session.rejected[0].TxHash)
+	require.Equal(t, txcache.RejectionReasonLowerNonce, session.rejected[0].Reason)
+	require.Equal(t, []byte("alice-5"), session.rejected[1].TxHash)
+	require.Equal(t, txcache.RejectionReasonNonceGap, session.rejected[1].Reason)
+}
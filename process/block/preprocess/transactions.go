@@ -1419,7 +1419,7 @@ func (txs *transactions) computeSortedTxs(
 		return nil, nil, err
 	}
 
-	sortedTxs := sortedTransactionsProvider.GetSortedTransactions(session)
+	sortedTxs := txs.getSortedTransactionsRecordingRejections(sortedTransactionsProvider, session)
 
 	// TODO: this could be moved to SortedTransactionsProvider
 	selectedTxs, remainingTxs := txs.preFilterTransactionsWithMoveBalancePriority(sortedTxs, gasBandwidth)
@@ -1428,6 +1428,24 @@ func (txs *transactions) computeSortedTxs(
 	return selectedTxs, remainingTxs, nil
 }
 
+// getSortedTransactionsRecordingRejections selects the transactions from the pool and, if the pool supports it, records
+// the transactions rejected by the selection, as reported by the selection itself.
+func (txs *transactions) getSortedTransactionsRecordingRejections(
+	sortedTransactionsProvider SortedTransactionsProvider,
+	session txcache.SelectionSession,
+) []*txcache.WrappedTransaction {
+	recorder, isRecorder := txs.txPool.(rejectedTransactionsRecorder)
+	if !isRecorder {
+		return sortedTransactionsProvider.GetSortedTransactions(session)
+	}
+
+	recordingSession := newRejectionsRecordingSession(session)
+	sortedTxs := sortedTransactionsProvider.GetSortedTransactions(recordingSession)
+	recorder.RecordRejectedTransactions(recordingSession.rejected)
+
+	return sortedTxs
+}
+
 // ProcessMiniBlock processes all the transactions from the given miniblock and saves the processed ones in a local cache
 func (txs *transactions) ProcessMiniBlock(
 	miniBlock *block.MiniBlock,
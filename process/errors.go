@@ -978,6 +978,9 @@ var ErrNilOrEmptyList = errors.New("nil or empty provided list")
 // ErrNilScQueryElement signals that a nil sc query service element was provided
 var ErrNilScQueryElement = errors.New("nil SC query service element")
 
+// ErrNilSCQueryRootHashResolver signals that a nil sc query root hash resolver was provided
+var ErrNilSCQueryRootHashResolver = errors.New("nil SC query root hash resolver")
+
 // ErrMaxAccumulatedFeesExceeded signals that max accumulated fees has been exceeded
 var ErrMaxAccumulatedFeesExceeded = errors.New("max accumulated fees has been exceeded")
 
@@ -1226,3 +1229,9 @@ var ErrNilSentSignatureTracker = errors.New("nil sent signature tracker")
 
 // ErrTransferAndExecuteByUserAddressesAreNil signals that transfer and execute by user addresses are nil
 var ErrTransferAndExecuteByUserAddressesAreNil = errors.New("transfer and execute by user addresses are nil")
+
+// ErrNilSCQuery signals that a nil smart contract query has been provided
+var ErrNilSCQuery = errors.New("nil SC query")
+
+// ErrDifferentBlockCoordinatesInQueries signals that the queries of the same batch define different block coordinates
+var ErrDifferentBlockCoordinatesInQueries = errors.New("different block coordinates in the queries of the same batch")
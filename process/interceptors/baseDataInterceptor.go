@@ -2,12 +2,15 @@ package interceptors
 
 import (
 	"bytes"
+	"errors"
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
 type baseDataInterceptor struct {
@@ -80,7 +83,11 @@ func (bdi *baseDataInterceptor) processInterceptedData(data process.InterceptedD
 
 	err = bdi.processor.Save(data, msg.Peer(), bdi.topic)
 	if err != nil {
-		log.Trace("intercepted data can not be processed",
+		logLevel := logger.LogTrace
+		if errors.Is(err, txcache.ErrReplacementUnderpriced) {
+			logLevel = logger.LogDebug
+		}
+		log.Log(logLevel, "intercepted data can not be processed",
 			"hash", data.Hash(),
 			"type", data.Type(),
 			"pid", p2p.MessageOriginatorPid(msg),
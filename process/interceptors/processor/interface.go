@@ -1,6 +1,7 @@
 package processor
 
 import (
+	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-go/state"
 )
 
@@ -24,3 +25,9 @@ type interceptedValidatorInfo interface {
 	Hash() []byte
 	ValidatorInfo() *state.ShardValidatorInfo
 }
+
+// transactionsPoolWithReplacement defines a pool able to report why a transaction was not added (e.g. an underpriced
+// replacement of a transaction with the same sender and nonce)
+type transactionsPoolWithReplacement interface {
+	AddTransaction(key []byte, tx data.TransactionHandler, sizeInBytes int, cacheID string) error
+}
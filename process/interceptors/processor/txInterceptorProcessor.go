@@ -66,6 +66,16 @@ func (txip *TxInterceptorProcessor) Save(data process.InterceptedData, peerOrigi
 
 	txLog.Trace("received transaction", "pid", peerOriginator.Pretty(), "hash", data.Hash())
 	cacherIdentifier := process.ShardCacherIdentifier(interceptedTx.SenderShardId(), interceptedTx.ReceiverShardId())
+	txPool, ok := txip.shardedPool.(transactionsPoolWithReplacement)
+	if ok {
+		return txPool.AddTransaction(
+			data.Hash(),
+			interceptedTx.Transaction(),
+			interceptedTx.Transaction().Size(),
+			cacherIdentifier,
+		)
+	}
+
 	txip.shardedPool.AddData(
 		data.Hash(),
 		interceptedTx.Transaction(),
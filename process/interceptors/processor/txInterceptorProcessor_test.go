@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/interceptors/processor"
 	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
@@ -169,6 +170,64 @@ func TestTxInterceptorProcessor_SaveShouldWork(t *testing.T) {
 
 //------- IsInterfaceNil
 
+type shardedDataStubWithReplacement struct {
+	*testscommon.ShardedDataStub
+	addTransactionCalled func(key []byte, tx data.TransactionHandler, sizeInBytes int, cacheID string) error
+}
+
+func (stub *shardedDataStubWithReplacement) AddTransaction(key []byte, tx data.TransactionHandler, sizeInBytes int, cacheID string) error {
+	return stub.addTransactionCalled(key, tx, sizeInBytes, cacheID)
+}
+
+func TestTxInterceptorProcessor_SaveWithReplacementShouldReturnTheError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := txcache.ErrReplacementUnderpriced
+	txInterceptedData := &struct {
+		testscommon.InterceptedDataStub
+		mock.InterceptedTxHandlerStub
+	}{
+		InterceptedDataStub: testscommon.InterceptedDataStub{
+			HashCalled: func() []byte {
+				return []byte("hash")
+			},
+		},
+		InterceptedTxHandlerStub: mock.InterceptedTxHandlerStub{
+			SenderShardIdCalled: func() uint32 {
+				return 1
+			},
+			ReceiverShardIdCalled: func() uint32 {
+				return 0
+			},
+			TransactionCalled: func() data.TransactionHandler {
+				return &transaction.Transaction{}
+			},
+		},
+	}
+
+	addDataWasCalled := false
+	shardedDataCache := testscommon.NewShardedDataStub()
+	shardedDataCache.AddDataCalled = func(key []byte, data interface{}, sizeInBytes int, cacheId string) {
+		addDataWasCalled = true
+	}
+	arg := createMockTxArgument()
+	arg.ShardedDataCache = &shardedDataStubWithReplacement{
+		ShardedDataStub: shardedDataCache,
+		addTransactionCalled: func(key []byte, tx data.TransactionHandler, sizeInBytes int, cacheID string) error {
+			assert.Equal(t, []byte("hash"), key)
+			assert.Equal(t, process.ShardCacherIdentifier(1, 0), cacheID)
+			return expectedErr
+		},
+	}
+
+	txip, _ := processor.NewTxInterceptorProcessor(arg)
+
+	err := txip.Save(txInterceptedData, "", "")
+
+	assert.Equal(t, expectedErr, err)
+	assert.False(t, addDataWasCalled)
+}
+
 func TestTxInterceptorProcessor_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
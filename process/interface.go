@@ -793,6 +793,14 @@ type SCQuery struct {
 	ShouldBeSynced bool
 	BlockNonce     core.OptionalUint64
 	BlockHash      []byte
+	BlockRootHash  []byte
+	HintEpoch      core.OptionalUint32
+}
+
+// SCQueryResult holds the outcome of one query executed as part of a batch of queries
+type SCQueryResult struct {
+	VMOutput *vmcommon.VMOutput
+	Err      error
 }
 
 // GasHandler is able to perform some gas calculation
@@ -934,11 +942,18 @@ type PeerValidatorMapper interface {
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteQueries(queries []*SCQuery) ([]*SCQueryResult, common.BlockInfo, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
 }
 
+// SCQueryRootHashResolver defines the component able to resolve the root hash of the state a query is executed on
+type SCQueryRootHashResolver interface {
+	GetQueryRootHash(query *SCQuery) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
 // EpochStartDataCreator defines the functionality for node to create epoch start data
 type EpochStartDataCreator interface {
 	CreateEpochStartData() (*block.EpochStart, error)
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-go/process"
+
+// SCQueryRootHashResolverStub -
+type SCQueryRootHashResolverStub struct {
+	GetQueryRootHashCalled func(query *process.SCQuery) ([]byte, error)
+}
+
+// GetQueryRootHash -
+func (stub *SCQueryRootHashResolverStub) GetQueryRootHash(query *process.SCQuery) ([]byte, error) {
+	if stub.GetQueryRootHashCalled != nil {
+		return stub.GetQueryRootHashCalled(query)
+	}
+
+	return query.BlockRootHash, nil
+}
+
+// IsInterfaceNil -
+func (stub *SCQueryRootHashResolverStub) IsInterfaceNil() bool {
+	return stub == nil
+}
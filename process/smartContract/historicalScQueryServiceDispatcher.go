@@ -0,0 +1,165 @@
+package smartContract
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+type pinnedScQueryService struct {
+	service  process.SCQueryService
+	pinnedTo string
+	lastUsed uint64
+}
+
+type historicalScQueryServiceDispatcher struct {
+	currentStateService process.SCQueryService
+	rootHashResolver    process.SCQueryRootHashResolver
+	mutPinned           sync.Mutex
+	pinnedServices      []*pinnedScQueryService
+	usageCounter        uint64
+}
+
+// NewHistoricalScQueryServiceDispatcher returns a smart contract query service dispatcher that forwards the queries
+// containing block coordinates towards a pool of query services, each of them pinned to the root hash of the state
+// queried last. This way, consecutive queries on the same historical state do not need to recreate the tries, no matter
+// if they provide the block nonce, the block hash or the root hash. The queries without block coordinates, together with
+// the gas limit computations, are forwarded to the current state service
+func NewHistoricalScQueryServiceDispatcher(
+	currentStateService process.SCQueryService,
+	historicalServices []process.SCQueryService,
+	rootHashResolver process.SCQueryRootHashResolver,
+) (*historicalScQueryServiceDispatcher, error) {
+	if check.IfNil(currentStateService) {
+		return nil, fmt.Errorf("%w for the current state service", process.ErrNilScQueryElement)
+	}
+	if check.IfNil(rootHashResolver) {
+		return nil, process.ErrNilSCQueryRootHashResolver
+	}
+	if len(historicalServices) == 0 {
+		return nil, fmt.Errorf("%w in NewHistoricalScQueryServiceDispatcher", process.ErrNilOrEmptyList)
+	}
+
+	pinnedServices := make([]*pinnedScQueryService, 0, len(historicalServices))
+	for i := 0; i < len(historicalServices); i++ {
+		if check.IfNil(historicalServices[i]) {
+			return nil, fmt.Errorf("%w at historical element %d", process.ErrNilScQueryElement, i)
+		}
+
+		pinnedServices = append(pinnedServices, &pinnedScQueryService{
+			service: historicalServices[i],
+		})
+	}
+
+	return &historicalScQueryServiceDispatcher{
+		currentStateService: currentStateService,
+		rootHashResolver:    rootHashResolver,
+		pinnedServices:      pinnedServices,
+	}, nil
+}
+
+// ExecuteQuery will forward the query towards the service pinned to the query block coordinates, if any
+func (hsqsd *historicalScQueryServiceDispatcher) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+	if !hasBlockCoordinates(query) {
+		return hsqsd.currentStateService.ExecuteQuery(query)
+	}
+
+	rootHash, err := hsqsd.rootHashResolver.GetQueryRootHash(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hsqsd.getServiceForRootHash(rootHash).ExecuteQuery(query)
+}
+
+// ExecuteQueries will forward the queries towards the service pinned to their block coordinates, if any
+func (hsqsd *historicalScQueryServiceDispatcher) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	if len(queries) == 0 {
+		return nil, nil, fmt.Errorf("%w of queries", process.ErrNilOrEmptyList)
+	}
+
+	if !hasBlockCoordinates(queries[0]) {
+		return hsqsd.currentStateService.ExecuteQueries(queries)
+	}
+
+	rootHash, err := hsqsd.rootHashResolver.GetQueryRootHash(queries[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hsqsd.getServiceForRootHash(rootHash).ExecuteQueries(queries)
+}
+
+func hasBlockCoordinates(query *process.SCQuery) bool {
+	if query == nil {
+		return false
+	}
+
+	return len(query.BlockRootHash) > 0 || len(query.BlockHash) > 0 || query.BlockNonce.HasValue
+}
+
+// getServiceForRootHash returns the service already pinned to the provided root hash. If there is none, the least
+// recently used service is pinned to the root hash
+func (hsqsd *historicalScQueryServiceDispatcher) getServiceForRootHash(rootHash []byte) process.SCQueryService {
+	pinKey := string(rootHash)
+
+	hsqsd.mutPinned.Lock()
+	defer hsqsd.mutPinned.Unlock()
+
+	hsqsd.usageCounter++
+
+	leastRecentlyUsed := hsqsd.pinnedServices[0]
+	for _, pinned := range hsqsd.pinnedServices {
+		if pinned.pinnedTo == pinKey {
+			pinned.lastUsed = hsqsd.usageCounter
+			return pinned.service
+		}
+
+		if pinned.lastUsed < leastRecentlyUsed.lastUsed {
+			leastRecentlyUsed = pinned
+		}
+	}
+
+	logQueryService.Trace("historicalScQueryServiceDispatcher: re-pinning service",
+		"old root hash", []byte(leastRecentlyUsed.pinnedTo), "new root hash", rootHash)
+	leastRecentlyUsed.pinnedTo = pinKey
+	leastRecentlyUsed.lastUsed = hsqsd.usageCounter
+
+	return leastRecentlyUsed.service
+}
+
+// ComputeScCallGasLimit will call this method on the current state service
+func (hsqsd *historicalScQueryServiceDispatcher) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
+	return hsqsd.currentStateService.ComputeScCallGasLimit(tx)
+}
+
+// Close closes all underlying components
+func (hsqsd *historicalScQueryServiceDispatcher) Close() error {
+	errFound := hsqsd.currentStateService.Close()
+	if errFound != nil {
+		logQueryService.Error("error while closing the current state SC query service in historicalScQueryServiceDispatcher.Close", "error", errFound)
+	}
+
+	hsqsd.mutPinned.Lock()
+	defer hsqsd.mutPinned.Unlock()
+
+	for _, pinned := range hsqsd.pinnedServices {
+		err := pinned.service.Close()
+		if err != nil {
+			logQueryService.Error("error while closing inner SC query service in historicalScQueryServiceDispatcher.Close", "error", err)
+			errFound = err
+		}
+	}
+
+	return errFound
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hsqsd *historicalScQueryServiceDispatcher) IsInterfaceNil() bool {
+	return hsqsd == nil
+}
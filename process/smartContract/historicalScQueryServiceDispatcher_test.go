@@ -0,0 +1,308 @@
+package smartContract
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func createCountingScQueryStub(counter *int) *mock.ScQueryStub {
+	return &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+			*counter++
+			return nil, nil, nil
+		},
+	}
+}
+
+func TestNewHistoricalScQueryServiceDispatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil current state service should error", func(t *testing.T) {
+		t.Parallel()
+
+		hsqsd, err := NewHistoricalScQueryServiceDispatcher(nil, []process.SCQueryService{&mock.ScQueryStub{}}, &mock.SCQueryRootHashResolverStub{})
+		assert.True(t, check.IfNil(hsqsd))
+		assert.True(t, errors.Is(err, process.ErrNilScQueryElement))
+	})
+	t.Run("empty historical list should error", func(t *testing.T) {
+		t.Parallel()
+
+		hsqsd, err := NewHistoricalScQueryServiceDispatcher(&mock.ScQueryStub{}, nil, &mock.SCQueryRootHashResolverStub{})
+		assert.True(t, check.IfNil(hsqsd))
+		assert.True(t, errors.Is(err, process.ErrNilOrEmptyList))
+	})
+	t.Run("nil historical element should error", func(t *testing.T) {
+		t.Parallel()
+
+		hsqsd, err := NewHistoricalScQueryServiceDispatcher(&mock.ScQueryStub{}, []process.SCQueryService{&mock.ScQueryStub{}, nil}, &mock.SCQueryRootHashResolverStub{})
+		assert.True(t, check.IfNil(hsqsd))
+		assert.True(t, errors.Is(err, process.ErrNilScQueryElement))
+	})
+	t.Run("nil root hash resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		hsqsd, err := NewHistoricalScQueryServiceDispatcher(&mock.ScQueryStub{}, []process.SCQueryService{&mock.ScQueryStub{}}, nil)
+		assert.True(t, check.IfNil(hsqsd))
+		assert.Equal(t, process.ErrNilSCQueryRootHashResolver, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hsqsd, err := NewHistoricalScQueryServiceDispatcher(&mock.ScQueryStub{}, []process.SCQueryService{&mock.ScQueryStub{}, &mock.ScQueryStub{}}, &mock.SCQueryRootHashResolverStub{})
+		assert.False(t, check.IfNil(hsqsd))
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(hsqsd.pinnedServices))
+	})
+}
+
+func TestHistoricalScQueryServiceDispatcher_ExecuteQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("query without block coordinates should use the current state service", func(t *testing.T) {
+		t.Parallel()
+
+		calledCurrent := 0
+		calledHistorical := 0
+		hsqsd, _ := NewHistoricalScQueryServiceDispatcher(
+			createCountingScQueryStub(&calledCurrent),
+			[]process.SCQueryService{createCountingScQueryStub(&calledHistorical)},
+			&mock.SCQueryRootHashResolverStub{
+				GetQueryRootHashCalled: func(query *process.SCQuery) ([]byte, error) {
+					assert.Fail(t, "should have not been called")
+					return nil, nil
+				},
+			},
+		)
+
+		_, _, _ = hsqsd.ExecuteQuery(&process.SCQuery{})
+		_, _, _ = hsqsd.ExecuteQuery(nil)
+
+		assert.Equal(t, 2, calledCurrent)
+		assert.Equal(t, 0, calledHistorical)
+	})
+	t.Run("root hash resolver error should not execute the query", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		calledCurrent := 0
+		calledHistorical := 0
+		hsqsd, _ := NewHistoricalScQueryServiceDispatcher(
+			createCountingScQueryStub(&calledCurrent),
+			[]process.SCQueryService{createCountingScQueryStub(&calledHistorical)},
+			&mock.SCQueryRootHashResolverStub{
+				GetQueryRootHashCalled: func(query *process.SCQuery) ([]byte, error) {
+					return nil, expectedErr
+				},
+			},
+		)
+
+		_, _, err := hsqsd.ExecuteQuery(&process.SCQuery{BlockHash: []byte("hash")})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 0, calledCurrent)
+		assert.Equal(t, 0, calledHistorical)
+	})
+	t.Run("queries on the same root hash should use the same service", func(t *testing.T) {
+		t.Parallel()
+
+		calledCurrent := 0
+		calledElement1 := 0
+		calledElement2 := 0
+		hsqsd, _ := NewHistoricalScQueryServiceDispatcher(
+			createCountingScQueryStub(&calledCurrent),
+			[]process.SCQueryService{
+				createCountingScQueryStub(&calledElement1),
+				createCountingScQueryStub(&calledElement2),
+			},
+			&mock.SCQueryRootHashResolverStub{
+				GetQueryRootHashCalled: func(query *process.SCQuery) ([]byte, error) {
+					if len(query.BlockRootHash) > 0 {
+						return query.BlockRootHash, nil
+					}
+
+					// block 10 with hash "hash 10" produced "root hash 10"
+					return []byte("root hash 10"), nil
+				},
+			},
+		)
+
+		queryOnNonce := &process.SCQuery{BlockNonce: core.OptionalUint64{Value: 10, HasValue: true}}
+		queryOnHash := &process.SCQuery{BlockHash: []byte("hash 10")}
+		queryOnSameRootHash := &process.SCQuery{BlockRootHash: []byte("root hash 10")}
+		queryOnOtherRootHash := &process.SCQuery{BlockRootHash: []byte("root hash")}
+		_, _, _ = hsqsd.ExecuteQuery(queryOnNonce)
+		_, _, _ = hsqsd.ExecuteQuery(queryOnOtherRootHash)
+		_, _, _ = hsqsd.ExecuteQuery(queryOnHash)
+		_, _, _ = hsqsd.ExecuteQuery(queryOnSameRootHash)
+		_, _, _ = hsqsd.ExecuteQuery(queryOnOtherRootHash)
+
+		assert.Equal(t, 0, calledCurrent)
+		assert.Equal(t, 3, calledElement1)
+		assert.Equal(t, 2, calledElement2)
+	})
+	t.Run("new root hash should re-pin the least recently used service", func(t *testing.T) {
+		t.Parallel()
+
+		calledElement1 := 0
+		calledElement2 := 0
+		hsqsd, _ := NewHistoricalScQueryServiceDispatcher(
+			&mock.ScQueryStub{},
+			[]process.SCQueryService{
+				createCountingScQueryStub(&calledElement1),
+				createCountingScQueryStub(&calledElement2),
+			},
+			&mock.SCQueryRootHashResolverStub{},
+		)
+
+		_, _, _ = hsqsd.ExecuteQuery(&process.SCQuery{BlockRootHash: []byte("root hash 1")}) // element 1
+		_, _, _ = hsqsd.ExecuteQuery(&process.SCQuery{BlockRootHash: []byte("root hash 2")}) // element 2
+		_, _, _ = hsqsd.ExecuteQuery(&process.SCQuery{BlockRootHash: []byte("root hash 1")}) // element 1
+		_, _, _ = hsqsd.ExecuteQuery(&process.SCQuery{BlockRootHash: []byte("root hash 3")}) // element 2, re-pinned
+		_, _, _ = hsqsd.ExecuteQuery(&process.SCQuery{BlockRootHash: []byte("root hash 1")}) // element 1
+
+		assert.Equal(t, 3, calledElement1)
+		assert.Equal(t, 2, calledElement2)
+		assert.Equal(t, "root hash 1", hsqsd.pinnedServices[0].pinnedTo)
+		assert.Equal(t, "root hash 3", hsqsd.pinnedServices[1].pinnedTo)
+	})
+}
+
+func TestHistoricalScQueryServiceDispatcher_ExecuteQueries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty queries should error", func(t *testing.T) {
+		t.Parallel()
+
+		hsqsd, _ := NewHistoricalScQueryServiceDispatcher(&mock.ScQueryStub{}, []process.SCQueryService{&mock.ScQueryStub{}}, &mock.SCQueryRootHashResolverStub{})
+
+		results, _, err := hsqsd.ExecuteQueries(nil)
+		assert.True(t, errors.Is(err, process.ErrNilOrEmptyList))
+		assert.Nil(t, results)
+	})
+	t.Run("should dispatch by the block coordinates of the queries", func(t *testing.T) {
+		t.Parallel()
+
+		calledCurrent := 0
+		calledHistorical := 0
+		hsqsd, _ := NewHistoricalScQueryServiceDispatcher(
+			&mock.ScQueryStub{
+				ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+					calledCurrent++
+					return nil, nil, nil
+				},
+			},
+			[]process.SCQueryService{
+				&mock.ScQueryStub{
+					ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+						calledHistorical++
+						return nil, nil, nil
+					},
+				},
+			},
+			&mock.SCQueryRootHashResolverStub{
+				GetQueryRootHashCalled: func(query *process.SCQuery) ([]byte, error) {
+					return []byte("root hash"), nil
+				},
+			},
+		)
+
+		_, _, _ = hsqsd.ExecuteQueries([]*process.SCQuery{{}, {}})
+		_, _, _ = hsqsd.ExecuteQueries([]*process.SCQuery{{BlockHash: []byte("hash")}, {BlockHash: []byte("hash")}})
+
+		assert.Equal(t, 1, calledCurrent)
+		assert.Equal(t, 1, calledHistorical)
+	})
+}
+
+func TestHistoricalScQueryServiceDispatcher_ComputeScCallGasLimitShouldUseTheCurrentStateService(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	hsqsd, _ := NewHistoricalScQueryServiceDispatcher(
+		&mock.ScQueryStub{
+			ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (uint64, error) {
+				wasCalled = true
+				return 37, nil
+			},
+		},
+		[]process.SCQueryService{
+			&mock.ScQueryStub{
+				ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (uint64, error) {
+					assert.Fail(t, "should have not been called")
+					return 0, nil
+				},
+			},
+		},
+		&mock.SCQueryRootHashResolverStub{},
+	)
+
+	gasLimit, err := hsqsd.ComputeScCallGasLimit(&transaction.Transaction{})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(37), gasLimit)
+	assert.True(t, wasCalled)
+}
+
+func TestHistoricalScQueryServiceDispatcher_ShouldWorkInAConcurrentManner(t *testing.T) {
+	t.Parallel()
+
+	hsqsd, _ := NewHistoricalScQueryServiceDispatcher(
+		&mock.ScQueryStub{},
+		[]process.SCQueryService{&mock.ScQueryStub{}, &mock.ScQueryStub{}},
+		&mock.SCQueryRootHashResolverStub{},
+	)
+
+	numCalls := 100
+	wg := &sync.WaitGroup{}
+	wg.Add(numCalls * 2)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			_, _, _ = hsqsd.ExecuteQuery(&process.SCQuery{BlockNonce: core.OptionalUint64{Value: uint64(idx % 5), HasValue: true}})
+			wg.Done()
+		}(i)
+		go func() {
+			_, _ = hsqsd.ComputeScCallGasLimit(nil)
+			wg.Done()
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestHistoricalScQueryServiceDispatcher_CloseShouldCloseAllServices(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	closeCalledCurrent := false
+	closeCalledHistorical := false
+	hsqsd, _ := NewHistoricalScQueryServiceDispatcher(
+		&mock.ScQueryStub{
+			CloseCalled: func() error {
+				closeCalledCurrent = true
+				return nil
+			},
+		},
+		[]process.SCQueryService{
+			&mock.ScQueryStub{
+				CloseCalled: func() error {
+					closeCalledHistorical = true
+					return expectedErr
+				},
+			},
+		},
+		&mock.SCQueryRootHashResolverStub{},
+	)
+
+	err := hsqsd.Close()
+	assert.Equal(t, expectedErr, err)
+	assert.True(t, closeCalledCurrent)
+	assert.True(t, closeCalledHistorical)
+}
@@ -53,6 +53,7 @@ type SCQueryService struct {
 	hasher                     hashing.Hasher
 	uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
 	isInHistoricalBalancesMode bool
+	recreatedRootHashHolder    common.RootHashHolder
 }
 
 // ArgsNewSCQueryService defines the arguments needed for the sc query service
@@ -183,8 +184,100 @@ func (service *SCQueryService) shouldAllowQueriesExecution() bool {
 	}
 }
 
+// ExecuteQueries executes all the provided queries against the same state. The block coordinates and the sync
+// requirement are taken from the queries, which should all define the same values for them. The per-query validation
+// and execution errors are returned as part of the results
+func (service *SCQueryService) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	if !service.shouldAllowQueriesExecution() {
+		return nil, nil, process.ErrQueriesNotAllowedYet
+	}
+	err := checkQueriesBatch(queries)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	service.mutRunSc.Lock()
+	defer service.mutRunSc.Unlock()
+
+	blockHeader, blockRootHash, err := service.prepareStateForQuery(queries[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make([]*process.SCQueryResult, 0, len(queries))
+	for _, query := range queries {
+		vmOutput, errRun := service.runQuery(query, 0)
+		results = append(results, &process.SCQueryResult{
+			VMOutput: vmOutput,
+			Err:      errRun,
+		})
+	}
+
+	blockInfo, err := service.createBlockInfo(queries[0], blockHeader, blockRootHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return results, blockInfo, nil
+}
+
+func checkQueriesBatch(queries []*process.SCQuery) error {
+	if len(queries) == 0 {
+		return fmt.Errorf("%w of queries", process.ErrNilOrEmptyList)
+	}
+
+	for i, query := range queries {
+		if query == nil {
+			return fmt.Errorf("%w at index %d", process.ErrNilSCQuery, i)
+		}
+		if !haveSameBlockCoordinates(queries[0], query) {
+			return fmt.Errorf("%w at index %d", process.ErrDifferentBlockCoordinatesInQueries, i)
+		}
+	}
+
+	return nil
+}
+
+func haveSameBlockCoordinates(first *process.SCQuery, second *process.SCQuery) bool {
+	return first.BlockNonce == second.BlockNonce &&
+		bytes.Equal(first.BlockHash, second.BlockHash) &&
+		bytes.Equal(first.BlockRootHash, second.BlockRootHash) &&
+		first.HintEpoch == second.HintEpoch &&
+		first.ShouldBeSynced == second.ShouldBeSynced
+}
+
+func (service *SCQueryService) runQuery(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error) {
+	if query.ScAddress == nil {
+		return nil, process.ErrNilScAddress
+	}
+	if len(query.FuncName) == 0 {
+		return nil, process.ErrEmptyFunctionName
+	}
+
+	return service.runScCall(query, gasPrice)
+}
+
 func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, common.BlockInfo, error) {
-	logQueryService.Trace("executeScCall", "address", query.ScAddress, "function", query.FuncName, "blockNonce", query.BlockNonce.Value, "blockHash", query.BlockHash)
+	blockHeader, blockRootHash, err := service.prepareStateForQuery(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vmOutput, err := service.runScCall(query, gasPrice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockInfo, err := service.createBlockInfo(query, blockHeader, blockRootHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vmOutput, blockInfo, nil
+}
+
+func (service *SCQueryService) prepareStateForQuery(query *process.SCQuery) (data.HeaderHandler, []byte, error) {
+	logQueryService.Trace("prepareStateForQuery", "blockNonce", query.BlockNonce.Value, "blockHash", query.BlockHash, "blockRootHash", query.BlockRootHash)
 
 	shouldEarlyExitBecauseOfSyncState := query.ShouldBeSynced && service.bootstrapper.GetNodeState() == common.NsNotSynchronized
 	if shouldEarlyExitBecauseOfSyncState {
@@ -202,13 +295,19 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 			return nil, nil, err
 		}
 
-		err = service.recreateTrie(blockRootHash, blockHeader)
+		err = service.recreateTrie(blockRootHash, blockHeader, query)
 		if err != nil {
 			return nil, nil, err
 		}
 		service.blockChainHook.SetCurrentHeader(blockHeader)
 	}
 
+	return blockHeader, blockRootHash, nil
+}
+
+func (service *SCQueryService) runScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error) {
+	logQueryService.Trace("runScCall", "address", query.ScAddress, "function", query.FuncName)
+
 	shouldCheckRootHashChanges := query.SameScState
 	rootHashBeforeExecution := make([]byte, 0)
 
@@ -220,7 +319,7 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	vm, _, err := scrCommon.FindVMByScAddress(service.vmContainer, query.ScAddress)
 	if err != nil {
 		service.wasmVMChangeLocker.RUnlock()
-		return nil, nil, err
+		return nil, err
 	}
 
 	query = prepareScQuery(query)
@@ -228,30 +327,40 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	vmOutput, err := vm.RunSmartContractCall(vmInput)
 	service.wasmVMChangeLocker.RUnlock()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	if query.SameScState {
 		err = service.checkForRootHashChanges(rootHashBeforeExecution)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 	}
 
+	return vmOutput, nil
+}
+
+func (service *SCQueryService) createBlockInfo(query *process.SCQuery, blockHeader data.HeaderHandler, blockRootHash []byte) (common.BlockInfo, error) {
+	if len(query.BlockRootHash) > 0 {
+		// the block which produced the provided root hash is not known
+		return holders.NewBlockInfo(nil, 0, blockRootHash), nil
+	}
+
+	var err error
 	var blockHash []byte
 	var blockNonce uint64
 	if !check.IfNil(blockHeader) {
 		blockNonce = blockHeader.GetNonce()
 		blockHash, err = core.CalculateHash(service.marshaller, service.hasher, blockHeader)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 	}
-	blockInfo := holders.NewBlockInfo(blockHash, blockNonce, blockRootHash)
-	return vmOutput, blockInfo, nil
+
+	return holders.NewBlockInfo(blockHash, blockNonce, blockRootHash), nil
 }
 
-func (service *SCQueryService) recreateTrie(blockRootHash []byte, blockHeader data.HeaderHandler) error {
+func (service *SCQueryService) recreateTrie(blockRootHash []byte, blockHeader data.HeaderHandler, query *process.SCQuery) error {
 	if check.IfNil(blockHeader) {
 		return process.ErrNilBlockHeader
 	}
@@ -260,15 +369,51 @@ func (service *SCQueryService) recreateTrie(blockRootHash []byte, blockHeader da
 
 	rootHashHolder := holders.NewDefaultRootHashesHolder(blockRootHash)
 	if service.isInHistoricalBalancesMode {
-		rootHashHolder = holders.NewRootHashHolder(blockRootHash, core.OptionalUint32{Value: blockHeader.GetEpoch(), HasValue: true})
+		epoch := core.OptionalUint32{Value: blockHeader.GetEpoch(), HasValue: true}
+		if len(query.BlockRootHash) > 0 {
+			// the epoch of the block which produced the root hash is only known if provided as hint
+			epoch = query.HintEpoch
+		}
+
+		rootHashHolder = holders.NewRootHashHolder(blockRootHash, epoch)
+	}
+
+	if service.isTrieRecreatedOn(rootHashHolder) {
+		logQueryService.Trace("skipping RecreateTrie, the trie is already on the requested root hash",
+			"block", blockHeader.GetNonce(), "rootHashHolder", rootHashHolder)
+		return nil
 	}
 
 	logQueryService.Trace("calling RecreateTrie", "block", blockHeader.GetNonce(), "rootHashHolder", rootHashHolder)
-	return accountsAdapter.RecreateTrie(rootHashHolder)
+	err := accountsAdapter.RecreateTrie(rootHashHolder)
+	if err != nil {
+		service.recreatedRootHashHolder = nil
+		return err
+	}
+
+	service.recreatedRootHashHolder = rootHashHolder
+
+	return nil
+}
+
+// isTrieRecreatedOn returns true if the last recreated trie has the same root hash and epoch as the provided ones.
+// The accounts adapter is used only by this service, so its trie cannot be changed between queries by other components
+func (service *SCQueryService) isTrieRecreatedOn(rootHashHolder common.RootHashHolder) bool {
+	if check.IfNil(service.recreatedRootHashHolder) {
+		return false
+	}
+
+	return bytes.Equal(service.recreatedRootHashHolder.GetRootHash(), rootHashHolder.GetRootHash()) &&
+		service.recreatedRootHashHolder.GetEpoch() == rootHashHolder.GetEpoch()
 }
 
 // TODO: extract duplicated code with nodeBlocks.go
 func (service *SCQueryService) extractBlockHeaderAndRootHash(query *process.SCQuery) (data.HeaderHandler, []byte, error) {
+	if len(query.BlockRootHash) > 0 {
+		// the block which produced the root hash cannot be inferred, so the current block is used as execution context
+		return service.mainBlockChain.GetCurrentBlockHeader(), query.BlockRootHash, nil
+	}
+
 	if len(query.BlockHash) > 0 {
 		currentHeader, err := service.getBlockHeaderByHash(query.BlockHash)
 		if err != nil {
@@ -290,6 +435,13 @@ func (service *SCQueryService) extractBlockHeaderAndRootHash(query *process.SCQu
 	return service.mainBlockChain.GetCurrentBlockHeader(), service.mainBlockChain.GetCurrentBlockRootHash(), nil
 }
 
+// GetQueryRootHash returns the root hash of the state on which the provided query is executed
+func (service *SCQueryService) GetQueryRootHash(query *process.SCQuery) ([]byte, error) {
+	_, rootHash, err := service.extractBlockHeaderAndRootHash(query)
+
+	return rootHash, err
+}
+
 func (service *SCQueryService) getRootHashForBlock(currentHeader data.HeaderHandler) (data.HeaderHandler, []byte, error) {
 	blockHeader, _, err := service.getBlockHeaderByNonce(currentHeader.GetNonce() + 1)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -570,6 +571,155 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 		assert.False(t, recreateTrieFromEpochWasCalled)
 		assert.Nil(t, err)
 	})
+	t.Run("block root hash should work - in deep history mode", func(t *testing.T) {
+		t.Parallel()
+
+		runWasCalled := false
+		providedRootHash := []byte("provided root hash")
+		hintEpoch := core.OptionalUint32{Value: 7, HasValue: true}
+
+		mockVM := &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+				runWasCalled = true
+				assert.Equal(t, funcName, input.Function)
+
+				return &vmcommon.VMOutput{
+					ReturnCode: vmcommon.Ok,
+				}, nil
+			},
+		}
+		argsNewSCQuery := createMockArgumentsForSCQuery()
+		argsNewSCQuery.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		}
+		argsNewSCQuery.EconomicsFee = &economicsmocks.EconomicsHandlerStub{
+			MaxGasLimitPerBlockCalled: func(_ uint32) uint64 {
+				return uint64(math.MaxUint64)
+			},
+		}
+		argsNewSCQuery.MainBlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{
+					Nonce: 100,
+					Epoch: 12,
+				}
+			},
+		}
+		argsNewSCQuery.StorageService = &storageStubs.ChainStorerStub{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+				require.Fail(t, "should not search for headers")
+				return nil, nil
+			},
+		}
+
+		recreateTrieWasCalled := false
+		argsNewSCQuery.BlockChainHook = &testscommon.BlockChainHookStub{
+			GetAccountsAdapterCalled: func() state.AccountsAdapter {
+				return &stateMocks.AccountsStub{
+					RecreateTrieCalled: func(options common.RootHashHolder) error {
+						recreateTrieWasCalled = true
+						assert.Equal(t, providedRootHash, options.GetRootHash())
+						assert.Equal(t, hintEpoch, options.GetEpoch())
+						return nil
+					},
+				}
+			},
+		}
+		argsNewSCQuery.IsInHistoricalBalancesMode = true
+
+		target, _ := NewSCQueryService(argsNewSCQuery)
+
+		query := process.SCQuery{
+			ScAddress:     scAddress,
+			FuncName:      funcName,
+			BlockRootHash: providedRootHash,
+			HintEpoch:     hintEpoch,
+		}
+
+		_, blockInfo, err := target.ExecuteQuery(&query)
+		assert.Nil(t, err)
+		assert.True(t, runWasCalled)
+		assert.True(t, recreateTrieWasCalled)
+		assert.Equal(t, providedRootHash, blockInfo.GetRootHash())
+		assert.Empty(t, blockInfo.GetHash())
+		assert.Zero(t, blockInfo.GetNonce())
+	})
+	t.Run("block root hash without hint epoch - in deep history mode", func(t *testing.T) {
+		t.Parallel()
+
+		providedRootHash := []byte("provided root hash")
+		argsNewSCQuery := createMockArgumentsForSCQuery()
+		argsNewSCQuery.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return &mock.VMExecutionHandlerStub{}, nil
+			},
+		}
+		argsNewSCQuery.MainBlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{
+					Epoch: 12,
+				}
+			},
+		}
+
+		recreateTrieWasCalled := false
+		argsNewSCQuery.BlockChainHook = &testscommon.BlockChainHookStub{
+			GetAccountsAdapterCalled: func() state.AccountsAdapter {
+				return &stateMocks.AccountsStub{
+					RecreateTrieCalled: func(options common.RootHashHolder) error {
+						recreateTrieWasCalled = true
+						assert.Equal(t, providedRootHash, options.GetRootHash())
+						assert.False(t, options.GetEpoch().HasValue)
+						return nil
+					},
+				}
+			},
+		}
+		argsNewSCQuery.IsInHistoricalBalancesMode = true
+
+		target, _ := NewSCQueryService(argsNewSCQuery)
+
+		query := process.SCQuery{
+			ScAddress:     scAddress,
+			FuncName:      funcName,
+			BlockRootHash: providedRootHash,
+		}
+
+		_, _, err := target.ExecuteQuery(&query)
+		assert.Nil(t, err)
+		assert.True(t, recreateTrieWasCalled)
+	})
+}
+
+func TestSCQueryService_GetQueryRootHash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("query on root hash should return the provided root hash", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		rootHash, err := service.GetQueryRootHash(&process.SCQuery{BlockRootHash: []byte("root hash")})
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("root hash"), rootHash)
+	})
+	t.Run("query on the current state should return the current root hash", func(t *testing.T) {
+		t.Parallel()
+
+		argsNewSCQuery := createMockArgumentsForSCQuery()
+		argsNewSCQuery.MainBlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockRootHashCalled: func() []byte {
+				return []byte("current root hash")
+			},
+		}
+		service, _ := NewSCQueryService(argsNewSCQuery)
+
+		rootHash, err := service.GetQueryRootHash(&process.SCQuery{})
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("current root hash"), rootHash)
+	})
 }
 
 func TestSCQueryService_RecreateTrie(t *testing.T) {
@@ -592,7 +742,7 @@ func TestSCQueryService_RecreateTrie(t *testing.T) {
 		}
 
 		service, _ := NewSCQueryService(argsNewSCQuery)
-		err := service.recreateTrie(testRootHash, nil)
+		err := service.recreateTrie(testRootHash, nil, &process.SCQuery{})
 		assert.ErrorIs(t, err, process.ErrNilBlockHeader)
 	})
 	t.Run("should call RecreateTrieFromEpoch if in deep history mode", func(t *testing.T) {
@@ -632,7 +782,7 @@ func TestSCQueryService_RecreateTrie(t *testing.T) {
 		service, _ := NewSCQueryService(argsNewSCQuery)
 
 		// For genesis block, RecreateTrieFromEpoch should be called
-		err := service.recreateTrie(testRootHash, &block.Header{})
+		err := service.recreateTrie(testRootHash, &block.Header{}, &process.SCQuery{})
 		assert.Nil(t, err)
 		assert.True(t, recreateTrieFromEpochWasCalled)
 		assert.False(t, recreateTrieWasCalled)
@@ -674,11 +824,79 @@ func TestSCQueryService_RecreateTrie(t *testing.T) {
 		service, _ := NewSCQueryService(argsNewSCQuery)
 
 		// For genesis block, RecreateTrieFromEpoch should be called
-		err := service.recreateTrie(testRootHash, &block.Header{})
+		err := service.recreateTrie(testRootHash, &block.Header{}, &process.SCQuery{})
 		assert.Nil(t, err)
 		assert.False(t, recreateTrieFromEpochWasCalled)
 		assert.True(t, recreateTrieWasCalled)
 	})
+	t.Run("should not call RecreateTrie if the trie is already on the same root hash", func(t *testing.T) {
+		t.Parallel()
+
+		numRecreateTrieCalls := 0
+		argsNewSCQuery := createMockArgumentsForSCQuery()
+		argsNewSCQuery.IsInHistoricalBalancesMode = true
+		argsNewSCQuery.BlockChainHook = &testscommon.BlockChainHookStub{
+			GetAccountsAdapterCalled: func() state.AccountsAdapter {
+				return &stateMocks.AccountsStub{
+					RecreateTrieCalled: func(options common.RootHashHolder) error {
+						numRecreateTrieCalls++
+						return nil
+					},
+				}
+			},
+		}
+
+		service, _ := NewSCQueryService(argsNewSCQuery)
+
+		err := service.recreateTrie(testRootHash, &block.Header{Epoch: 3}, &process.SCQuery{})
+		assert.Nil(t, err)
+		err = service.recreateTrie(testRootHash, &block.Header{Epoch: 3}, &process.SCQuery{})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numRecreateTrieCalls)
+
+		err = service.recreateTrie(testRootHash, &block.Header{Epoch: 4}, &process.SCQuery{})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numRecreateTrieCalls)
+
+		err = service.recreateTrie([]byte("another root hash"), &block.Header{Epoch: 4}, &process.SCQuery{})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, numRecreateTrieCalls)
+
+		err = service.recreateTrie(testRootHash, &block.Header{Epoch: 4}, &process.SCQuery{})
+		assert.Nil(t, err)
+		assert.Equal(t, 4, numRecreateTrieCalls)
+	})
+	t.Run("should call RecreateTrie again if the previous call failed", func(t *testing.T) {
+		t.Parallel()
+
+		numRecreateTrieCalls := 0
+		expectedErr := errors.New("expected error")
+		argsNewSCQuery := createMockArgumentsForSCQuery()
+		argsNewSCQuery.BlockChainHook = &testscommon.BlockChainHookStub{
+			GetAccountsAdapterCalled: func() state.AccountsAdapter {
+				return &stateMocks.AccountsStub{
+					RecreateTrieCalled: func(options common.RootHashHolder) error {
+						numRecreateTrieCalls++
+						if numRecreateTrieCalls == 2 {
+							return expectedErr
+						}
+
+						return nil
+					},
+				}
+			},
+		}
+
+		service, _ := NewSCQueryService(argsNewSCQuery)
+
+		err := service.recreateTrie(testRootHash, &block.Header{}, &process.SCQuery{})
+		assert.Nil(t, err)
+		err = service.recreateTrie([]byte("another root hash"), &block.Header{}, &process.SCQuery{})
+		assert.Equal(t, expectedErr, err)
+		err = service.recreateTrie([]byte("another root hash"), &block.Header{}, &process.SCQuery{})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, numRecreateTrieCalls)
+	})
 }
 
 func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
@@ -722,6 +940,149 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 	assert.Equal(t, d[1], vmOutput.ReturnData[1])
 }
 
+func TestSCQueryService_ExecuteQueries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("queries not allowed yet should error", func(t *testing.T) {
+		t.Parallel()
+
+		argsNewSCQuery := createMockArgumentsForSCQuery()
+		argsNewSCQuery.AllowExternalQueriesChan = make(chan struct{})
+		target, _ := NewSCQueryService(argsNewSCQuery)
+
+		results, blockInfo, err := target.ExecuteQueries([]*process.SCQuery{{}})
+		require.Equal(t, process.ErrQueriesNotAllowedYet, err)
+		require.Nil(t, results)
+		require.Nil(t, blockInfo)
+	})
+	t.Run("empty queries should error", func(t *testing.T) {
+		t.Parallel()
+
+		target, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		results, _, err := target.ExecuteQueries(nil)
+		require.ErrorIs(t, err, process.ErrNilOrEmptyList)
+		require.Nil(t, results)
+	})
+	t.Run("nil query should error", func(t *testing.T) {
+		t.Parallel()
+
+		target, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		results, _, err := target.ExecuteQueries([]*process.SCQuery{{}, nil})
+		require.ErrorIs(t, err, process.ErrNilSCQuery)
+		require.Nil(t, results)
+	})
+	t.Run("different block coordinates should error", func(t *testing.T) {
+		t.Parallel()
+
+		target, _ := NewSCQueryService(createMockArgumentsForSCQuery())
+
+		queries := []*process.SCQuery{
+			{BlockNonce: core.OptionalUint64{Value: 1, HasValue: true}},
+			{BlockNonce: core.OptionalUint64{Value: 2, HasValue: true}},
+		}
+		results, _, err := target.ExecuteQueries(queries)
+		require.ErrorIs(t, err, process.ErrDifferentBlockCoordinatesInQueries)
+		require.Nil(t, results)
+	})
+	t.Run("node not synced should error", func(t *testing.T) {
+		t.Parallel()
+
+		argsNewSCQuery := createMockArgumentsForSCQuery()
+		argsNewSCQuery.Bootstrapper = &mock.BootstrapperStub{
+			GetNodeStateCalled: func() common.NodeState {
+				return common.NsNotSynchronized
+			},
+		}
+		target, _ := NewSCQueryService(argsNewSCQuery)
+
+		results, _, err := target.ExecuteQueries([]*process.SCQuery{{ShouldBeSynced: true}})
+		require.Equal(t, process.ErrNodeIsNotSynced, err)
+		require.Nil(t, results)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		providedRootHash := []byte("provided root hash")
+		mockVM := &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+				if input.Function == "failing" {
+					return nil, expectedErr
+				}
+
+				return &vmcommon.VMOutput{
+					ReturnCode: vmcommon.Ok,
+					ReturnData: [][]byte{[]byte(input.Function)},
+				}, nil
+			},
+		}
+		argsNewSCQuery := createMockArgumentsForSCQuery()
+		argsNewSCQuery.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		}
+		argsNewSCQuery.MainBlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{
+					Nonce: 37,
+				}
+			},
+			GetCurrentBlockRootHashCalled: func() []byte {
+				return providedRootHash
+			},
+		}
+		numRecreateTrieCalls := 0
+		argsNewSCQuery.BlockChainHook = &testscommon.BlockChainHookStub{
+			GetAccountsAdapterCalled: func() state.AccountsAdapter {
+				return &stateMocks.AccountsStub{
+					RecreateTrieCalled: func(options common.RootHashHolder) error {
+						numRecreateTrieCalls++
+						require.Equal(t, providedRootHash, options.GetRootHash())
+						return nil
+					},
+				}
+			},
+		}
+
+		target, _ := NewSCQueryService(argsNewSCQuery)
+
+		queries := []*process.SCQuery{
+			{
+				ScAddress: []byte(DummyScAddress),
+				FuncName:  "first",
+			},
+			{
+				ScAddress: []byte(DummyScAddress),
+				FuncName:  "failing",
+			},
+			{
+				ScAddress: []byte(DummyScAddress),
+			},
+			{
+				ScAddress: []byte(DummyScAddress),
+				FuncName:  "second",
+			},
+		}
+		results, blockInfo, err := target.ExecuteQueries(queries)
+		require.Nil(t, err)
+		require.Equal(t, 1, numRecreateTrieCalls)
+		require.Equal(t, uint64(37), blockInfo.GetNonce())
+		require.Equal(t, providedRootHash, blockInfo.GetRootHash())
+
+		require.Equal(t, 4, len(results))
+		require.Nil(t, results[0].Err)
+		require.Equal(t, [][]byte{[]byte("first")}, results[0].VMOutput.ReturnData)
+		require.Equal(t, expectedErr, results[1].Err)
+		require.Nil(t, results[1].VMOutput)
+		require.Equal(t, process.ErrEmptyFunctionName, results[2].Err)
+		require.Nil(t, results[3].Err)
+		require.Equal(t, [][]byte{[]byte("second")}, results[3].VMOutput.ReturnData)
+	})
+}
+
 func TestExecuteQuery_GasProvidedShouldBeApplied(t *testing.T) {
 	t.Parallel()
 
@@ -814,6 +814,16 @@ func (bbt *baseBlockTrack) initNotarizedHeaders(startHeaders map[uint32]data.Hea
 	return nil
 }
 
+// ResetNotarizedHeaders drops all the cross and self notarized headers and initializes them with the provided start headers
+func (bbt *baseBlockTrack) ResetNotarizedHeaders(crossStartHeaders map[uint32]data.HeaderHandler, selfStartHeaders map[uint32]data.HeaderHandler) error {
+	err := bbt.crossNotarizer.InitNotarizedHeaders(crossStartHeaders)
+	if err != nil {
+		return err
+	}
+
+	return bbt.selfNotarizer.InitNotarizedHeaders(selfStartHeaders)
+}
+
 func (bbt *baseBlockTrack) doWhitelistWithMetaBlockIfNeeded(metablock data.MetaHeaderHandler) {
 	selfShardID := bbt.shardCoordinator.SelfId()
 	if selfShardID == core.MetachainShardId {
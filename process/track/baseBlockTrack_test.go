@@ -2209,6 +2209,48 @@ func TestInitNotarizedHeaders_ShouldWork(t *testing.T) {
 	assert.Equal(t, selfStartHeader, lastSelfNotarizedHeaderForMetachain)
 }
 
+func TestResetNotarizedHeaders_ShouldErrNotarizedHeadersSliceIsNil(t *testing.T) {
+	t.Parallel()
+
+	shardArguments := CreateShardTrackerMockArguments()
+	sbt, _ := track.NewShardBlockTrack(shardArguments)
+
+	err := sbt.ResetNotarizedHeaders(nil, make(map[uint32]data.HeaderHandler))
+	assert.Equal(t, process.ErrNotarizedHeadersSliceIsNil, err)
+
+	err = sbt.ResetNotarizedHeaders(make(map[uint32]data.HeaderHandler), nil)
+	assert.Equal(t, process.ErrNotarizedHeadersSliceIsNil, err)
+}
+
+func TestResetNotarizedHeaders_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	shardArguments := CreateShardTrackerMockArguments()
+	sbt, _ := track.NewShardBlockTrack(shardArguments)
+
+	sbt.AddCrossNotarizedHeader(core.MetachainShardId, &block.MetaBlock{Nonce: 1}, []byte("hash1"))
+	sbt.AddCrossNotarizedHeader(core.MetachainShardId, &block.MetaBlock{Nonce: 2}, []byte("hash2"))
+	sbt.AddSelfNotarizedHeader(core.MetachainShardId, &block.Header{Nonce: 1}, []byte("hash3"))
+
+	crossStartHeader := &block.MetaBlock{Nonce: 3}
+	selfStartHeader := &block.Header{Nonce: 4}
+	err := sbt.ResetNotarizedHeaders(
+		map[uint32]data.HeaderHandler{core.MetachainShardId: crossStartHeader},
+		map[uint32]data.HeaderHandler{core.MetachainShardId: selfStartHeader},
+	)
+	assert.Nil(t, err)
+
+	firstCrossNotarizedHeader, _, _ := sbt.GetCrossNotarizedHeader(core.MetachainShardId, 0)
+	_, _, err = sbt.GetCrossNotarizedHeader(core.MetachainShardId, 1)
+	assert.Equal(t, crossStartHeader, firstCrossNotarizedHeader)
+	assert.Equal(t, track.ErrNotarizedHeaderOffsetIsOutOfBound, err)
+
+	firstSelfNotarizedHeader, _, _ := sbt.GetSelfNotarizedHeader(core.MetachainShardId, 0)
+	_, _, err = sbt.GetSelfNotarizedHeader(core.MetachainShardId, 1)
+	assert.Equal(t, selfStartHeader, firstSelfNotarizedHeader)
+	assert.Equal(t, track.ErrNotarizedHeaderOffsetIsOutOfBound, err)
+}
+
 func TestComputeLongestChain_ShouldWorkWithLongestChain(t *testing.T) {
 	t.Parallel()
 
@@ -9,4 +9,35 @@ import (
 type SimulationResultsWithVMOutput struct {
 	transaction.SimulationResults
 	VMOutput *vmcommon.VMOutput `json:"-"`
+	Trace    *ExecutionStep     `json:"trace,omitempty"`
+}
+
+// ExecutionStep is the data transfer object which holds one step of a traced transaction execution, together with
+// the steps triggered by it
+type ExecutionStep struct {
+	Type          string           `json:"type"`
+	CallType      string           `json:"callType,omitempty"`
+	Caller        string           `json:"caller,omitempty"`
+	Callee        string           `json:"callee,omitempty"`
+	Function      string           `json:"function,omitempty"`
+	Arguments     []string         `json:"arguments,omitempty"`
+	Value         string           `json:"value,omitempty"`
+	GasProvided   uint64           `json:"gasProvided,omitempty"`
+	GasUsed       uint64           `json:"gasUsed,omitempty"`
+	ReturnCode    string           `json:"returnCode,omitempty"`
+	ReturnMessage string           `json:"returnMessage,omitempty"`
+	Error         string           `json:"error,omitempty"`
+	StorageReads  []*StorageAccess `json:"storageReads,omitempty"`
+	StorageWrites []*StorageAccess `json:"storageWrites,omitempty"`
+	Calls         []*ExecutionStep `json:"calls,omitempty"`
+}
+
+// StorageAccess is the data transfer object which holds a read or a write operation on an account data trie.
+// Keys and values are hex encoded
+type StorageAccess struct {
+	Address  string `json:"address"`
+	Key      string `json:"key"`
+	Value    string `json:"value,omitempty"`
+	OldValue string `json:"oldValue,omitempty"`
+	NewValue string `json:"newValue,omitempty"`
 }
@@ -0,0 +1,516 @@
+package transactionEvaluator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+)
+
+const (
+	// StepTypeTransaction is the type of the root step of a trace
+	StepTypeTransaction = "transaction"
+	// StepTypeSmartContractDeploy is the type of the step that runs a smart contract deployment on a VM
+	StepTypeSmartContractDeploy = "scDeploy"
+	// StepTypeSmartContractCall is the type of the step that runs a smart contract call on a VM
+	StepTypeSmartContractCall = "scCall"
+	// StepTypeBuiltInFunction is the type of the step that runs a built-in function
+	StepTypeBuiltInFunction = "builtInFunction"
+	// StepTypeVMInternalCall is the type of the steps reported by a VM as being executed inside it
+	StepTypeVMInternalCall = "vmInternalCall"
+)
+
+const (
+	transferValueOnlyIdentifier = "transferValueOnly"
+	// the synchronous calls made inside a VM are logged without a call type
+	executeOnDestContextCallType = "ExecuteOnDestContext"
+	executeOnSameContextCallType = "ExecuteOnSameContext"
+)
+
+type accountsGetter interface {
+	GetExistingAccount(address []byte) (vmcommon.AccountHandler, error)
+	IsInterfaceNil() bool
+}
+
+type traceFrame struct {
+	step *txSimData.ExecutionStep
+	// recordAccountWrites is set for built-in functions, as they write directly into the accounts. The writes made
+	// by a VM are taken from its output, at the end of its execution
+	recordAccountWrites bool
+}
+
+type executionTracer struct {
+	mut             sync.Mutex
+	pubKeyConverter core.PubkeyConverter
+	argsParser      process.CallArgumentsParser
+	frames          []*traceFrame
+	root            *txSimData.ExecutionStep
+}
+
+// NewExecutionTracer returns a new instance of an execution tracer. The tracer records the execution steps of a
+// simulated transaction only between StartTrace and StopTrace calls
+func NewExecutionTracer(pubKeyConverter core.PubkeyConverter) (*executionTracer, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	return &executionTracer{
+		pubKeyConverter: pubKeyConverter,
+		argsParser:      parsers.NewCallArgsParser(),
+	}, nil
+}
+
+// StartTrace will start recording the execution steps of the provided transaction
+func (et *executionTracer) StartTrace(tx *transaction.Transaction) {
+	root := &txSimData.ExecutionStep{
+		Type:        StepTypeTransaction,
+		Caller:      et.encodeAddress(tx.SndAddr),
+		Callee:      et.encodeAddress(tx.RcvAddr),
+		Value:       bigIntToString(tx.Value),
+		GasProvided: tx.GasLimit,
+	}
+	function, args, err := et.argsParser.ParseData(string(tx.Data))
+	if err == nil {
+		root.Function = function
+		root.Arguments = encodeArguments(args)
+	}
+
+	et.mut.Lock()
+	et.root = root
+	et.frames = []*traceFrame{{step: root}}
+	et.mut.Unlock()
+}
+
+// StopTrace will stop the recording and will return the root of the recorded execution tree
+func (et *executionTracer) StopTrace() *txSimData.ExecutionStep {
+	et.mut.Lock()
+	defer et.mut.Unlock()
+
+	root := et.root
+	et.root = nil
+	et.frames = nil
+
+	return root
+}
+
+func (et *executionTracer) isTracing() bool {
+	et.mut.Lock()
+	defer et.mut.Unlock()
+
+	return et.root != nil
+}
+
+func (et *executionTracer) enterStep(step *txSimData.ExecutionStep, recordAccountWrites bool) bool {
+	et.mut.Lock()
+	defer et.mut.Unlock()
+
+	if et.root == nil {
+		return false
+	}
+
+	parent := et.frames[len(et.frames)-1].step
+	parent.Calls = append(parent.Calls, step)
+	et.frames = append(et.frames, &traceFrame{
+		step:                step,
+		recordAccountWrites: recordAccountWrites,
+	})
+
+	return true
+}
+
+func (et *executionTracer) exitStep() {
+	et.mut.Lock()
+	defer et.mut.Unlock()
+
+	// the root frame is removed only by StopTrace
+	if len(et.frames) > 1 {
+		et.frames = et.frames[:len(et.frames)-1]
+	}
+}
+
+func (et *executionTracer) enterVMExecution(input *vmcommon.VMInput, recipient []byte, function string, isDeploy bool) *txSimData.ExecutionStep {
+	stepType := StepTypeSmartContractCall
+	if isDeploy {
+		stepType = StepTypeSmartContractDeploy
+	}
+
+	step := et.createStepFromVMInput(stepType, input, recipient, function)
+	if !et.enterStep(step, false) {
+		return nil
+	}
+
+	return step
+}
+
+func (et *executionTracer) exitVMExecution(step *txSimData.ExecutionStep, vmOutput *vmcommon.VMOutput, err error, accounts accountsGetter) {
+	if step == nil {
+		return
+	}
+
+	et.completeStep(step, vmOutput, err)
+	if vmOutput != nil {
+		step.StorageWrites = append(step.StorageWrites, et.getStorageWritesFromVMOutput(vmOutput, accounts)...)
+		et.addInternalCalls(step, vmOutput)
+	}
+
+	et.exitStep()
+}
+
+// addInternalCalls adds the calls made inside a VM as a tree under the provided step. The VM reports these calls
+// only through its logs, in the order in which they were made, so a call is nested under the latest call received
+// by its caller that did not return yet. The storage accesses and the built-in functions recorded on the provided
+// step are afterward moved under the internal call of the contract that made them
+func (et *executionTracer) addInternalCalls(step *txSimData.ExecutionStep, vmOutput *vmcommon.VMOutput) {
+	internalCalls := et.getInternalCallsFromVMOutput(vmOutput)
+	if len(internalCalls) == 0 {
+		return
+	}
+
+	recordedCalls := step.Calls
+	step.Calls = nil
+
+	stack := []*txSimData.ExecutionStep{step}
+	for _, call := range internalCalls {
+		for len(stack) > 1 && stack[len(stack)-1].Callee != call.Caller {
+			stack = stack[:len(stack)-1]
+		}
+
+		parent := stack[len(stack)-1]
+		parent.Calls = append(parent.Calls, call)
+		stack = append(stack, call)
+	}
+
+	callsByCallee := make(map[string]*txSimData.ExecutionStep)
+	mapInternalCallsByCallee(step.Calls, callsByCallee)
+	delete(callsByCallee, step.Callee)
+
+	step.StorageReads = moveStorageAccesses(step.StorageReads, callsByCallee, func(call *txSimData.ExecutionStep, access *txSimData.StorageAccess) {
+		call.StorageReads = append(call.StorageReads, access)
+	})
+	step.StorageWrites = moveStorageAccesses(step.StorageWrites, callsByCallee, func(call *txSimData.ExecutionStep, access *txSimData.StorageAccess) {
+		call.StorageWrites = append(call.StorageWrites, access)
+	})
+
+	remainingCalls := make([]*txSimData.ExecutionStep, 0, len(recordedCalls))
+	for _, recordedCall := range recordedCalls {
+		internalCall, found := callsByCallee[recordedCall.Caller]
+		if !found {
+			remainingCalls = append(remainingCalls, recordedCall)
+			continue
+		}
+
+		internalCall.Calls = append(internalCall.Calls, recordedCall)
+	}
+	step.Calls = append(remainingCalls, step.Calls...)
+}
+
+// mapInternalCallsByCallee keeps, for each callee, the first internal call received by it
+func mapInternalCallsByCallee(calls []*txSimData.ExecutionStep, callsByCallee map[string]*txSimData.ExecutionStep) {
+	for _, call := range calls {
+		_, exists := callsByCallee[call.Callee]
+		if !exists {
+			callsByCallee[call.Callee] = call
+		}
+
+		mapInternalCallsByCallee(call.Calls, callsByCallee)
+	}
+}
+
+func moveStorageAccesses(
+	accesses []*txSimData.StorageAccess,
+	callsByCallee map[string]*txSimData.ExecutionStep,
+	addToCall func(call *txSimData.ExecutionStep, access *txSimData.StorageAccess),
+) []*txSimData.StorageAccess {
+	remainingAccesses := make([]*txSimData.StorageAccess, 0, len(accesses))
+	for _, access := range accesses {
+		call, found := callsByCallee[access.Address]
+		if !found {
+			remainingAccesses = append(remainingAccesses, access)
+			continue
+		}
+
+		addToCall(call, access)
+	}
+
+	if len(remainingAccesses) == 0 {
+		return nil
+	}
+
+	return remainingAccesses
+}
+
+func (et *executionTracer) enterBuiltInFunction(input *vmcommon.ContractCallInput) *txSimData.ExecutionStep {
+	step := et.createStepFromVMInput(StepTypeBuiltInFunction, &input.VMInput, input.RecipientAddr, input.Function)
+	if !et.enterStep(step, true) {
+		return nil
+	}
+
+	return step
+}
+
+func (et *executionTracer) exitBuiltInFunction(step *txSimData.ExecutionStep, vmOutput *vmcommon.VMOutput, err error) {
+	if step == nil {
+		return
+	}
+
+	et.completeStep(step, vmOutput, err)
+	et.exitStep()
+}
+
+func (et *executionTracer) recordStorageRead(address []byte, key []byte, value []byte) {
+	et.mut.Lock()
+	defer et.mut.Unlock()
+
+	if et.root == nil {
+		return
+	}
+
+	step := et.frames[len(et.frames)-1].step
+	step.StorageReads = append(step.StorageReads, &txSimData.StorageAccess{
+		Address: et.encodeAddress(address),
+		Key:     hex.EncodeToString(key),
+		Value:   hex.EncodeToString(value),
+	})
+}
+
+func (et *executionTracer) shouldRecordAccountWrites() bool {
+	et.mut.Lock()
+	defer et.mut.Unlock()
+
+	if et.root == nil {
+		return false
+	}
+
+	return et.frames[len(et.frames)-1].recordAccountWrites
+}
+
+func (et *executionTracer) recordStorageWrite(address []byte, key []byte, oldValue []byte, newValue []byte) {
+	et.mut.Lock()
+	defer et.mut.Unlock()
+
+	if et.root == nil {
+		return
+	}
+
+	step := et.frames[len(et.frames)-1].step
+	step.StorageWrites = append(step.StorageWrites, et.newStorageWrite(address, key, oldValue, newValue))
+}
+
+func (et *executionTracer) createStepFromVMInput(stepType string, input *vmcommon.VMInput, recipient []byte, function string) *txSimData.ExecutionStep {
+	return &txSimData.ExecutionStep{
+		Type:        stepType,
+		CallType:    input.CallType.ToString(),
+		Caller:      et.encodeAddress(input.CallerAddr),
+		Callee:      et.encodeAddress(recipient),
+		Function:    function,
+		Arguments:   encodeArguments(input.Arguments),
+		Value:       bigIntToString(input.CallValue),
+		GasProvided: input.GasProvided,
+	}
+}
+
+func (et *executionTracer) completeStep(step *txSimData.ExecutionStep, vmOutput *vmcommon.VMOutput, err error) {
+	if err != nil {
+		step.Error = err.Error()
+	}
+	if vmOutput == nil {
+		return
+	}
+
+	step.ReturnCode = vmOutput.ReturnCode.String()
+	step.ReturnMessage = vmOutput.ReturnMessage
+	if vmOutput.GasRemaining <= step.GasProvided {
+		step.GasUsed = step.GasProvided - vmOutput.GasRemaining
+	}
+}
+
+// getStorageWritesFromVMOutput returns the storage writes of a VM execution. The old values are read from the
+// accounts before the VM output is applied on them
+func (et *executionTracer) getStorageWritesFromVMOutput(vmOutput *vmcommon.VMOutput, accounts accountsGetter) []*txSimData.StorageAccess {
+	writes := make([]*txSimData.StorageAccess, 0)
+	for _, outputAccount := range sortedOutputAccounts(vmOutput) {
+		keys := make([]string, 0, len(outputAccount.StorageUpdates))
+		for key, update := range outputAccount.StorageUpdates {
+			if update.Written {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			update := outputAccount.StorageUpdates[key]
+			oldValue := retrieveValueWithoutTracing(accounts, outputAccount.Address, update.Offset)
+			writes = append(writes, et.newStorageWrite(outputAccount.Address, update.Offset, oldValue, update.Data))
+		}
+	}
+
+	return writes
+}
+
+// getInternalCallsFromVMOutput returns the calls made inside a VM, as reported by the VM in its logs, in the order in
+// which they were made. The gas provided is reported by the VM only for the calls that created an output transfer,
+// the synchronous calls being executed directly with the gas of their caller
+func (et *executionTracer) getInternalCallsFromVMOutput(vmOutput *vmcommon.VMOutput) []*txSimData.ExecutionStep {
+	calls := make([]*txSimData.ExecutionStep, 0)
+	transfersIndexes := make(map[string]int)
+	for _, logEntry := range vmOutput.Logs {
+		if logEntry == nil || string(logEntry.Identifier) != transferValueOnlyIdentifier {
+			continue
+		}
+		if len(logEntry.Topics) < 2 || len(logEntry.Data) < 1 {
+			continue
+		}
+
+		callData := logEntry.Data
+		destination := logEntry.Topics[1]
+		step := &txSimData.ExecutionStep{
+			Type:     StepTypeVMInternalCall,
+			CallType: string(callData[0]),
+			Caller:   et.encodeAddress(logEntry.Address),
+			Callee:   et.encodeAddress(destination),
+			Value:    bigIntToString(bigIntFromBytes(logEntry.Topics[0])),
+		}
+		if len(callData) > 1 {
+			step.Function = string(callData[1])
+			step.Arguments = encodeArguments(callData[2:])
+		}
+
+		switch step.CallType {
+		case "":
+			step.CallType = executeOnDestContextCallType
+		case executeOnSameContextCallType:
+		default:
+			// the n-th logged call between two addresses corresponds to the n-th output transfer between them
+			transferKey := string(logEntry.Address) + string(destination)
+			step.GasProvided = getGasLimitOfOutputTransfer(vmOutput, destination, logEntry.Address, transfersIndexes[transferKey])
+			transfersIndexes[transferKey]++
+		}
+
+		calls = append(calls, step)
+	}
+
+	return calls
+}
+
+func (et *executionTracer) newStorageWrite(address []byte, key []byte, oldValue []byte, newValue []byte) *txSimData.StorageAccess {
+	return &txSimData.StorageAccess{
+		Address:  et.encodeAddress(address),
+		Key:      hex.EncodeToString(key),
+		OldValue: hex.EncodeToString(oldValue),
+		NewValue: hex.EncodeToString(newValue),
+	}
+}
+
+func (et *executionTracer) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return et.pubKeyConverter.SilentEncode(address, log)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (et *executionTracer) IsInterfaceNil() bool {
+	return et == nil
+}
+
+func sortedOutputAccounts(vmOutput *vmcommon.VMOutput) []*vmcommon.OutputAccount {
+	outputAccounts := make([]*vmcommon.OutputAccount, 0, len(vmOutput.OutputAccounts))
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		outputAccounts = append(outputAccounts, outputAccount)
+	}
+
+	sort.Slice(outputAccounts, func(i, j int) bool {
+		return bytes.Compare(outputAccounts[i].Address, outputAccounts[j].Address) < 0
+	})
+
+	return outputAccounts
+}
+
+func getGasLimitOfOutputTransfer(vmOutput *vmcommon.VMOutput, destination []byte, sender []byte, index int) uint64 {
+	outputAccount, found := vmOutput.OutputAccounts[string(destination)]
+	if !found {
+		return 0
+	}
+
+	transfers := make([]vmcommon.OutputTransfer, 0, len(outputAccount.OutputTransfers))
+	for _, outputTransfer := range outputAccount.OutputTransfers {
+		if bytes.Equal(outputTransfer.SenderAddress, sender) {
+			transfers = append(transfers, outputTransfer)
+		}
+	}
+	if index >= len(transfers) {
+		return 0
+	}
+
+	sort.SliceStable(transfers, func(i, j int) bool {
+		return transfers[i].Index < transfers[j].Index
+	})
+
+	return transfers[index].GasLimit
+}
+
+func encodeArguments(args [][]byte) []string {
+	if len(args) == 0 {
+		return nil
+	}
+
+	encoded := make([]string, 0, len(args))
+	for _, arg := range args {
+		encoded = append(encoded, hex.EncodeToString(arg))
+	}
+
+	return encoded
+}
+
+// retrieveValueWithoutTracing returns the current value of the provided key from the account's data trie, without
+// recording it as a storage read
+func retrieveValueWithoutTracing(accounts accountsGetter, address []byte, key []byte) []byte {
+	if check.IfNil(accounts) {
+		return nil
+	}
+
+	account, err := accounts.GetExistingAccount(address)
+	if err != nil {
+		return nil
+	}
+
+	var userAccount state.UserAccountHandler
+	switch acc := account.(type) {
+	case *tracedUserAccount:
+		userAccount = acc.tracedAccountHandler
+	case state.UserAccountHandler:
+		userAccount = acc
+	default:
+		return nil
+	}
+
+	value, _, err := userAccount.RetrieveValue(key)
+	if err != nil {
+		return nil
+	}
+
+	return value
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil || value.Sign() == 0 {
+		return ""
+	}
+
+	return value.String()
+}
+
+func bigIntFromBytes(value []byte) *big.Int {
+	return big.NewInt(0).SetBytes(value)
+}
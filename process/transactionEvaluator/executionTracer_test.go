@@ -0,0 +1,438 @@
+package transactionEvaluator
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	senderAddress   = []byte("sender__________________________")
+	contractAddress = []byte("contract________________________")
+	otherAddress    = []byte("other___________________________")
+)
+
+func createUserAccountWithStorage(address []byte, storage map[string][]byte) *stateMock.UserAccountStub {
+	return &stateMock.UserAccountStub{
+		Address: address,
+		RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+			return storage[string(key)], 0, nil
+		},
+		SaveKeyValueCalled: func(key []byte, value []byte) error {
+			storage[string(key)] = value
+			return nil
+		},
+	}
+}
+
+func createAccountsWithStorage(accounts map[string]vmcommon.AccountHandler) *stateMock.AccountsStub {
+	return &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			account, found := accounts[string(address)]
+			if !found {
+				return nil, errors.New("account not found")
+			}
+
+			return account, nil
+		},
+	}
+}
+
+func TestNewExecutionTracer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, err := NewExecutionTracer(nil)
+		require.Equal(t, ErrNilPubkeyConverter, err)
+		require.True(t, check.IfNil(tracer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, err := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32))
+		require.Nil(t, err)
+		require.False(t, check.IfNil(tracer))
+		require.False(t, tracer.isTracing())
+	})
+}
+
+func TestExecutionTracer_StartStopTrace(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32))
+	tracer.StartTrace(&transaction.Transaction{
+		SndAddr:  senderAddress,
+		RcvAddr:  contractAddress,
+		Value:    big.NewInt(10),
+		GasLimit: 5000,
+		Data:     []byte("add@05"),
+	})
+	require.True(t, tracer.isTracing())
+
+	trace := tracer.StopTrace()
+	require.False(t, tracer.isTracing())
+	require.Equal(t, &txSimData.ExecutionStep{
+		Type:        StepTypeTransaction,
+		Caller:      hex.EncodeToString(senderAddress),
+		Callee:      hex.EncodeToString(contractAddress),
+		Function:    "add",
+		Arguments:   []string{"05"},
+		Value:       "10",
+		GasProvided: 5000,
+	}, trace)
+
+	// a second stop should not return anything
+	require.Nil(t, tracer.StopTrace())
+}
+
+func TestExecutionTracer_NotTracingShouldNotRecord(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32))
+	storage := map[string][]byte{"key": []byte("value")}
+	account := newTracedAccount(createUserAccountWithStorage(contractAddress, storage), tracer).(*tracedUserAccount)
+
+	step := tracer.enterVMExecution(&vmcommon.VMInput{CallerAddr: senderAddress}, contractAddress, "add", false)
+	require.Nil(t, step)
+	tracer.exitVMExecution(step, &vmcommon.VMOutput{}, nil, nil)
+
+	value, _, err := account.RetrieveValue([]byte("key"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.False(t, tracer.shouldRecordAccountWrites())
+
+	tracer.StartTrace(&transaction.Transaction{})
+	trace := tracer.StopTrace()
+	require.Empty(t, trace.Calls)
+	require.Empty(t, trace.StorageReads)
+}
+
+func TestExecutionTracer_VMExecutionShouldRecordOutputAndInternalCalls(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32))
+	storage := map[string][]byte{"counter": {1}}
+	contract := newTracedAccount(createUserAccountWithStorage(contractAddress, storage), tracer)
+	accounts := createAccountsWithStorage(map[string]vmcommon.AccountHandler{
+		string(contractAddress): contract,
+	})
+
+	tracer.StartTrace(&transaction.Transaction{SndAddr: senderAddress, RcvAddr: contractAddress, GasLimit: 1000})
+	input := &vmcommon.VMInput{
+		CallerAddr:  senderAddress,
+		Arguments:   [][]byte{{5}},
+		CallValue:   big.NewInt(0),
+		GasProvided: 900,
+	}
+	step := tracer.enterVMExecution(input, contractAddress, "add", false)
+	require.NotNil(t, step)
+
+	// the VM reads through the account data handler
+	value, _, _ := contract.(vmcommon.UserAccountHandler).AccountDataHandler().RetrieveValue([]byte("counter"))
+	require.Equal(t, []byte{1}, value)
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 300,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(contractAddress): {
+				Address: contractAddress,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"counter": {Offset: []byte("counter"), Data: []byte{6}, Written: true},
+					"read":    {Offset: []byte("read"), Data: []byte{7}},
+				},
+			},
+			string(otherAddress): {
+				Address: otherAddress,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{SenderAddress: contractAddress, GasLimit: 200},
+				},
+			},
+		},
+		Logs: []*vmcommon.LogEntry{
+			{
+				Identifier: []byte("event"),
+				Address:    contractAddress,
+			},
+			{
+				Identifier: []byte(transferValueOnlyIdentifier),
+				Address:    contractAddress,
+				Topics:     [][]byte{big.NewInt(3).Bytes(), otherAddress},
+				Data:       [][]byte{[]byte("ExecuteOnDestContext"), []byte("callMe"), {1}, {2}},
+			},
+		},
+	}
+	tracer.exitVMExecution(step, vmOutput, nil, accounts)
+
+	// the writes of the VM output, applied by the smart contract processor, are not recorded again
+	_ = contract.(vmcommon.UserAccountHandler).AccountDataHandler().SaveKeyValue([]byte("counter"), []byte{6})
+
+	trace := tracer.StopTrace()
+	require.Equal(t, &txSimData.ExecutionStep{
+		Type:        StepTypeSmartContractCall,
+		CallType:    vm.DirectCall.ToString(),
+		Caller:      hex.EncodeToString(senderAddress),
+		Callee:      hex.EncodeToString(contractAddress),
+		Function:    "add",
+		Arguments:   []string{"05"},
+		GasProvided: 900,
+		GasUsed:     600,
+		ReturnCode:  vmcommon.Ok.String(),
+		StorageReads: []*txSimData.StorageAccess{
+			{
+				Address: hex.EncodeToString(contractAddress),
+				Key:     hex.EncodeToString([]byte("counter")),
+				Value:   "01",
+			},
+		},
+		StorageWrites: []*txSimData.StorageAccess{
+			{
+				Address:  hex.EncodeToString(contractAddress),
+				Key:      hex.EncodeToString([]byte("counter")),
+				OldValue: "01",
+				NewValue: "06",
+			},
+		},
+		Calls: []*txSimData.ExecutionStep{
+			{
+				Type:        StepTypeVMInternalCall,
+				CallType:    "ExecuteOnDestContext",
+				Caller:      hex.EncodeToString(contractAddress),
+				Callee:      hex.EncodeToString(otherAddress),
+				Function:    "callMe",
+				Arguments:   []string{"01", "02"},
+				Value:       "3",
+				GasProvided: 200,
+			},
+		},
+	}, trace.Calls[0])
+	require.Len(t, trace.Calls, 1)
+	require.Empty(t, trace.StorageWrites)
+}
+
+func TestExecutionTracer_VMExecutionShouldRecordNestedInternalCalls(t *testing.T) {
+	t.Parallel()
+
+	thirdAddress := []byte("third___________________________")
+	tracer, _ := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32))
+	tracer.StartTrace(&transaction.Transaction{SndAddr: senderAddress, RcvAddr: contractAddress, GasLimit: 1000})
+
+	step := tracer.enterVMExecution(&vmcommon.VMInput{CallerAddr: senderAddress, GasProvided: 900}, contractAddress, "run", false)
+	require.NotNil(t, step)
+
+	// storage reads and built-in functions done by the contracts called inside the VM
+	tracer.recordStorageRead(contractAddress, []byte("a"), []byte{1})
+	tracer.recordStorageRead(thirdAddress, []byte("c"), []byte{3})
+	transferStep := tracer.enterBuiltInFunction(&vmcommon.ContractCallInput{
+		VMInput:       vmcommon.VMInput{CallerAddr: otherAddress},
+		RecipientAddr: thirdAddress,
+		Function:      "ESDTTransfer",
+	})
+	tracer.exitBuiltInFunction(transferStep, &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil)
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(otherAddress): {
+				Address: otherAddress,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{Index: 3, SenderAddress: contractAddress, GasLimit: 300, CallType: vm.AsynchronousCall},
+					{Index: 2, SenderAddress: contractAddress, GasLimit: 200, CallType: vm.AsynchronousCall},
+				},
+			},
+		},
+		Logs: []*vmcommon.LogEntry{
+			{
+				Identifier: []byte(transferValueOnlyIdentifier),
+				Address:    contractAddress,
+				Topics:     [][]byte{nil, otherAddress},
+				Data:       [][]byte{{}, []byte("first")},
+			},
+			{
+				Identifier: []byte(transferValueOnlyIdentifier),
+				Address:    otherAddress,
+				Topics:     [][]byte{nil, thirdAddress},
+				Data:       [][]byte{{}, []byte("nested")},
+			},
+			{
+				Identifier: []byte(transferValueOnlyIdentifier),
+				Address:    contractAddress,
+				Topics:     [][]byte{nil, otherAddress},
+				Data:       [][]byte{[]byte("AsyncCall"), []byte("second")},
+			},
+			{
+				Identifier: []byte(transferValueOnlyIdentifier),
+				Address:    contractAddress,
+				Topics:     [][]byte{nil, otherAddress},
+				Data:       [][]byte{[]byte("AsyncCall"), []byte("third")},
+			},
+		},
+	}
+	tracer.exitVMExecution(step, vmOutput, nil, nil)
+	trace := tracer.StopTrace()
+
+	require.Len(t, trace.Calls, 1)
+	vmStep := trace.Calls[0]
+	require.Equal(t, []*txSimData.StorageAccess{
+		{Address: hex.EncodeToString(contractAddress), Key: "61", Value: "01"},
+	}, vmStep.StorageReads)
+
+	require.Len(t, vmStep.Calls, 3)
+	first := vmStep.Calls[0]
+	require.Equal(t, "first", first.Function)
+	require.Equal(t, executeOnDestContextCallType, first.CallType)
+	require.Equal(t, hex.EncodeToString(contractAddress), first.Caller)
+	require.Equal(t, uint64(0), first.GasProvided)
+
+	require.Len(t, first.Calls, 2)
+	nested := first.Calls[0]
+	require.Equal(t, "nested", nested.Function)
+	require.Equal(t, hex.EncodeToString(otherAddress), nested.Caller)
+	require.Equal(t, hex.EncodeToString(thirdAddress), nested.Callee)
+	require.Equal(t, []*txSimData.StorageAccess{
+		{Address: hex.EncodeToString(thirdAddress), Key: "63", Value: "03"},
+	}, nested.StorageReads)
+	require.Equal(t, StepTypeBuiltInFunction, first.Calls[1].Type)
+	require.Equal(t, "ESDTTransfer", first.Calls[1].Function)
+
+	require.Equal(t, "second", vmStep.Calls[1].Function)
+	require.Equal(t, "AsyncCall", vmStep.Calls[1].CallType)
+	require.Equal(t, uint64(200), vmStep.Calls[1].GasProvided)
+	require.Empty(t, vmStep.Calls[1].Calls)
+	require.Equal(t, "third", vmStep.Calls[2].Function)
+	require.Equal(t, uint64(300), vmStep.Calls[2].GasProvided)
+}
+
+func TestExecutionTracer_BuiltInFunctionShouldRecordAccountWrites(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32))
+	storage := make(map[string][]byte)
+	destination := newTracedAccount(createUserAccountWithStorage(otherAddress, storage), tracer)
+
+	container := builtInFunctions.NewBuiltInFunctionContainer()
+	_ = container.Add("transfer", &mock.BuiltInFunctionStub{
+		ProcessBuiltinFunctionCalled: func(acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			errSave := acntDst.AccountDataHandler().SaveKeyValue([]byte("token"), []byte{10})
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided - 10}, errSave
+		},
+	})
+	expectedErr := errors.New("expected error")
+	_ = container.Add("failing", &mock.BuiltInFunctionStub{
+		ProcessBuiltinFunctionCalled: func(acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	})
+
+	err := tracer.WrapBuiltInFunctions(container)
+	require.Nil(t, err)
+	// wrapping twice should not trace twice
+	err = tracer.WrapBuiltInFunctions(container)
+	require.Nil(t, err)
+
+	tracer.StartTrace(&transaction.Transaction{SndAddr: senderAddress, RcvAddr: otherAddress})
+	transfer, _ := container.Get("transfer")
+	_, err = transfer.ProcessBuiltinFunction(nil, destination.(vmcommon.UserAccountHandler), &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  senderAddress,
+			GasProvided: 100,
+			CallType:    vm.DirectCall,
+		},
+		RecipientAddr: otherAddress,
+		Function:      "transfer",
+	})
+	require.Nil(t, err)
+
+	failing, _ := container.Get("failing")
+	_, err = failing.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{
+		RecipientAddr: otherAddress,
+		Function:      "failing",
+	})
+	require.Equal(t, expectedErr, err)
+
+	trace := tracer.StopTrace()
+	require.Len(t, trace.Calls, 2)
+	require.Equal(t, &txSimData.ExecutionStep{
+		Type:        StepTypeBuiltInFunction,
+		CallType:    vm.DirectCall.ToString(),
+		Caller:      hex.EncodeToString(senderAddress),
+		Callee:      hex.EncodeToString(otherAddress),
+		Function:    "transfer",
+		GasProvided: 100,
+		GasUsed:     10,
+		ReturnCode:  vmcommon.Ok.String(),
+		StorageWrites: []*txSimData.StorageAccess{
+			{
+				Address:  hex.EncodeToString(otherAddress),
+				Key:      hex.EncodeToString([]byte("token")),
+				NewValue: "0a",
+			},
+		},
+	}, trace.Calls[0])
+	require.Equal(t, StepTypeBuiltInFunction, trace.Calls[1].Type)
+	require.Equal(t, "failing", trace.Calls[1].Function)
+	require.Equal(t, expectedErr.Error(), trace.Calls[1].Error)
+	require.Equal(t, []byte{10}, storage["token"])
+}
+
+func TestExecutionTracer_WrapVMContainer(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32))
+
+	_, err := tracer.WrapVMContainer(nil, &stateMock.AccountsStub{})
+	require.NotNil(t, err)
+	_, err = tracer.WrapVMContainer(&mock.VMContainerMock{}, nil)
+	require.Equal(t, ErrNilAccountsAdapter, err)
+
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCreateCalled: func(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		},
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, ReturnMessage: "failed"}, nil
+		},
+	}
+	tracedContainer, err := tracer.WrapVMContainer(&mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			return vm, nil
+		},
+	}, &stateMock.AccountsStub{})
+	require.Nil(t, err)
+
+	tracedVM, err := tracedContainer.Get([]byte("vm"))
+	require.Nil(t, err)
+
+	tracer.StartTrace(&transaction.Transaction{})
+	_, _ = tracedVM.RunSmartContractCreate(&vmcommon.ContractCreateInput{
+		VMInput: vmcommon.VMInput{CallerAddr: senderAddress},
+	})
+	_, _ = tracedVM.RunSmartContractCall(&vmcommon.ContractCallInput{
+		VMInput:       vmcommon.VMInput{CallerAddr: senderAddress},
+		RecipientAddr: contractAddress,
+		Function:      "fail",
+	})
+	trace := tracer.StopTrace()
+
+	require.Len(t, trace.Calls, 2)
+	require.Equal(t, StepTypeSmartContractDeploy, trace.Calls[0].Type)
+	require.Empty(t, trace.Calls[0].Callee)
+	require.Equal(t, vmcommon.Ok.String(), trace.Calls[0].ReturnCode)
+	require.Equal(t, StepTypeSmartContractCall, trace.Calls[1].Type)
+	require.Equal(t, "fail", trace.Calls[1].Function)
+	require.Equal(t, "failed", trace.Calls[1].ReturnMessage)
+}
@@ -2,6 +2,7 @@ package transactionEvaluator
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 )
@@ -17,3 +18,10 @@ type TransactionProcessor interface {
 type DataFieldParser interface {
 	Parse(dataField []byte, sender, receiver []byte, numOfShards uint32) *datafield.ResponseParseData
 }
+
+// ExecutionTracer defines what an execution tracer should be able to do
+type ExecutionTracer interface {
+	StartTrace(tx *transaction.Transaction)
+	StopTrace() *txSimData.ExecutionStep
+	IsInterfaceNil() bool
+}
@@ -15,6 +15,7 @@ type simulationAccountsDB struct {
 	mutex            sync.RWMutex
 	cachedAccounts   map[string]vmcommon.AccountHandler
 	originalAccounts state.AccountsAdapter
+	tracer           *executionTracer
 }
 
 // NewSimulationAccountsDB returns a new instance of simulationAccountsDB
@@ -57,9 +58,7 @@ func (r *simulationAccountsDB) GetExistingAccount(address []byte) (vmcommon.Acco
 		return nil, err
 	}
 
-	r.addToCache(account)
-
-	return account, nil
+	return r.addToCache(account), nil
 }
 
 // GetAccountFromBytes will call the original accounts' function with the same name
@@ -79,9 +78,7 @@ func (r *simulationAccountsDB) LoadAccount(address []byte) (vmcommon.AccountHand
 		return nil, err
 	}
 
-	r.addToCache(account)
-
-	return account, nil
+	return r.addToCache(account), nil
 }
 
 // SaveAccount won't do anything as write operations are disabled on this component
@@ -184,10 +181,30 @@ func (r *simulationAccountsDB) CleanCache() {
 	r.mutex.Unlock()
 }
 
-func (r *simulationAccountsDB) addToCache(account vmcommon.AccountHandler) {
+// SetExecutionTracer sets the tracer which will record the data trie accesses of the provided accounts
+func (r *simulationAccountsDB) SetExecutionTracer(tracer *executionTracer) error {
+	if check.IfNil(tracer) {
+		return ErrNilExecutionTracer
+	}
+
 	r.mutex.Lock()
-	r.cachedAccounts[string(account.AddressBytes())] = account
+	r.tracer = tracer
 	r.mutex.Unlock()
+
+	return nil
+}
+
+func (r *simulationAccountsDB) addToCache(account vmcommon.AccountHandler) vmcommon.AccountHandler {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	tracedAccount, isTraced := account.(*tracedUserAccount)
+	if isTraced {
+		account = tracedAccount.tracedAccountHandler
+	}
+	r.cachedAccounts[string(account.AddressBytes())] = account
+
+	return r.traceAccountIfNeeded(account)
 }
 
 func (r *simulationAccountsDB) getFromCache(address []byte) (vmcommon.AccountHandler, bool) {
@@ -195,6 +212,19 @@ func (r *simulationAccountsDB) getFromCache(address []byte) (vmcommon.AccountHan
 	defer r.mutex.RUnlock()
 
 	account, found := r.cachedAccounts[string(address)]
+	if !found {
+		return nil, false
+	}
+
+	return r.traceAccountIfNeeded(account), true
+}
+
+// traceAccountIfNeeded wraps the provided account only while a trace is recorded. The cached accounts are never
+// wrapped, so the simulations without trace are not affected by the tracer
+func (r *simulationAccountsDB) traceAccountIfNeeded(account vmcommon.AccountHandler) vmcommon.AccountHandler {
+	if check.IfNil(r.tracer) || !r.tracer.isTracing() {
+		return account
+	}
 
-	return account, found
+	return newTracedAccount(account, r.tracer)
 }
@@ -6,10 +6,12 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/errChan"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/state/parsers"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/require"
@@ -155,3 +157,39 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	err = allLeaves.ErrChan.ReadFromChanNonBlocking()
 	require.NoError(t, err)
 }
+
+func TestReadOnlyAccountsDB_ShouldTraceAccountsOnlyWhileTracing(t *testing.T) {
+	t.Parallel()
+
+	account := createUserAccountWithStorage(contractAddress, map[string][]byte{"key": []byte("value")})
+	accDb := &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return account, nil
+		},
+	}
+
+	simAccountsDB, _ := NewSimulationAccountsDB(accDb)
+	tracer, _ := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32))
+	err := simAccountsDB.SetExecutionTracer(tracer)
+	require.NoError(t, err)
+
+	acc, err := simAccountsDB.GetExistingAccount(contractAddress)
+	require.NoError(t, err)
+	require.Equal(t, account, acc)
+
+	tracer.StartTrace(&transaction.Transaction{})
+	acc, err = simAccountsDB.GetExistingAccount(contractAddress)
+	require.NoError(t, err)
+	tracedAccount, isTraced := acc.(*tracedUserAccount)
+	require.True(t, isTraced)
+	require.Equal(t, account, tracedAccount.tracedAccountHandler)
+
+	// saving the traced account should not cache the wrapper
+	err = simAccountsDB.SaveAccount(acc)
+	require.NoError(t, err)
+	_ = tracer.StopTrace()
+
+	acc, err = simAccountsDB.GetExistingAccount(contractAddress)
+	require.NoError(t, err)
+	require.Equal(t, account, acc)
+}
@@ -0,0 +1,230 @@
+package transactionEvaluator
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// tracedVMContainer wraps a VM container so that all the VMs returned by it are traced. The underlying container
+// can still replace its VMs (e.g. on a Wasm VM version change)
+type tracedVMContainer struct {
+	process.VirtualMachinesContainer
+	tracer   *executionTracer
+	accounts accountsGetter
+}
+
+// WrapVMContainer returns a VM container which records the executions of the VMs from the provided container.
+// The provided accounts are used to fetch the values overwritten by the VMs
+func (et *executionTracer) WrapVMContainer(
+	vmContainer process.VirtualMachinesContainer,
+	accounts state.AccountsAdapter,
+) (process.VirtualMachinesContainer, error) {
+	if check.IfNil(vmContainer) {
+		return nil, process.ErrNilVMContainer
+	}
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	return &tracedVMContainer{
+		VirtualMachinesContainer: vmContainer,
+		tracer:                   et,
+		accounts:                 accounts,
+	}, nil
+}
+
+// Get returns the traced VM stored under the provided key
+func (container *tracedVMContainer) Get(key []byte) (vmcommon.VMExecutionHandler, error) {
+	vm, err := container.VirtualMachinesContainer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracedVM{
+		VMExecutionHandler: vm,
+		tracer:             container.tracer,
+		accounts:           container.accounts,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (container *tracedVMContainer) IsInterfaceNil() bool {
+	return container == nil
+}
+
+type tracedVM struct {
+	vmcommon.VMExecutionHandler
+	tracer   *executionTracer
+	accounts accountsGetter
+}
+
+// RunSmartContractCreate runs the smart contract deployment while recording it as an execution step
+func (vm *tracedVM) RunSmartContractCreate(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+	step := vm.tracer.enterVMExecution(&input.VMInput, nil, "", true)
+	vmOutput, err := vm.VMExecutionHandler.RunSmartContractCreate(input)
+	vm.tracer.exitVMExecution(step, vmOutput, err, vm.accounts)
+
+	return vmOutput, err
+}
+
+// RunSmartContractCall runs the smart contract call while recording it as an execution step
+func (vm *tracedVM) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	step := vm.tracer.enterVMExecution(&input.VMInput, input.RecipientAddr, input.Function, false)
+	vmOutput, err := vm.VMExecutionHandler.RunSmartContractCall(input)
+	vm.tracer.exitVMExecution(step, vmOutput, err, vm.accounts)
+
+	return vmOutput, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vm *tracedVM) IsInterfaceNil() bool {
+	return vm == nil || check.IfNil(vm.VMExecutionHandler)
+}
+
+type tracedBuiltInFunction struct {
+	vmcommon.BuiltinFunction
+	tracer *executionTracer
+}
+
+// WrapBuiltInFunctions replaces all the built-in functions from the provided container with wrappers that record
+// their executions on the tracer
+func (et *executionTracer) WrapBuiltInFunctions(container vmcommon.BuiltInFunctionContainer) error {
+	if check.IfNil(container) {
+		return process.ErrNilBuiltInFunction
+	}
+
+	for name := range container.Keys() {
+		function, err := container.Get(name)
+		if err != nil {
+			return err
+		}
+		if _, isTraced := function.(*tracedBuiltInFunction); isTraced {
+			continue
+		}
+
+		err = container.Replace(name, &tracedBuiltInFunction{
+			BuiltinFunction: function,
+			tracer:          et,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ProcessBuiltinFunction runs the built-in function while recording it as an execution step
+func (function *tracedBuiltInFunction) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	var step *txSimData.ExecutionStep
+	if vmInput != nil {
+		step = function.tracer.enterBuiltInFunction(vmInput)
+	}
+
+	vmOutput, err := function.BuiltinFunction.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	function.tracer.exitBuiltInFunction(step, vmOutput, err)
+
+	return vmOutput, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (function *tracedBuiltInFunction) IsInterfaceNil() bool {
+	return function == nil || check.IfNil(function.BuiltinFunction)
+}
+
+type tracedAccountHandler interface {
+	state.UserAccountHandler
+	AccountDataHandler() vmcommon.AccountDataHandler
+}
+
+// tracedUserAccount wraps a user account so that the accesses to its data trie are recorded on the tracer
+type tracedUserAccount struct {
+	tracedAccountHandler
+	tracer *executionTracer
+}
+
+func newTracedAccount(account vmcommon.AccountHandler, tracer *executionTracer) vmcommon.AccountHandler {
+	if check.IfNil(tracer) {
+		return account
+	}
+
+	switch acc := account.(type) {
+	case *tracedUserAccount:
+		return acc
+	case tracedAccountHandler:
+		return &tracedUserAccount{
+			tracedAccountHandler: acc,
+			tracer:               tracer,
+		}
+	default:
+		return account
+	}
+}
+
+// RetrieveValue returns the value of the provided key while recording the read
+func (account *tracedUserAccount) RetrieveValue(key []byte) ([]byte, uint32, error) {
+	value, depth, err := account.tracedAccountHandler.RetrieveValue(key)
+	// an account without a data trie has all its keys empty
+	if err == nil || errors.Is(err, state.ErrNilTrie) {
+		account.tracer.recordStorageRead(account.AddressBytes(), key, value)
+	}
+
+	return value, depth, err
+}
+
+// SaveKeyValue saves the provided value under the key. The write is recorded only if done by a built-in function,
+// the writes done by the VMs are taken from their outputs
+func (account *tracedUserAccount) SaveKeyValue(key []byte, value []byte) error {
+	if !account.tracer.shouldRecordAccountWrites() {
+		return account.tracedAccountHandler.SaveKeyValue(key, value)
+	}
+
+	oldValue, _, _ := account.tracedAccountHandler.RetrieveValue(key)
+	err := account.tracedAccountHandler.SaveKeyValue(key, value)
+	if err == nil {
+		account.tracer.recordStorageWrite(account.AddressBytes(), key, oldValue, value)
+	}
+
+	return err
+}
+
+// AccountDataHandler returns the data handler of the account, which records the data trie accesses
+func (account *tracedUserAccount) AccountDataHandler() vmcommon.AccountDataHandler {
+	return &tracedAccountDataHandler{
+		AccountDataHandler: account.tracedAccountHandler.AccountDataHandler(),
+		account:            account,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (account *tracedUserAccount) IsInterfaceNil() bool {
+	return account == nil || check.IfNil(account.tracedAccountHandler)
+}
+
+type tracedAccountDataHandler struct {
+	vmcommon.AccountDataHandler
+	account *tracedUserAccount
+}
+
+// RetrieveValue returns the value of the provided key while recording the read
+func (handler *tracedAccountDataHandler) RetrieveValue(key []byte) ([]byte, uint32, error) {
+	return handler.account.RetrieveValue(key)
+}
+
+// SaveKeyValue saves the provided value under the key while recording the write, if needed
+func (handler *tracedAccountDataHandler) SaveKeyValue(key []byte, value []byte) error {
+	return handler.account.SaveKeyValue(key, value)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *tracedAccountDataHandler) IsInterfaceNil() bool {
+	return handler == nil || check.IfNil(handler.AccountDataHandler)
+}
@@ -91,7 +91,7 @@ func NewAPITransactionEvaluator(args ArgsApiTransactionEvaluator) (*apiTransacti
 }
 
 // SimulateTransactionExecution will simulate a transaction's execution and will return the results
-func (ate *apiTransactionEvaluator) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (ate *apiTransactionEvaluator) SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 	ate.mutExecution.Lock()
 	defer func() {
 		ate.accounts.CleanCache()
@@ -100,7 +100,7 @@ func (ate *apiTransactionEvaluator) SimulateTransactionExecution(tx *transaction
 
 	currentHeader := ate.getCurrentBlockHeader()
 
-	return ate.txSimulator.ProcessTx(tx, currentHeader)
+	return ate.txSimulator.ProcessTx(tx, currentHeader, withTrace)
 }
 
 // ComputeTransactionGasLimit will calculate how many gas units a transaction will consume
@@ -150,7 +150,7 @@ func (ate *apiTransactionEvaluator) simulateTransactionCost(tx *transaction.Tran
 
 	costResponse := &transaction.CostResponse{}
 	currentHeader := ate.getCurrentBlockHeader()
-	res, err := ate.txSimulator.ProcessTx(tx, currentHeader)
+	res, err := ate.txSimulator.ProcessTx(tx, currentHeader, false)
 	if err != nil {
 		costResponse.ReturnMessage = err.Error()
 		return costResponse, nil
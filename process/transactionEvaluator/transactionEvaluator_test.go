@@ -127,7 +127,7 @@ func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 		},
 	}
 	args.TxSimulator = &mock.TransactionSimulatorStub{
-		ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+		ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler, _ bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 			return &txSimData.SimulationResultsWithVMOutput{}, nil
 		},
 	}
@@ -166,7 +166,7 @@ func TestComputeTransactionGasLimit_MoveBalanceInvalidNonceShouldStillComputeCos
 		},
 	}
 	args.TxSimulator = &mock.TransactionSimulatorStub{
-		ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+		ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler, _ bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 			return nil, simulationErr
 		},
 	}
@@ -197,7 +197,7 @@ func TestComputeTransactionGasLimit_BuiltInFunction(t *testing.T) {
 		},
 	}
 	args.TxSimulator = &mock.TransactionSimulatorStub{
-		ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+		ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler, _ bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 			return &txSimData.SimulationResultsWithVMOutput{
 				VMOutput: &vmcommon.VMOutput{
 					ReturnCode:   vmcommon.Ok,
@@ -233,7 +233,7 @@ func TestComputeTransactionGasLimit_BuiltInFunctionShouldErr(t *testing.T) {
 		},
 	}
 	args.TxSimulator = &mock.TransactionSimulatorStub{
-		ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+		ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler, _ bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 			return nil, localErr
 		},
 	}
@@ -263,7 +263,7 @@ func TestComputeTransactionGasLimit_NilVMOutput(t *testing.T) {
 		},
 	}
 	args.TxSimulator = &mock.TransactionSimulatorStub{
-		ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+		ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler, _ bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 			return &txSimData.SimulationResultsWithVMOutput{}, nil
 		},
 	}
@@ -294,7 +294,7 @@ func TestComputeTransactionGasLimit_RetCodeNotOk(t *testing.T) {
 		},
 	}
 	args.TxSimulator = &mock.TransactionSimulatorStub{
-		ProcessTxCalled: func(tx *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+		ProcessTxCalled: func(tx *transaction.Transaction, _ data.HeaderHandler, _ bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 			return &txSimData.SimulationResultsWithVMOutput{
 				VMOutput: &vmcommon.VMOutput{
 					ReturnCode: vmcommon.UserError,
@@ -359,7 +359,7 @@ func TestApiTransactionEvaluator_SimulateTransactionExecution(t *testing.T) {
 	_ = args.BlockChain.SetCurrentBlockHeaderAndRootHash(&block.Header{Nonce: expectedNonce}, []byte("test"))
 
 	args.TxSimulator = &mock.TransactionSimulatorStub{
-		ProcessTxCalled: func(_ *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+		ProcessTxCalled: func(_ *transaction.Transaction, currentHeader data.HeaderHandler, _ bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 			called = true
 			require.Equal(t, expectedNonce, currentHeader.GetNonce())
 			return nil, nil
@@ -371,7 +371,7 @@ func TestApiTransactionEvaluator_SimulateTransactionExecution(t *testing.T) {
 
 	tx := &transaction.Transaction{}
 
-	_, err = tce.SimulateTransactionExecution(tx)
+	_, err = tce.SimulateTransactionExecution(tx, false)
 	require.Nil(t, err)
 	require.True(t, called)
 }
@@ -391,7 +391,7 @@ func TestApiTransactionEvaluator_ComputeTransactionGasLimit(t *testing.T) {
 		},
 	}
 	args.TxSimulator = &mock.TransactionSimulatorStub{
-		ProcessTxCalled: func(_ *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+		ProcessTxCalled: func(_ *transaction.Transaction, currentHeader data.HeaderHandler, _ bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 			called = true
 			require.Equal(t, expectedNonce, currentHeader.GetNonce())
 			return &txSimData.SimulationResultsWithVMOutput{}, nil
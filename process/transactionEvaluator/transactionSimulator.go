@@ -35,6 +35,7 @@ type ArgsTxSimulator struct {
 	Marshalizer               marshal.Marshalizer
 	DataFieldParser           DataFieldParser
 	BlockChainHook            process.BlockChainHookHandler
+	ExecutionTracer           ExecutionTracer
 }
 
 type refundHandler interface {
@@ -53,6 +54,7 @@ type transactionSimulator struct {
 	refundDetector         refundHandler
 	dataFieldParser        DataFieldParser
 	blockChainHook         process.BlockChainHookHandler
+	executionTracer        ExecutionTracer
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -84,6 +86,9 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.BlockChainHook) {
 		return nil, process.ErrNilBlockChainHook
 	}
+	if check.IfNil(args.ExecutionTracer) {
+		return nil, ErrNilExecutionTracer
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
@@ -96,11 +101,13 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 		refundDetector:         transactionAPI.NewRefundDetector(),
 		dataFieldParser:        args.DataFieldParser,
 		blockChainHook:         args.BlockChainHook,
+		executionTracer:        args.ExecutionTracer,
 	}, nil
 }
 
-// ProcessTx will process the transaction in a special environment, where state-writing is not allowed
-func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+// ProcessTx will process the transaction in a special environment, where state-writing is not allowed. If withTrace
+// is set, the execution steps of the transaction are recorded and returned in the results
+func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction, currentHeader data.HeaderHandler, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 	ts.mutOperation.Lock()
 	defer ts.mutOperation.Unlock()
 
@@ -109,7 +116,14 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction, currentHe
 
 	ts.blockChainHook.SetCurrentHeader(currentHeader)
 
+	if withTrace {
+		ts.executionTracer.StartTrace(tx)
+	}
 	retCode, err := ts.txProcessor.ProcessTransaction(tx)
+	var trace *txSimData.ExecutionStep
+	if withTrace {
+		trace = ts.executionTracer.StopTrace()
+	}
 	if err != nil {
 		failReason = err.Error()
 		txStatus = transaction.TxStatusFail
@@ -137,10 +151,28 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction, currentHe
 	}
 
 	ts.addLogsFromVmOutput(results, vmOutput)
+	results.Trace = completeTrace(trace, retCode, failReason, vmOutput)
 
 	return results, nil
 }
 
+func completeTrace(trace *txSimData.ExecutionStep, retCode vmcommon.ReturnCode, failReason string, vmOutput *vmcommon.VMOutput) *txSimData.ExecutionStep {
+	if trace == nil {
+		return nil
+	}
+
+	trace.ReturnCode = retCode.String()
+	trace.Error = failReason
+	if vmOutput != nil {
+		trace.ReturnMessage = vmOutput.ReturnMessage
+		if vmOutput.GasRemaining <= trace.GasProvided {
+			trace.GasUsed = trace.GasProvided - vmOutput.GasRemaining
+		}
+	}
+
+	return trace
+}
+
 func (ts *transactionSimulator) addLogsFromVmOutput(results *txSimData.SimulationResultsWithVMOutput, vmOutput *vmcommon.VMOutput) {
 	if vmOutput == nil || len(vmOutput.Logs) == 0 {
 		return
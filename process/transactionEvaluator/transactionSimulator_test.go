@@ -1,6 +1,7 @@
 package transactionEvaluator
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"sync"
@@ -85,6 +86,15 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: process.ErrNilBlockChainHook,
 		},
+		{
+			name: "NilExecutionTracer",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.ExecutionTracer = nil
+				return args
+			},
+			exError: ErrNilExecutionTracer,
+		},
 		{
 			name: "NilMarshalizer",
 			argsFunc: func() ArgsTxSimulator {
@@ -134,11 +144,63 @@ func TestTransactionSimulator_ProcessTxProcessingErrShouldSignal(t *testing.T) {
 	}
 	ts, _ := NewTransactionSimulator(args)
 
-	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37}, &block.Header{})
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37}, &block.Header{}, false)
 	require.NoError(t, err)
 	require.Equal(t, expErr.Error(), results.FailReason)
 }
 
+func TestTransactionSimulator_ProcessTxWithTrace(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		Nonce:    37,
+		SndAddr:  bytes.Repeat([]byte("s"), 32),
+		RcvAddr:  bytes.Repeat([]byte("r"), 32),
+		GasLimit: 1000,
+		Data:     []byte("doSomething@01"),
+	}
+
+	args := getTxSimulatorArgs()
+	args.VMOutputCacher, _ = storageunit.NewCache(storageunit.CacheConfig{
+		Type:     storageunit.LRUCache,
+		Capacity: 100,
+	})
+	txHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
+	tracer := args.ExecutionTracer.(*executionTracer)
+	wasTracing := false
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(transaction *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			wasTracing = tracer.isTracing()
+			args.VMOutputCacher.Put(txHash, &vmcommon.VMOutput{GasRemaining: 400, ReturnMessage: "message"}, 0)
+			return vmcommon.UserError, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	t.Run("without trace", func(t *testing.T) {
+		results, err := ts.ProcessTx(tx, &block.Header{}, false)
+		require.Nil(t, err)
+		require.False(t, wasTracing)
+		require.Nil(t, results.Trace)
+	})
+	t.Run("with trace", func(t *testing.T) {
+		results, err := ts.ProcessTx(tx, &block.Header{}, true)
+		require.Nil(t, err)
+		require.True(t, wasTracing)
+		require.False(t, tracer.isTracing())
+		require.NotNil(t, results.Trace)
+
+		trace := results.Trace
+		require.Equal(t, StepTypeTransaction, trace.Type)
+		require.Equal(t, "doSomething", trace.Function)
+		require.Equal(t, []string{"01"}, trace.Arguments)
+		require.Equal(t, uint64(1000), trace.GasProvided)
+		require.Equal(t, uint64(600), trace.GasUsed)
+		require.Equal(t, vmcommon.UserError.String(), trace.ReturnCode)
+		require.Equal(t, "message", trace.ReturnMessage)
+	})
+}
+
 func TestTransactionSimulator_getVMOutputComputeHashFails(t *testing.T) {
 	t.Parallel()
 
@@ -216,7 +278,7 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 	txHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
 	args.VMOutputCacher.Put(txHash, &vmcommon.VMOutput{}, 0)
 
-	results, err := ts.ProcessTx(tx, &block.Header{})
+	results, err := ts.ProcessTx(tx, &block.Header{}, false)
 	require.NoError(t, err)
 	require.Equal(
 		t,
@@ -236,6 +298,7 @@ func getTxSimulatorArgs() ArgsTxSimulator {
 		AddressLength: pubKeyConverter.Len(),
 		Marshalizer:   &mock.MarshalizerMock{},
 	})
+	executionTracer, _ := NewExecutionTracer(pubKeyConverter)
 	return ArgsTxSimulator{
 		TransactionProcessor:      &testscommon.TxProcessorStub{},
 		IntermediateProcContainer: &mock.IntermProcessorContainerStub{},
@@ -246,6 +309,7 @@ func getTxSimulatorArgs() ArgsTxSimulator {
 		Hasher:                    &hashingMocks.HasherMock{},
 		DataFieldParser:           dataFieldParser,
 		BlockChainHook:            &testscommon.BlockChainHookStub{},
+		ExecutionTracer:           executionTracer,
 	}
 }
 
@@ -271,7 +335,7 @@ func TestTransactionSimulator_ProcessTxConcurrentCalls(t *testing.T) {
 	for i := 0; i < numCalls; i++ {
 		go func(idx int) {
 			time.Sleep(time.Millisecond * 10)
-			_, _ = txSimulator.ProcessTx(tx, &block.Header{})
+			_, _ = txSimulator.ProcessTx(tx, &block.Header{}, false)
 			wg.Done()
 		}(i)
 	}
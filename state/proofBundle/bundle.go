@@ -0,0 +1,38 @@
+package proofBundle
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+)
+
+// Bundle holds everything a light client needs for verifying an account, and optionally a key of its data trie,
+// without trusting the node providing it: the Merkle proofs, the header holding the state root hash and the
+// aggregated signature of the consensus group over that header. All byte fields are hex encoded
+type Bundle struct {
+	ShardID             uint32   `json:"shardID"`
+	HeaderHash          string   `json:"headerHash"`
+	Header              string   `json:"header"`
+	AggregatedSignature string   `json:"aggregatedSignature"`
+	PubKeysBitmap       string   `json:"pubKeysBitmap"`
+	RootHash            string   `json:"rootHash"`
+	Address             string   `json:"address"`
+	AccountProof        []string `json:"accountProof"`
+	Key                 string   `json:"key,omitempty"`
+	DataTrieProof       []string `json:"dataTrieProof,omitempty"`
+}
+
+// GetStateRootHash returns the root hash of the accounts state the provided header commits to and which is kept in
+// the node's storage. For headers holding a scheduled root hash, this is the state the block was processed on, as
+// committed by the previous block together with its scheduled transactions
+func GetStateRootHash(header data.HeaderHandler) []byte {
+	if check.IfNil(header) {
+		return nil
+	}
+
+	additionalData := header.GetAdditionalData()
+	if additionalData != nil && len(additionalData.GetScheduledRootHash()) > 0 {
+		return additionalData.GetScheduledRootHash()
+	}
+
+	return header.GetRootHash()
+}
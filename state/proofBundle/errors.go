@@ -0,0 +1,66 @@
+package proofBundle
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilMultiSigVerifier signals that a nil multi signature verifier has been provided
+var ErrNilMultiSigVerifier = errors.New("nil multi signature verifier")
+
+// ErrNilConsensusValidatorsProvider signals that a nil consensus validators provider has been provided
+var ErrNilConsensusValidatorsProvider = errors.New("nil consensus validators provider")
+
+// ErrNilBundle signals that a nil proof bundle has been provided
+var ErrNilBundle = errors.New("nil proof bundle")
+
+// ErrInvalidBundleField signals that a field of the proof bundle could not be decoded
+var ErrInvalidBundleField = errors.New("invalid proof bundle field")
+
+// ErrHeaderHashMismatch signals that the header hash does not match the provided header
+var ErrHeaderHashMismatch = errors.New("header hash mismatch")
+
+// ErrShardIDMismatch signals that the shard ID does not match the one of the provided header
+var ErrShardIDMismatch = errors.New("shard ID mismatch")
+
+// ErrSignatureMismatch signals that the aggregated signature does not match the one of the provided header
+var ErrSignatureMismatch = errors.New("aggregated signature mismatch")
+
+// ErrPubKeysBitmapMismatch signals that the public keys bitmap does not match the one of the provided header
+var ErrPubKeysBitmapMismatch = errors.New("public keys bitmap mismatch")
+
+// ErrMissingSignature signals that the header is not signed
+var ErrMissingSignature = errors.New("missing aggregated signature")
+
+// ErrBlockProposerSignatureMissing signals that the bitmap does not mark the block proposer as a signer
+var ErrBlockProposerSignatureMissing = errors.New("block proposer signature is missing")
+
+// ErrWrongSizeBitmap signals that the size of the bitmap does not match the consensus size
+var ErrWrongSizeBitmap = errors.New("wrong size bitmap")
+
+// ErrNotEnoughSignatures signals that the header was signed by too few validators of the consensus group
+var ErrNotEnoughSignatures = errors.New("not enough signatures")
+
+// ErrRootHashMismatch signals that the root hash is not the state root hash of the provided header
+var ErrRootHashMismatch = errors.New("root hash mismatch")
+
+// ErrInvalidAccountProof signals that the account proof could not be verified
+var ErrInvalidAccountProof = errors.New("invalid account proof")
+
+// ErrAddressMismatch signals that the proven account does not belong to the provided address
+var ErrAddressMismatch = errors.New("address mismatch")
+
+// ErrInvalidDataTrieProof signals that the data trie proof could not be verified
+var ErrInvalidDataTrieProof = errors.New("invalid data trie proof")
+
+// ErrEmptyDataTrie signals that a data trie proof was provided for an account without a data trie
+var ErrEmptyDataTrie = errors.New("account has an empty data trie")
+
+// ErrMissingDataTrieProof signals that a key was provided without its data trie proof
+var ErrMissingDataTrieProof = errors.New("missing data trie proof")
+
+// ErrInvalidDataTrieValue signals that the value of the data trie leaf does not belong to the provided key and address
+var ErrInvalidDataTrieValue = errors.New("invalid data trie value")
@@ -0,0 +1,8 @@
+package proofBundle
+
+// ConsensusValidatorsProvider defines the component able to provide the consensus group of a block, such as the
+// nodes coordinator initialized with a known validator set
+type ConsensusValidatorsProvider interface {
+	GetConsensusValidatorsPublicKeys(randomness []byte, round uint64, shardID uint32, epoch uint32) ([]string, error)
+	IsInterfaceNil() bool
+}
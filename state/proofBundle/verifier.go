@@ -0,0 +1,356 @@
+package proofBundle
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/dataTrieValue"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+// ArgsBundleVerifier holds the arguments needed for creating a proof bundle verifier
+type ArgsBundleVerifier struct {
+	Marshaller                  marshal.Marshalizer
+	Hasher                      hashing.Hasher
+	MultiSigVerifier            crypto.MultiSigVerifier
+	ConsensusValidatorsProvider ConsensusValidatorsProvider
+}
+
+// VerifiedData holds the data proven by a proof bundle
+type VerifiedData struct {
+	ShardID    uint32
+	HeaderHash []byte
+	Nonce      uint64
+	Round      uint64
+	Epoch      uint32
+	RootHash   []byte
+	Account    *accounts.UserAccountData
+	Key        []byte
+	Value      []byte
+}
+
+type decodedBundle struct {
+	headerHash          []byte
+	header              []byte
+	aggregatedSignature []byte
+	pubKeysBitmap       []byte
+	rootHash            []byte
+	address             []byte
+	accountProof        [][]byte
+	key                 []byte
+	dataTrieProof       [][]byte
+}
+
+type merkleProofVerifier interface {
+	VerifyProofAndGetLeafData(rootHash []byte, key []byte, proof [][]byte) (core.TrieData, error)
+}
+
+type bundleVerifier struct {
+	marshaller          marshal.Marshalizer
+	hasher              hashing.Hasher
+	multiSigVerifier    crypto.MultiSigVerifier
+	validatorsProvider  ConsensusValidatorsProvider
+	merkleProofVerifier merkleProofVerifier
+}
+
+// NewBundleVerifier creates a verifier for proof bundles. It does not need access to any node, the chain of trust
+// starting from the consensus group provided by the validators provider: the aggregated signature proves the header,
+// the header proves the state root hash and the Merkle proofs prove the account and the data trie value
+func NewBundleVerifier(args ArgsBundleVerifier) (*bundleVerifier, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.MultiSigVerifier) {
+		return nil, ErrNilMultiSigVerifier
+	}
+	if check.IfNil(args.ConsensusValidatorsProvider) {
+		return nil, ErrNilConsensusValidatorsProvider
+	}
+
+	mpv, err := trie.NewMerkleProofVerifier(args.Marshaller, args.Hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bundleVerifier{
+		marshaller:          args.Marshaller,
+		hasher:              args.Hasher,
+		multiSigVerifier:    args.MultiSigVerifier,
+		validatorsProvider:  args.ConsensusValidatorsProvider,
+		merkleProofVerifier: mpv,
+	}, nil
+}
+
+// Verify checks the whole chain of trust of the provided bundle and returns the proven data
+func (bv *bundleVerifier) Verify(bundle *Bundle) (*VerifiedData, error) {
+	if bundle == nil {
+		return nil, ErrNilBundle
+	}
+
+	decoded, err := decodeBundle(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := bv.verifyHeader(bundle.ShardID, decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash := GetStateRootHash(header)
+	if !bytes.Equal(rootHash, decoded.rootHash) {
+		return nil, ErrRootHashMismatch
+	}
+
+	account, err := bv.verifyAccount(rootHash, decoded.address, decoded.accountProof)
+	if err != nil {
+		return nil, err
+	}
+
+	verifiedData := &VerifiedData{
+		ShardID:    header.GetShardID(),
+		HeaderHash: decoded.headerHash,
+		Nonce:      header.GetNonce(),
+		Round:      header.GetRound(),
+		Epoch:      header.GetEpoch(),
+		RootHash:   rootHash,
+		Account:    account,
+	}
+	if len(decoded.key) == 0 {
+		return verifiedData, nil
+	}
+
+	value, err := bv.verifyDataTrieValue(account, decoded.key, decoded.dataTrieProof)
+	if err != nil {
+		return nil, err
+	}
+	verifiedData.Key = decoded.key
+	verifiedData.Value = value
+
+	return verifiedData, nil
+}
+
+func (bv *bundleVerifier) verifyHeader(shardID uint32, decoded *decodedBundle) (data.HeaderHandler, error) {
+	headerHash := bv.hasher.Compute(string(decoded.header))
+	if !bytes.Equal(headerHash, decoded.headerHash) {
+		return nil, ErrHeaderHashMismatch
+	}
+
+	header, err := process.UnmarshalHeader(shardID, bv.marshaller, decoded.header)
+	if err != nil {
+		return nil, fmt.Errorf("%w: header, %s", ErrInvalidBundleField, err.Error())
+	}
+	if header.GetShardID() != shardID {
+		return nil, ErrShardIDMismatch
+	}
+	if len(header.GetSignature()) == 0 {
+		return nil, ErrMissingSignature
+	}
+	if !bytes.Equal(header.GetSignature(), decoded.aggregatedSignature) {
+		return nil, ErrSignatureMismatch
+	}
+	if !bytes.Equal(header.GetPubKeysBitmap(), decoded.pubKeysBitmap) {
+		return nil, ErrPubKeysBitmapMismatch
+	}
+
+	err = bv.verifyAggregatedSignature(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// verifyAggregatedSignature checks the header signature the same way the nodes do when receiving a header
+func (bv *bundleVerifier) verifyAggregatedSignature(header data.HeaderHandler) error {
+	signers, err := bv.getConsensusSigners(header)
+	if err != nil {
+		return err
+	}
+
+	headerCopy := header.ShallowClone()
+	err = headerCopy.SetSignature(nil)
+	if err != nil {
+		return err
+	}
+	err = headerCopy.SetPubKeysBitmap(nil)
+	if err != nil {
+		return err
+	}
+	err = headerCopy.SetLeaderSignature(nil)
+	if err != nil {
+		return err
+	}
+
+	hash, err := core.CalculateHash(bv.marshaller, bv.hasher, headerCopy)
+	if err != nil {
+		return err
+	}
+
+	return bv.multiSigVerifier.VerifyAggregatedSig(signers, hash, header.GetSignature())
+}
+
+func (bv *bundleVerifier) getConsensusSigners(header data.HeaderHandler) ([][]byte, error) {
+	bitmap := header.GetPubKeysBitmap()
+	if len(bitmap) == 0 || bitmap[0]&1 == 0 {
+		return nil, ErrBlockProposerSignatureMissing
+	}
+
+	// the start of epoch block is signed by the consensus group of the previous epoch
+	epochForConsensus := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epochForConsensus > 0 {
+		epochForConsensus--
+	}
+
+	consensusPubKeys, err := bv.validatorsProvider.GetConsensusValidatorsPublicKeys(
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		header.GetShardID(),
+		epochForConsensus,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedBitmapSize := (len(consensusPubKeys) + 7) / 8
+	if len(bitmap) != expectedBitmapSize {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrWrongSizeBitmap, expectedBitmapSize, len(bitmap))
+	}
+
+	signers := make([][]byte, 0, len(consensusPubKeys))
+	for i := range consensusPubKeys {
+		if bitmap[i/8]&(1<<uint(i%8)) == 0 {
+			continue
+		}
+		signers = append(signers, []byte(consensusPubKeys[i]))
+	}
+
+	minNumOfSigners := core.GetPBFTThreshold(len(consensusPubKeys))
+	if len(signers) < minNumOfSigners {
+		return nil, fmt.Errorf("%w: got %d, needed %d", ErrNotEnoughSignatures, len(signers), minNumOfSigners)
+	}
+
+	return signers, nil
+}
+
+func (bv *bundleVerifier) verifyAccount(rootHash []byte, address []byte, proof [][]byte) (*accounts.UserAccountData, error) {
+	leafData, err := bv.merkleProofVerifier.VerifyProofAndGetLeafData(rootHash, address, proof)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAccountProof, err.Error())
+	}
+
+	account := &accounts.UserAccountData{}
+	err = bv.marshaller.Unmarshal(account, leafData.Value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAccountProof, err.Error())
+	}
+	if !bytes.Equal(account.Address, address) {
+		return nil, ErrAddressMismatch
+	}
+
+	return account, nil
+}
+
+func (bv *bundleVerifier) verifyDataTrieValue(account *accounts.UserAccountData, key []byte, proof [][]byte) ([]byte, error) {
+	if len(proof) == 0 {
+		return nil, ErrMissingDataTrieProof
+	}
+	if len(account.RootHash) == 0 {
+		return nil, ErrEmptyDataTrie
+	}
+
+	leafData, err := bv.merkleProofVerifier.VerifyProofAndGetLeafData(account.RootHash, key, proof)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDataTrieProof, err.Error())
+	}
+
+	if leafData.Version == core.AutoBalanceEnabled {
+		leafValue := &dataTrieValue.TrieLeafData{}
+		err = bv.marshaller.Unmarshal(leafValue, leafData.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidDataTrieValue, err.Error())
+		}
+		if !bytes.Equal(leafValue.Key, key) || !bytes.Equal(leafValue.Address, account.Address) {
+			return nil, ErrInvalidDataTrieValue
+		}
+
+		return leafValue.Value, nil
+	}
+
+	suffix := append(append(make([]byte, 0, len(key)+len(account.Address)), key...), account.Address...)
+	if !bytes.HasSuffix(leafData.Value, suffix) {
+		return nil, ErrInvalidDataTrieValue
+	}
+
+	return common.TrimSuffixFromValue(leafData.Value, len(suffix))
+}
+
+func decodeBundle(bundle *Bundle) (*decodedBundle, error) {
+	var err error
+	decoded := &decodedBundle{}
+	fields := []struct {
+		name    string
+		value   string
+		dest    *[]byte
+		canMiss bool
+	}{
+		{name: "header hash", value: bundle.HeaderHash, dest: &decoded.headerHash},
+		{name: "header", value: bundle.Header, dest: &decoded.header},
+		{name: "aggregated signature", value: bundle.AggregatedSignature, dest: &decoded.aggregatedSignature},
+		{name: "public keys bitmap", value: bundle.PubKeysBitmap, dest: &decoded.pubKeysBitmap},
+		{name: "root hash", value: bundle.RootHash, dest: &decoded.rootHash},
+		{name: "address", value: bundle.Address, dest: &decoded.address},
+		{name: "key", value: bundle.Key, dest: &decoded.key, canMiss: true},
+	}
+	for _, field := range fields {
+		*field.dest, err = hex.DecodeString(field.value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s, %s", ErrInvalidBundleField, field.name, err.Error())
+		}
+		if len(*field.dest) == 0 && !field.canMiss {
+			return nil, fmt.Errorf("%w: empty %s", ErrInvalidBundleField, field.name)
+		}
+	}
+
+	decoded.accountProof, err = decodeProof(bundle.AccountProof)
+	if err != nil {
+		return nil, fmt.Errorf("%w: account proof, %s", ErrInvalidBundleField, err.Error())
+	}
+	decoded.dataTrieProof, err = decodeProof(bundle.DataTrieProof)
+	if err != nil {
+		return nil, fmt.Errorf("%w: data trie proof, %s", ErrInvalidBundleField, err.Error())
+	}
+
+	return decoded, nil
+}
+
+func decodeProof(proof []string) ([][]byte, error) {
+	decodedProof := make([][]byte, 0, len(proof))
+	for _, encodedNode := range proof {
+		node, err := hex.DecodeString(encodedNode)
+		if err != nil {
+			return nil, err
+		}
+		decodedProof = append(decodedProof, node)
+	}
+
+	return decodedProof, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bv *bundleVerifier) IsInterfaceNil() bool {
+	return bv == nil
+}
@@ -0,0 +1,472 @@
+package proofBundle
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/dataTrieValue"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshaller = &marshal.GogoProtoMarshalizer{}
+	testHasher     = blake2b.NewBlake2b()
+	testAddress    = bytes.Repeat([]byte("a"), 32)
+	testConsensus  = []string{"pk0", "pk1", "pk2", "pk3"}
+)
+
+type provenTrie interface {
+	UpdateWithVersion(key []byte, value []byte, version core.TrieNodeVersion) error
+	RootHash() ([]byte, error)
+	GetProof(key []byte) ([][]byte, []byte, error)
+}
+
+type testState struct {
+	accountsTrie provenTrie
+	dataTrie     provenTrie
+	rootHash     []byte
+}
+
+func createTestTrie(t *testing.T) provenTrie {
+	args := storage.GetStorageManagerArgs()
+	args.Marshalizer = testMarshaller
+	args.Hasher = testHasher
+	trieStorage, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	tr, err := trie.NewTrie(trieStorage, testMarshaller, testHasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+
+	return tr
+}
+
+func createTestState(t *testing.T) *testState {
+	dataTrie := createTestTrie(t)
+	autoBalancedLeaf, _ := testMarshaller.Marshal(&dataTrieValue.TrieLeafData{
+		Key:     []byte("auto balanced key"),
+		Value:   []byte("auto balanced value"),
+		Address: testAddress,
+	})
+	require.Nil(t, dataTrie.UpdateWithVersion(testHasher.Compute("auto balanced key"), autoBalancedLeaf, core.AutoBalanceEnabled))
+	legacyLeaf := append([]byte("legacy value"), append([]byte("legacy key"), testAddress...)...)
+	require.Nil(t, dataTrie.UpdateWithVersion([]byte("legacy key"), legacyLeaf, core.NotSpecified))
+	dataTrieRootHash, _ := dataTrie.RootHash()
+
+	accountsTrie := createTestTrie(t)
+	account, _ := testMarshaller.Marshal(&accounts.UserAccountData{
+		Nonce:    7,
+		Balance:  big.NewInt(1000),
+		RootHash: dataTrieRootHash,
+		Address:  testAddress,
+	})
+	require.Nil(t, accountsTrie.UpdateWithVersion(testAddress, account, core.NotSpecified))
+	otherAccount, _ := testMarshaller.Marshal(&accounts.UserAccountData{
+		Nonce:   1,
+		Balance: big.NewInt(1),
+		Address: bytes.Repeat([]byte("b"), 32),
+	})
+	require.Nil(t, accountsTrie.UpdateWithVersion(bytes.Repeat([]byte("b"), 32), otherAccount, core.NotSpecified))
+	rootHash, _ := accountsTrie.RootHash()
+
+	return &testState{
+		accountsTrie: accountsTrie,
+		dataTrie:     dataTrie,
+		rootHash:     rootHash,
+	}
+}
+
+func createTestHeader(rootHash []byte) *block.HeaderV2 {
+	return &block.HeaderV2{
+		Header: &block.Header{
+			ShardID:         1,
+			Nonce:           10,
+			Round:           11,
+			Epoch:           2,
+			PrevRandSeed:    []byte("prev rand seed"),
+			RootHash:        rootHash,
+			PubKeysBitmap:   []byte{0x07},
+			Signature:       []byte("aggregated signature"),
+			LeaderSignature: []byte("leader signature"),
+		},
+	}
+}
+
+func createBundle(t *testing.T, state *testState, header data.HeaderHandler, key []byte) *Bundle {
+	headerBytes, err := testMarshaller.Marshal(header)
+	require.Nil(t, err)
+	accountProof, _, err := state.accountsTrie.GetProof(testAddress)
+	require.Nil(t, err)
+
+	bundle := &Bundle{
+		ShardID:             header.GetShardID(),
+		HeaderHash:          hex.EncodeToString(testHasher.Compute(string(headerBytes))),
+		Header:              hex.EncodeToString(headerBytes),
+		AggregatedSignature: hex.EncodeToString(header.GetSignature()),
+		PubKeysBitmap:       hex.EncodeToString(header.GetPubKeysBitmap()),
+		RootHash:            hex.EncodeToString(GetStateRootHash(header)),
+		Address:             hex.EncodeToString(testAddress),
+		AccountProof:        encodeProof(accountProof),
+	}
+	if len(key) == 0 {
+		return bundle
+	}
+
+	dataTrieProof, _, err := state.dataTrie.GetProof(key)
+	require.Nil(t, err)
+	bundle.Key = hex.EncodeToString(key)
+	bundle.DataTrieProof = encodeProof(dataTrieProof)
+
+	return bundle
+}
+
+func encodeProof(proof [][]byte) []string {
+	encodedProof := make([]string, 0, len(proof))
+	for _, encodedNode := range proof {
+		encodedProof = append(encodedProof, hex.EncodeToString(encodedNode))
+	}
+
+	return encodedProof
+}
+
+func createMockArgs(header data.HeaderHandler) ArgsBundleVerifier {
+	headerCopy := header.ShallowClone()
+	_ = headerCopy.SetSignature(nil)
+	_ = headerCopy.SetPubKeysBitmap(nil)
+	_ = headerCopy.SetLeaderSignature(nil)
+	signedHash, _ := core.CalculateHash(testMarshaller, testHasher, headerCopy)
+
+	return ArgsBundleVerifier{
+		Marshaller: testMarshaller,
+		Hasher:     testHasher,
+		MultiSigVerifier: &cryptoMocks.MultisignerMock{
+			VerifyAggregatedSigCalled: func(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
+				expectedSigners := [][]byte{[]byte("pk0"), []byte("pk1"), []byte("pk2")}
+				if !assert.ObjectsAreEqual(expectedSigners, pubKeysSigners) {
+					return errors.New("wrong signers")
+				}
+				if !bytes.Equal(signedHash, message) || !bytes.Equal(header.GetSignature(), aggSig) {
+					return errors.New("invalid signature")
+				}
+
+				return nil
+			},
+		},
+		ConsensusValidatorsProvider: &shardingMocks.NodesCoordinatorStub{
+			GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+				if !bytes.Equal(randomness, header.GetPrevRandSeed()) || round != header.GetRound() || shardId != header.GetShardID() {
+					return nil, errors.New("unexpected consensus group request")
+				}
+
+				return testConsensus, nil
+			},
+		},
+	}
+}
+
+func TestNewBundleVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(createTestHeader(nil))
+		args.Marshaller = nil
+		bv, err := NewBundleVerifier(args)
+		assert.Equal(t, ErrNilMarshaller, err)
+		assert.Nil(t, bv)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(createTestHeader(nil))
+		args.Hasher = nil
+		bv, err := NewBundleVerifier(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.Nil(t, bv)
+	})
+	t.Run("nil multi signature verifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(createTestHeader(nil))
+		args.MultiSigVerifier = nil
+		bv, err := NewBundleVerifier(args)
+		assert.Equal(t, ErrNilMultiSigVerifier, err)
+		assert.Nil(t, bv)
+	})
+	t.Run("nil consensus validators provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(createTestHeader(nil))
+		args.ConsensusValidatorsProvider = nil
+		bv, err := NewBundleVerifier(args)
+		assert.Equal(t, ErrNilConsensusValidatorsProvider, err)
+		assert.Nil(t, bv)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		bv, err := NewBundleVerifier(createMockArgs(createTestHeader(nil)))
+		assert.Nil(t, err)
+		assert.False(t, bv.IsInterfaceNil())
+	})
+}
+
+func TestBundleVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	state := createTestState(t)
+
+	t.Run("nil bundle should error", func(t *testing.T) {
+		t.Parallel()
+
+		bv, _ := NewBundleVerifier(createMockArgs(createTestHeader(state.rootHash)))
+		verifiedData, err := bv.Verify(nil)
+		assert.Equal(t, ErrNilBundle, err)
+		assert.Nil(t, verifiedData)
+	})
+	t.Run("account proof should work", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+		bundle := createBundle(t, state, header, nil)
+
+		verifiedData, err := bv.Verify(bundle)
+		require.Nil(t, err)
+		assert.Equal(t, uint32(1), verifiedData.ShardID)
+		assert.Equal(t, uint64(10), verifiedData.Nonce)
+		assert.Equal(t, uint64(11), verifiedData.Round)
+		assert.Equal(t, uint32(2), verifiedData.Epoch)
+		assert.Equal(t, state.rootHash, verifiedData.RootHash)
+		assert.Equal(t, bundle.HeaderHash, hex.EncodeToString(verifiedData.HeaderHash))
+		assert.Equal(t, uint64(7), verifiedData.Account.Nonce)
+		assert.Equal(t, big.NewInt(1000), verifiedData.Account.Balance)
+		assert.Nil(t, verifiedData.Key)
+		assert.Nil(t, verifiedData.Value)
+	})
+	t.Run("auto balanced data trie value should work", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+		bundle := createBundle(t, state, header, testHasher.Compute("auto balanced key"))
+		bundle.Key = hex.EncodeToString([]byte("auto balanced key"))
+
+		verifiedData, err := bv.Verify(bundle)
+		require.Nil(t, err)
+		assert.Equal(t, []byte("auto balanced key"), verifiedData.Key)
+		assert.Equal(t, []byte("auto balanced value"), verifiedData.Value)
+	})
+	t.Run("legacy data trie value should work", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+		bundle := createBundle(t, state, header, []byte("legacy key"))
+
+		verifiedData, err := bv.Verify(bundle)
+		require.Nil(t, err)
+		assert.Equal(t, []byte("legacy key"), verifiedData.Key)
+		assert.Equal(t, []byte("legacy value"), verifiedData.Value)
+	})
+	t.Run("scheduled root hash should be used when present", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader([]byte("root hash before scheduled"))
+		header.ScheduledRootHash = state.rootHash
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+		bundle := createBundle(t, state, header, nil)
+
+		verifiedData, err := bv.Verify(bundle)
+		require.Nil(t, err)
+		assert.Equal(t, state.rootHash, verifiedData.RootHash)
+	})
+	t.Run("start of epoch block should be verified with the previous epoch consensus", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		header.Header.EpochStartMetaHash = []byte("epoch start meta hash")
+		args := createMockArgs(header)
+		args.ConsensusValidatorsProvider = &shardingMocks.NodesCoordinatorStub{
+			GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+				if epoch != header.GetEpoch()-1 {
+					return nil, errors.New("wrong epoch")
+				}
+
+				return testConsensus, nil
+			},
+		}
+		bv, _ := NewBundleVerifier(args)
+
+		_, err := bv.Verify(createBundle(t, state, header, nil))
+		assert.Nil(t, err)
+	})
+	t.Run("invalid hex field should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+		bundle := createBundle(t, state, header, nil)
+		bundle.AccountProof = append(bundle.AccountProof, "not hex")
+
+		_, err := bv.Verify(bundle)
+		assert.True(t, errors.Is(err, ErrInvalidBundleField))
+	})
+	t.Run("header hash mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+		bundle := createBundle(t, state, header, nil)
+		bundle.HeaderHash = hex.EncodeToString([]byte("another hash"))
+
+		_, err := bv.Verify(bundle)
+		assert.Equal(t, ErrHeaderHashMismatch, err)
+	})
+	t.Run("shard ID mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+		bundle := createBundle(t, state, header, nil)
+		bundle.ShardID = 0
+
+		_, err := bv.Verify(bundle)
+		assert.Equal(t, ErrShardIDMismatch, err)
+	})
+	t.Run("signature not matching the header should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+		bundle := createBundle(t, state, header, nil)
+		bundle.AggregatedSignature = hex.EncodeToString([]byte("another signature"))
+
+		_, err := bv.Verify(bundle)
+		assert.Equal(t, ErrSignatureMismatch, err)
+	})
+	t.Run("bitmap not matching the header should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+		bundle := createBundle(t, state, header, nil)
+		bundle.PubKeysBitmap = "0f"
+
+		_, err := bv.Verify(bundle)
+		assert.Equal(t, ErrPubKeysBitmapMismatch, err)
+	})
+	t.Run("bitmap without the block proposer should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		header.Header.PubKeysBitmap = []byte{0x0e}
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+
+		_, err := bv.Verify(createBundle(t, state, header, nil))
+		assert.Equal(t, ErrBlockProposerSignatureMissing, err)
+	})
+	t.Run("wrong size bitmap should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		header.Header.PubKeysBitmap = []byte{0x07, 0x00}
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+
+		_, err := bv.Verify(createBundle(t, state, header, nil))
+		assert.True(t, errors.Is(err, ErrWrongSizeBitmap))
+	})
+	t.Run("not enough signatures should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		header.Header.PubKeysBitmap = []byte{0x03}
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+
+		_, err := bv.Verify(createBundle(t, state, header, nil))
+		assert.True(t, errors.Is(err, ErrNotEnoughSignatures))
+	})
+	t.Run("invalid aggregated signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		args := createMockArgs(header)
+		header.Header.Nonce++
+		bv, _ := NewBundleVerifier(args)
+
+		_, err := bv.Verify(createBundle(t, state, header, nil))
+		assert.Equal(t, "invalid signature", err.Error())
+	})
+	t.Run("root hash not committed by the header should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader([]byte("another root hash"))
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+		bundle := createBundle(t, state, header, nil)
+		bundle.RootHash = hex.EncodeToString(state.rootHash)
+
+		_, err := bv.Verify(bundle)
+		assert.Equal(t, ErrRootHashMismatch, err)
+	})
+	t.Run("account proof not leading to the address should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+		bundle := createBundle(t, state, header, nil)
+		otherProof, _, _ := state.accountsTrie.GetProof(bytes.Repeat([]byte("b"), 32))
+		bundle.AccountProof = encodeProof(otherProof)
+
+		_, err := bv.Verify(bundle)
+		assert.True(t, errors.Is(err, ErrInvalidAccountProof))
+	})
+	t.Run("missing data trie proof should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+		bundle := createBundle(t, state, header, []byte("legacy key"))
+		bundle.DataTrieProof = nil
+
+		_, err := bv.Verify(bundle)
+		assert.Equal(t, ErrMissingDataTrieProof, err)
+	})
+	t.Run("data trie proof for another key should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createTestHeader(state.rootHash)
+		bv, _ := NewBundleVerifier(createMockArgs(header))
+		bundle := createBundle(t, state, header, []byte("legacy key"))
+		bundle.Key = hex.EncodeToString([]byte("another key"))
+
+		_, err := bv.Verify(bundle)
+		assert.True(t, errors.Is(err, ErrInvalidDataTrieProof))
+	})
+}
+
+func TestGetStateRootHash(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, GetStateRootHash(nil))
+	assert.Equal(t, []byte("root hash"), GetStateRootHash(&block.MetaBlock{RootHash: []byte("root hash")}))
+	assert.Equal(t, []byte("root hash"), GetStateRootHash(createTestHeader([]byte("root hash"))))
+
+	header := createTestHeader([]byte("root hash"))
+	header.ScheduledRootHash = []byte("scheduled root hash")
+	assert.Equal(t, []byte("scheduled root hash"), GetStateRootHash(header))
+}
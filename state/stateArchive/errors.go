@@ -0,0 +1,39 @@
+package stateArchive
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrEmptyRootHash signals that an empty root hash has been provided
+var ErrEmptyRootHash = errors.New("empty root hash")
+
+// ErrInvalidArchive signals that the archive does not have the expected layout
+var ErrInvalidArchive = errors.New("invalid state archive")
+
+// ErrUnsupportedArchiveVersion signals that the archive was created with an unsupported version
+var ErrUnsupportedArchiveVersion = errors.New("unsupported state archive version")
+
+// ErrRootHashMismatch signals that the archive root hash is not the expected one
+var ErrRootHashMismatch = errors.New("state archive root hash mismatch")
+
+// ErrShardIDMismatch signals that the archive was created for another shard
+var ErrShardIDMismatch = errors.New("state archive shard ID mismatch")
+
+// ErrInvalidTrieNode signals that an archived trie node does not match its hash
+var ErrInvalidTrieNode = errors.New("invalid trie node in state archive")
+
+// ErrRecordTooLarge signals that an archived record exceeds the maximum allowed size
+var ErrRecordTooLarge = errors.New("state archive record too large")
+
+// ErrNumTrieNodesMismatch signals that the number of trie nodes does not match the manifest
+var ErrNumTrieNodesMismatch = errors.New("number of trie nodes mismatch")
+
+// ErrChecksumMismatch signals that the archived trie nodes checksum does not match the manifest
+var ErrChecksumMismatch = errors.New("state archive checksum mismatch")
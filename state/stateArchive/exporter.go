@@ -0,0 +1,196 @@
+package stateArchive
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/stateArchive")
+
+const (
+	archiveFileMode      = 0644
+	progressLogInterval  = 100000
+	tempNodesFilePattern = "stateArchive-*.tmp"
+)
+
+// ArgsStateExporter is the DTO used to create a new state exporter
+type ArgsStateExporter struct {
+	Marshaller marshal.Marshalizer
+	Hasher     hashing.Hasher
+	Storer     common.BaseStorer
+}
+
+type stateExporter struct {
+	marshaller marshal.Marshalizer
+	hasher     hashing.Hasher
+	storer     common.BaseStorer
+}
+
+// NewStateExporter creates a new state exporter, reading the trie nodes from the provided storer
+func NewStateExporter(args ArgsStateExporter) (*stateExporter, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+
+	return &stateExporter{
+		marshaller: args.Marshaller,
+		hasher:     args.Hasher,
+		storer:     args.Storer,
+	}, nil
+}
+
+// Export writes the accounts trie with the given root hash, together with all the data tries, in a compressed state
+// archive at the output path. The trie nodes are first written in a temporary file next to the output, as the archive
+// entries need their size known upfront
+func (se *stateExporter) Export(rootHash []byte, epoch uint32, shardID uint32, outputPath string) (*Manifest, error) {
+	if common.IsEmptyTrie(rootHash) {
+		return nil, ErrEmptyRootHash
+	}
+
+	nodesFile, err := os.CreateTemp(filepath.Dir(outputPath), tempNodesFilePattern)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = nodesFile.Close()
+		_ = os.Remove(nodesFile.Name())
+	}()
+
+	manifest, err := se.writeTrieNodes(rootHash, nodesFile)
+	if err != nil {
+		return nil, err
+	}
+	manifest.Epoch = epoch
+	manifest.ShardID = shardID
+
+	_, err = nodesFile.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeArchive(outputPath, manifest, nodesFile)
+	if err != nil {
+		_ = os.Remove(outputPath)
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (se *stateExporter) writeTrieNodes(rootHash []byte, nodesFile *os.File) (*Manifest, error) {
+	checksum := sha256.New()
+	counter := &countingWriter{}
+	bufferedWriter := bufio.NewWriter(io.MultiWriter(nodesFile, checksum, counter))
+
+	numTrieNodes := uint64(0)
+	startTime := time.Now()
+	numDataTries, err := walkStateTries(rootHash, se.storer, se.marshaller, se.hasher, func(hash []byte, encodedNode []byte) error {
+		numTrieNodes++
+		if numTrieNodes%progressLogInterval == 0 {
+			log.Info("exporting state", "num trie nodes", numTrieNodes, "size", counter.size, "elapsed", time.Since(startTime))
+		}
+
+		return writeRecord(bufferedWriter, hash, encodedNode)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = bufferedWriter.Flush()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("exported trie nodes", "num trie nodes", numTrieNodes, "num data tries", numDataTries,
+		"size", counter.size, "elapsed", time.Since(startTime))
+
+	return &Manifest{
+		Version:          CurrentVersion,
+		RootHash:         hex.EncodeToString(rootHash),
+		NumTrieNodes:     numTrieNodes,
+		NumDataTries:     numDataTries,
+		NodesSizeInBytes: counter.size,
+		NodesChecksum:    hex.EncodeToString(checksum.Sum(nil)),
+	}, nil
+}
+
+func writeArchive(outputPath string, manifest *Manifest, nodesReader io.Reader) error {
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	outputFile, err := os.OpenFile(outputPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, archiveFileMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = outputFile.Close()
+	}()
+
+	gzipWriter := gzip.NewWriter(outputFile)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err = writeArchiveEntry(tarWriter, ManifestFileName, int64(len(manifestBytes)), bytes.NewReader(manifestBytes))
+	if err != nil {
+		return err
+	}
+
+	err = writeArchiveEntry(tarWriter, TrieNodesFileName, int64(manifest.NodesSizeInBytes), nodesReader)
+	if err != nil {
+		return err
+	}
+
+	err = tarWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	err = gzipWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	return outputFile.Sync()
+}
+
+func writeArchiveEntry(tarWriter *tar.Writer, name string, size int64, reader io.Reader) error {
+	err := tarWriter.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    archiveFileMode,
+		Size:    size,
+		ModTime: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.CopyN(tarWriter, reader, size)
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (se *stateExporter) IsInterfaceNil() bool {
+	return se == nil
+}
@@ -0,0 +1,243 @@
+package stateArchive
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshaller = &marshal.GogoProtoMarshalizer{}
+	testHasher     = blake2b.NewBlake2b()
+)
+
+const (
+	testNumAccounts       = 50
+	testNumDataTries      = 10
+	testNumDataTrieValues = 20
+	testEpoch             = uint32(4)
+	testShardID           = uint32(1)
+)
+
+type testState struct {
+	storageManager common.StorageManager
+	rootHash       []byte
+}
+
+// createTestState creates and commits an accounts trie with testNumAccounts accounts, the first testNumDataTries of
+// them having a data trie
+func createTestState(t *testing.T) *testState {
+	args := storage.GetStorageManagerArgs()
+	args.Marshalizer = testMarshaller
+	args.Hasher = testHasher
+	storageManager, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	newTrie := func() common.Trie {
+		tr, errNew := trie.NewTrie(storageManager, testMarshaller, testHasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+		require.Nil(t, errNew)
+		return tr
+	}
+
+	accountsTrie := newTrie()
+	for i := 0; i < testNumAccounts; i++ {
+		address := testHasher.Compute(fmt.Sprintf("address%d", i))
+		account := &accounts.UserAccountData{
+			Nonce:   uint64(i),
+			Balance: big.NewInt(int64(i)),
+			Address: address,
+		}
+
+		if i < testNumDataTries {
+			dataTrie := newTrie()
+			for j := 0; j < testNumDataTrieValues; j++ {
+				require.Nil(t, dataTrie.Update([]byte(fmt.Sprintf("key%d_%d", i, j)), []byte(fmt.Sprintf("value%d", j))))
+			}
+			require.Nil(t, dataTrie.Commit())
+			account.RootHash, err = dataTrie.RootHash()
+			require.Nil(t, err)
+		}
+
+		accountBytes, errMarshal := testMarshaller.Marshal(account)
+		require.Nil(t, errMarshal)
+		require.Nil(t, accountsTrie.Update(address, accountBytes))
+	}
+	require.Nil(t, accountsTrie.Commit())
+
+	rootHash, err := accountsTrie.RootHash()
+	require.Nil(t, err)
+
+	return &testState{
+		storageManager: storageManager,
+		rootHash:       rootHash,
+	}
+}
+
+func createMockArgsStateExporter() ArgsStateExporter {
+	return ArgsStateExporter{
+		Marshaller: testMarshaller,
+		Hasher:     testHasher,
+		Storer:     testscommon.NewMemDbMock(),
+	}
+}
+
+func exportTestState(t *testing.T, state *testState) (string, *Manifest) {
+	args := createMockArgsStateExporter()
+	args.Storer = state.storageManager
+	exporter, _ := NewStateExporter(args)
+
+	outputPath := filepath.Join(t.TempDir(), "state.tar.gz")
+	manifest, err := exporter.Export(state.rootHash, testEpoch, testShardID, outputPath)
+	require.Nil(t, err)
+
+	return outputPath, manifest
+}
+
+func TestNewStateExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter()
+		args.Marshaller = nil
+		exporter, err := NewStateExporter(args)
+		assert.Equal(t, ErrNilMarshaller, err)
+		assert.Nil(t, exporter)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter()
+		args.Hasher = nil
+		exporter, err := NewStateExporter(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.Nil(t, exporter)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter()
+		args.Storer = nil
+		exporter, err := NewStateExporter(args)
+		assert.Equal(t, ErrNilStorer, err)
+		assert.Nil(t, exporter)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, err := NewStateExporter(createMockArgsStateExporter())
+		assert.Nil(t, err)
+		assert.False(t, exporter.IsInterfaceNil())
+	})
+}
+
+func TestStateExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, _ := NewStateExporter(createMockArgsStateExporter())
+		outputPath := filepath.Join(t.TempDir(), "state.tar.gz")
+		manifest, err := exporter.Export(common.EmptyTrieHash, testEpoch, testShardID, outputPath)
+		assert.Equal(t, ErrEmptyRootHash, err)
+		assert.Nil(t, manifest)
+	})
+	t.Run("missing trie node should error and not leave files behind", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, _ := NewStateExporter(createMockArgsStateExporter())
+		outputDir := t.TempDir()
+		manifest, err := exporter.Export([]byte("missing root hash"), testEpoch, testShardID, filepath.Join(outputDir, "state.tar.gz"))
+		assert.NotNil(t, err)
+		assert.Nil(t, manifest)
+
+		entries, _ := os.ReadDir(outputDir)
+		assert.Empty(t, entries)
+	})
+	t.Run("storer error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsStateExporter()
+		args.Storer = &storage.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		exporter, _ := NewStateExporter(args)
+		_, err := exporter.Export([]byte("root hash"), testEpoch, testShardID, filepath.Join(t.TempDir(), "state.tar.gz"))
+		assert.Contains(t, err.Error(), expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		state := createTestState(t)
+		outputPath, manifest := exportTestState(t, state)
+
+		assert.Equal(t, CurrentVersion, manifest.Version)
+		assert.Equal(t, hex.EncodeToString(state.rootHash), manifest.RootHash)
+		assert.Equal(t, testEpoch, manifest.Epoch)
+		assert.Equal(t, testShardID, manifest.ShardID)
+		assert.Equal(t, uint64(testNumDataTries), manifest.NumDataTries)
+		assert.True(t, manifest.NumTrieNodes > testNumAccounts+testNumDataTries*testNumDataTrieValues)
+		assert.NotZero(t, manifest.NodesSizeInBytes)
+		assert.NotEmpty(t, manifest.NodesChecksum)
+
+		readManifest, err := ReadManifest(outputPath)
+		require.Nil(t, err)
+		assert.Equal(t, manifest, readManifest)
+
+		entries, _ := os.ReadDir(filepath.Dir(outputPath))
+		require.Len(t, entries, 1)
+	})
+}
+
+func TestReadManifest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		manifest, err := ReadManifest(filepath.Join(t.TempDir(), "missing.tar.gz"))
+		assert.NotNil(t, err)
+		assert.Nil(t, manifest)
+	})
+	t.Run("not an archive should error", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "state.tar.gz")
+		require.Nil(t, os.WriteFile(path, []byte("not an archive"), archiveFileMode))
+
+		manifest, err := ReadManifest(path)
+		assert.True(t, errors.Is(err, ErrInvalidArchive))
+		assert.Nil(t, manifest)
+	})
+	t.Run("unsupported version should error", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "state.tar.gz")
+		require.Nil(t, writeArchive(path, &Manifest{Version: CurrentVersion + 1}, bytes.NewReader(nil)))
+
+		manifest, err := ReadManifest(path)
+		assert.True(t, errors.Is(err, ErrUnsupportedArchiveVersion))
+		assert.Nil(t, manifest)
+	})
+}
@@ -0,0 +1,187 @@
+package stateArchive
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// ArgsStateImporter is the DTO used to create a new state importer
+type ArgsStateImporter struct {
+	Marshaller marshal.Marshalizer
+	Hasher     hashing.Hasher
+}
+
+type stateImporter struct {
+	marshaller marshal.Marshalizer
+	hasher     hashing.Hasher
+}
+
+// NewStateImporter creates a new state importer
+func NewStateImporter(args ArgsStateImporter) (*stateImporter, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &stateImporter{
+		marshaller: args.Marshaller,
+		hasher:     args.Hasher,
+	}, nil
+}
+
+// Import writes the trie nodes from the state archive found at the given path into the provided storer. The archive
+// must hold the state of the given shard at the expected root hash. Each trie node is checked against its hash while
+// importing, then the archive checksum is checked and the imported state is walked from the root hash to make sure it
+// is complete. An error means the imported state can not be used
+func (si *stateImporter) Import(archivePath string, expectedRootHash []byte, shardID uint32, storer common.BaseStorer) (*Manifest, error) {
+	if common.IsEmptyTrie(expectedRootHash) {
+		return nil, ErrEmptyRootHash
+	}
+	if check.IfNil(storer) {
+		return nil, ErrNilStorer
+	}
+
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	gzipReader, err := gzip.NewReader(bufio.NewReader(file))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err.Error())
+	}
+	defer func() {
+		_ = gzipReader.Close()
+	}()
+
+	tarReader := tar.NewReader(gzipReader)
+	manifest, err := readManifestEntry(tarReader)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkManifest(manifest, expectedRootHash, shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err.Error())
+	}
+	if header.Name != TrieNodesFileName {
+		return nil, fmt.Errorf("%w: expected %s entry, got %s", ErrInvalidArchive, TrieNodesFileName, header.Name)
+	}
+
+	startTime := time.Now()
+	err = si.importTrieNodes(tarReader, manifest, storer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = si.checkCompleteness(expectedRootHash, manifest, storer)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("imported state archive", "root hash", manifest.RootHash, "epoch", manifest.Epoch,
+		"num trie nodes", manifest.NumTrieNodes, "num data tries", manifest.NumDataTries, "elapsed", time.Since(startTime))
+
+	return manifest, nil
+}
+
+func checkManifest(manifest *Manifest, expectedRootHash []byte, shardID uint32) error {
+	rootHash, err := hex.DecodeString(manifest.RootHash)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidArchive, err.Error())
+	}
+	if !bytes.Equal(rootHash, expectedRootHash) {
+		return fmt.Errorf("%w: expected %x, archive has %s", ErrRootHashMismatch, expectedRootHash, manifest.RootHash)
+	}
+	if manifest.ShardID != shardID {
+		return fmt.Errorf("%w: expected %d, archive has %d", ErrShardIDMismatch, shardID, manifest.ShardID)
+	}
+
+	return nil
+}
+
+func (si *stateImporter) importTrieNodes(reader io.Reader, manifest *Manifest, storer common.BaseStorer) error {
+	checksum := sha256.New()
+	counter := &countingWriter{}
+	recordsReader := bufio.NewReader(io.TeeReader(reader, io.MultiWriter(checksum, counter)))
+
+	numTrieNodes := uint64(0)
+	for {
+		hash, encodedNode, err := readRecord(recordsReader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidArchive, err.Error())
+		}
+
+		if !bytes.Equal(si.hasher.Compute(string(encodedNode)), hash) {
+			return fmt.Errorf("%w: hash %x", ErrInvalidTrieNode, hash)
+		}
+
+		err = storer.Put(hash, encodedNode)
+		if err != nil {
+			return err
+		}
+
+		numTrieNodes++
+		if numTrieNodes%progressLogInterval == 0 {
+			log.Info("importing state archive", "num trie nodes", numTrieNodes, "total", manifest.NumTrieNodes)
+		}
+	}
+
+	if numTrieNodes != manifest.NumTrieNodes {
+		return fmt.Errorf("%w: manifest has %d, archive has %d", ErrNumTrieNodesMismatch, manifest.NumTrieNodes, numTrieNodes)
+	}
+	if counter.size != manifest.NodesSizeInBytes || hex.EncodeToString(checksum.Sum(nil)) != manifest.NodesChecksum {
+		return ErrChecksumMismatch
+	}
+
+	return nil
+}
+
+func (si *stateImporter) checkCompleteness(rootHash []byte, manifest *Manifest, storer common.BaseStorer) error {
+	numTrieNodes := uint64(0)
+	numDataTries, err := walkStateTries(rootHash, storer, si.marshaller, si.hasher, func(_ []byte, _ []byte) error {
+		numTrieNodes++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("incomplete state after import: %w", err)
+	}
+
+	if numTrieNodes != manifest.NumTrieNodes || numDataTries != manifest.NumDataTries {
+		return fmt.Errorf("%w: manifest has %d trie nodes and %d data tries, imported state has %d trie nodes and %d data tries",
+			ErrNumTrieNodesMismatch, manifest.NumTrieNodes, manifest.NumDataTries, numTrieNodes, numDataTries)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (si *stateImporter) IsInterfaceNil() bool {
+	return si == nil
+}
@@ -0,0 +1,202 @@
+package stateArchive
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsStateImporter() ArgsStateImporter {
+	return ArgsStateImporter{
+		Marshaller: testMarshaller,
+		Hasher:     testHasher,
+	}
+}
+
+// writeTestArchive writes an archive holding the provided records, with a manifest matching them
+func writeTestArchive(t *testing.T, rootHash []byte, numDataTries uint64, records [][2][]byte) string {
+	nodes := bytes.NewBuffer(nil)
+	for _, record := range records {
+		require.Nil(t, writeRecord(nodes, record[0], record[1]))
+	}
+	checksum := sha256.Sum256(nodes.Bytes())
+
+	manifest := &Manifest{
+		Version:          CurrentVersion,
+		RootHash:         hex.EncodeToString(rootHash),
+		Epoch:            testEpoch,
+		ShardID:          testShardID,
+		NumTrieNodes:     uint64(len(records)),
+		NumDataTries:     numDataTries,
+		NodesSizeInBytes: uint64(nodes.Len()),
+		NodesChecksum:    hex.EncodeToString(checksum[:]),
+	}
+
+	path := filepath.Join(t.TempDir(), "state.tar.gz")
+	require.Nil(t, writeArchive(path, manifest, nodes))
+
+	return path
+}
+
+func getTestStateRecords(t *testing.T, state *testState) [][2][]byte {
+	records := make([][2][]byte, 0)
+	_, err := walkStateTries(state.rootHash, state.storageManager, testMarshaller, testHasher, func(hash []byte, encodedNode []byte) error {
+		records = append(records, [2][]byte{hash, encodedNode})
+		return nil
+	})
+	require.Nil(t, err)
+
+	return records
+}
+
+func TestNewStateImporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateImporter()
+		args.Marshaller = nil
+		importer, err := NewStateImporter(args)
+		assert.Equal(t, ErrNilMarshaller, err)
+		assert.Nil(t, importer)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateImporter()
+		args.Hasher = nil
+		importer, err := NewStateImporter(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.Nil(t, importer)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		importer, err := NewStateImporter(createMockArgsStateImporter())
+		assert.Nil(t, err)
+		assert.False(t, importer.IsInterfaceNil())
+	})
+}
+
+func TestStateImporter_Import(t *testing.T) {
+	t.Parallel()
+
+	state := createTestState(t)
+	archivePath, exportedManifest := exportTestState(t, state)
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter())
+		_, err := importer.Import(archivePath, nil, testShardID, testscommon.NewMemDbMock())
+		assert.Equal(t, ErrEmptyRootHash, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter())
+		_, err := importer.Import(archivePath, state.rootHash, testShardID, nil)
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("missing archive should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter())
+		_, err := importer.Import(filepath.Join(t.TempDir(), "missing"), state.rootHash, testShardID, testscommon.NewMemDbMock())
+		assert.NotNil(t, err)
+	})
+	t.Run("root hash mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter())
+		_, err := importer.Import(archivePath, testHasher.Compute("other root hash"), testShardID, testscommon.NewMemDbMock())
+		assert.True(t, errors.Is(err, ErrRootHashMismatch))
+	})
+	t.Run("shard ID mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter())
+		_, err := importer.Import(archivePath, state.rootHash, testShardID+1, testscommon.NewMemDbMock())
+		assert.True(t, errors.Is(err, ErrShardIDMismatch))
+	})
+	t.Run("trie node not matching its hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		records := getTestStateRecords(t, state)
+		records[len(records)/2][1] = append([]byte("tampered"), records[len(records)/2][1]...)
+		path := writeTestArchive(t, state.rootHash, testNumDataTries, records)
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter())
+		_, err := importer.Import(path, state.rootHash, testShardID, testscommon.NewMemDbMock())
+		assert.True(t, errors.Is(err, ErrInvalidTrieNode))
+	})
+	t.Run("checksum mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		records := getTestStateRecords(t, state)
+		path := writeTestArchive(t, state.rootHash, testNumDataTries, records)
+		manifest, _ := ReadManifest(path)
+		manifest.NodesChecksum = hex.EncodeToString(testHasher.Compute("other checksum"))
+		nodes := bytes.NewBuffer(nil)
+		for _, record := range records {
+			require.Nil(t, writeRecord(nodes, record[0], record[1]))
+		}
+		require.Nil(t, writeArchive(path, manifest, nodes))
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter())
+		_, err := importer.Import(path, state.rootHash, testShardID, testscommon.NewMemDbMock())
+		assert.Equal(t, ErrChecksumMismatch, err)
+	})
+	t.Run("incomplete state should error", func(t *testing.T) {
+		t.Parallel()
+
+		records := getTestStateRecords(t, state)
+		path := writeTestArchive(t, state.rootHash, testNumDataTries, records[:len(records)-1])
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter())
+		_, err := importer.Import(path, state.rootHash, testShardID, testscommon.NewMemDbMock())
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "incomplete state after import")
+	})
+	t.Run("truncated archive should error", func(t *testing.T) {
+		t.Parallel()
+
+		archiveBytes, err := os.ReadFile(archivePath)
+		require.Nil(t, err)
+		path := filepath.Join(t.TempDir(), "state.tar.gz")
+		require.Nil(t, os.WriteFile(path, archiveBytes[:len(archiveBytes)/2], archiveFileMode))
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter())
+		_, err = importer.Import(path, state.rootHash, testShardID, testscommon.NewMemDbMock())
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testscommon.NewMemDbMock()
+		importer, _ := NewStateImporter(createMockArgsStateImporter())
+		manifest, err := importer.Import(archivePath, state.rootHash, testShardID, storer)
+		require.Nil(t, err)
+		assert.Equal(t, exportedManifest, manifest)
+
+		numLeaves := 0
+		err = trie.WalkTrieNodes(state.rootHash, storer, testMarshaller, testHasher, func(_ []byte, _ []byte, leafValue []byte) error {
+			if leafValue != nil {
+				numLeaves++
+			}
+			return nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, testNumAccounts, numLeaves)
+	})
+}
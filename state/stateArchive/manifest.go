@@ -0,0 +1,85 @@
+package stateArchive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	// CurrentVersion is the version of the state archives created by this package
+	CurrentVersion = uint32(1)
+
+	// ManifestFileName is the name of the manifest entry, always the first one in the archive
+	ManifestFileName = "manifest.json"
+
+	// TrieNodesFileName is the name of the entry holding the archived trie nodes
+	TrieNodesFileName = "trieNodes.bin"
+
+	maxManifestSizeInBytes = 1024 * 1024
+)
+
+// Manifest describes the content of a state archive
+type Manifest struct {
+	Version          uint32 `json:"version"`
+	RootHash         string `json:"rootHash"`
+	Epoch            uint32 `json:"epoch"`
+	ShardID          uint32 `json:"shardID"`
+	NumTrieNodes     uint64 `json:"numTrieNodes"`
+	NumDataTries     uint64 `json:"numDataTries"`
+	NodesSizeInBytes uint64 `json:"nodesSizeInBytes"`
+	NodesChecksum    string `json:"nodesChecksum"`
+}
+
+// ReadManifest opens the state archive from the given path and returns its manifest, without reading the trie nodes
+func ReadManifest(archivePath string) (*Manifest, error) {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err.Error())
+	}
+	defer func() {
+		_ = gzipReader.Close()
+	}()
+
+	return readManifestEntry(tar.NewReader(gzipReader))
+}
+
+func readManifestEntry(tarReader *tar.Reader) (*Manifest, error) {
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err.Error())
+	}
+	if header.Name != ManifestFileName {
+		return nil, fmt.Errorf("%w: expected %s as first entry, got %s", ErrInvalidArchive, ManifestFileName, header.Name)
+	}
+	if header.Size > maxManifestSizeInBytes {
+		return nil, fmt.Errorf("%w: manifest too large", ErrInvalidArchive)
+	}
+
+	manifestBytes, err := io.ReadAll(tarReader)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(manifestBytes, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err.Error())
+	}
+	if manifest.Version != CurrentVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedArchiveVersion, manifest.Version)
+	}
+
+	return manifest, nil
+}
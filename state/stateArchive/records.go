@@ -0,0 +1,85 @@
+package stateArchive
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	recordLengthSize     = 4
+	maxRecordSizeInBytes = 32 * 1024 * 1024
+)
+
+// writeRecord writes the key-value pair as length prefixed fields: key length, key, value length, value
+func writeRecord(writer io.Writer, key []byte, value []byte) error {
+	err := writeField(writer, key)
+	if err != nil {
+		return err
+	}
+
+	return writeField(writer, value)
+}
+
+func writeField(writer io.Writer, field []byte) error {
+	lengthBuff := make([]byte, recordLengthSize)
+	binary.BigEndian.PutUint32(lengthBuff, uint32(len(field)))
+
+	_, err := writer.Write(lengthBuff)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(field)
+	return err
+}
+
+// readRecord reads a key-value pair written by writeRecord. It returns io.EOF if there are no more records
+func readRecord(reader io.Reader) ([]byte, []byte, error) {
+	key, err := readField(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	value, err := readField(reader)
+	if err == io.EOF {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, value, nil
+}
+
+func readField(reader io.Reader) ([]byte, error) {
+	lengthBuff := make([]byte, recordLengthSize)
+	_, err := io.ReadFull(reader, lengthBuff)
+	if err != nil {
+		return nil, err
+	}
+
+	length := binary.BigEndian.Uint32(lengthBuff)
+	if length > maxRecordSizeInBytes {
+		return nil, fmt.Errorf("%w: %d bytes", ErrRecordTooLarge, length)
+	}
+
+	field := make([]byte, length)
+	_, err = io.ReadFull(reader, field)
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return field, err
+}
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	size uint64
+}
+
+// Write counts the provided bytes
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	cw.size += uint64(len(p))
+	return len(p), nil
+}
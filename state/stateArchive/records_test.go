@@ -0,0 +1,59 @@
+package stateArchive
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteAndReadRecord(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff := bytes.NewBuffer(nil)
+		require.Nil(t, writeRecord(buff, []byte("key1"), []byte("value1")))
+		require.Nil(t, writeRecord(buff, []byte("key2"), []byte("")))
+
+		key, value, err := readRecord(buff)
+		require.Nil(t, err)
+		assert.Equal(t, []byte("key1"), key)
+		assert.Equal(t, []byte("value1"), value)
+
+		key, value, err = readRecord(buff)
+		require.Nil(t, err)
+		assert.Equal(t, []byte("key2"), key)
+		assert.Empty(t, value)
+
+		_, _, err = readRecord(buff)
+		assert.Equal(t, io.EOF, err)
+	})
+	t.Run("record too large should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := bytes.NewBuffer([]byte{0xFF, 0xFF, 0xFF, 0xFF})
+		_, _, err := readRecord(buff)
+		assert.True(t, errors.Is(err, ErrRecordTooLarge))
+	})
+	t.Run("missing value should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := bytes.NewBuffer(nil)
+		require.Nil(t, writeField(buff, []byte("key")))
+		_, _, err := readRecord(buff)
+		assert.Equal(t, io.ErrUnexpectedEOF, err)
+	})
+	t.Run("truncated field should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := bytes.NewBuffer(nil)
+		require.Nil(t, writeField(buff, []byte("key")))
+		_, _, err := readRecord(bytes.NewBuffer(buff.Bytes()[:buff.Len()-1]))
+		assert.Equal(t, io.ErrUnexpectedEOF, err)
+	})
+}
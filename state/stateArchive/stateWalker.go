@@ -0,0 +1,50 @@
+package stateArchive
+
+import (
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+// walkStateTries walks the accounts trie with the given root hash and, for each account, its data trie. Data tries
+// shared by more accounts are walked only once. It returns the number of walked data tries
+func walkStateTries(
+	rootHash []byte,
+	db common.BaseStorer,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+	handler func(hash []byte, encodedNode []byte) error,
+) (uint64, error) {
+	dataTrieRootHashes := make(map[string]struct{})
+	err := trie.WalkTrieNodes(rootHash, db, marshaller, hasher, func(hash []byte, encodedNode []byte, leafValue []byte) error {
+		err := handler(hash, encodedNode)
+		if err != nil {
+			return err
+		}
+		if leafValue == nil {
+			return nil
+		}
+
+		accountData := &accounts.UserAccountData{}
+		err = marshaller.Unmarshal(accountData, leafValue)
+		if err != nil {
+			log.Trace("this must be a leaf with code", "hash", hash, "err", err)
+			return nil
+		}
+		if common.IsEmptyTrie(accountData.RootHash) {
+			return nil
+		}
+		if _, isWalked := dataTrieRootHashes[string(accountData.RootHash)]; isWalked {
+			return nil
+		}
+		dataTrieRootHashes[string(accountData.RootHash)] = struct{}{}
+
+		return trie.WalkTrieNodes(accountData.RootHash, db, marshaller, hasher, func(hash []byte, encodedNode []byte, _ []byte) error {
+			return handler(hash, encodedNode)
+		})
+	})
+
+	return uint64(len(dataTrieRootHashes)), err
+}
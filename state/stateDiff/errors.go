@@ -0,0 +1,24 @@
+package stateDiff
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrNilContext signals that a nil context has been provided
+var ErrNilContext = errors.New("nil context")
+
+// ErrInvalidMaxAccounts signals that an invalid maximum number of accounts has been provided
+var ErrInvalidMaxAccounts = errors.New("invalid maximum number of accounts")
+
+// ErrInvalidMaxDataTrieChanges signals that an invalid maximum number of data trie changes has been provided
+var ErrInvalidMaxDataTrieChanges = errors.New("invalid maximum number of data trie changes")
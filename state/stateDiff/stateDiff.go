@@ -0,0 +1,81 @@
+package stateDiff
+
+import "github.com/multiversx/mx-chain-core-go/core"
+
+// ChangeType defines the way an account changed between the two compared states
+type ChangeType string
+
+const (
+	// Added marks an account which exists only in the newer state
+	Added ChangeType = "added"
+	// Removed marks an account which exists only in the older state
+	Removed ChangeType = "removed"
+	// Modified marks an account which exists in both states, with different contents
+	Modified ChangeType = "modified"
+)
+
+// Names of the account fields which can be reported as changed
+const (
+	NonceField           = "nonce"
+	BalanceField         = "balance"
+	DeveloperRewardField = "developerReward"
+	CodeHashField        = "codeHash"
+	CodeMetadataField    = "codeMetadata"
+	OwnerAddressField    = "ownerAddress"
+	UsernameField        = "username"
+	RootHashField        = "rootHash"
+)
+
+// Request holds the parameters of a state diff query. Each compared state is given either by its root hash or by the
+// nonce of the block which produced it
+type Request struct {
+	FromRootHash   string
+	ToRootHash     string
+	FromBlockNonce core.OptionalUint64
+	ToBlockNonce   core.OptionalUint64
+	StartAddress   string
+	MaxAccounts    int
+
+	// MaxDataTrieChangesPerAccount is set by the facade, from the node configuration
+	MaxDataTrieChangesPerAccount int
+}
+
+// StateDiff holds a page of the accounts which differ between two states, in the order they are found in the accounts
+// trie. If more accounts differ, the next page can be requested using the next address as start address
+type StateDiff struct {
+	FromRootHash string         `json:"fromRootHash"`
+	ToRootHash   string         `json:"toRootHash"`
+	Accounts     []*AccountDiff `json:"accounts"`
+	NextAddress  string         `json:"nextAddress,omitempty"`
+}
+
+// AccountDiff describes how an account changed between two states
+type AccountDiff struct {
+	Address                 string            `json:"address"`
+	Change                  ChangeType        `json:"change"`
+	ChangedFields           []string          `json:"changedFields,omitempty"`
+	Old                     *AccountState     `json:"old,omitempty"`
+	New                     *AccountState     `json:"new,omitempty"`
+	DataTrieChanges         []*DataTrieChange `json:"dataTrieChanges,omitempty"`
+	IsDataTrieDiffTruncated bool              `json:"isDataTrieDiffTruncated,omitempty"`
+}
+
+// AccountState holds the fields of an account in one of the compared states
+type AccountState struct {
+	Nonce           uint64 `json:"nonce"`
+	Balance         string `json:"balance"`
+	DeveloperReward string `json:"developerReward"`
+	CodeHash        string `json:"codeHash,omitempty"`
+	CodeMetadata    string `json:"codeMetadata,omitempty"`
+	OwnerAddress    string `json:"ownerAddress,omitempty"`
+	Username        string `json:"username,omitempty"`
+	RootHash        string `json:"rootHash,omitempty"`
+}
+
+// DataTrieChange describes a changed key of a data trie. The old value is empty for an added key, while the new value
+// is empty for a removed one. All fields are hex encoded
+type DataTrieChange struct {
+	Key      string `json:"key"`
+	OldValue string `json:"oldValue,omitempty"`
+	NewValue string `json:"newValue,omitempty"`
+}
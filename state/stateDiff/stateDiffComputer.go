@@ -0,0 +1,364 @@
+package stateDiff
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/dataTrieValue"
+	"github.com/multiversx/mx-chain-go/trie"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/stateDiff")
+
+// ArgsStateDiffComputer is the DTO used to create a new state diff computer
+type ArgsStateDiffComputer struct {
+	Storer                 common.BaseStorer
+	Marshaller             marshal.Marshalizer
+	Hasher                 hashing.Hasher
+	AddressPubkeyConverter core.PubkeyConverter
+}
+
+type trieDiffer interface {
+	Diff(oldRootHash []byte, newRootHash []byte, startKey []byte, handler trie.TrieLeavesDiffHandler) error
+}
+
+type stateDiffComputer struct {
+	differ                 trieDiffer
+	marshaller             marshal.Marshalizer
+	addressPubkeyConverter core.PubkeyConverter
+}
+
+type dataTrieValues struct {
+	oldValue []byte
+	newValue []byte
+}
+
+// NewStateDiffComputer creates a new state diff computer, reading the trie nodes of both compared states from the
+// provided storer
+func NewStateDiffComputer(args ArgsStateDiffComputer) (*stateDiffComputer, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	differ, err := trie.NewTrieDiffer(trie.ArgsTrieDiffer{
+		Storer:     args.Storer,
+		Marshaller: args.Marshaller,
+		Hasher:     args.Hasher,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateDiffComputer{
+		differ:                 differ,
+		marshaller:             args.Marshaller,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+	}, nil
+}
+
+// ComputeDiff returns at most maxAccounts accounts which differ between the two states, starting after the provided
+// address, if any. For each of them, at most maxDataTrieChangesPerAccount changed data trie keys are reported. The
+// accounts trie and the data tries are walked in lockstep, the identical subtries being skipped
+func (sdc *stateDiffComputer) ComputeDiff(
+	ctx context.Context,
+	fromRootHash []byte,
+	toRootHash []byte,
+	startAddress []byte,
+	maxAccounts int,
+	maxDataTrieChangesPerAccount int,
+) (*StateDiff, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+	if maxAccounts <= 0 {
+		return nil, ErrInvalidMaxAccounts
+	}
+	if maxDataTrieChangesPerAccount <= 0 {
+		return nil, ErrInvalidMaxDataTrieChanges
+	}
+
+	diff := &StateDiff{
+		FromRootHash: hex.EncodeToString(fromRootHash),
+		ToRootHash:   hex.EncodeToString(toRootHash),
+		Accounts:     make([]*AccountDiff, 0),
+	}
+
+	var lastAddress []byte
+	err := sdc.differ.Diff(fromRootHash, toRootHash, startAddress, func(oldLeaf *core.TrieData, newLeaf *core.TrieData) (bool, error) {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+
+		oldAccount := sdc.getAccount(oldLeaf)
+		newAccount := sdc.getAccount(newLeaf)
+		if oldAccount == nil && newAccount == nil {
+			// code entries are also kept in the accounts trie
+			return true, nil
+		}
+		if len(diff.Accounts) == maxAccounts {
+			diff.NextAddress = sdc.addressPubkeyConverter.SilentEncode(lastAddress, log)
+			return false, nil
+		}
+
+		accountDiff, err := sdc.computeAccountDiff(ctx, oldAccount, newAccount, maxDataTrieChangesPerAccount)
+		if err != nil {
+			return false, err
+		}
+
+		diff.Accounts = append(diff.Accounts, accountDiff)
+		lastAddress = getAddress(oldAccount, newAccount)
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+func (sdc *stateDiffComputer) getAccount(leaf *core.TrieData) *accounts.UserAccountData {
+	if leaf == nil {
+		return nil
+	}
+
+	account := &accounts.UserAccountData{}
+	err := sdc.marshaller.Unmarshal(account, leaf.Value)
+	if err != nil || !bytes.Equal(account.Address, leaf.Key) {
+		return nil
+	}
+
+	return account
+}
+
+func getAddress(oldAccount *accounts.UserAccountData, newAccount *accounts.UserAccountData) []byte {
+	if newAccount != nil {
+		return newAccount.Address
+	}
+
+	return oldAccount.Address
+}
+
+func getRootHash(account *accounts.UserAccountData) []byte {
+	if account == nil {
+		return nil
+	}
+
+	return account.RootHash
+}
+
+func (sdc *stateDiffComputer) computeAccountDiff(
+	ctx context.Context,
+	oldAccount *accounts.UserAccountData,
+	newAccount *accounts.UserAccountData,
+	maxDataTrieChanges int,
+) (*AccountDiff, error) {
+	address := getAddress(oldAccount, newAccount)
+	accountDiff := &AccountDiff{
+		Address: sdc.addressPubkeyConverter.SilentEncode(address, log),
+		Old:     sdc.toAccountState(oldAccount),
+		New:     sdc.toAccountState(newAccount),
+	}
+
+	switch {
+	case oldAccount == nil:
+		accountDiff.Change = Added
+	case newAccount == nil:
+		accountDiff.Change = Removed
+	default:
+		accountDiff.Change = Modified
+		accountDiff.ChangedFields = getChangedFields(accountDiff.Old, accountDiff.New)
+	}
+
+	oldRootHash := getRootHash(oldAccount)
+	newRootHash := getRootHash(newAccount)
+	if bytes.Equal(oldRootHash, newRootHash) {
+		return accountDiff, nil
+	}
+
+	dataTrieChanges, isTruncated, err := sdc.computeDataTrieDiff(ctx, address, oldRootHash, newRootHash, maxDataTrieChanges)
+	if err != nil {
+		return nil, err
+	}
+	accountDiff.DataTrieChanges = dataTrieChanges
+	accountDiff.IsDataTrieDiffTruncated = isTruncated
+
+	return accountDiff, nil
+}
+
+// computeDataTrieDiff returns the changed keys of a data trie, sorted by key. A key migrated to the new data trie
+// leaf version is found in the tries under different paths, so the changes are merged by key and the keys with the
+// same value in both states are not reported
+func (sdc *stateDiffComputer) computeDataTrieDiff(
+	ctx context.Context,
+	address []byte,
+	oldRootHash []byte,
+	newRootHash []byte,
+	maxDataTrieChanges int,
+) ([]*DataTrieChange, bool, error) {
+	changes := make(map[string]*dataTrieValues)
+	isTruncated := false
+	addChange := func(key []byte, value []byte, isOld bool) bool {
+		entry, found := changes[string(key)]
+		if !found {
+			if len(changes) == maxDataTrieChanges {
+				isTruncated = true
+				return false
+			}
+
+			entry = &dataTrieValues{}
+			changes[string(key)] = entry
+		}
+
+		if isOld {
+			entry.oldValue = value
+		} else {
+			entry.newValue = value
+		}
+
+		return true
+	}
+
+	err := sdc.differ.Diff(oldRootHash, newRootHash, nil, func(oldLeaf *core.TrieData, newLeaf *core.TrieData) (bool, error) {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+
+		if oldLeaf != nil {
+			key, value, err := sdc.getDataTrieKeyValue(address, oldLeaf)
+			if err != nil {
+				return false, err
+			}
+			if !addChange(key, value, true) {
+				return false, nil
+			}
+		}
+		if newLeaf != nil {
+			key, value, err := sdc.getDataTrieKeyValue(address, newLeaf)
+			if err != nil {
+				return false, err
+			}
+			if !addChange(key, value, false) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	keys := make([]string, 0, len(changes))
+	for key, entry := range changes {
+		if bytes.Equal(entry.oldValue, entry.newValue) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	dataTrieChanges := make([]*DataTrieChange, 0, len(keys))
+	for _, key := range keys {
+		dataTrieChanges = append(dataTrieChanges, &DataTrieChange{
+			Key:      hex.EncodeToString([]byte(key)),
+			OldValue: hex.EncodeToString(changes[key].oldValue),
+			NewValue: hex.EncodeToString(changes[key].newValue),
+		})
+	}
+
+	return dataTrieChanges, isTruncated, nil
+}
+
+// getDataTrieKeyValue returns the key and the value held by a data trie leaf, in the format given by the leaf version
+func (sdc *stateDiffComputer) getDataTrieKeyValue(address []byte, leaf *core.TrieData) ([]byte, []byte, error) {
+	if leaf.Version == core.AutoBalanceEnabled {
+		leafData := &dataTrieValue.TrieLeafData{}
+		err := sdc.marshaller.Unmarshal(leafData, leaf.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return leafData.Key, leafData.Value, nil
+	}
+
+	value, err := common.TrimSuffixFromValue(leaf.Value, len(leaf.Key)+len(address))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return leaf.Key, value, nil
+}
+
+func (sdc *stateDiffComputer) toAccountState(account *accounts.UserAccountData) *AccountState {
+	if account == nil {
+		return nil
+	}
+
+	accountState := &AccountState{
+		Nonce:           account.Nonce,
+		Balance:         bigIntToString(account.Balance),
+		DeveloperReward: bigIntToString(account.DeveloperReward),
+		CodeHash:        hex.EncodeToString(account.CodeHash),
+		CodeMetadata:    hex.EncodeToString(account.CodeMetadata),
+		Username:        string(account.UserName),
+		RootHash:        hex.EncodeToString(account.RootHash),
+	}
+	if len(account.OwnerAddress) > 0 {
+		accountState.OwnerAddress = sdc.addressPubkeyConverter.SilentEncode(account.OwnerAddress, log)
+	}
+
+	return accountState
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+func getChangedFields(oldState *AccountState, newState *AccountState) []string {
+	changedFields := make([]string, 0)
+	addIfChanged := func(field string, isChanged bool) {
+		if isChanged {
+			changedFields = append(changedFields, field)
+		}
+	}
+
+	addIfChanged(NonceField, oldState.Nonce != newState.Nonce)
+	addIfChanged(BalanceField, oldState.Balance != newState.Balance)
+	addIfChanged(DeveloperRewardField, oldState.DeveloperReward != newState.DeveloperReward)
+	addIfChanged(CodeHashField, oldState.CodeHash != newState.CodeHash)
+	addIfChanged(CodeMetadataField, oldState.CodeMetadata != newState.CodeMetadata)
+	addIfChanged(OwnerAddressField, oldState.OwnerAddress != newState.OwnerAddress)
+	addIfChanged(UsernameField, oldState.Username != newState.Username)
+	addIfChanged(RootHashField, oldState.RootHash != newState.RootHash)
+
+	return changedFields
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sdc *stateDiffComputer) IsInterfaceNil() bool {
+	return sdc == nil
+}
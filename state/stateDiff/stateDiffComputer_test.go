@@ -0,0 +1,350 @@
+package stateDiff
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/dataTrieValue"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshaller = &marshal.GogoProtoMarshalizer{}
+	testHasher     = blake2b.NewBlake2b()
+)
+
+const (
+	testNumAccounts        = 10
+	testMaxAccounts        = 100
+	testMaxDataTrieChanges = 100
+)
+
+type testStates struct {
+	storageManager common.StorageManager
+	fromRootHash   []byte
+	toRootHash     []byte
+}
+
+func testAddress(index int) []byte {
+	return testHasher.Compute(fmt.Sprintf("address%d", index))
+}
+
+func newTestTrie(t *testing.T, storageManager common.StorageManager) state.DataTrie {
+	tr, err := trie.NewTrie(storageManager, testMarshaller, testHasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+
+	return tr
+}
+
+func saveAccount(t *testing.T, accountsTrie common.Trie, account *accounts.UserAccountData) {
+	accountBytes, err := testMarshaller.Marshal(account)
+	require.Nil(t, err)
+	require.Nil(t, accountsTrie.Update(account.Address, accountBytes))
+}
+
+func updateLegacyDataTrieValue(t *testing.T, dataTrie state.DataTrie, address []byte, key string, value string) {
+	legacyValue := append(append([]byte(value), key...), address...)
+	require.Nil(t, dataTrie.Update([]byte(key), legacyValue))
+}
+
+func updateAutoBalancedDataTrieValue(t *testing.T, dataTrie state.DataTrie, address []byte, key string, value string) {
+	leafData, err := testMarshaller.Marshal(&dataTrieValue.TrieLeafData{
+		Value:   []byte(value),
+		Key:     []byte(key),
+		Address: address,
+	})
+	require.Nil(t, err)
+	require.Nil(t, dataTrie.UpdateWithVersion(testHasher.Compute(key), leafData, core.AutoBalanceEnabled))
+}
+
+func commitTrie(t *testing.T, tr common.Trie) []byte {
+	require.Nil(t, tr.Commit())
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+// createTestStates creates two states of testNumAccounts accounts. In the second state the account 0 has a changed
+// balance and nonce, the account 1 is removed, the account 2 has a changed data trie, the account 3 has a data trie key
+// migrated to the new leaf version, the account testNumAccounts is added and a new code entry is saved
+func createTestStates(t *testing.T) *testStates {
+	args := storage.GetStorageManagerArgs()
+	args.Marshalizer = testMarshaller
+	args.Hasher = testHasher
+	storageManager, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	accountsTrie := newTestTrie(t, storageManager)
+	dataTrie2 := newTestTrie(t, storageManager)
+	dataTrie3 := newTestTrie(t, storageManager)
+	for i := 0; i < 3; i++ {
+		updateLegacyDataTrieValue(t, dataTrie2, testAddress(2), fmt.Sprintf("key%d", i), fmt.Sprintf("value%d", i))
+		updateLegacyDataTrieValue(t, dataTrie3, testAddress(3), fmt.Sprintf("key%d", i), fmt.Sprintf("value%d", i))
+	}
+
+	accountsData := make([]*accounts.UserAccountData, 0, testNumAccounts)
+	for i := 0; i < testNumAccounts; i++ {
+		account := &accounts.UserAccountData{
+			Nonce:   uint64(i),
+			Balance: big.NewInt(int64(i * 10)),
+			Address: testAddress(i),
+		}
+		switch i {
+		case 2:
+			account.RootHash = commitTrie(t, dataTrie2)
+		case 3:
+			account.RootHash = commitTrie(t, dataTrie3)
+		}
+
+		saveAccount(t, accountsTrie, account)
+		accountsData = append(accountsData, account)
+	}
+	fromRootHash := commitTrie(t, accountsTrie)
+
+	accountsData[0].Nonce = 100
+	accountsData[0].Balance = big.NewInt(1000)
+	saveAccount(t, accountsTrie, accountsData[0])
+
+	require.Nil(t, accountsTrie.Delete(testAddress(1)))
+
+	updateLegacyDataTrieValue(t, dataTrie2, testAddress(2), "key0", "modified")
+	require.Nil(t, dataTrie2.Delete([]byte("key1")))
+	updateAutoBalancedDataTrieValue(t, dataTrie2, testAddress(2), "key3", "added")
+	accountsData[2].RootHash = commitTrie(t, dataTrie2)
+	saveAccount(t, accountsTrie, accountsData[2])
+
+	require.Nil(t, dataTrie3.Delete([]byte("key0")))
+	updateAutoBalancedDataTrieValue(t, dataTrie3, testAddress(3), "key0", "value0")
+	accountsData[3].RootHash = commitTrie(t, dataTrie3)
+	saveAccount(t, accountsTrie, accountsData[3])
+
+	saveAccount(t, accountsTrie, &accounts.UserAccountData{
+		Nonce:        1,
+		Balance:      big.NewInt(5),
+		Address:      testAddress(testNumAccounts),
+		OwnerAddress: testAddress(0),
+	})
+
+	code := []byte("contract code")
+	codeEntry, err := testMarshaller.Marshal(&state.CodeEntry{Code: code, NumReferences: 1})
+	require.Nil(t, err)
+	require.Nil(t, accountsTrie.Update(testHasher.Compute(string(code)), codeEntry))
+
+	return &testStates{
+		storageManager: storageManager,
+		fromRootHash:   fromRootHash,
+		toRootHash:     commitTrie(t, accountsTrie),
+	}
+}
+
+func createMockArgsStateDiffComputer(storer common.BaseStorer) ArgsStateDiffComputer {
+	return ArgsStateDiffComputer{
+		Storer:                 storer,
+		Marshaller:             testMarshaller,
+		Hasher:                 testHasher,
+		AddressPubkeyConverter: testscommon.NewPubkeyConverterMock(32),
+	}
+}
+
+func getAccountDiffs(diff *StateDiff) map[string]*AccountDiff {
+	accountDiffs := make(map[string]*AccountDiff)
+	for _, accountDiff := range diff.Accounts {
+		accountDiffs[accountDiff.Address] = accountDiff
+	}
+
+	return accountDiffs
+}
+
+func TestNewStateDiffComputer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		sdc, err := NewStateDiffComputer(createMockArgsStateDiffComputer(nil))
+		assert.Equal(t, ErrNilStorer, err)
+		assert.Nil(t, sdc)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateDiffComputer(testscommon.NewMemDbMock())
+		args.Marshaller = nil
+		sdc, err := NewStateDiffComputer(args)
+		assert.Equal(t, ErrNilMarshaller, err)
+		assert.Nil(t, sdc)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateDiffComputer(testscommon.NewMemDbMock())
+		args.Hasher = nil
+		sdc, err := NewStateDiffComputer(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.Nil(t, sdc)
+	})
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateDiffComputer(testscommon.NewMemDbMock())
+		args.AddressPubkeyConverter = nil
+		sdc, err := NewStateDiffComputer(args)
+		assert.Equal(t, ErrNilPubkeyConverter, err)
+		assert.Nil(t, sdc)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sdc, err := NewStateDiffComputer(createMockArgsStateDiffComputer(testscommon.NewMemDbMock()))
+		assert.Nil(t, err)
+		assert.False(t, sdc.IsInterfaceNil())
+	})
+}
+
+func TestStateDiffComputer_ComputeDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		sdc, _ := NewStateDiffComputer(createMockArgsStateDiffComputer(testscommon.NewMemDbMock()))
+		diff, err := sdc.ComputeDiff(nil, nil, nil, nil, testMaxAccounts, testMaxDataTrieChanges)
+		assert.Equal(t, ErrNilContext, err)
+		assert.Nil(t, diff)
+
+		diff, err = sdc.ComputeDiff(context.Background(), nil, nil, nil, 0, testMaxDataTrieChanges)
+		assert.Equal(t, ErrInvalidMaxAccounts, err)
+		assert.Nil(t, diff)
+
+		diff, err = sdc.ComputeDiff(context.Background(), nil, nil, nil, testMaxAccounts, 0)
+		assert.Equal(t, ErrInvalidMaxDataTrieChanges, err)
+		assert.Nil(t, diff)
+	})
+	t.Run("cancelled context should error", func(t *testing.T) {
+		t.Parallel()
+
+		states := createTestStates(t)
+		sdc, _ := NewStateDiffComputer(createMockArgsStateDiffComputer(states.storageManager))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		diff, err := sdc.ComputeDiff(ctx, states.fromRootHash, states.toRootHash, nil, testMaxAccounts, testMaxDataTrieChanges)
+		assert.Equal(t, context.Canceled, err)
+		assert.Nil(t, diff)
+	})
+	t.Run("same state should not report changes", func(t *testing.T) {
+		t.Parallel()
+
+		states := createTestStates(t)
+		sdc, _ := NewStateDiffComputer(createMockArgsStateDiffComputer(states.storageManager))
+		diff, err := sdc.ComputeDiff(context.Background(), states.toRootHash, states.toRootHash, nil, testMaxAccounts, testMaxDataTrieChanges)
+		require.Nil(t, err)
+		assert.Empty(t, diff.Accounts)
+		assert.Empty(t, diff.NextAddress)
+	})
+	t.Run("should report the changed accounts", func(t *testing.T) {
+		t.Parallel()
+
+		states := createTestStates(t)
+		sdc, _ := NewStateDiffComputer(createMockArgsStateDiffComputer(states.storageManager))
+		diff, err := sdc.ComputeDiff(context.Background(), states.fromRootHash, states.toRootHash, nil, testMaxAccounts, testMaxDataTrieChanges)
+		require.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString(states.fromRootHash), diff.FromRootHash)
+		assert.Equal(t, hex.EncodeToString(states.toRootHash), diff.ToRootHash)
+		assert.Empty(t, diff.NextAddress)
+		require.Len(t, diff.Accounts, 5)
+
+		accountDiffs := getAccountDiffs(diff)
+		modifiedAccount := accountDiffs[hex.EncodeToString(testAddress(0))]
+		assert.Equal(t, Modified, modifiedAccount.Change)
+		assert.Equal(t, []string{NonceField, BalanceField}, modifiedAccount.ChangedFields)
+		assert.Equal(t, "0", modifiedAccount.Old.Balance)
+		assert.Equal(t, "1000", modifiedAccount.New.Balance)
+		assert.Empty(t, modifiedAccount.DataTrieChanges)
+
+		removedAccount := accountDiffs[hex.EncodeToString(testAddress(1))]
+		assert.Equal(t, Removed, removedAccount.Change)
+		assert.Nil(t, removedAccount.New)
+		assert.Equal(t, uint64(1), removedAccount.Old.Nonce)
+
+		dataTrieAccount := accountDiffs[hex.EncodeToString(testAddress(2))]
+		assert.Equal(t, Modified, dataTrieAccount.Change)
+		assert.Equal(t, []string{RootHashField}, dataTrieAccount.ChangedFields)
+		assert.False(t, dataTrieAccount.IsDataTrieDiffTruncated)
+		assert.Equal(t, []*DataTrieChange{
+			{
+				Key:      hex.EncodeToString([]byte("key0")),
+				OldValue: hex.EncodeToString([]byte("value0")),
+				NewValue: hex.EncodeToString([]byte("modified")),
+			},
+			{
+				Key:      hex.EncodeToString([]byte("key1")),
+				OldValue: hex.EncodeToString([]byte("value1")),
+			},
+			{
+				Key:      hex.EncodeToString([]byte("key3")),
+				NewValue: hex.EncodeToString([]byte("added")),
+			},
+		}, dataTrieAccount.DataTrieChanges)
+
+		migratedAccount := accountDiffs[hex.EncodeToString(testAddress(3))]
+		assert.Equal(t, []string{RootHashField}, migratedAccount.ChangedFields)
+		assert.Empty(t, migratedAccount.DataTrieChanges)
+
+		addedAccount := accountDiffs[hex.EncodeToString(testAddress(testNumAccounts))]
+		assert.Equal(t, Added, addedAccount.Change)
+		assert.Nil(t, addedAccount.Old)
+		assert.Equal(t, hex.EncodeToString(testAddress(0)), addedAccount.New.OwnerAddress)
+	})
+	t.Run("should paginate the changed accounts", func(t *testing.T) {
+		t.Parallel()
+
+		states := createTestStates(t)
+		sdc, _ := NewStateDiffComputer(createMockArgsStateDiffComputer(states.storageManager))
+		fullDiff, _ := sdc.ComputeDiff(context.Background(), states.fromRootHash, states.toRootHash, nil, testMaxAccounts, testMaxDataTrieChanges)
+
+		pagedAccounts := make([]*AccountDiff, 0)
+		var startAddress []byte
+		for numPages := 1; ; numPages++ {
+			diff, err := sdc.ComputeDiff(context.Background(), states.fromRootHash, states.toRootHash, startAddress, 2, testMaxDataTrieChanges)
+			require.Nil(t, err)
+			pagedAccounts = append(pagedAccounts, diff.Accounts...)
+			if len(diff.NextAddress) == 0 {
+				assert.Equal(t, 3, numPages)
+				break
+			}
+
+			assert.Equal(t, diff.Accounts[len(diff.Accounts)-1].Address, diff.NextAddress)
+			startAddress, _ = hex.DecodeString(diff.NextAddress)
+		}
+
+		assert.Equal(t, fullDiff.Accounts, pagedAccounts)
+	})
+	t.Run("should cap the data trie changes", func(t *testing.T) {
+		t.Parallel()
+
+		states := createTestStates(t)
+		sdc, _ := NewStateDiffComputer(createMockArgsStateDiffComputer(states.storageManager))
+		diff, err := sdc.ComputeDiff(context.Background(), states.fromRootHash, states.toRootHash, nil, testMaxAccounts, 1)
+		require.Nil(t, err)
+
+		dataTrieAccount := getAccountDiffs(diff)[hex.EncodeToString(testAddress(2))]
+		assert.True(t, dataTrieAccount.IsDataTrieDiffTruncated)
+		assert.Len(t, dataTrieAccount.DataTrieChanges, 1)
+	})
+}
@@ -37,8 +37,12 @@ type baseAccountsSyncer struct {
 	enableEpochsHandler               common.EnableEpochsHandler
 
 	trieSyncerVersion int
+	numSyncWorkers    int
 	numTriesSynced    int32
 	numMaxTries       int32
+
+	mutSyncScheduler sync.RWMutex
+	syncScheduler    trie.TrieSyncScheduler
 }
 
 const timeBetweenStatisticsPrints = time.Second * 2
@@ -57,6 +61,7 @@ type ArgsNewBaseAccountsSyncer struct {
 	MaxTrieLevelInMemory              uint
 	MaxHardCapForMissingNodes         int
 	TrieSyncerVersion                 int
+	NumSyncWorkers                    int
 	CheckNodesOnDisk                  bool
 }
 
@@ -88,22 +93,15 @@ func checkArgs(args ArgsNewBaseAccountsSyncer) error {
 	if args.MaxHardCapForMissingNodes < 1 {
 		return state.ErrInvalidMaxHardCapForMissingNodes
 	}
+	if trie.IsParallelTrieSyncerVersion(args.TrieSyncerVersion) && args.NumSyncWorkers < 1 {
+		return fmt.Errorf("%w provided: %v", trie.ErrInvalidNumSyncWorkers, args.NumSyncWorkers)
+	}
 
 	return trie.CheckTrieSyncerVersion(args.TrieSyncerVersion)
 }
 
-func (b *baseAccountsSyncer) syncMainTrie(
-	rootHash []byte,
-	trieTopic string,
-	ctx context.Context,
-	leavesChan chan core.KeyValueHolder,
-) error {
-	atomic.AddInt32(&b.numMaxTries, 1)
-
-	log.Trace("syncing main trie", "roothash", rootHash)
-
-	b.dataTries[string(rootHash)] = struct{}{}
-	arg := trie.ArgTrieSyncer{
+func (b *baseAccountsSyncer) createTrieSyncerArgs(trieTopic string, checkNodesOnDisk bool, leavesChan chan core.KeyValueHolder) trie.ArgTrieSyncer {
+	return trie.ArgTrieSyncer{
 		RequestHandler:            b.requestHandler,
 		InterceptedNodes:          b.cacher,
 		DB:                        b.trieStorageManager,
@@ -114,10 +112,77 @@ func (b *baseAccountsSyncer) syncMainTrie(
 		TrieSyncStatistics:        b.userAccountsSyncStatisticsHandler,
 		TimeoutHandler:            b.timeoutHandler,
 		MaxHardCapForMissingNodes: b.maxHardCapForMissingNodes,
-		CheckNodesOnDisk:          b.checkNodesOnDisk,
+		NumSyncWorkers:            b.numSyncWorkers,
+		CheckNodesOnDisk:          checkNodesOnDisk,
 		LeavesChan:                leavesChan,
 	}
-	trieSyncer, err := trie.CreateTrieSyncer(arg, b.trieSyncerVersion)
+}
+
+// startSyncScheduler creates the scheduler shared by all the tries synced until stopSyncScheduler is called. It does
+// nothing if the configured trie syncer version does not use a parallel sync scheduler
+func (b *baseAccountsSyncer) startSyncScheduler(trieTopic string) error {
+	if !trie.IsParallelTrieSyncerVersion(b.trieSyncerVersion) {
+		return nil
+	}
+
+	scheduler, err := trie.NewParallelSyncScheduler(b.createTrieSyncerArgs(trieTopic, b.checkNodesOnDisk, nil))
+	if err != nil {
+		return err
+	}
+
+	b.mutSyncScheduler.Lock()
+	b.syncScheduler = scheduler
+	b.mutSyncScheduler.Unlock()
+
+	return nil
+}
+
+func (b *baseAccountsSyncer) stopSyncScheduler() {
+	b.mutSyncScheduler.Lock()
+	defer b.mutSyncScheduler.Unlock()
+
+	if check.IfNil(b.syncScheduler) {
+		return
+	}
+
+	b.updateSyncSchedulerMetrics(b.syncScheduler)
+	err := b.syncScheduler.Close()
+	if err != nil {
+		log.Warn("error closing the trie sync scheduler", "name", b.name, "error", err)
+	}
+	b.syncScheduler = nil
+}
+
+func (b *baseAccountsSyncer) getSyncScheduler() trie.TrieSyncScheduler {
+	b.mutSyncScheduler.RLock()
+	defer b.mutSyncScheduler.RUnlock()
+
+	return b.syncScheduler
+}
+
+// createTrieSyncer creates a trie syncer using the sync scheduler, if started
+func (b *baseAccountsSyncer) createTrieSyncer(arg trie.ArgTrieSyncer) (trie.TrieSyncer, error) {
+	scheduler := b.getSyncScheduler()
+	if !check.IfNil(scheduler) {
+		return scheduler.CreateTrieSyncer(arg.LeavesChan), nil
+	}
+
+	return trie.CreateTrieSyncer(arg, b.trieSyncerVersion)
+}
+
+func (b *baseAccountsSyncer) syncMainTrie(
+	rootHash []byte,
+	trieTopic string,
+	ctx context.Context,
+	leavesChan chan core.KeyValueHolder,
+) error {
+	atomic.AddInt32(&b.numMaxTries, 1)
+
+	log.Trace("syncing main trie", "roothash", rootHash)
+
+	b.dataTries[string(rootHash)] = struct{}{}
+	arg := b.createTrieSyncerArgs(trieTopic, b.checkNodesOnDisk, leavesChan)
+	trieSyncer, err := b.createTrieSyncer(arg)
 	if err != nil {
 		return err
 	}
@@ -187,16 +252,48 @@ func (b *baseAccountsSyncer) printStatisticsAndUpdateMetrics(ctx context.Context
 				"iterations", b.userAccountsSyncStatisticsHandler.NumIterations(),
 				"CPU time", b.userAccountsSyncStatisticsHandler.ProcessingTime(),
 				"processing speed", speed)
+			b.printSyncSchedulerProgress()
 
 			b.updateMetrics()
 		}
 	}
 }
 
+func (b *baseAccountsSyncer) printSyncSchedulerProgress() {
+	scheduler := b.getSyncScheduler()
+	if check.IfNil(scheduler) {
+		return
+	}
+
+	progress := scheduler.Progress()
+	log.Info("trie sync scheduler progress",
+		"name", b.name,
+		"num active tries", progress.NumActiveTries,
+		"num pending nodes", progress.NumPendingNodes,
+		"num in flight requests", progress.NumInFlightRequests,
+		"main trie synced", fmt.Sprintf("%.2f%%", progress.SyncedFraction*100),
+		"estimated time left", progress.EstimatedTimeLeft.Truncate(time.Second),
+	)
+}
+
 func (b *baseAccountsSyncer) updateMetrics() {
 	b.appStatusHandler.SetUInt64Value(common.MetricTrieSyncNumProcessedNodes, uint64(b.userAccountsSyncStatisticsHandler.NumProcessed()))
 	b.appStatusHandler.SetUInt64Value(common.MetricTrieSyncNumReceivedBytes, b.userAccountsSyncStatisticsHandler.NumBytesReceived())
 	b.appStatusHandler.SetUInt64Value(common.MetricShardId, uint64(b.shardId))
+
+	scheduler := b.getSyncScheduler()
+	if check.IfNil(scheduler) {
+		return
+	}
+
+	b.updateSyncSchedulerMetrics(scheduler)
+}
+
+func (b *baseAccountsSyncer) updateSyncSchedulerMetrics(scheduler trie.TrieSyncScheduler) {
+	progress := scheduler.Progress()
+	b.appStatusHandler.SetUInt64Value(common.MetricTrieSyncNumPendingNodes, uint64(progress.NumPendingNodes))
+	b.appStatusHandler.SetUInt64Value(common.MetricTrieSyncProgressPercent, uint64(progress.SyncedFraction*100))
+	b.appStatusHandler.SetUInt64Value(common.MetricTrieSyncEstimatedTimeLeftInSec, uint64(progress.EstimatedTimeLeft.Seconds()))
 }
 
 func convertBytesPerIntervalToSpeed(bytes uint64, interval time.Duration) string {
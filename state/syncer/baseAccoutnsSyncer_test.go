@@ -1,6 +1,7 @@
 package syncer_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
+	"github.com/multiversx/mx-chain-go/trie"
 	"github.com/stretchr/testify/require"
 )
 
@@ -108,9 +110,24 @@ func TestBaseAccountsSyncer_CheckArgs(t *testing.T) {
 		require.Equal(t, state.ErrInvalidMaxHardCapForMissingNodes, err)
 	})
 
+	t.Run("invalid number of sync workers for the parallel trie syncer", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultBaseAccSyncerArgs()
+		args.TrieSyncerVersion = 4
+		args.NumSyncWorkers = 0
+		err := syncer.CheckBaseAccountsSyncerArgs(args)
+		require.True(t, errors.Is(err, trie.ErrInvalidNumSyncWorkers))
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
 		require.Nil(t, syncer.CheckBaseAccountsSyncerArgs(getDefaultBaseAccSyncerArgs()))
+
+		args := getDefaultBaseAccSyncerArgs()
+		args.TrieSyncerVersion = 4
+		args.NumSyncWorkers = 2
+		require.Nil(t, syncer.CheckBaseAccountsSyncerArgs(args))
 	})
 }
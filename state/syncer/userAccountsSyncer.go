@@ -89,6 +89,7 @@ func NewUserAccountsSyncer(args ArgsNewUserAccountsSyncer) (*userAccountsSyncer,
 		name:                              fmt.Sprintf("user accounts for shard %s", core.GetShardIDString(args.ShardId)),
 		maxHardCapForMissingNodes:         args.MaxHardCapForMissingNodes,
 		trieSyncerVersion:                 args.TrieSyncerVersion,
+		numSyncWorkers:                    args.NumSyncWorkers,
 		checkNodesOnDisk:                  args.CheckNodesOnDisk,
 		userAccountsSyncStatisticsHandler: args.UserAccountsSyncStatisticsHandler,
 		appStatusHandler:                  args.AppStatusHandler,
@@ -122,6 +123,12 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker common.
 		cancel()
 	}()
 
+	err := u.startSyncScheduler(factory.AccountTrieNodesTopic)
+	if err != nil {
+		return err
+	}
+	defer u.stopSyncScheduler()
+
 	go u.printStatisticsAndUpdateMetrics(ctx)
 
 	leavesChannels := &common.TrieIteratorChannels{
@@ -143,7 +150,7 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker common.
 		wgSyncMainTrie.Done()
 	}()
 
-	err := u.syncAccountDataTries(leavesChannels, ctx)
+	err = u.syncAccountDataTries(leavesChannels, ctx)
 	if err != nil {
 		return err
 	}
@@ -188,21 +195,12 @@ func (u *userAccountsSyncer) createAndStartSyncer(
 	hash []byte,
 	checkNodesOnDisk bool,
 ) (trie.TrieSyncer, error) {
-	arg := trie.ArgTrieSyncer{
-		RequestHandler:            u.requestHandler,
-		InterceptedNodes:          u.cacher,
-		DB:                        u.trieStorageManager,
-		Marshalizer:               u.marshalizer,
-		Hasher:                    u.hasher,
-		ShardId:                   u.shardId,
-		Topic:                     factory.AccountTrieNodesTopic,
-		TrieSyncStatistics:        u.userAccountsSyncStatisticsHandler,
-		TimeoutHandler:            u.timeoutHandler,
-		MaxHardCapForMissingNodes: u.maxHardCapForMissingNodes,
-		CheckNodesOnDisk:          checkNodesOnDisk,
-		LeavesChan:                nil, // not used for data tries
-	}
-	trieSyncer, err := trie.CreateTrieSyncer(arg, u.trieSyncerVersion)
+	arg := u.createTrieSyncerArgs(
+		factory.AccountTrieNodesTopic,
+		checkNodesOnDisk,
+		nil, // not used for data tries
+	)
+	trieSyncer, err := u.createTrieSyncer(arg)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package syncer_test
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/multiversx/mx-chain-go/state/syncer"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
 	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
 	"github.com/multiversx/mx-chain-go/trie"
@@ -158,6 +160,52 @@ func TestUserAccountsSyncer_SyncAccounts(t *testing.T) {
 		err = s.SyncAccounts(key, storageMarker.NewDisabledStorageMarker())
 		require.Nil(t, err)
 	})
+
+	t.Run("should work with the parallel trie syncer", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultUserAccountsSyncerArgs()
+		args.Timeout = 5 * time.Second
+		args.TrieSyncerVersion = 4
+		args.NumSyncWorkers = 2
+
+		key := []byte("rootHash")
+		serializedLeafNode := getSerializedTrieNode(key, args.Marshalizer, args.Hasher)
+		itn, err := trie.NewInterceptedTrieNode(serializedLeafNode, args.Hasher)
+		require.Nil(t, err)
+
+		args.TrieStorageManager = &storageManager.StorageManagerStub{
+			GetCalled: func(b []byte) ([]byte, error) {
+				return serializedLeafNode, nil
+			},
+		}
+
+		cacher := testscommon.NewCacherMock()
+		cacher.Put(key, itn, 0)
+		args.Cacher = cacher
+
+		mutMetrics := sync.Mutex{}
+		progressPercent := uint64(0)
+		args.AppStatusHandler = &statusHandler.AppStatusHandlerStub{
+			SetUInt64ValueHandler: func(key string, value uint64) {
+				if key == common.MetricTrieSyncProgressPercent {
+					mutMetrics.Lock()
+					progressPercent = value
+					mutMetrics.Unlock()
+				}
+			},
+		}
+
+		s, err := syncer.NewUserAccountsSyncer(args)
+		require.Nil(t, err)
+
+		err = s.SyncAccounts(key, storageMarker.NewDisabledStorageMarker())
+		require.Nil(t, err)
+
+		mutMetrics.Lock()
+		assert.Equal(t, uint64(100), progressPercent)
+		mutMetrics.Unlock()
+	})
 }
 
 func getDefaultTrieParameters() (common.StorageManager, marshal.Marshalizer, hashing.Hasher, common.EnableEpochsHandler, uint) {
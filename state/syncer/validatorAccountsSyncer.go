@@ -47,6 +47,7 @@ func NewValidatorAccountsSyncer(args ArgsNewValidatorAccountsSyncer) (*validator
 		name:                              "peer accounts",
 		maxHardCapForMissingNodes:         args.MaxHardCapForMissingNodes,
 		trieSyncerVersion:                 args.TrieSyncerVersion,
+		numSyncWorkers:                    args.NumSyncWorkers,
 		checkNodesOnDisk:                  args.CheckNodesOnDisk,
 		userAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 		appStatusHandler:                  args.AppStatusHandler,
@@ -78,9 +79,15 @@ func (v *validatorAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker co
 		cancel()
 	}()
 
+	err := v.startSyncScheduler(factory.ValidatorTrieNodesTopic)
+	if err != nil {
+		return err
+	}
+	defer v.stopSyncScheduler()
+
 	go v.printStatisticsAndUpdateMetrics(ctx)
 
-	err := v.syncMainTrie(
+	err = v.syncMainTrie(
 		rootHash,
 		factory.ValidatorTrieNodesTopic,
 		ctx,
@@ -0,0 +1,21 @@
+package triesStatistics
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrNilContext signals that a nil context has been provided
+var ErrNilContext = errors.New("nil context")
+
+// ErrInvalidNumTopAccounts signals that an invalid number of top accounts has been provided
+var ErrInvalidNumTopAccounts = errors.New("invalid number of top accounts")
@@ -0,0 +1,82 @@
+package triesStatistics
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// Report holds the statistics of the accounts trie and of the data tries of a state
+type Report struct {
+	RootHash    string `json:"rootHash"`
+	NumAccounts uint64 `json:"numAccounts"`
+
+	// the statistics are partial if some trie nodes could not be loaded
+	IsComplete      bool   `json:"isComplete"`
+	NumMissingNodes uint64 `json:"numMissingNodes"`
+	NumCorruptNodes uint64 `json:"numCorruptNodes"`
+
+	TriesByType               map[common.TrieType]*TrieTypeStatistics `json:"triesByType"`
+	TopAccountsByDataTrieSize []*AccountStatistics                    `json:"topAccountsByDataTrieSize"`
+	TopAccountsByNumLeaves    []*AccountStatistics                    `json:"topAccountsByNumLeaves"`
+}
+
+// TrieTypeStatistics holds the merged statistics of all the tries of a type
+type TrieTypeStatistics struct {
+	NumTries           uint64 `json:"numTries"`
+	NumNodes           uint64 `json:"numNodes"`
+	TotalSize          uint64 `json:"totalSize"`
+	MaxDepth           uint32 `json:"maxDepth"`
+	NumBranchNodes     uint64 `json:"numBranchNodes"`
+	BranchNodesSize    uint64 `json:"branchNodesSize"`
+	NumExtensionNodes  uint64 `json:"numExtensionNodes"`
+	ExtensionNodesSize uint64 `json:"extensionNodesSize"`
+	NumLeaves          uint64 `json:"numLeaves"`
+	LeavesSize         uint64 `json:"leavesSize"`
+
+	NumLeavesByVersion map[string]uint64 `json:"numLeavesByVersion"`
+	// OldVersionLeavesFraction is the fraction of the leaves not yet migrated to the auto balanced trie node version
+	OldVersionLeavesFraction float64 `json:"oldVersionLeavesFraction"`
+}
+
+// AccountStatistics holds the statistics of the data trie of an account
+type AccountStatistics struct {
+	Address                  string  `json:"address"`
+	RootHash                 string  `json:"rootHash"`
+	DataTrieSize             uint64  `json:"dataTrieSize"`
+	NumNodes                 uint64  `json:"numNodes"`
+	NumLeaves                uint64  `json:"numLeaves"`
+	MaxDepth                 uint32  `json:"maxDepth"`
+	OldVersionLeavesFraction float64 `json:"oldVersionLeavesFraction"`
+}
+
+func newTrieTypeStatistics(stats common.TrieStatisticsHandler, numTries uint64) *TrieTypeStatistics {
+	migrationStats := stats.GetLeavesMigrationStats()
+	numLeavesByVersion := make(map[string]uint64, len(migrationStats))
+	for version, numLeaves := range migrationStats {
+		numLeavesByVersion[version.String()] = numLeaves
+	}
+
+	return &TrieTypeStatistics{
+		NumTries:                 numTries,
+		NumNodes:                 stats.GetTotalNumNodes(),
+		TotalSize:                stats.GetTotalNodesSize(),
+		MaxDepth:                 stats.GetMaxTrieDepth(),
+		NumBranchNodes:           stats.GetNumBranchNodes(),
+		BranchNodesSize:          stats.GetBranchNodesSize(),
+		NumExtensionNodes:        stats.GetNumExtensionNodes(),
+		ExtensionNodesSize:       stats.GetExtensionNodesSize(),
+		NumLeaves:                stats.GetNumLeafNodes(),
+		LeavesSize:               stats.GetLeafNodesSize(),
+		NumLeavesByVersion:       numLeavesByVersion,
+		OldVersionLeavesFraction: getOldVersionLeavesFraction(stats),
+	}
+}
+
+func getOldVersionLeavesFraction(stats common.TrieStatisticsHandler) float64 {
+	numLeaves := stats.GetNumLeafNodes()
+	if numLeaves == 0 {
+		return 0
+	}
+
+	return float64(stats.GetLeavesMigrationStats()[core.NotSpecified]) / float64(numLeaves)
+}
@@ -0,0 +1,218 @@
+package triesStatistics
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/trie/statistics"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/triesStatistics")
+
+// ArgsTriesStatisticsComputer is the DTO used to create a new tries statistics computer
+type ArgsTriesStatisticsComputer struct {
+	Storer                 common.BaseStorer
+	Marshaller             marshal.Marshalizer
+	Hasher                 hashing.Hasher
+	AddressPubkeyConverter core.PubkeyConverter
+}
+
+type trieChecker interface {
+	CheckTrie(rootHash []byte, stats common.TrieStatisticsHandler, leafHandler trie.TrieLeafHandler) (*trie.TrieCheckResult, error)
+}
+
+type triesStatisticsComputer struct {
+	checker                trieChecker
+	marshaller             marshal.Marshalizer
+	addressPubkeyConverter core.PubkeyConverter
+}
+
+// NewTriesStatisticsComputer creates a new tries statistics computer, reading the trie nodes from the provided storer
+func NewTriesStatisticsComputer(args ArgsTriesStatisticsComputer) (*triesStatisticsComputer, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	checker, err := trie.NewTrieConsistencyChecker(trie.ArgsTrieConsistencyChecker{
+		Storer:     args.Storer,
+		Marshaller: args.Marshaller,
+		Hasher:     args.Hasher,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &triesStatisticsComputer{
+		checker:                checker,
+		marshaller:             args.Marshaller,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+	}, nil
+}
+
+// ComputeStatistics walks the accounts trie with the given root hash and all its data tries, returning the statistics
+// of each trie type and the numTopAccounts accounts with the largest data tries, both by size and by number of leaves.
+// The missing or corrupt trie nodes do not stop the walk, the report being marked as incomplete instead
+func (tsc *triesStatisticsComputer) ComputeStatistics(ctx context.Context, rootHash []byte, numTopAccounts int) (*Report, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+	if numTopAccounts <= 0 {
+		return nil, ErrInvalidNumTopAccounts
+	}
+
+	report := &Report{
+		RootHash:                  hex.EncodeToString(rootHash),
+		TriesByType:               make(map[common.TrieType]*TrieTypeStatistics),
+		TopAccountsByDataTrieSize: make([]*AccountStatistics, 0, numTopAccounts),
+		TopAccountsByNumLeaves:    make([]*AccountStatistics, 0, numTopAccounts),
+	}
+
+	mainTrieStats := statistics.NewTrieStatistics()
+	dataTriesStats := statistics.NewTrieStatistics()
+	numMainTries := uint64(0)
+	numDataTries := uint64(0)
+	if !common.IsEmptyTrie(rootHash) {
+		numMainTries = 1
+		result, err := tsc.checker.CheckTrie(rootHash, mainTrieStats, func(key []byte, value []byte, _ core.TrieNodeVersion) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			account := tsc.getAccount(key, value)
+			if account == nil {
+				// code entries are also kept in the accounts trie
+				return nil
+			}
+			report.NumAccounts++
+			if common.IsEmptyTrie(account.RootHash) {
+				return nil
+			}
+
+			accountStats, err := tsc.computeDataTrieStatistics(ctx, account, report)
+			if err != nil {
+				return err
+			}
+			dataTriesStats.MergeTriesStatistics(accountStats)
+			numDataTries++
+
+			tsc.addTopAccount(report, account, accountStats, numTopAccounts)
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		addIssues(report, result)
+	}
+
+	report.TriesByType[common.MainTrie] = newTrieTypeStatistics(mainTrieStats, numMainTries)
+	report.TriesByType[common.DataTrie] = newTrieTypeStatistics(dataTriesStats, numDataTries)
+	report.IsComplete = report.NumMissingNodes == 0 && report.NumCorruptNodes == 0
+
+	return report, nil
+}
+
+func (tsc *triesStatisticsComputer) getAccount(key []byte, value []byte) *accounts.UserAccountData {
+	account := &accounts.UserAccountData{}
+	err := tsc.marshaller.Unmarshal(account, value)
+	if err != nil || !bytes.Equal(account.Address, key) {
+		return nil
+	}
+
+	return account
+}
+
+func (tsc *triesStatisticsComputer) computeDataTrieStatistics(
+	ctx context.Context,
+	account *accounts.UserAccountData,
+	report *Report,
+) (common.TrieStatisticsHandler, error) {
+	stats := statistics.NewTrieStatistics()
+	result, err := tsc.checker.CheckTrie(account.RootHash, stats, func(_ []byte, _ []byte, _ core.TrieNodeVersion) error {
+		return ctx.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	addIssues(report, result)
+
+	return stats, nil
+}
+
+func (tsc *triesStatisticsComputer) addTopAccount(
+	report *Report,
+	account *accounts.UserAccountData,
+	stats common.TrieStatisticsHandler,
+	numTopAccounts int,
+) {
+	accountStats := &AccountStatistics{
+		Address:                  tsc.addressPubkeyConverter.SilentEncode(account.Address, log),
+		RootHash:                 hex.EncodeToString(account.RootHash),
+		DataTrieSize:             stats.GetTotalNodesSize(),
+		NumNodes:                 stats.GetTotalNumNodes(),
+		NumLeaves:                stats.GetNumLeafNodes(),
+		MaxDepth:                 stats.GetMaxTrieDepth(),
+		OldVersionLeavesFraction: getOldVersionLeavesFraction(stats),
+	}
+
+	report.TopAccountsByDataTrieSize = insertTopAccount(report.TopAccountsByDataTrieSize, accountStats, numTopAccounts, func(a, b *AccountStatistics) bool {
+		return a.DataTrieSize < b.DataTrieSize
+	})
+	report.TopAccountsByNumLeaves = insertTopAccount(report.TopAccountsByNumLeaves, accountStats, numTopAccounts, func(a, b *AccountStatistics) bool {
+		return a.NumLeaves < b.NumLeaves
+	})
+}
+
+// insertTopAccount inserts the account in the list sorted in descending order, keeping at most maxAccounts of them.
+// Between equal accounts, the first one found is kept first
+func insertTopAccount(
+	top []*AccountStatistics,
+	account *AccountStatistics,
+	maxAccounts int,
+	isLess func(a, b *AccountStatistics) bool,
+) []*AccountStatistics {
+	index := sort.Search(len(top), func(i int) bool {
+		return isLess(top[i], account)
+	})
+	if index >= maxAccounts {
+		return top
+	}
+
+	top = append(top, nil)
+	copy(top[index+1:], top[index:])
+	top[index] = account
+	if len(top) > maxAccounts {
+		top = top[:maxAccounts]
+	}
+
+	return top
+}
+
+func addIssues(report *Report, result *trie.TrieCheckResult) {
+	report.NumMissingNodes += uint64(len(result.MissingNodes))
+	report.NumCorruptNodes += uint64(len(result.CorruptNodes))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tsc *triesStatisticsComputer) IsInterfaceNil() bool {
+	return tsc == nil
+}
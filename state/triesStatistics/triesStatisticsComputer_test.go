@@ -0,0 +1,300 @@
+package triesStatistics
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/dataTrieValue"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshaller = &marshal.GogoProtoMarshalizer{}
+	testHasher     = blake2b.NewBlake2b()
+)
+
+type testState struct {
+	storageManager     common.StorageManager
+	rootHash           []byte
+	dataTrieRootHashes [][]byte
+}
+
+type missingNodeStorer struct {
+	common.BaseStorer
+	missingHash []byte
+}
+
+func (mns *missingNodeStorer) Get(key []byte) ([]byte, error) {
+	if bytes.Equal(key, mns.missingHash) {
+		return nil, errors.New("missing node")
+	}
+
+	return mns.BaseStorer.Get(key)
+}
+
+func testAddress(index int) []byte {
+	return testHasher.Compute(fmt.Sprintf("address%d", index))
+}
+
+func commitTrie(t *testing.T, tr common.Trie) []byte {
+	require.Nil(t, tr.Commit())
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+// createTestState creates a state with 4 accounts and a code entry. The account 0 has no data trie, the account 1 has
+// a data trie with 3 legacy leaves, the account 2 has one with 10 legacy leaves and the account 3 has one with 2
+// legacy leaves and 4 auto balanced leaves
+func createTestState(t *testing.T) *testState {
+	args := storage.GetStorageManagerArgs()
+	args.Marshalizer = testMarshaller
+	args.Hasher = testHasher
+	storageManager, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	newTrie := func() state.DataTrie {
+		tr, errNew := trie.NewTrie(storageManager, testMarshaller, testHasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+		require.Nil(t, errNew)
+
+		return tr
+	}
+	createDataTrie := func(address []byte, numLegacyLeaves int, numAutoBalancedLeaves int) []byte {
+		dataTrie := newTrie()
+		for i := 0; i < numLegacyLeaves; i++ {
+			key := fmt.Sprintf("legacy%d", i)
+			value := append(append([]byte("value"), key...), address...)
+			require.Nil(t, dataTrie.Update([]byte(key), value))
+		}
+		for i := 0; i < numAutoBalancedLeaves; i++ {
+			key := fmt.Sprintf("balanced%d", i)
+			leafData, errMarshal := testMarshaller.Marshal(&dataTrieValue.TrieLeafData{
+				Value:   []byte("value"),
+				Key:     []byte(key),
+				Address: address,
+			})
+			require.Nil(t, errMarshal)
+			require.Nil(t, dataTrie.UpdateWithVersion(testHasher.Compute(key), leafData, core.AutoBalanceEnabled))
+		}
+
+		return commitTrie(t, dataTrie)
+	}
+
+	dataTrieRootHashes := [][]byte{
+		nil,
+		createDataTrie(testAddress(1), 3, 0),
+		createDataTrie(testAddress(2), 10, 0),
+		createDataTrie(testAddress(3), 2, 4),
+	}
+
+	accountsTrie := newTrie()
+	for i, dataTrieRootHash := range dataTrieRootHashes {
+		accountBytes, errMarshal := testMarshaller.Marshal(&accounts.UserAccountData{
+			Nonce:    uint64(i),
+			Balance:  big.NewInt(10),
+			Address:  testAddress(i),
+			RootHash: dataTrieRootHash,
+		})
+		require.Nil(t, errMarshal)
+		require.Nil(t, accountsTrie.Update(testAddress(i), accountBytes))
+	}
+	code := []byte("code")
+	require.Nil(t, accountsTrie.Update(testHasher.Compute(string(code)), code))
+
+	return &testState{
+		storageManager:     storageManager,
+		rootHash:           commitTrie(t, accountsTrie),
+		dataTrieRootHashes: dataTrieRootHashes,
+	}
+}
+
+func createMockArgsTriesStatisticsComputer(storer common.BaseStorer) ArgsTriesStatisticsComputer {
+	return ArgsTriesStatisticsComputer{
+		Storer:                 storer,
+		Marshaller:             testMarshaller,
+		Hasher:                 testHasher,
+		AddressPubkeyConverter: testscommon.NewPubkeyConverterMock(32),
+	}
+}
+
+func TestNewTriesStatisticsComputer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		tsc, err := NewTriesStatisticsComputer(createMockArgsTriesStatisticsComputer(nil))
+		assert.Equal(t, ErrNilStorer, err)
+		assert.Nil(t, tsc)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTriesStatisticsComputer(testscommon.NewMemDbMock())
+		args.Marshaller = nil
+		tsc, err := NewTriesStatisticsComputer(args)
+		assert.Equal(t, ErrNilMarshaller, err)
+		assert.Nil(t, tsc)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTriesStatisticsComputer(testscommon.NewMemDbMock())
+		args.Hasher = nil
+		tsc, err := NewTriesStatisticsComputer(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.Nil(t, tsc)
+	})
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTriesStatisticsComputer(testscommon.NewMemDbMock())
+		args.AddressPubkeyConverter = nil
+		tsc, err := NewTriesStatisticsComputer(args)
+		assert.Equal(t, ErrNilPubkeyConverter, err)
+		assert.Nil(t, tsc)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tsc, err := NewTriesStatisticsComputer(createMockArgsTriesStatisticsComputer(testscommon.NewMemDbMock()))
+		assert.Nil(t, err)
+		assert.False(t, tsc.IsInterfaceNil())
+	})
+}
+
+func TestTriesStatisticsComputer_ComputeStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		tsc, _ := NewTriesStatisticsComputer(createMockArgsTriesStatisticsComputer(testscommon.NewMemDbMock()))
+		report, err := tsc.ComputeStatistics(nil, nil, 1)
+		assert.Equal(t, ErrNilContext, err)
+		assert.Nil(t, report)
+
+		report, err = tsc.ComputeStatistics(context.Background(), nil, 0)
+		assert.Equal(t, ErrInvalidNumTopAccounts, err)
+		assert.Nil(t, report)
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		t.Parallel()
+
+		testState := createTestState(t)
+		tsc, _ := NewTriesStatisticsComputer(createMockArgsTriesStatisticsComputer(testState.storageManager))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		report, err := tsc.ComputeStatistics(ctx, testState.rootHash, 1)
+		assert.Equal(t, context.Canceled, err)
+		assert.Nil(t, report)
+	})
+	t.Run("empty state should return empty statistics", func(t *testing.T) {
+		t.Parallel()
+
+		tsc, _ := NewTriesStatisticsComputer(createMockArgsTriesStatisticsComputer(testscommon.NewMemDbMock()))
+		report, err := tsc.ComputeStatistics(context.Background(), common.EmptyTrieHash, 1)
+		require.Nil(t, err)
+		assert.True(t, report.IsComplete)
+		assert.Zero(t, report.NumAccounts)
+		assert.Zero(t, report.TriesByType[common.MainTrie].NumTries)
+		assert.Zero(t, report.TriesByType[common.DataTrie].NumTries)
+		assert.Empty(t, report.TopAccountsByDataTrieSize)
+		assert.Empty(t, report.TopAccountsByNumLeaves)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		testState := createTestState(t)
+		tsc, _ := NewTriesStatisticsComputer(createMockArgsTriesStatisticsComputer(testState.storageManager))
+		report, err := tsc.ComputeStatistics(context.Background(), testState.rootHash, 2)
+		require.Nil(t, err)
+
+		assert.Equal(t, hex.EncodeToString(testState.rootHash), report.RootHash)
+		assert.True(t, report.IsComplete)
+		assert.Equal(t, uint64(4), report.NumAccounts)
+
+		mainTrieStats := report.TriesByType[common.MainTrie]
+		assert.Equal(t, uint64(1), mainTrieStats.NumTries)
+		assert.Equal(t, uint64(5), mainTrieStats.NumLeaves)
+		assert.Equal(t, map[string]uint64{core.NotSpecifiedString: 5}, mainTrieStats.NumLeavesByVersion)
+		assert.Equal(t, 1.0, mainTrieStats.OldVersionLeavesFraction)
+
+		dataTriesStats := report.TriesByType[common.DataTrie]
+		assert.Equal(t, uint64(3), dataTriesStats.NumTries)
+		assert.Equal(t, uint64(19), dataTriesStats.NumLeaves)
+		assert.Equal(t, dataTriesStats.NumBranchNodes+dataTriesStats.NumExtensionNodes+dataTriesStats.NumLeaves, dataTriesStats.NumNodes)
+		assert.Equal(t, dataTriesStats.BranchNodesSize+dataTriesStats.ExtensionNodesSize+dataTriesStats.LeavesSize, dataTriesStats.TotalSize)
+		assert.Equal(t, map[string]uint64{
+			core.NotSpecifiedString:       15,
+			core.AutoBalanceEnabledString: 4,
+		}, dataTriesStats.NumLeavesByVersion)
+		assert.Equal(t, 15.0/19.0, dataTriesStats.OldVersionLeavesFraction)
+
+		require.Equal(t, 2, len(report.TopAccountsByNumLeaves))
+		assert.Equal(t, hex.EncodeToString(testAddress(2)), report.TopAccountsByNumLeaves[0].Address)
+		assert.Equal(t, hex.EncodeToString(testState.dataTrieRootHashes[2]), report.TopAccountsByNumLeaves[0].RootHash)
+		assert.Equal(t, uint64(10), report.TopAccountsByNumLeaves[0].NumLeaves)
+		assert.Equal(t, 1.0, report.TopAccountsByNumLeaves[0].OldVersionLeavesFraction)
+		assert.Equal(t, hex.EncodeToString(testAddress(3)), report.TopAccountsByNumLeaves[1].Address)
+		assert.Equal(t, uint64(6), report.TopAccountsByNumLeaves[1].NumLeaves)
+		assert.Equal(t, 2.0/6.0, report.TopAccountsByNumLeaves[1].OldVersionLeavesFraction)
+
+		require.Equal(t, 2, len(report.TopAccountsByDataTrieSize))
+		assert.Equal(t, hex.EncodeToString(testAddress(2)), report.TopAccountsByDataTrieSize[0].Address)
+		assert.True(t, report.TopAccountsByDataTrieSize[0].DataTrieSize >= report.TopAccountsByDataTrieSize[1].DataTrieSize)
+	})
+	t.Run("missing trie node should mark the report as incomplete", func(t *testing.T) {
+		t.Parallel()
+
+		testState := createTestState(t)
+		storer := &missingNodeStorer{
+			BaseStorer:  testState.storageManager,
+			missingHash: testState.dataTrieRootHashes[2],
+		}
+		tsc, _ := NewTriesStatisticsComputer(createMockArgsTriesStatisticsComputer(storer))
+		report, err := tsc.ComputeStatistics(context.Background(), testState.rootHash, 2)
+		require.Nil(t, err)
+
+		assert.False(t, report.IsComplete)
+		assert.Equal(t, uint64(1), report.NumMissingNodes)
+		assert.Equal(t, uint64(4), report.NumAccounts)
+		assert.Equal(t, uint64(9), report.TriesByType[common.DataTrie].NumLeaves)
+		assert.Equal(t, hex.EncodeToString(testAddress(3)), report.TopAccountsByNumLeaves[0].Address)
+	})
+}
+
+func TestInsertTopAccount(t *testing.T) {
+	t.Parallel()
+
+	isLess := func(a, b *AccountStatistics) bool {
+		return a.NumLeaves < b.NumLeaves
+	}
+	top := make([]*AccountStatistics, 0)
+	for i, numLeaves := range []uint64{3, 7, 1, 7, 5, 9} {
+		top = insertTopAccount(top, &AccountStatistics{Address: fmt.Sprintf("%d", i), NumLeaves: numLeaves}, 3, isLess)
+	}
+
+	require.Equal(t, 3, len(top))
+	assert.Equal(t, "5", top[0].Address)
+	assert.Equal(t, "1", top[1].Address)
+	assert.Equal(t, "3", top[2].Address)
+}
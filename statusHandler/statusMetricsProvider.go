@@ -122,6 +122,9 @@ func (sm *statusMetrics) StatusMetricsMapWithoutP2P() (map[string]interface{}, e
 	// remove these metrics, since they are returned through the /node/bootstrapstatus endpoint
 	delete(metrics, common.MetricTrieSyncNumReceivedBytes)
 	delete(metrics, common.MetricTrieSyncNumProcessedNodes)
+	delete(metrics, common.MetricTrieSyncNumPendingNodes)
+	delete(metrics, common.MetricTrieSyncProgressPercent)
+	delete(metrics, common.MetricTrieSyncEstimatedTimeLeftInSec)
 
 	return metrics, nil
 }
@@ -497,6 +500,9 @@ func (sm *statusMetrics) BootstrapMetrics() (map[string]interface{}, error) {
 	sm.mutUint64Operations.RLock()
 	bootstrapMetrics[common.MetricTrieSyncNumReceivedBytes] = sm.uint64Metrics[common.MetricTrieSyncNumReceivedBytes]
 	bootstrapMetrics[common.MetricTrieSyncNumProcessedNodes] = sm.uint64Metrics[common.MetricTrieSyncNumProcessedNodes]
+	bootstrapMetrics[common.MetricTrieSyncNumPendingNodes] = sm.uint64Metrics[common.MetricTrieSyncNumPendingNodes]
+	bootstrapMetrics[common.MetricTrieSyncProgressPercent] = sm.uint64Metrics[common.MetricTrieSyncProgressPercent]
+	bootstrapMetrics[common.MetricTrieSyncEstimatedTimeLeftInSec] = sm.uint64Metrics[common.MetricTrieSyncEstimatedTimeLeftInSec]
 	bootstrapMetrics[common.MetricShardId] = sm.uint64Metrics[common.MetricShardId]
 	sm.mutUint64Operations.RUnlock()
 
@@ -278,6 +278,9 @@ func TestStatusMetrics_StatusMetricsMapWithoutP2P(t *testing.T) {
 	sm.SetUInt64Value(common.MetricNoncesPassedInCurrentEpoch, 1)
 	sm.SetUInt64Value(common.MetricTrieSyncNumReceivedBytes, 100)
 	sm.SetUInt64Value(common.MetricTrieSyncNumProcessedNodes, 101)
+	sm.SetUInt64Value(common.MetricTrieSyncNumPendingNodes, 102)
+	sm.SetUInt64Value(common.MetricTrieSyncProgressPercent, 50)
+	sm.SetUInt64Value(common.MetricTrieSyncEstimatedTimeLeftInSec, 103)
 
 	res, _ := sm.StatusMetricsMapWithoutP2P()
 
@@ -290,6 +293,9 @@ func TestStatusMetrics_StatusMetricsMapWithoutP2P(t *testing.T) {
 	require.NotContains(t, res, common.MetricNoncesPassedInCurrentEpoch)
 	require.NotContains(t, res, common.MetricTrieSyncNumReceivedBytes)
 	require.NotContains(t, res, common.MetricTrieSyncNumProcessedNodes)
+	require.NotContains(t, res, common.MetricTrieSyncNumPendingNodes)
+	require.NotContains(t, res, common.MetricTrieSyncProgressPercent)
+	require.NotContains(t, res, common.MetricTrieSyncEstimatedTimeLeftInSec)
 }
 
 func TestStatusMetrics_EnableEpochMetrics(t *testing.T) {
@@ -649,14 +655,20 @@ func TestStatusMetrics_BootstrapMetrics(t *testing.T) {
 
 	sm.SetUInt64Value(common.MetricTrieSyncNumReceivedBytes, uint64(5001))
 	sm.SetUInt64Value(common.MetricTrieSyncNumProcessedNodes, uint64(10000))
+	sm.SetUInt64Value(common.MetricTrieSyncNumPendingNodes, uint64(300))
+	sm.SetUInt64Value(common.MetricTrieSyncProgressPercent, uint64(40))
+	sm.SetUInt64Value(common.MetricTrieSyncEstimatedTimeLeftInSec, uint64(600))
 	sm.SetUInt64Value(common.MetricShardId, uint64(2))
 	sm.SetStringValue(common.MetricGatewayMetricsEndpoint, "http://localhost:8080")
 
 	expectedMetrics := map[string]interface{}{
-		common.MetricTrieSyncNumReceivedBytes:  uint64(5001),
-		common.MetricTrieSyncNumProcessedNodes: uint64(10000),
-		common.MetricShardId:                   uint64(2),
-		common.MetricGatewayMetricsEndpoint:    "http://localhost:8080",
+		common.MetricTrieSyncNumReceivedBytes:       uint64(5001),
+		common.MetricTrieSyncNumProcessedNodes:      uint64(10000),
+		common.MetricTrieSyncNumPendingNodes:        uint64(300),
+		common.MetricTrieSyncProgressPercent:        uint64(40),
+		common.MetricTrieSyncEstimatedTimeLeftInSec: uint64(600),
+		common.MetricShardId:                        uint64(2),
+		common.MetricGatewayMetricsEndpoint:         "http://localhost:8080",
 	}
 
 	bootstrapMetrics, err := sm.BootstrapMetrics()
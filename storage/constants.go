@@ -39,3 +39,6 @@ const TxPoolDestinationMeNumItemsToPreemptivelyEvict = uint32(1000)
 
 // ShardedDataNumItemsToPreemptivelyEvict is a configuration of the eviction algorithm
 const ShardedDataNumItemsToPreemptivelyEvict = uint32(1000)
+
+// TxPoolNumRejectedTransactionsToKeep is the number of evicted or rejected transactions kept in memory, for inspection
+const TxPoolNumRejectedTransactionsToKeep = uint32(10000)
@@ -0,0 +1,156 @@
+package factory
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+)
+
+const (
+	defaultEpochsStorerBatchDelaySeconds = 2
+	defaultEpochsStorerMaxBatchSize      = 100
+	defaultEpochsStorerMaxOpenFiles      = 10
+)
+
+// ArgsEpochsStorer holds the arguments needed to create a new epochs storer
+type ArgsEpochsStorer struct {
+	DBPath     string
+	ShardID    uint32
+	Epoch      uint32
+	Identifier string
+	ReadOnly   bool
+}
+
+type epochsStorer struct {
+	persisters []storage.Persister
+	readOnly   bool
+}
+
+// NewEpochsStorer opens, for the given shard, the databases with the provided identifier (e.g. AccountsTrie) of all
+// the epochs up to the given one, found under the database path (including the chain ID) of a stopped node. Each key
+// is searched starting with the newest epoch, as the node itself does for the trie storage, while the writes, if
+// allowed, go to the newest epoch database. It is meant for the offline tools working on the node databases
+func NewEpochsStorer(args ArgsEpochsStorer) (*epochsStorer, error) {
+	if len(args.DBPath) == 0 {
+		return nil, storage.ErrInvalidFilePath
+	}
+	if len(args.Identifier) == 0 {
+		return nil, storage.ErrEmptyDBIdentifier
+	}
+
+	pathManager, err := CreatePathManagerFromSinglePathString(args.DBPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// the configuration saved next to each database takes precedence over this default one
+	persisterFactory, err := NewPersisterFactory(config.DBConfig{
+		Type:              string(storageunit.LvlDBSerial),
+		BatchDelaySeconds: defaultEpochsStorerBatchDelaySeconds,
+		MaxBatchSize:      defaultEpochsStorerMaxBatchSize,
+		MaxOpenFiles:      defaultEpochsStorerMaxOpenFiles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	es := &epochsStorer{
+		readOnly: args.ReadOnly,
+	}
+	shardID := core.GetShardIDString(args.ShardID)
+	for epoch := int64(args.Epoch); epoch >= 0; epoch-- {
+		path := pathManager.PathForEpoch(shardID, uint32(epoch), args.Identifier)
+		if !isDirectory(path) {
+			continue
+		}
+
+		persister, errCreate := persisterFactory.Create(path)
+		if errCreate != nil {
+			_ = es.Close()
+			return nil, fmt.Errorf("%w while opening %s", errCreate, path)
+		}
+
+		log.Debug("opened database", "path", path)
+		es.persisters = append(es.persisters, persister)
+	}
+
+	if len(es.persisters) == 0 {
+		return nil, fmt.Errorf("%w for shard %s, identifier %s and epochs up to %d in %s",
+			storage.ErrNoDatabaseFound, shardID, args.Identifier, args.Epoch, args.DBPath)
+	}
+
+	return es, nil
+}
+
+func isDirectory(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+
+	return info.IsDir()
+}
+
+// Get returns the value of the key from the newest epoch database holding it
+func (es *epochsStorer) Get(key []byte) ([]byte, error) {
+	for _, persister := range es.persisters {
+		value, err := persister.Get(key)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// Put writes the key-value pair in the newest epoch database
+func (es *epochsStorer) Put(key, val []byte) error {
+	if es.readOnly {
+		return storage.ErrReadOnlyStorer
+	}
+
+	return es.persisters[0].Put(key, val)
+}
+
+// Remove removes the key from all the opened databases
+func (es *epochsStorer) Remove(key []byte) error {
+	if es.readOnly {
+		return storage.ErrReadOnlyStorer
+	}
+
+	for _, persister := range es.persisters {
+		err := persister.Remove(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close closes all the opened databases
+func (es *epochsStorer) Close() error {
+	var lastErr error
+	for _, persister := range es.persisters {
+		err := persister.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// NumDatabases returns the number of opened databases
+func (es *epochsStorer) NumDatabases() int {
+	return len(es.persisters)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (es *epochsStorer) IsInterfaceNil() bool {
+	return es == nil
+}
@@ -0,0 +1,142 @@
+package factory_test
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsEpochsStorer(t *testing.T) factory.ArgsEpochsStorer {
+	return factory.ArgsEpochsStorer{
+		DBPath:     t.TempDir(),
+		ShardID:    1,
+		Epoch:      3,
+		Identifier: "AccountsTrie",
+		ReadOnly:   true,
+	}
+}
+
+func createEpochDB(t *testing.T, args factory.ArgsEpochsStorer, shardID uint32, epoch uint32, data map[string]string) {
+	path := filepath.Join(
+		args.DBPath,
+		fmt.Sprintf("%s_%d", storage.DefaultEpochString, epoch),
+		fmt.Sprintf("%s_%s", storage.DefaultShardString, core.GetShardIDString(shardID)),
+		args.Identifier,
+	)
+	db, err := database.NewSerialDB(path, 2, 100, 10)
+	require.Nil(t, err)
+
+	for key, value := range data {
+		require.Nil(t, db.Put([]byte(key), []byte(value)))
+	}
+
+	require.Nil(t, db.Close())
+}
+
+func TestNewEpochsStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty db path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsEpochsStorer(t)
+		args.DBPath = ""
+		es, err := factory.NewEpochsStorer(args)
+		assert.Equal(t, storage.ErrInvalidFilePath, err)
+		assert.Nil(t, es)
+	})
+	t.Run("empty identifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsEpochsStorer(t)
+		args.Identifier = ""
+		es, err := factory.NewEpochsStorer(args)
+		assert.Equal(t, storage.ErrEmptyDBIdentifier, err)
+		assert.Nil(t, es)
+	})
+	t.Run("no database should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsEpochsStorer(t)
+		createEpochDB(t, args, 0, 1, map[string]string{"key": "value"})
+		createEpochDB(t, args, 1, 4, map[string]string{"key": "value"})
+
+		es, err := factory.NewEpochsStorer(args)
+		assert.True(t, errors.Is(err, storage.ErrNoDatabaseFound))
+		assert.Nil(t, es)
+	})
+}
+
+func TestEpochsStorer_ReadOnly(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsEpochsStorer(t)
+	createEpochDB(t, args, 1, 0, map[string]string{"key0": "value0", "key": "old value"})
+	createEpochDB(t, args, 1, 2, map[string]string{"key2": "value2", "key": "new value"})
+	createEpochDB(t, args, 1, 4, map[string]string{"key4": "value4"})
+
+	es, err := factory.NewEpochsStorer(args)
+	require.Nil(t, err)
+	assert.False(t, es.IsInterfaceNil())
+	assert.Equal(t, 2, es.NumDatabases())
+	defer func() {
+		_ = es.Close()
+	}()
+
+	value, err := es.Get([]byte("key0"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value0"), value)
+
+	value, err = es.Get([]byte("key2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), value)
+
+	value, err = es.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("new value"), value)
+
+	value, err = es.Get([]byte("key4"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Nil(t, value)
+
+	assert.Equal(t, storage.ErrReadOnlyStorer, es.Put([]byte("key"), []byte("value")))
+	assert.Equal(t, storage.ErrReadOnlyStorer, es.Remove([]byte("key")))
+}
+
+func TestEpochsStorer_Writable(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsEpochsStorer(t)
+	args.ReadOnly = false
+	createEpochDB(t, args, 1, 0, map[string]string{"key": "old value"})
+	createEpochDB(t, args, 1, 2, map[string]string{"key2": "value2"})
+
+	es, err := factory.NewEpochsStorer(args)
+	require.Nil(t, err)
+
+	require.Nil(t, es.Put([]byte("new key"), []byte("new value")))
+	value, err := es.Get([]byte("new key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("new value"), value)
+
+	require.Nil(t, es.Remove([]byte("key")))
+	_, err = es.Get([]byte("key"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	require.Nil(t, es.Close())
+
+	// the written key should be found in the newest epoch database only
+	args.Epoch = 1
+	es, err = factory.NewEpochsStorer(args)
+	require.Nil(t, err)
+	_, err = es.Get([]byte("new key"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	require.Nil(t, es.Close())
+}
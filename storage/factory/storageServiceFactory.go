@@ -524,9 +524,47 @@ func (psf *StorageServiceFactory) setUpDbLookupExtensions(chainStorer *dataRetri
 
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	err = psf.setUpAddressHistoryStorerIfNeeded(chainStorer, shardID)
+	if err != nil {
+		return err
+	}
+
+	err = psf.setUpEventsIndexStorerIfNeeded(chainStorer, shardID)
+	if err != nil {
+		return err
+	}
+
 	return psf.setUpEsdtSuppliesStorer(chainStorer, shardID)
 }
 
+func (psf *StorageServiceFactory) setUpAddressHistoryStorerIfNeeded(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
+	if !psf.generalConfig.DbLookupExtensions.AddressHistoryIndexEnabled {
+		return nil
+	}
+
+	addressHistoryUnit, err := psf.createStaticStorageUnit(psf.generalConfig.DbLookupExtensions.AddressHistoryStorageConfig, shardIDStr, emptyDBPathSuffix)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.AddressHistoryStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.AddressHistoryUnit, addressHistoryUnit)
+	return nil
+}
+
+func (psf *StorageServiceFactory) setUpEventsIndexStorerIfNeeded(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
+	if !psf.generalConfig.DbLookupExtensions.EventsIndexEnabled {
+		return nil
+	}
+
+	eventsIndexUnit, err := psf.createStaticStorageUnit(psf.generalConfig.DbLookupExtensions.EventsIndexStorageConfig, shardIDStr, emptyDBPathSuffix)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.EventsIndexStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.EventsIndexUnit, eventsIndexUnit)
+	return nil
+}
+
 func (psf *StorageServiceFactory) setUpEsdtSuppliesStorer(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
 	esdtSuppliesUnit, err := psf.createStaticStorageUnit(psf.generalConfig.DbLookupExtensions.ESDTSuppliesStorageConfig, shardIDStr, emptyDBPathSuffix)
 	if err != nil {
@@ -56,6 +56,8 @@ func createMockArgument(t *testing.T) StorageServiceFactoryArgs {
 				ResultsHashesByTxHashStorageConfig: createMockStorageConfig("ResultsHashesByTxHashStorage"),
 				ESDTSuppliesStorageConfig:          createMockStorageConfig("ESDTSuppliesStorage"),
 				RoundHashStorageConfig:             createMockStorageConfig("RoundHashStorage"),
+				AddressHistoryStorageConfig:        createMockStorageConfig("AddressHistoryStorage"),
+				EventsIndexStorageConfig:           createMockStorageConfig("EventsIndexStorage"),
 			},
 			LogsAndEvents: config.LogsAndEventsConfig{
 				SaveInStorageEnabled: true,
@@ -389,6 +391,28 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 		assert.Equal(t, expectedErrForCacheString+" for DbLookupExtensions.RoundHashStorageConfig", err.Error())
 		assert.True(t, check.IfNil(storageService))
 	})
+	t.Run("wrong config for DbLookupExtensions.AddressHistoryStorageConfig should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.AddressHistoryIndexEnabled = true
+		args.Config.DbLookupExtensions.AddressHistoryStorageConfig.Cache.Type = ""
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForShard()
+		assert.Equal(t, expectedErrForCacheString+" for DbLookupExtensions.AddressHistoryStorageConfig", err.Error())
+		assert.True(t, check.IfNil(storageService))
+	})
+	t.Run("wrong config for DbLookupExtensions.EventsIndexStorageConfig should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.EventsIndexEnabled = true
+		args.Config.DbLookupExtensions.EventsIndexStorageConfig.Cache.Type = ""
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForShard()
+		assert.Equal(t, expectedErrForCacheString+" for DbLookupExtensions.EventsIndexStorageConfig", err.Error())
+		assert.True(t, check.IfNil(storageService))
+	})
 	t.Run("wrong config for LogsAndEvents.TxLogsStorage should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -419,6 +443,42 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 
 		_ = storageService.CloseAll()
 	})
+	t.Run("should work with the address history index", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.AddressHistoryIndexEnabled = true
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForShard()
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(storageService))
+		allStorers := storageService.GetAllStorers()
+		expectedStorers := 24
+		assert.Equal(t, expectedStorers, len(allStorers))
+
+		_, err = storageService.GetStorer(dataRetriever.AddressHistoryUnit)
+		assert.Nil(t, err)
+
+		_ = storageService.CloseAll()
+	})
+	t.Run("should work with the events index", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.EventsIndexEnabled = true
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForShard()
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(storageService))
+		allStorers := storageService.GetAllStorers()
+		expectedStorers := 24
+		assert.Equal(t, expectedStorers, len(allStorers))
+
+		_, err = storageService.GetStorer(dataRetriever.EventsIndexUnit)
+		assert.Nil(t, err)
+
+		_ = storageService.CloseAll()
+	})
 	t.Run("should work without DbLookupExtensions", func(t *testing.T) {
 		t.Parallel()
 
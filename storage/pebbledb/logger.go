@@ -0,0 +1,21 @@
+package pebbledb
+
+import (
+	"fmt"
+)
+
+// pebbleLogger redirects the messages of the pebble engine to the node's logger
+type pebbleLogger struct {
+}
+
+// Infof logs an informative message of the pebble engine
+func (pl *pebbleLogger) Infof(format string, args ...interface{}) {
+	log.Debug("pebble: " + fmt.Sprintf(format, args...))
+}
+
+// Fatalf logs an unrecoverable error of the pebble engine, then panics (the engine does not expect it to return)
+func (pl *pebbleLogger) Fatalf(format string, args ...interface{}) {
+	message := "pebble: " + fmt.Sprintf(format, args...)
+	log.Error(message)
+	panic(message)
+}
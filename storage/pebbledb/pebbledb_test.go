@@ -0,0 +1,236 @@
+package pebbledb
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPebbleDb(t *testing.T, batchDelaySeconds int, maxBatchSize int) *DB {
+	db, err := NewDB(t.TempDir(), batchDelaySeconds, maxBatchSize, 10)
+	require.Nil(t, err)
+
+	return db
+}
+
+func TestNewDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max open files should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewDB(t.TempDir(), 10, 1, 0)
+		assert.Nil(t, db)
+		assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+	})
+	t.Run("double open should error", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		db, err := NewDB(dir, 10, 1, 10)
+		require.Nil(t, err)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		secondDb, err := NewDB(dir, 10, 1, 10)
+		assert.Nil(t, secondDb)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDb(t, 10, 1)
+		assert.False(t, check.IfNil(db))
+		assert.Nil(t, db.Close())
+	})
+}
+
+func TestDB_PutGetHasRemove(t *testing.T) {
+	t.Parallel()
+
+	t.Run("from batch", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDb(t, 10, 100)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		key, val := []byte("key"), []byte("value")
+		require.Nil(t, db.Put(key, val))
+
+		value, err := db.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, val, value)
+		assert.Nil(t, db.Has(key))
+
+		require.Nil(t, db.Remove(key))
+		value, err = db.Get(key)
+		assert.Nil(t, value)
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+		assert.Equal(t, storage.ErrKeyNotFound, db.Has(key))
+	})
+	t.Run("from database", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDb(t, 10, 1)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		key, val := []byte("key"), []byte("value")
+		require.Nil(t, db.Put(key, val))
+		require.True(t, db.batch.isEmpty())
+
+		value, err := db.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, val, value)
+		assert.Nil(t, db.Has(key))
+
+		require.Nil(t, db.Remove(key))
+		require.True(t, db.batch.isEmpty())
+		assert.Equal(t, storage.ErrKeyNotFound, db.Has(key))
+	})
+	t.Run("batch committed on timeout", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDb(t, 1, 100)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		require.Nil(t, db.Put([]byte("key"), []byte("value")))
+		require.False(t, db.batch.isEmpty())
+
+		time.Sleep(time.Second * 2)
+
+		db.mutBatch.RLock()
+		assert.True(t, db.batch.isEmpty())
+		db.mutBatch.RUnlock()
+		assert.Nil(t, db.Has([]byte("key")))
+	})
+}
+
+func TestDB_CloseAndReopenShouldKeepTheData(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	db, err := NewDB(dir, 10, 100, 10)
+	require.Nil(t, err)
+
+	// not yet committed, the batch is written on close
+	require.Nil(t, db.Put([]byte("key"), []byte("value")))
+	require.Nil(t, db.Close())
+
+	value, err := db.Get([]byte("key"))
+	assert.Nil(t, value)
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+
+	db, err = NewDB(dir, 10, 100, 10)
+	require.Nil(t, err)
+
+	value, err = db.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+	assert.Nil(t, db.Destroy())
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDb(t, 10, 1)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	expected := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+	}
+	for key, val := range expected {
+		require.Nil(t, db.Put([]byte(key), val))
+	}
+
+	db.RangeKeys(nil)
+
+	recovered := make(map[string][]byte)
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		recovered[string(key)] = value
+		return true
+	})
+	assert.Equal(t, expected, recovered)
+
+	numVisited := 0
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		numVisited++
+		return false
+	})
+	assert.Equal(t, 1, numVisited)
+}
+
+func TestDB_MethodCallsAfterCloseOrDestroyShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should not have panicked: %v", r))
+		}
+	}()
+
+	db := createPebbleDb(t, 10, 1)
+	require.Nil(t, db.Destroy())
+
+	_ = db.Put([]byte("key"), []byte("value"))
+	_, _ = db.Get([]byte("key"))
+	_ = db.Has([]byte("key"))
+	_ = db.Remove([]byte("key"))
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		return true
+	})
+	_ = db.Close()
+	_ = db.DestroyClosed()
+}
+
+func TestDB_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDb(t, 1, 10)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	numOperations := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(index int) {
+			defer wg.Done()
+
+			key := []byte(fmt.Sprintf("key-%d", index%100))
+			switch index % 5 {
+			case 0:
+				_ = db.Remove(key)
+			case 1:
+				_, _ = db.Get(key)
+			case 2:
+				_ = db.Has(key)
+			case 3:
+				db.RangeKeys(func(key []byte, value []byte) bool {
+					return true
+				})
+			default:
+				_ = db.Put(key, []byte("value"))
+			}
+		}(i)
+	}
+	wg.Wait()
+}
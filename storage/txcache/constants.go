@@ -0,0 +1,5 @@
+package txcache
+
+const diagnosisMaxTransactionsToDisplay = 10000
+const initialCapacityOfSelectionSlice = 30000
+const selectionLoopDurationCheckInterval = 10
@@ -0,0 +1,38 @@
+package txcache
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-storage-go/types"
+)
+
+// AccountState represents the state of an account (as seen by the mempool)
+type AccountState = types.AccountState
+
+// MempoolHost provides blockchain information for mempool operations
+type MempoolHost interface {
+	ComputeTxFee(tx data.TransactionWithFeeHandler) *big.Int
+	GetTransferredValue(tx data.TransactionHandler) *big.Int
+	IsInterfaceNil() bool
+}
+
+// SelectionSession provides blockchain information for transaction selection
+type SelectionSession interface {
+	GetAccountState(accountKey []byte) (*AccountState, error)
+	IsIncorrectlyGuarded(tx data.TransactionHandler) bool
+	IsInterfaceNil() bool
+}
+
+// RejectionsRecordingSession defines a selection session which records the transactions rejected by the selection.
+// The transactions only blocked by a previous rejected transaction of the same sender are not recorded.
+type RejectionsRecordingSession interface {
+	SelectionSession
+	RecordRejectedTransaction(tx *WrappedTransaction, reason RejectionReason)
+}
+
+// EvictionHandler is notified about the transactions evicted from the cache, together with the reason of their eviction
+type EvictionHandler func(evicted []*WrappedTransaction, reason RejectionReason)
+
+// ForEachTransaction is an iterator callback
+type ForEachTransaction func(txHash []byte, value *WrappedTransaction)
@@ -0,0 +1,154 @@
+package txcache
+
+import (
+	"bytes"
+	"sync"
+	"time"
+)
+
+// RejectionReason describes why a transaction has been evicted from the mempool or skipped at selection
+type RejectionReason string
+
+const (
+	// RejectionReasonEvictedUnderHighLoad signals that the transaction has been evicted because the mempool was full
+	RejectionReasonEvictedUnderHighLoad RejectionReason = "evicted under high load"
+	// RejectionReasonEvictedBySenderLimits signals that the transaction has been evicted because its sender has too many
+	// transactions (or bytes) in the mempool
+	RejectionReasonEvictedBySenderLimits RejectionReason = "evicted by sender limits"
+	// RejectionReasonLowerNonce signals that the transaction nonce is lower than the sender account nonce
+	RejectionReasonLowerNonce RejectionReason = "nonce lower than the account nonce"
+	// RejectionReasonNonceGap signals that the transaction nonce is not the next expected one for the sender
+	RejectionReasonNonceGap RejectionReason = "nonce gap"
+	// RejectionReasonDuplicateNonce signals that another transaction with the same nonce is preferred for the sender
+	RejectionReasonDuplicateNonce RejectionReason = "duplicate nonce"
+	// RejectionReasonInsufficientBalance signals that the sender balance does not cover the transaction fee
+	RejectionReasonInsufficientBalance RejectionReason = "insufficient balance"
+	// RejectionReasonIncorrectlyGuarded signals that the transaction is not executable due to its guardian settings
+	RejectionReasonIncorrectlyGuarded RejectionReason = "incorrectly guarded"
+	// RejectionReasonSenderBlocked signals that a previous transaction of the same sender prevents the selection
+	RejectionReasonSenderBlocked RejectionReason = "blocked by a previous transaction of the sender"
+	// RejectionReasonReplacedByFee signals that the transaction has been replaced by one with the same nonce and a higher gas price
+	RejectionReasonReplacedByFee RejectionReason = "replaced by fee"
+	// RejectionReasonReplacementUnderpriced signals that the transaction has the same nonce as one already in the mempool,
+	// without paying a high enough gas price in order to replace it
+	RejectionReasonReplacementUnderpriced RejectionReason = "replacement underpriced"
+)
+
+// RejectedTransaction holds the information about a transaction evicted from the mempool or rejected at selection
+type RejectedTransaction struct {
+	TxHash    []byte
+	Sender    []byte
+	Nonce     uint64
+	Reason    RejectionReason
+	Timestamp time.Time
+}
+
+// NewRejectedTransaction creates a new rejected transaction entry, timestamped with the current time
+func NewRejectedTransaction(tx *WrappedTransaction, reason RejectionReason) *RejectedTransaction {
+	return &RejectedTransaction{
+		TxHash:    tx.TxHash,
+		Sender:    tx.Tx.GetSndAddr(),
+		Nonce:     tx.Tx.GetNonce(),
+		Reason:    reason,
+		Timestamp: time.Now(),
+	}
+}
+
+// RejectedTransactionsLog is a bounded in-memory log of the most recently rejected transactions.
+// When full, the oldest entries are overwritten. A transaction rejected again for the same reason is not
+// recorded twice, as long as its previous entry is still in the log. It is concurrency safe.
+type RejectedTransactionsLog struct {
+	mut          sync.RWMutex
+	entries      []*RejectedTransaction
+	latestByHash map[string]*RejectedTransaction
+	next         int
+	isFull       bool
+}
+
+// NewRejectedTransactionsLog creates a new log able to hold the provided number of entries.
+// A log with 0 capacity does not record anything
+func NewRejectedTransactionsLog(capacity uint32) *RejectedTransactionsLog {
+	return &RejectedTransactionsLog{
+		entries:      make([]*RejectedTransaction, capacity),
+		latestByHash: make(map[string]*RejectedTransaction),
+	}
+}
+
+// Add appends the provided entries to the log, overwriting the oldest ones, if needed
+func (rtl *RejectedTransactionsLog) Add(rejected ...*RejectedTransaction) {
+	if len(rtl.entries) == 0 {
+		return
+	}
+
+	rtl.mut.Lock()
+	defer rtl.mut.Unlock()
+
+	for _, entry := range rejected {
+		if entry == nil {
+			continue
+		}
+
+		latest, exists := rtl.latestByHash[string(entry.TxHash)]
+		if exists && latest.Reason == entry.Reason {
+			continue
+		}
+
+		overwritten := rtl.entries[rtl.next]
+		if overwritten != nil && rtl.latestByHash[string(overwritten.TxHash)] == overwritten {
+			delete(rtl.latestByHash, string(overwritten.TxHash))
+		}
+
+		rtl.latestByHash[string(entry.TxHash)] = entry
+		rtl.entries[rtl.next] = entry
+		rtl.next++
+		if rtl.next == len(rtl.entries) {
+			rtl.next = 0
+			rtl.isFull = true
+		}
+	}
+}
+
+// GetAll returns all the entries from the log, the oldest first
+func (rtl *RejectedTransactionsLog) GetAll() []*RejectedTransaction {
+	return rtl.filter(func(_ *RejectedTransaction) bool {
+		return true
+	})
+}
+
+// GetForSender returns the entries of the provided sender, the oldest first
+func (rtl *RejectedTransactionsLog) GetForSender(sender []byte) []*RejectedTransaction {
+	return rtl.filter(func(entry *RejectedTransaction) bool {
+		return bytes.Equal(entry.Sender, sender)
+	})
+}
+
+func (rtl *RejectedTransactionsLog) filter(shouldInclude func(entry *RejectedTransaction) bool) []*RejectedTransaction {
+	rtl.mut.RLock()
+	defer rtl.mut.RUnlock()
+
+	result := make([]*RejectedTransaction, 0)
+	if rtl.isFull {
+		result = appendIncludedEntries(result, rtl.entries[rtl.next:], shouldInclude)
+	}
+
+	return appendIncludedEntries(result, rtl.entries[:rtl.next], shouldInclude)
+}
+
+func appendIncludedEntries(
+	result []*RejectedTransaction,
+	entries []*RejectedTransaction,
+	shouldInclude func(entry *RejectedTransaction) bool,
+) []*RejectedTransaction {
+	for _, entry := range entries {
+		if shouldInclude(entry) {
+			result = append(result, entry)
+		}
+	}
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rtl *RejectedTransactionsLog) IsInterfaceNil() bool {
+	return rtl == nil
+}
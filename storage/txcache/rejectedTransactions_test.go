@@ -0,0 +1,132 @@
+package txcache
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func createWrappedTx(hash string, sender string, nonce uint64) *WrappedTransaction {
+	return &WrappedTransaction{
+		Tx: &transaction.Transaction{
+			SndAddr: []byte(sender),
+			Nonce:   nonce,
+		},
+		TxHash: []byte(hash),
+	}
+}
+
+func TestNewRejectedTransaction(t *testing.T) {
+	t.Parallel()
+
+	entry := NewRejectedTransaction(createWrappedTx("hash", "alice", 7), RejectionReasonNonceGap)
+	assert.Equal(t, []byte("hash"), entry.TxHash)
+	assert.Equal(t, []byte("alice"), entry.Sender)
+	assert.Equal(t, uint64(7), entry.Nonce)
+	assert.Equal(t, RejectionReasonNonceGap, entry.Reason)
+	assert.False(t, entry.Timestamp.IsZero())
+}
+
+func TestRejectedTransactionsLog_Add(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero capacity should not record", func(t *testing.T) {
+		t.Parallel()
+
+		rtl := NewRejectedTransactionsLog(0)
+		rtl.Add(NewRejectedTransaction(createWrappedTx("hash", "alice", 0), RejectionReasonNonceGap))
+
+		assert.Empty(t, rtl.GetAll())
+	})
+	t.Run("nil entries should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		rtl := NewRejectedTransactionsLog(2)
+		rtl.Add(nil, NewRejectedTransaction(createWrappedTx("hash", "alice", 0), RejectionReasonNonceGap), nil)
+
+		assert.Equal(t, 1, len(rtl.GetAll()))
+	})
+	t.Run("same transaction rejected for the same reason should be recorded once", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createWrappedTx("hash", "alice", 0)
+		rtl := NewRejectedTransactionsLog(3)
+		rtl.Add(NewRejectedTransaction(tx, RejectionReasonNonceGap))
+		rtl.Add(NewRejectedTransaction(tx, RejectionReasonNonceGap))
+		assert.Equal(t, 1, len(rtl.GetAll()))
+
+		rtl.Add(NewRejectedTransaction(tx, RejectionReasonInsufficientBalance))
+		assert.Equal(t, 2, len(rtl.GetAll()))
+
+		// once its entries are overwritten, the transaction is recorded again
+		rtl.Add(NewRejectedTransaction(createWrappedTx("hash1", "alice", 1), RejectionReasonNonceGap))
+		rtl.Add(NewRejectedTransaction(createWrappedTx("hash2", "alice", 2), RejectionReasonNonceGap))
+		rtl.Add(NewRejectedTransaction(createWrappedTx("hash3", "alice", 3), RejectionReasonNonceGap))
+		rtl.Add(NewRejectedTransaction(tx, RejectionReasonInsufficientBalance))
+
+		entries := rtl.GetAll()
+		assert.Equal(t, 3, len(entries))
+		assert.Equal(t, []byte("hash"), entries[2].TxHash)
+	})
+	t.Run("should keep the most recent entries, the oldest first", func(t *testing.T) {
+		t.Parallel()
+
+		rtl := NewRejectedTransactionsLog(3)
+		for i := 0; i < 5; i++ {
+			rtl.Add(NewRejectedTransaction(createWrappedTx(fmt.Sprintf("hash%d", i), "alice", uint64(i)), RejectionReasonNonceGap))
+		}
+
+		entries := rtl.GetAll()
+		assert.Equal(t, 3, len(entries))
+		assert.Equal(t, []byte("hash2"), entries[0].TxHash)
+		assert.Equal(t, []byte("hash3"), entries[1].TxHash)
+		assert.Equal(t, []byte("hash4"), entries[2].TxHash)
+	})
+}
+
+func TestRejectedTransactionsLog_GetForSender(t *testing.T) {
+	t.Parallel()
+
+	rtl := NewRejectedTransactionsLog(10)
+	rtl.Add(
+		NewRejectedTransaction(createWrappedTx("hash0", "alice", 0), RejectionReasonLowerNonce),
+		NewRejectedTransaction(createWrappedTx("hash1", "bob", 0), RejectionReasonInsufficientBalance),
+		NewRejectedTransaction(createWrappedTx("hash2", "alice", 1), RejectionReasonIncorrectlyGuarded),
+	)
+
+	entries := rtl.GetForSender([]byte("alice"))
+	assert.Equal(t, 2, len(entries))
+	assert.Equal(t, RejectionReasonLowerNonce, entries[0].Reason)
+	assert.Equal(t, RejectionReasonIncorrectlyGuarded, entries[1].Reason)
+
+	assert.Empty(t, rtl.GetForSender([]byte("carol")))
+}
+
+func TestRejectedTransactionsLog_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	rtl := NewRejectedTransactionsLog(10)
+
+	numCalls := 100
+	wg := &sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			switch idx % 3 {
+			case 0:
+				rtl.Add(NewRejectedTransaction(createWrappedTx("hash", "alice", uint64(idx)), RejectionReasonNonceGap))
+			case 1:
+				_ = rtl.GetAll()
+			default:
+				_ = rtl.GetForSender([]byte("alice"))
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,66 @@
+package txcache
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrReplacementUnderpriced signals that a transaction having the same sender and nonce as one already in the cache
+// does not pay a high enough gas price in order to replace it
+var ErrReplacementUnderpriced = errors.New("replacement transaction underpriced")
+
+const oneHundredPercent = 100
+
+// ReplaceableTxCache defines the cache operations needed in order to replace transactions
+type ReplaceableTxCache interface {
+	ReplaceTx(tx *WrappedTransaction, isSufficientGasPriceBump func(oldGasPrice uint64, newGasPrice uint64) bool) (bool, []*WrappedTransaction, error)
+	CheckReplacement(tx *WrappedTransaction, isSufficientGasPriceBump func(oldGasPrice uint64, newGasPrice uint64) bool) error
+}
+
+// TxReplacer adds transactions to a cache, applying the "replace-by-fee" rules: a transaction having the same sender and
+// nonce as the ones already in the cache replaces them if its gas price is higher by (at least) a minimum bump.
+// The conflicting transactions are looked up in the (current) list of the sender, held by the cache, thus transactions
+// removed or evicted in the meantime are never considered.
+type TxReplacer struct {
+	minGasPriceBumpPercent uint64
+}
+
+// NewTxReplacer creates a new TxReplacer. A zero minimum bump requires a strictly higher gas price.
+func NewTxReplacer(minGasPriceBumpPercent uint32) *TxReplacer {
+	return &TxReplacer{
+		minGasPriceBumpPercent: uint64(minGasPriceBumpPercent),
+	}
+}
+
+// AddTx adds a transaction to the cache, replacing the transactions with the same sender and nonce (if any).
+// If the transaction is underpriced with respect to the ones having the same sender and nonce, it isn't added, and
+// ErrReplacementUnderpriced is returned.
+func (replacer *TxReplacer) AddTx(cache ReplaceableTxCache, tx *WrappedTransaction) (bool, []*WrappedTransaction, error) {
+	return cache.ReplaceTx(tx, replacer.isSufficientGasPriceBump)
+}
+
+// CheckReplacement checks, without altering the cache, whether AddTx would reject the transaction with
+// ErrReplacementUnderpriced
+func (replacer *TxReplacer) CheckReplacement(cache ReplaceableTxCache, tx *WrappedTransaction) error {
+	return cache.CheckReplacement(tx, replacer.isSufficientGasPriceBump)
+}
+
+func (replacer *TxReplacer) isSufficientGasPriceBump(oldGasPrice uint64, newGasPrice uint64) bool {
+	if newGasPrice <= oldGasPrice {
+		return false
+	}
+
+	// newGasPrice * 100 >= oldGasPrice * (100 + bump), computed without overflow
+	newScaled := big.NewInt(0).Mul(big.NewInt(0).SetUint64(newGasPrice), big.NewInt(oneHundredPercent))
+	oldScaled := big.NewInt(0).Mul(
+		big.NewInt(0).SetUint64(oldGasPrice),
+		big.NewInt(0).SetUint64(oneHundredPercent+replacer.minGasPriceBumpPercent),
+	)
+
+	return newScaled.Cmp(oldScaled) >= 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (replacer *TxReplacer) IsInterfaceNil() bool {
+	return replacer == nil
+}
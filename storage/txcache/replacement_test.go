@@ -0,0 +1,267 @@
+package txcache
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-storage-go/testscommon/txcachemocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createWrappedTxWithGasPrice(hash string, sender string, nonce uint64, gasPrice uint64) *WrappedTransaction {
+	return &WrappedTransaction{
+		Tx: &transaction.Transaction{
+			SndAddr:  []byte(sender),
+			Nonce:    nonce,
+			GasPrice: gasPrice,
+			GasLimit: 50_000,
+		},
+		TxHash: []byte(hash),
+		Size:   128,
+	}
+}
+
+func createTxCacheForReplacement(t *testing.T) *TxCache {
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                        "test",
+		NumChunks:                   4,
+		NumBytesThreshold:           1_048_576, // 1 MB
+		NumBytesPerSenderThreshold:  1_048_576, // 1 MB
+		CountThreshold:              math.MaxUint32,
+		CountPerSenderThreshold:     math.MaxUint32,
+		NumItemsToPreemptivelyEvict: 1,
+	}, txcachemocks.NewMempoolHostMock())
+	require.Nil(t, err)
+
+	return cache
+}
+
+func getHashesOfSender(cache *TxCache, sender string) []string {
+	hashes := make([]string, 0)
+	for _, tx := range cache.GetTransactionsPoolForSender(sender) {
+		hashes = append(hashes, string(tx.TxHash))
+	}
+
+	return hashes
+}
+
+func TestNewTxReplacer(t *testing.T) {
+	t.Parallel()
+
+	replacer := NewTxReplacer(10)
+	require.False(t, replacer.IsInterfaceNil())
+	require.Equal(t, uint64(10), replacer.minGasPriceBumpPercent)
+}
+
+func TestTxReplacer_IsSufficientGasPriceBump(t *testing.T) {
+	t.Parallel()
+
+	replacer := NewTxReplacer(0)
+	require.False(t, replacer.isSufficientGasPriceBump(1_000_000_000, 1_000_000_000))
+	require.False(t, replacer.isSufficientGasPriceBump(1_000_000_000, 999_999_999))
+	require.True(t, replacer.isSufficientGasPriceBump(1_000_000_000, 1_000_000_001))
+
+	replacer = NewTxReplacer(10)
+	require.False(t, replacer.isSufficientGasPriceBump(1_000_000_000, 1_099_999_999))
+	require.True(t, replacer.isSufficientGasPriceBump(1_000_000_000, 1_100_000_000))
+	require.False(t, replacer.isSufficientGasPriceBump(math.MaxUint64-1, math.MaxUint64))
+}
+
+func TestTxReplacer_AddTx(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no transaction with the same nonce should add", func(t *testing.T) {
+		t.Parallel()
+
+		cache := createTxCacheForReplacement(t)
+		replacer := NewTxReplacer(10)
+
+		added, replaced, err := replacer.AddTx(cache, createWrappedTxWithGasPrice("a", "alice", 1, 1_000_000_000))
+		require.Nil(t, err)
+		require.True(t, added)
+		require.Empty(t, replaced)
+
+		added, replaced, err = replacer.AddTx(cache, createWrappedTxWithGasPrice("b", "alice", 2, 1_000_000_000))
+		require.Nil(t, err)
+		require.True(t, added)
+		require.Empty(t, replaced)
+		require.Equal(t, []string{"a", "b"}, getHashesOfSender(cache, "alice"))
+	})
+	t.Run("duplicate should not add", func(t *testing.T) {
+		t.Parallel()
+
+		cache := createTxCacheForReplacement(t)
+		replacer := NewTxReplacer(10)
+
+		_, _, _ = replacer.AddTx(cache, createWrappedTxWithGasPrice("a", "alice", 1, 1_000_000_000))
+		added, replaced, err := replacer.AddTx(cache, createWrappedTxWithGasPrice("a", "alice", 1, 1_000_000_000))
+		require.Nil(t, err)
+		require.False(t, added)
+		require.Empty(t, replaced)
+		require.Equal(t, []string{"a"}, getHashesOfSender(cache, "alice"))
+	})
+	t.Run("underpriced replacement should error", func(t *testing.T) {
+		t.Parallel()
+
+		cache := createTxCacheForReplacement(t)
+		replacer := NewTxReplacer(10)
+
+		_, _, _ = replacer.AddTx(cache, createWrappedTxWithGasPrice("a", "alice", 1, 1_000_000_000))
+
+		for _, gasPrice := range []uint64{900_000_000, 1_000_000_000, 1_050_000_000} {
+			added, replaced, err := replacer.AddTx(cache, createWrappedTxWithGasPrice(fmt.Sprintf("b-%d", gasPrice), "alice", 1, gasPrice))
+			require.Equal(t, ErrReplacementUnderpriced, err)
+			require.False(t, added)
+			require.Nil(t, replaced)
+		}
+		require.Equal(t, []string{"a"}, getHashesOfSender(cache, "alice"))
+	})
+	t.Run("sufficient bump should replace", func(t *testing.T) {
+		t.Parallel()
+
+		cache := createTxCacheForReplacement(t)
+		replacer := NewTxReplacer(10)
+
+		_, _, _ = replacer.AddTx(cache, createWrappedTxWithGasPrice("a", "alice", 1, 1_000_000_000))
+		_, _, _ = replacer.AddTx(cache, createWrappedTxWithGasPrice("b", "alice", 2, 1_000_000_000))
+		_, _, _ = replacer.AddTx(cache, createWrappedTxWithGasPrice("c", "bob", 1, 1_000_000_000))
+
+		added, replaced, err := replacer.AddTx(cache, createWrappedTxWithGasPrice("a2", "alice", 1, 1_100_000_000))
+		require.Nil(t, err)
+		require.True(t, added)
+		require.Equal(t, 1, len(replaced))
+		require.Equal(t, []byte("a"), replaced[0].TxHash)
+
+		require.Equal(t, []string{"a2", "b"}, getHashesOfSender(cache, "alice"))
+		require.Equal(t, []string{"c"}, getHashesOfSender(cache, "bob"))
+		require.False(t, cache.Has([]byte("a")))
+	})
+	t.Run("should keep the transactions with lower and higher nonces", func(t *testing.T) {
+		t.Parallel()
+
+		cache := createTxCacheForReplacement(t)
+		replacer := NewTxReplacer(10)
+
+		for nonce := uint64(0); nonce < 4; nonce++ {
+			_, _, _ = replacer.AddTx(cache, createWrappedTxWithGasPrice(fmt.Sprintf("a%d", nonce), "alice", nonce, 1_000_000_000))
+		}
+
+		added, replaced, err := replacer.AddTx(cache, createWrappedTxWithGasPrice("b2", "alice", 2, 2_000_000_000))
+		require.Nil(t, err)
+		require.True(t, added)
+		require.Equal(t, 1, len(replaced))
+		require.Equal(t, []string{"a0", "a1", "b2", "a3"}, getHashesOfSender(cache, "alice"))
+		require.Equal(t, uint64(4), cache.CountTx())
+	})
+	t.Run("should replace all the transactions with the same nonce", func(t *testing.T) {
+		t.Parallel()
+
+		cache := createTxCacheForReplacement(t)
+		replacer := NewTxReplacer(10)
+
+		_, _, _ = replacer.AddTx(cache, createWrappedTxWithGasPrice("a", "alice", 1, 1_000_000_000))
+		// transaction with the same nonce, added without replacement rules
+		cache.AddTx(createWrappedTxWithGasPrice("b", "alice", 1, 1_200_000_000))
+
+		added, replaced, err := replacer.AddTx(cache, createWrappedTxWithGasPrice("c", "alice", 1, 1_300_000_000))
+		require.Equal(t, ErrReplacementUnderpriced, err)
+		require.False(t, added)
+		require.Nil(t, replaced)
+
+		added, replaced, err = replacer.AddTx(cache, createWrappedTxWithGasPrice("d", "alice", 1, 1_320_000_000))
+		require.Nil(t, err)
+		require.True(t, added)
+		require.Equal(t, 2, len(replaced))
+		require.Equal(t, []string{"d"}, getHashesOfSender(cache, "alice"))
+	})
+	t.Run("transaction removed from cache should not be replaced", func(t *testing.T) {
+		t.Parallel()
+
+		cache := createTxCacheForReplacement(t)
+		replacer := NewTxReplacer(10)
+
+		_, _, _ = replacer.AddTx(cache, createWrappedTxWithGasPrice("a", "alice", 1, 1_000_000_000))
+		cache.RemoveTxByHash([]byte("a"))
+
+		added, replaced, err := replacer.AddTx(cache, createWrappedTxWithGasPrice("b", "alice", 1, 500_000_000))
+		require.Nil(t, err)
+		require.True(t, added)
+		require.Empty(t, replaced)
+		require.Equal(t, []string{"b"}, getHashesOfSender(cache, "alice"))
+	})
+}
+
+func TestTxReplacer_AddTxAfterEviction(t *testing.T) {
+	t.Parallel()
+
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                        "test",
+		NumChunks:                   4,
+		NumBytesThreshold:           1_048_576, // 1 MB
+		NumBytesPerSenderThreshold:  1_048_576, // 1 MB
+		CountThreshold:              math.MaxUint32,
+		CountPerSenderThreshold:     2,
+		NumItemsToPreemptivelyEvict: 1,
+	}, txcachemocks.NewMempoolHostMock())
+	require.Nil(t, err)
+	replacer := NewTxReplacer(10)
+
+	_, _, _ = replacer.AddTx(cache, createWrappedTxWithGasPrice("a1", "alice", 1, 1_000_000_000))
+	_, _, _ = replacer.AddTx(cache, createWrappedTxWithGasPrice("a2", "alice", 2, 1_000_000_000))
+	// evicts "a2", due to the limits of the sender
+	_, _, _ = replacer.AddTx(cache, createWrappedTxWithGasPrice("a0", "alice", 0, 1_000_000_000))
+	require.Equal(t, []string{"a0", "a1"}, getHashesOfSender(cache, "alice"))
+
+	// the evicted transaction is not considered, thus no bump is required
+	cache.RemoveTxByHash([]byte("a0"))
+	added, replaced, err := replacer.AddTx(cache, createWrappedTxWithGasPrice("b2", "alice", 2, 500_000_000))
+	require.Nil(t, err)
+	require.True(t, added)
+	require.Empty(t, replaced)
+	require.Equal(t, []string{"a1", "b2"}, getHashesOfSender(cache, "alice"))
+}
+
+func TestTxReplacer_AddTxConcurrently(t *testing.T) {
+	t.Parallel()
+
+	cache := createTxCacheForReplacement(t)
+	replacer := NewTxReplacer(0)
+
+	numSenders := 10
+	numNonces := 5
+	numCompetingTxs := 20
+	maxGasPrice := uint64(1_000_000_000 + numCompetingTxs - 1)
+
+	wg := sync.WaitGroup{}
+	for senderIndex := 0; senderIndex < numSenders; senderIndex++ {
+		for nonce := 0; nonce < numNonces; nonce++ {
+			for competitor := 0; competitor < numCompetingTxs; competitor++ {
+				wg.Add(1)
+
+				go func(senderIndex int, nonce int, competitor int) {
+					defer wg.Done()
+
+					sender := fmt.Sprintf("sender-%d", senderIndex)
+					hash := fmt.Sprintf("%s-%d-%d", sender, nonce, competitor)
+					gasPrice := uint64(1_000_000_000 + competitor)
+					_, _, _ = replacer.AddTx(cache, createWrappedTxWithGasPrice(hash, sender, uint64(nonce), gasPrice))
+				}(senderIndex, nonce, competitor)
+			}
+		}
+	}
+	wg.Wait()
+
+	require.Equal(t, uint64(numSenders*numNonces), cache.CountTx())
+	for senderIndex := 0; senderIndex < numSenders; senderIndex++ {
+		senderTxs := cache.GetTransactionsPoolForSender(fmt.Sprintf("sender-%d", senderIndex))
+		require.Equal(t, numNonces, len(senderTxs))
+
+		for nonce, tx := range senderTxs {
+			require.Equal(t, uint64(nonce), tx.Tx.GetNonce())
+			require.Equal(t, maxGasPrice, tx.Tx.GetGasPrice())
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package txcache
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+)
+
+// TransactionSelectability holds the outcome of checking a transaction against the per-sender selection rules
+type TransactionSelectability struct {
+	Tx         *WrappedTransaction
+	Selectable bool
+	Reason     RejectionReason
+}
+
+// AnalyzeSenderTransactions checks the transactions of a sender, sorted by nonce (as provided by the mempool),
+// against the per-sender rules applied by the selection (the same checks, in the same order). The global constraints
+// of a selection (gas, number of transactions, duration) are not taken into account.
+// Once a transaction blocks its sender (nonce gap, insufficient balance), all the following ones are marked as blocked.
+func AnalyzeSenderTransactions(
+	txs []*WrappedTransaction,
+	accountState *AccountState,
+	isIncorrectlyGuarded func(tx data.TransactionHandler) bool,
+) []*TransactionSelectability {
+	results := make([]*TransactionSelectability, 0, len(txs))
+
+	item, err := newTransactionsHeapItem(txs)
+	if err != nil {
+		return results
+	}
+
+	item.senderState = accountState
+	if isIncorrectlyGuarded == nil {
+		isIncorrectlyGuarded = func(_ data.TransactionHandler) bool {
+			return false
+		}
+	}
+
+	var blockingReason RejectionReason
+	for {
+		result := &TransactionSelectability{
+			Tx: item.currentTransaction,
+		}
+
+		if len(blockingReason) > 0 {
+			result.Reason = RejectionReasonSenderBlocked
+		} else {
+			blockingReason = detectSenderBlockingReason(item)
+			result.Reason = blockingReason
+		}
+		if len(result.Reason) == 0 {
+			result.Reason = detectTransactionSkippingReason(isIncorrectlyGuarded, item)
+		}
+		if len(result.Reason) == 0 {
+			item.selectCurrentTransaction()
+			result.Selectable = true
+		}
+
+		results = append(results, result)
+		if !item.gotoNextTransaction() {
+			return results
+		}
+	}
+}
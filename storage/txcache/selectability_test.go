@@ -0,0 +1,204 @@
+package txcache
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-storage-go/testscommon/txcachemocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createWrappedTxWithFee(hash string, nonce uint64, fee int64) *WrappedTransaction {
+	tx := createWrappedTx(hash, "alice", nonce)
+	tx.Fee = big.NewInt(fee)
+	tx.TransferredValue = big.NewInt(0)
+
+	return tx
+}
+
+func extractReasons(t *testing.T, results []*TransactionSelectability) []RejectionReason {
+	reasons := make([]RejectionReason, 0, len(results))
+	for _, result := range results {
+		assert.Equal(t, len(result.Reason) == 0, result.Selectable)
+		reasons = append(reasons, result.Reason)
+	}
+
+	return reasons
+}
+
+func TestAnalyzeSenderTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no transactions", func(t *testing.T) {
+		t.Parallel()
+
+		results := AnalyzeSenderTransactions(nil, &AccountState{Nonce: 0, Balance: big.NewInt(0)}, nil)
+		assert.Empty(t, results)
+	})
+	t.Run("all selectable", func(t *testing.T) {
+		t.Parallel()
+
+		txs := []*WrappedTransaction{
+			createWrappedTxWithFee("a", 5, 10),
+			createWrappedTxWithFee("b", 6, 10),
+			createWrappedTxWithFee("c", 7, 10),
+		}
+
+		results := AnalyzeSenderTransactions(txs, &AccountState{Nonce: 5, Balance: big.NewInt(30)}, nil)
+		assert.Equal(t, []RejectionReason{"", "", ""}, extractReasons(t, results))
+	})
+	t.Run("lower nonce and duplicates should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		txs := []*WrappedTransaction{
+			createWrappedTxWithFee("a", 4, 10),
+			createWrappedTxWithFee("b", 5, 10),
+			createWrappedTxWithFee("c", 5, 10),
+			createWrappedTxWithFee("d", 6, 10),
+		}
+
+		results := AnalyzeSenderTransactions(txs, &AccountState{Nonce: 5, Balance: big.NewInt(100)}, nil)
+		assert.Equal(t, []RejectionReason{RejectionReasonLowerNonce, "", RejectionReasonDuplicateNonce, ""}, extractReasons(t, results))
+	})
+	t.Run("initial gap should block the sender", func(t *testing.T) {
+		t.Parallel()
+
+		txs := []*WrappedTransaction{
+			createWrappedTxWithFee("a", 7, 10),
+			createWrappedTxWithFee("b", 8, 10),
+		}
+
+		results := AnalyzeSenderTransactions(txs, &AccountState{Nonce: 5, Balance: big.NewInt(100)}, nil)
+		assert.Equal(t, []RejectionReason{RejectionReasonNonceGap, RejectionReasonSenderBlocked}, extractReasons(t, results))
+	})
+	t.Run("middle gap should block the sender", func(t *testing.T) {
+		t.Parallel()
+
+		txs := []*WrappedTransaction{
+			createWrappedTxWithFee("a", 5, 10),
+			createWrappedTxWithFee("b", 7, 10),
+			createWrappedTxWithFee("c", 8, 10),
+		}
+
+		results := AnalyzeSenderTransactions(txs, &AccountState{Nonce: 5, Balance: big.NewInt(100)}, nil)
+		assert.Equal(t, []RejectionReason{"", RejectionReasonNonceGap, RejectionReasonSenderBlocked}, extractReasons(t, results))
+	})
+	t.Run("insufficient balance should block the sender", func(t *testing.T) {
+		t.Parallel()
+
+		txs := []*WrappedTransaction{
+			createWrappedTxWithFee("a", 5, 10),
+			createWrappedTxWithFee("b", 6, 10),
+			createWrappedTxWithFee("c", 7, 10),
+		}
+		txs[0].TransferredValue = big.NewInt(5)
+
+		results := AnalyzeSenderTransactions(txs, &AccountState{Nonce: 5, Balance: big.NewInt(20)}, nil)
+		assert.Equal(t, []RejectionReason{"", RejectionReasonInsufficientBalance, RejectionReasonSenderBlocked}, extractReasons(t, results))
+	})
+	t.Run("incorrectly guarded should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		txs := []*WrappedTransaction{
+			createWrappedTxWithFee("a", 5, 10),
+			createWrappedTxWithFee("b", 5, 10),
+			createWrappedTxWithFee("c", 6, 10),
+		}
+		isIncorrectlyGuarded := func(tx data.TransactionHandler) bool {
+			return tx == txs[0].Tx
+		}
+
+		results := AnalyzeSenderTransactions(txs, &AccountState{Nonce: 5, Balance: big.NewInt(100)}, isIncorrectlyGuarded)
+		assert.Equal(t, []RejectionReason{RejectionReasonIncorrectlyGuarded, "", ""}, extractReasons(t, results))
+	})
+	t.Run("unknown account state should only apply the checks between transactions", func(t *testing.T) {
+		t.Parallel()
+
+		txs := []*WrappedTransaction{
+			createWrappedTxWithFee("a", 5, 10),
+			createWrappedTxWithFee("b", 5, 10),
+			createWrappedTxWithFee("c", 7, 10),
+		}
+
+		results := AnalyzeSenderTransactions(txs, nil, nil)
+		assert.Equal(t, []RejectionReason{"", RejectionReasonDuplicateNonce, RejectionReasonNonceGap}, extractReasons(t, results))
+	})
+}
+
+type rejectionsRecordingSessionMock struct {
+	*txcachemocks.SelectionSessionMock
+	reasons map[string]RejectionReason
+}
+
+func (session *rejectionsRecordingSessionMock) RecordRejectedTransaction(tx *WrappedTransaction, reason RejectionReason) {
+	session.reasons[string(tx.TxHash)] = reason
+}
+
+func TestAnalyzeSenderTransactions_ShouldMatchTheSelection(t *testing.T) {
+	t.Parallel()
+
+	cache := newUnconstrainedCacheToTest()
+	session := &rejectionsRecordingSessionMock{
+		SelectionSessionMock: txcachemocks.NewSelectionSessionMock(),
+		reasons:              make(map[string]RejectionReason),
+	}
+	session.SetNonce([]byte("alice"), 1)
+	session.SetNonce([]byte("bob"), 5)
+	session.SetNonce([]byte("carol"), 1)
+	session.SetBalance([]byte("carol"), big.NewInt(70_000_000_000_000))
+	session.SetNonce([]byte("dan"), 0)
+
+	cache.AddTx(createTx([]byte("hash-alice-0"), "alice", 0))
+	cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("hash-alice-2"), "alice", 2).withGasPrice(2 * oneBillion))
+	cache.AddTx(createTx([]byte("hash-alice-2-duplicate"), "alice", 2))
+	cache.AddTx(createTx([]byte("hash-alice-4"), "alice", 4))
+	cache.AddTx(createTx([]byte("hash-alice-5"), "alice", 5))
+	cache.AddTx(createTx([]byte("hash-bob-5"), "bob", 5))
+	cache.AddTx(createTx([]byte("hash-bob-6"), "bob", 6))
+	cache.AddTx(createTx([]byte("hash-carol-1"), "carol", 1))
+	cache.AddTx(createTx([]byte("hash-carol-2"), "carol", 2))
+	cache.AddTx(createTx([]byte("hash-carol-3"), "carol", 3))
+	cache.AddTx(createTx([]byte("hash-dan-3"), "dan", 3))
+	session.IsIncorrectlyGuardedCalled = func(tx data.TransactionHandler) bool {
+		return bytes.Equal(tx.GetSndAddr(), []byte("bob")) && tx.GetNonce() == 5
+	}
+
+	selected, _ := cache.SelectTransactions(session, math.MaxUint64, math.MaxInt, selectionLoopMaximumDuration)
+	selectedHashes := make(map[string]struct{})
+	for _, tx := range selected {
+		selectedHashes[string(tx.TxHash)] = struct{}{}
+	}
+
+	analyzedReasons := make(map[string]RejectionReason)
+	for _, sender := range []string{"alice", "bob", "carol", "dan"} {
+		accountState, err := session.GetAccountState([]byte(sender))
+		require.Nil(t, err)
+
+		results := AnalyzeSenderTransactions(cache.GetTransactionsPoolForSender(sender), accountState, session.IsIncorrectlyGuarded)
+		for _, result := range results {
+			_, isSelected := selectedHashes[string(result.Tx.TxHash)]
+			require.Equal(t, isSelected, result.Selectable, string(result.Tx.TxHash))
+			if !result.Selectable && result.Reason != RejectionReasonSenderBlocked {
+				analyzedReasons[string(result.Tx.TxHash)] = result.Reason
+			}
+		}
+	}
+
+	expectedReasons := map[string]RejectionReason{
+		"hash-alice-0":           RejectionReasonLowerNonce,
+		"hash-alice-2-duplicate": RejectionReasonDuplicateNonce,
+		"hash-alice-4":           RejectionReasonNonceGap,
+		"hash-bob-5":             RejectionReasonIncorrectlyGuarded,
+		"hash-bob-6":             RejectionReasonNonceGap,
+		"hash-carol-2":           RejectionReasonInsufficientBalance,
+		"hash-dan-3":             RejectionReasonNonceGap,
+	}
+	require.Equal(t, expectedReasons, session.reasons)
+	require.Equal(t, expectedReasons, analyzedReasons)
+	require.Equal(t, 3, len(selected))
+}
@@ -0,0 +1,141 @@
+package txcache
+
+import (
+	"container/heap"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+)
+
+func (cache *TxCache) doSelectTransactions(session SelectionSession, gasRequested uint64, maxNum int, selectionLoopMaximumDuration time.Duration) (bunchOfTransactions, uint64) {
+	bunches := cache.acquireBunchesOfTransactions()
+
+	return selectTransactionsFromBunches(session, bunches, gasRequested, maxNum, selectionLoopMaximumDuration)
+}
+
+func (cache *TxCache) acquireBunchesOfTransactions() []bunchOfTransactions {
+	senders := cache.getSenders()
+	bunches := make([]bunchOfTransactions, 0, len(senders))
+
+	for _, sender := range senders {
+		bunches = append(bunches, sender.getTxs())
+	}
+
+	return bunches
+}
+
+// Selection tolerates concurrent transaction additions / removals.
+func selectTransactionsFromBunches(session SelectionSession, bunches []bunchOfTransactions, gasRequested uint64, maxNum int, selectionLoopMaximumDuration time.Duration) (bunchOfTransactions, uint64) {
+	selectedTransactions := make(bunchOfTransactions, 0, initialCapacityOfSelectionSlice)
+
+	// Items popped from the heap are added to "selectedTransactions".
+	transactionsHeap := newMaxTransactionsHeap(len(bunches))
+	heap.Init(transactionsHeap)
+
+	// Initialize the heap with the first transaction of each bunch
+	for _, bunch := range bunches {
+		item, err := newTransactionsHeapItem(bunch)
+		if err != nil {
+			continue
+		}
+
+		// Items will be reused (see below). Each sender gets one (and only one) item in the heap.
+		heap.Push(transactionsHeap, item)
+	}
+
+	accumulatedGas := uint64(0)
+	selectionLoopStartTime := time.Now()
+	isIncorrectlyGuarded := session.IsIncorrectlyGuarded
+	rejectionsRecorder, _ := session.(RejectionsRecordingSession)
+
+	// Select transactions (sorted).
+	for transactionsHeap.Len() > 0 {
+		// Always pick the best transaction.
+		item := heap.Pop(transactionsHeap).(*transactionsHeapItem)
+		gasLimit := item.currentTransaction.Tx.GetGasLimit()
+
+		if accumulatedGas+gasLimit > gasRequested {
+			break
+		}
+		if len(selectedTransactions) >= maxNum {
+			break
+		}
+		if len(selectedTransactions)%selectionLoopDurationCheckInterval == 0 {
+			if time.Since(selectionLoopStartTime) > selectionLoopMaximumDuration {
+				logSelect.Debug("TxCache.selectTransactionsFromBunches, selection loop timeout", "duration", time.Since(selectionLoopStartTime))
+				break
+			}
+		}
+
+		err := item.requestAccountStateIfNecessary(session)
+		if err != nil {
+			// Skip this sender.
+			logSelect.Debug("TxCache.selectTransactionsFromBunches, could not retrieve account state", "sender", item.sender, "err", err)
+			continue
+		}
+
+		senderBlockingReason := detectSenderBlockingReason(item)
+		if len(senderBlockingReason) > 0 {
+			// Item was popped from the heap, but not used downstream.
+			// Therefore, the sender is completely ignored (from now on) in the current selection session.
+			recordRejectedTransaction(rejectionsRecorder, item.currentTransaction, senderBlockingReason)
+			continue
+		}
+
+		skippingReason := detectTransactionSkippingReason(isIncorrectlyGuarded, item)
+		if len(skippingReason) == 0 {
+			accumulatedGas += gasLimit
+			selectedTransactions = append(selectedTransactions, item.selectCurrentTransaction())
+		} else {
+			recordRejectedTransaction(rejectionsRecorder, item.currentTransaction, skippingReason)
+		}
+
+		// If there are more transactions in the same bunch (same sender as the popped item),
+		// add the next one to the heap (to compete with the others).
+		// Heap item is reused (same originating sender), pushed back on the heap.
+		if item.gotoNextTransaction() {
+			heap.Push(transactionsHeap, item)
+		}
+	}
+
+	return selectedTransactions, accumulatedGas
+}
+
+// detectSenderBlockingReason returns the reason for which the current transaction, together with all the following
+// ones of the same sender, cannot be selected (empty if none)
+func detectSenderBlockingReason(item *transactionsHeapItem) RejectionReason {
+	if item.detectInitialGap() {
+		return RejectionReasonNonceGap
+	}
+	if item.detectMiddleGap() {
+		return RejectionReasonNonceGap
+	}
+	if item.detectWillFeeExceedBalance() {
+		return RejectionReasonInsufficientBalance
+	}
+
+	return ""
+}
+
+// detectTransactionSkippingReason returns the reason for which the current transaction is skipped (empty if none)
+func detectTransactionSkippingReason(isIncorrectlyGuarded func(tx data.TransactionHandler) bool, item *transactionsHeapItem) RejectionReason {
+	if item.detectLowerNonce() {
+		return RejectionReasonLowerNonce
+	}
+	if item.detectIncorrectlyGuarded(isIncorrectlyGuarded) {
+		return RejectionReasonIncorrectlyGuarded
+	}
+	if item.detectNonceDuplicate() {
+		return RejectionReasonDuplicateNonce
+	}
+
+	return ""
+}
+
+func recordRejectedTransaction(recorder RejectionsRecordingSession, tx *WrappedTransaction, reason RejectionReason) {
+	if recorder == nil {
+		return
+	}
+
+	recorder.RecordRejectedTransaction(tx, reason)
+}
@@ -0,0 +1,346 @@
+package txcache
+
+import (
+	"bytes"
+	"container/list"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/atomic"
+)
+
+// txListForSender represents a sorted list of transactions of a particular sender
+type txListForSender struct {
+	sender      string
+	items       *list.List
+	totalBytes  atomic.Counter
+	constraints *senderConstraints
+
+	mutex sync.RWMutex
+}
+
+// newTxListForSender creates a new (sorted) list of transactions
+func newTxListForSender(sender string, constraints *senderConstraints) *txListForSender {
+	return &txListForSender{
+		items:       list.New(),
+		sender:      sender,
+		constraints: constraints,
+	}
+}
+
+// AddTx adds a transaction in sender's list
+// This is a "sorted" insert
+func (listForSender *txListForSender) AddTx(tx *WrappedTransaction) (bool, [][]byte) {
+	// We don't allow concurrent interceptor goroutines to mutate a given sender's list
+	listForSender.mutex.Lock()
+	defer listForSender.mutex.Unlock()
+
+	insertionPlace, err := listForSender.findInsertionPlace(tx)
+	if err != nil {
+		return false, nil
+	}
+
+	if insertionPlace == nil {
+		listForSender.items.PushFront(tx)
+	} else {
+		listForSender.items.InsertAfter(tx, insertionPlace)
+	}
+
+	listForSender.onAddedTransaction(tx)
+
+	evicted := listForSender.applySizeConstraints()
+	return true, evicted
+}
+
+// ReplaceTx adds a transaction in sender's list, replacing the ones having the same nonce, if each of them is outbid,
+// as decided by "isSufficientGasPriceBump". The replacement happens in a single critical section: the list never holds
+// both, or none, of the replaced and the incoming transactions.
+func (listForSender *txListForSender) ReplaceTx(
+	tx *WrappedTransaction,
+	isSufficientGasPriceBump func(oldGasPrice uint64, newGasPrice uint64) bool,
+) (bool, []*WrappedTransaction, [][]byte, error) {
+	listForSender.mutex.Lock()
+	defer listForSender.mutex.Unlock()
+
+	replacedElements, err := listForSender.findReplacedElements(tx, isSufficientGasPriceBump)
+	if err == errItemAlreadyInCache {
+		return false, nil, nil, nil
+	}
+	if err != nil {
+		return false, nil, nil, err
+	}
+
+	// The insertion place is computed before removing anything, so that a failure leaves the list untouched.
+	// The replaced transactions (if any) are removed only after the incoming one is in place.
+	insertionPlace, err := listForSender.findInsertionPlace(tx)
+	if err != nil {
+		return false, nil, nil, err
+	}
+
+	if insertionPlace == nil {
+		listForSender.items.PushFront(tx)
+	} else {
+		listForSender.items.InsertAfter(tx, insertionPlace)
+	}
+
+	replaced := make([]*WrappedTransaction, 0, len(replacedElements))
+	for _, element := range replacedElements {
+		listForSender.items.Remove(element)
+		listForSender.onRemovedListElement(element)
+		replaced = append(replaced, element.Value.(*WrappedTransaction))
+	}
+
+	listForSender.onAddedTransaction(tx)
+
+	evicted := listForSender.applySizeConstraints()
+	return true, replaced, evicted, nil
+}
+
+// CheckReplacement checks, without altering the list, whether a transaction would be accepted by ReplaceTx.
+// A duplicate of a transaction in the list is not reported as an error.
+func (listForSender *txListForSender) CheckReplacement(
+	tx *WrappedTransaction,
+	isSufficientGasPriceBump func(oldGasPrice uint64, newGasPrice uint64) bool,
+) error {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	_, err := listForSender.findReplacedElements(tx, isSufficientGasPriceBump)
+	if err == errItemAlreadyInCache {
+		return nil
+	}
+
+	return err
+}
+
+// This function should only be used in critical section (listForSender.mutex).
+// It returns the elements holding the transactions with the same nonce as the incoming one, or ErrReplacementUnderpriced
+// if any of them isn't outbid. A duplicate of the incoming transaction is reported through errItemAlreadyInCache.
+func (listForSender *txListForSender) findReplacedElements(
+	incomingTx *WrappedTransaction,
+	isSufficientGasPriceBump func(oldGasPrice uint64, newGasPrice uint64) bool,
+) ([]*list.Element, error) {
+	incomingNonce := incomingTx.Tx.GetNonce()
+	incomingGasPrice := incomingTx.Tx.GetGasPrice()
+
+	replacedElements := make([]*list.Element, 0)
+	isUnderpriced := false
+
+	// Iterating from the back, as in "findInsertionPlace": the incoming transactions mostly have higher nonces.
+	for element := listForSender.items.Back(); element != nil; element = element.Prev() {
+		currentTx := element.Value.(*WrappedTransaction)
+		currentTxNonce := currentTx.Tx.GetNonce()
+
+		if currentTxNonce < incomingNonce {
+			break
+		}
+		if currentTxNonce > incomingNonce {
+			continue
+		}
+		if bytes.Equal(currentTx.TxHash, incomingTx.TxHash) {
+			return nil, errItemAlreadyInCache
+		}
+		if !isSufficientGasPriceBump(currentTx.Tx.GetGasPrice(), incomingGasPrice) {
+			// The search continues, since a duplicate takes precedence.
+			isUnderpriced = true
+			continue
+		}
+
+		replacedElements = append(replacedElements, element)
+	}
+
+	if isUnderpriced {
+		return nil, ErrReplacementUnderpriced
+	}
+
+	return replacedElements, nil
+}
+
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) applySizeConstraints() [][]byte {
+	evictedTxHashes := make([][]byte, 0)
+
+	// Iterate back to front
+	for element := listForSender.items.Back(); element != nil; element = element.Prev() {
+		if !listForSender.isCapacityExceeded() {
+			break
+		}
+
+		listForSender.items.Remove(element)
+		listForSender.onRemovedListElement(element)
+
+		// Keep track of removed transactions
+		value := element.Value.(*WrappedTransaction)
+		evictedTxHashes = append(evictedTxHashes, value.TxHash)
+	}
+
+	return evictedTxHashes
+}
+
+func (listForSender *txListForSender) isCapacityExceeded() bool {
+	maxBytes := int64(listForSender.constraints.maxNumBytes)
+	maxNumTxs := uint64(listForSender.constraints.maxNumTxs)
+	tooManyBytes := listForSender.totalBytes.Get() > maxBytes
+	tooManyTxs := listForSender.countTx() > maxNumTxs
+
+	return tooManyBytes || tooManyTxs
+}
+
+func (listForSender *txListForSender) onAddedTransaction(tx *WrappedTransaction) {
+	listForSender.totalBytes.Add(tx.Size)
+}
+
+// This function should only be used in critical section (listForSender.mutex).
+// When searching for the insertion place, we consider the following rules:
+// - transactions are sorted by nonce in ascending order.
+// - transactions with the same nonce are sorted by gas price in descending order.
+// - transactions with the same nonce and gas price are sorted by hash in ascending order.
+// - duplicates are not allowed.
+// - "PPU" measurement is not relevant in this context. Competition among transactions of the same sender (and nonce) is based on gas price.
+func (listForSender *txListForSender) findInsertionPlace(incomingTx *WrappedTransaction) (*list.Element, error) {
+	incomingNonce := incomingTx.Tx.GetNonce()
+	incomingGasPrice := incomingTx.Tx.GetGasPrice()
+
+	// The loop iterates from the back to the front of the list.
+	// Starting from the back allows the function to quickly find the insertion point for transactions with higher nonces, which are more likely to be added.
+	for element := listForSender.items.Back(); element != nil; element = element.Prev() {
+		currentTx := element.Value.(*WrappedTransaction)
+		currentTxNonce := currentTx.Tx.GetNonce()
+		currentTxGasPrice := currentTx.Tx.GetGasPrice()
+
+		if currentTxNonce == incomingNonce {
+			if currentTxGasPrice > incomingGasPrice {
+				// The case of same nonce, lower gas price.
+				// We've found an insertion place: right after "element".
+				return element, nil
+			}
+
+			if currentTxGasPrice == incomingGasPrice {
+				// The case of same nonce, same gas price.
+
+				comparison := bytes.Compare(currentTx.TxHash, incomingTx.TxHash)
+				if comparison == 0 {
+					// The incoming transaction will be discarded, since it's already in the cache.
+					return nil, errItemAlreadyInCache
+				}
+				if comparison < 0 {
+					// We've found an insertion place: right after "element".
+					return element, nil
+				}
+
+				// We allow the search loop to continue, since the incoming transaction has a "higher hash".
+			}
+
+			// We allow the search loop to continue, since the incoming transaction has a higher gas price.
+			continue
+		}
+
+		if currentTxNonce < incomingNonce {
+			// We've found the first transaction with a lower nonce than the incoming one,
+			// thus the incoming transaction will be placed right after this one.
+			return element, nil
+		}
+
+		// We allow the search loop to continue, since the incoming transaction has a higher nonce.
+	}
+
+	// The incoming transaction will be inserted at the head of the list.
+	return nil, nil
+}
+
+func (listForSender *txListForSender) onRemovedListElement(element *list.Element) {
+	tx := element.Value.(*WrappedTransaction)
+	listForSender.totalBytes.Subtract(tx.Size)
+}
+
+// IsEmpty checks whether the list is empty
+func (listForSender *txListForSender) IsEmpty() bool {
+	return listForSender.countTxWithLock() == 0
+}
+
+// getTxs returns the transactions of the sender
+func (listForSender *txListForSender) getTxs() []*WrappedTransaction {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	result := make([]*WrappedTransaction, 0, listForSender.countTx())
+
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		value := element.Value.(*WrappedTransaction)
+		result = append(result, value)
+	}
+
+	return result
+}
+
+// getTxsReversed returns the transactions of the sender, in reverse nonce order
+func (listForSender *txListForSender) getTxsReversed() []*WrappedTransaction {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	result := make([]*WrappedTransaction, 0, listForSender.countTx())
+
+	for element := listForSender.items.Back(); element != nil; element = element.Prev() {
+		value := element.Value.(*WrappedTransaction)
+		result = append(result, value)
+	}
+
+	return result
+}
+
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) countTx() uint64 {
+	return uint64(listForSender.items.Len())
+}
+
+func (listForSender *txListForSender) countTxWithLock() uint64 {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+	return uint64(listForSender.items.Len())
+}
+
+// removeTransactionsWithLowerOrEqualNonceReturnHashes removes transactions with nonces lower or equal to the given nonce
+func (listForSender *txListForSender) removeTransactionsWithLowerOrEqualNonceReturnHashes(targetNonce uint64) [][]byte {
+	evictedTxHashes := make([][]byte, 0)
+
+	// We don't allow concurrent goroutines to mutate a given sender's list
+	listForSender.mutex.Lock()
+	defer listForSender.mutex.Unlock()
+
+	for element := listForSender.items.Front(); element != nil; {
+		tx := element.Value.(*WrappedTransaction)
+		txNonce := tx.Tx.GetNonce()
+
+		if txNonce > targetNonce {
+			break
+		}
+
+		nextElement := element.Next()
+		_ = listForSender.items.Remove(element)
+		listForSender.onRemovedListElement(element)
+		element = nextElement
+
+		// Keep track of removed transactions
+		evictedTxHashes = append(evictedTxHashes, tx.TxHash)
+	}
+
+	return evictedTxHashes
+}
+
+func (listForSender *txListForSender) removeTransactionsWithHigherOrEqualNonce(givenNonce uint64) {
+	listForSender.mutex.Lock()
+	defer listForSender.mutex.Unlock()
+
+	for element := listForSender.items.Back(); element != nil; {
+		tx := element.Value.(*WrappedTransaction)
+		txNonce := tx.Tx.GetNonce()
+
+		if txNonce < givenNonce {
+			break
+		}
+
+		prevElement := element.Prev()
+		_ = listForSender.items.Remove(element)
+		listForSender.onRemovedListElement(element)
+		element = prevElement
+	}
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
 )
 
@@ -9,6 +10,7 @@ import (
 type GroupHandlerStub struct {
 	UpdateFacadeCalled   func(facade interface{}) error
 	RegisterRoutesCalled func(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig)
+	GetEndpointsCalled   func() []*shared.EndpointHandlerData
 }
 
 // UpdateFacade -
@@ -26,6 +28,14 @@ func (stub *GroupHandlerStub) RegisterRoutes(ws *gin.RouterGroup, apiConfig conf
 	}
 }
 
+// GetEndpoints -
+func (stub *GroupHandlerStub) GetEndpoints() []*shared.EndpointHandlerData {
+	if stub.GetEndpointsCalled != nil {
+		return stub.GetEndpointsCalled()
+	}
+	return nil
+}
+
 // IsInterfaceNil -
 func (stub *GroupHandlerStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -4,8 +4,13 @@ import "github.com/multiversx/mx-chain-go/node/chainSimulator/process"
 
 // ChainSimulatorMock -
 type ChainSimulatorMock struct {
-	GenerateBlocksCalled func(numOfBlocks int) error
-	GetNodeHandlerCalled func(shardID uint32) process.NodeHandler
+	GenerateBlocksCalled   func(numOfBlocks int) error
+	WarpToRoundCalled      func(targetRound int64, numOfBlocks int) error
+	WarpTimestampCalled    func(timestamp int64, numOfBlocks int) error
+	InjectFaultCalled      func(shardID uint32, round int64, fault process.Fault) error
+	GetNodeHandlerCalled   func(shardID uint32) process.NodeHandler
+	TakeSnapshotCalled     func() (int, error)
+	RevertToSnapshotCalled func(snapshotID int) error
 }
 
 // GenerateBlocks -
@@ -17,6 +22,33 @@ func (mock *ChainSimulatorMock) GenerateBlocks(numOfBlocks int) error {
 	return nil
 }
 
+// WarpToRound -
+func (mock *ChainSimulatorMock) WarpToRound(targetRound int64, numOfBlocks int) error {
+	if mock.WarpToRoundCalled != nil {
+		return mock.WarpToRoundCalled(targetRound, numOfBlocks)
+	}
+
+	return nil
+}
+
+// WarpTimestamp -
+func (mock *ChainSimulatorMock) WarpTimestamp(timestamp int64, numOfBlocks int) error {
+	if mock.WarpTimestampCalled != nil {
+		return mock.WarpTimestampCalled(timestamp, numOfBlocks)
+	}
+
+	return nil
+}
+
+// InjectFault -
+func (mock *ChainSimulatorMock) InjectFault(shardID uint32, round int64, fault process.Fault) error {
+	if mock.InjectFaultCalled != nil {
+		return mock.InjectFaultCalled(shardID, round, fault)
+	}
+
+	return nil
+}
+
 // GetNodeHandler -
 func (mock *ChainSimulatorMock) GetNodeHandler(shardID uint32) process.NodeHandler {
 	if mock.GetNodeHandlerCalled != nil {
@@ -25,6 +57,24 @@ func (mock *ChainSimulatorMock) GetNodeHandler(shardID uint32) process.NodeHandl
 	return nil
 }
 
+// TakeSnapshot -
+func (mock *ChainSimulatorMock) TakeSnapshot() (int, error) {
+	if mock.TakeSnapshotCalled != nil {
+		return mock.TakeSnapshotCalled()
+	}
+
+	return 0, nil
+}
+
+// RevertToSnapshot -
+func (mock *ChainSimulatorMock) RevertToSnapshot(snapshotID int) error {
+	if mock.RevertToSnapshotCalled != nil {
+		return mock.RevertToSnapshotCalled(snapshotID)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (mock *ChainSimulatorMock) IsInterfaceNil() bool {
 	return mock == nil
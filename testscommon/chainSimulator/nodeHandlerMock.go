@@ -21,8 +21,10 @@ type NodeHandlerMock struct {
 	GetStateComponentsCalled      func() factory.StateComponentsHolder
 	GetFacadeHandlerCalled        func() shared.FacadeHandler
 	GetStatusCoreComponentsCalled func() factory.StatusCoreComponentsHolder
+	GetStatusComponentsCalled     func() factory.StatusComponentsHolder
 	SetKeyValueForAddressCalled   func(addressBytes []byte, state map[string]string) error
 	SetStateForAddressCalled      func(address []byte, state *dtos.AddressState) error
+	GetStateForAddressCalled      func(address []byte) (*dtos.AddressState, error)
 	RemoveAccountCalled           func(address []byte) error
 	CloseCalled                   func() error
 }
@@ -112,6 +114,14 @@ func (mock *NodeHandlerMock) GetStatusCoreComponents() factory.StatusCoreCompone
 	return nil
 }
 
+// GetStatusComponents -
+func (mock *NodeHandlerMock) GetStatusComponents() factory.StatusComponentsHolder {
+	if mock.GetStatusComponentsCalled != nil {
+		return mock.GetStatusComponentsCalled()
+	}
+	return nil
+}
+
 // SetKeyValueForAddress -
 func (mock *NodeHandlerMock) SetKeyValueForAddress(addressBytes []byte, state map[string]string) error {
 	if mock.SetKeyValueForAddressCalled != nil {
@@ -128,6 +138,14 @@ func (mock *NodeHandlerMock) SetStateForAddress(address []byte, state *dtos.Addr
 	return nil
 }
 
+// GetStateForAddress -
+func (mock *NodeHandlerMock) GetStateForAddress(address []byte) (*dtos.AddressState, error) {
+	if mock.GetStateForAddressCalled != nil {
+		return mock.GetStateForAddressCalled(address)
+	}
+	return nil, nil
+}
+
 // RemoveAccount -
 func (mock *NodeHandlerMock) RemoveAccount(address []byte) error {
 	if mock.RemoveAccountCalled != nil {
@@ -6,7 +6,9 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressHistory"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 )
 
 // HistoryRepositoryStub -
@@ -17,6 +19,8 @@ type HistoryRepositoryStub struct {
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	GetESDTSupplyCalled                func(token string) (*esdtSupply.SupplyESDT, error)
+	GetAddressHistoryCalled            func(address []byte, cursor uint64, maxEntries int) (*addressHistory.AddressHistoryPage, error)
+	GetEventsCalled                    func(filter *eventsIndex.EventsFilter) (*eventsIndex.EventsPage, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -89,6 +93,24 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtSupply.Supply
 	return nil, nil
 }
 
+// GetAddressHistory -
+func (hp *HistoryRepositoryStub) GetAddressHistory(address []byte, cursor uint64, maxEntries int) (*addressHistory.AddressHistoryPage, error) {
+	if hp.GetAddressHistoryCalled != nil {
+		return hp.GetAddressHistoryCalled(address, cursor, maxEntries)
+	}
+
+	return nil, nil
+}
+
+// GetEvents -
+func (hp *HistoryRepositoryStub) GetEvents(filter *eventsIndex.EventsFilter) (*eventsIndex.EventsPage, error) {
+	if hp.GetEventsCalled != nil {
+		return hp.GetEventsCalled(filter)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
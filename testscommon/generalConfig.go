@@ -361,6 +361,7 @@ func GetGeneralConfig() config.Config {
 			MaxHardCapForMissingNodes: 500,
 			TrieSyncerVersion:         2,
 			CheckNodesOnDisk:          false,
+			NumParallelSyncWorkers:    2,
 		},
 		Antiflood: config.AntifloodConfig{
 			NumConcurrentResolverJobs:           2,
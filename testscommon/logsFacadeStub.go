@@ -2,12 +2,14 @@ package testscommon
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 // LogsFacadeStub -
 type LogsFacadeStub struct {
 	GetLogCalled                    func(txHash []byte, epoch uint32) (*transaction.ApiLogs, error)
 	IncludeLogsInTransactionsCalled func(txs []*transaction.ApiTransactionResult, logsKeys [][]byte, epoch uint32) error
+	FilterEventsCalled              func(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error)
 }
 
 // GetLog -
@@ -28,6 +30,15 @@ func (stub *LogsFacadeStub) IncludeLogsInTransactions(txs []*transaction.ApiTran
 	return nil
 }
 
+// FilterEvents -
+func (stub *LogsFacadeStub) FilterEvents(filter *common.EventsFilterApiRequest) (*common.FilteredEventsApiResponse, error) {
+	if stub.FilterEventsCalled != nil {
+		return stub.FilterEventsCalled(filter)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (stub *LogsFacadeStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -11,6 +11,9 @@ type OutportStub struct {
 	SaveValidatorsRatingCalled  func(validatorsRating *outportcore.ValidatorsRating)
 	SaveValidatorsPubKeysCalled func(validatorsPubKeys *outportcore.ValidatorsPubKeys)
 	HasDriversCalled            func() bool
+	SubscribeDriverCalled       func(driver outport.Driver) error
+	SaveRoundsInfoCalled        func(roundsInfo *outportcore.RoundsInfo)
+	FinalizedBlockCalled        func(finalizedBlock *outportcore.FinalizedBlock)
 }
 
 // SaveBlock -
@@ -65,15 +68,28 @@ func (as *OutportStub) Close() error {
 }
 
 // SaveRoundsInfo -
-func (as *OutportStub) SaveRoundsInfo(_ *outportcore.RoundsInfo) {
-
+func (as *OutportStub) SaveRoundsInfo(roundsInfo *outportcore.RoundsInfo) {
+	if as.SaveRoundsInfoCalled != nil {
+		as.SaveRoundsInfoCalled(roundsInfo)
+	}
 }
 
 // SubscribeDriver -
-func (as *OutportStub) SubscribeDriver(_ outport.Driver) error {
+func (as *OutportStub) SubscribeDriver(driver outport.Driver) error {
+	if as.SubscribeDriverCalled != nil {
+		return as.SubscribeDriverCalled(driver)
+	}
+
 	return nil
 }
 
 // FinalizedBlock -
-func (as *OutportStub) FinalizedBlock(_ *outportcore.FinalizedBlock) {
+func (as *OutportStub) FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock) {
+	if as.FinalizedBlockCalled != nil {
+		as.FinalizedBlockCalled(finalizedBlock)
+	}
+}
+
+// NewTransactionInPool -
+func (as *OutportStub) NewTransactionInPool(_ []byte, _ interface{}) {
 }
@@ -147,7 +147,7 @@ func (rm *RaterMock) ComputeDecreaseProposer(shardId uint32, currentRating uint3
 // RevertIncreaseValidator -
 func (rm *RaterMock) RevertIncreaseValidator(shardId uint32, currentRating uint32, nrReverts uint32) uint32 {
 	if rm.RevertIncreaseValidatorCalled != nil {
-		return rm.RevertIncreaseProposerCalled(shardId, currentRating, nrReverts)
+		return rm.RevertIncreaseValidatorCalled(shardId, currentRating, nrReverts)
 	}
 	return 1
 }
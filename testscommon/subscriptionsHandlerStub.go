@@ -0,0 +1,22 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// SubscriptionsHandlerStub -
+type SubscriptionsHandlerStub struct {
+	HandleConnectionCalled func(conn common.WebSocketConnection) error
+}
+
+// HandleConnection -
+func (stub *SubscriptionsHandlerStub) HandleConnection(conn common.WebSocketConnection) error {
+	if stub.HandleConnectionCalled != nil {
+		return stub.HandleConnectionCalled(conn)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *SubscriptionsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,35 @@
+package testscommon
+
+// WebSocketConnectionStub -
+type WebSocketConnectionStub struct {
+	ReadMessageCalled  func() (messageType int, p []byte, err error)
+	WriteMessageCalled func(messageType int, data []byte) error
+	CloseCalled        func() error
+}
+
+// ReadMessage -
+func (stub *WebSocketConnectionStub) ReadMessage() (messageType int, p []byte, err error) {
+	if stub.ReadMessageCalled != nil {
+		return stub.ReadMessageCalled()
+	}
+
+	return 0, nil, nil
+}
+
+// WriteMessage -
+func (stub *WebSocketConnectionStub) WriteMessage(messageType int, data []byte) error {
+	if stub.WriteMessageCalled != nil {
+		return stub.WriteMessageCalled(messageType, data)
+	}
+
+	return nil
+}
+
+// Close -
+func (stub *WebSocketConnectionStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
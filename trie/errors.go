@@ -91,6 +91,9 @@ var ErrInvalidMaxHardCapForMissingNodes = errors.New("invalid max hardcap for mi
 // ErrInvalidTrieSyncerVersion signals that an invalid trie syncer version was provided
 var ErrInvalidTrieSyncerVersion = errors.New("invalid trie syncer version")
 
+// ErrInvalidNumSyncWorkers signals that an invalid number of trie sync workers was provided
+var ErrInvalidNumSyncWorkers = errors.New("invalid number of trie sync workers")
+
 // ErrTrieSyncTimeout signals that a timeout occurred while syncing the trie
 var ErrTrieSyncTimeout = errors.New("trie sync timeout")
 
@@ -123,3 +126,21 @@ var ErrNilTrieLeafParser = errors.New("nil trie leaf parser")
 
 // ErrInvalidNodeVersion signals that an invalid node version has been provided
 var ErrInvalidNodeVersion = errors.New("invalid node version provided")
+
+// ErrProofNotVerified signals that the provided Merkle proof could not be verified against the root hash
+var ErrProofNotVerified = errors.New("proof not verified")
+
+// ErrNilTrieNodeHandler signals that a nil trie node handler has been provided
+var ErrNilTrieNodeHandler = errors.New("nil trie node handler")
+
+// ErrNilTrieLeafHandler signals that a nil trie leaf handler has been provided
+var ErrNilTrieLeafHandler = errors.New("nil trie leaf handler")
+
+// ErrNilTrieStatisticsHandler signals that a nil trie statistics handler has been provided
+var ErrNilTrieStatisticsHandler = errors.New("nil trie statistics handler")
+
+// ErrNodeHashMismatch signals that the hash of an encoded trie node does not match the key it was stored with
+var ErrNodeHashMismatch = errors.New("trie node hash mismatch")
+
+// ErrNilTrieLeavesDiffHandler signals that a nil trie leaves diff handler has been provided
+var ErrNilTrieLeavesDiffHandler = errors.New("nil trie leaves diff handler")
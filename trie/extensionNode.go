@@ -703,6 +703,9 @@ func (en *extensionNode) getNextHashAndKey(key []byte) (bool, []byte, []byte) {
 	if len(key) == 0 || check.IfNil(en) {
 		return false, nil, nil
 	}
+	if !bytes.HasPrefix(key, en.Key) {
+		return false, nil, nil
+	}
 
 	nextKey := key[len(en.Key):]
 	wantHash := en.EncodedChild
@@ -963,6 +963,23 @@ func TestExtensionNode_getNextHashAndKey(t *testing.T) {
 	assert.Equal(t, []byte{}, nextKey)
 }
 
+func TestExtensionNode_getNextHashAndKeyKeyNotPrefixedByNodeKey(t *testing.T) {
+	t.Parallel()
+
+	_, collapsedEn := getEnAndCollapsedEn()
+	collapsedEn.Key = []byte("dog")
+
+	proofVerified, nextHash, nextKey := collapsedEn.getNextHashAndKey([]byte("dot"))
+	assert.False(t, proofVerified)
+	assert.Nil(t, nextHash)
+	assert.Nil(t, nextKey)
+
+	proofVerified, nextHash, nextKey = collapsedEn.getNextHashAndKey([]byte("do"))
+	assert.False(t, proofVerified)
+	assert.Nil(t, nextHash)
+	assert.Nil(t, nextKey)
+}
+
 func TestExtensionNode_getNextHashAndKeyNilKey(t *testing.T) {
 	t.Parallel()
 
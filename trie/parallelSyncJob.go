@@ -0,0 +1,137 @@
+package trie
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+// syncJob holds the state of a trie synced through the parallel sync scheduler. Except the leaves buffer and the
+// cancelled flag, its fields are only accessed from the processing loop of the scheduler
+type syncJob struct {
+	rootHash    []byte
+	stats       *baseSyncTrie
+	startTime   time.Time
+	numPending  int
+	doneWeight  float64
+	isFinished  bool
+	isCancelled int32
+	done        chan error
+
+	hasLeavesChan   bool
+	mutLeaves       sync.Mutex
+	leaves          []core.KeyValueHolder
+	leavesAvailable chan struct{}
+}
+
+func newSyncJob(rootHash []byte, stats *baseSyncTrie, hasLeavesChan bool) *syncJob {
+	return &syncJob{
+		rootHash:        rootHash,
+		stats:           stats,
+		startTime:       time.Now(),
+		done:            make(chan error, 1),
+		hasLeavesChan:   hasLeavesChan,
+		leaves:          make([]core.KeyValueHolder, 0),
+		leavesAvailable: make(chan struct{}, 1),
+	}
+}
+
+func (job *syncJob) finish(err error) {
+	job.isFinished = true
+	job.stats.setSyncDuration(time.Since(job.startTime))
+	job.done <- err
+}
+
+func (job *syncJob) cancel() {
+	atomic.StoreInt32(&job.isCancelled, 1)
+}
+
+func (job *syncJob) wasCancelled() bool {
+	return atomic.LoadInt32(&job.isCancelled) == 1
+}
+
+func (job *syncJob) addLeaf(leaf core.KeyValueHolder) {
+	if !job.hasLeavesChan {
+		return
+	}
+
+	job.mutLeaves.Lock()
+	job.leaves = append(job.leaves, leaf)
+	job.mutLeaves.Unlock()
+
+	select {
+	case job.leavesAvailable <- struct{}{}:
+	default:
+	}
+}
+
+func (job *syncJob) popLeaves() []core.KeyValueHolder {
+	job.mutLeaves.Lock()
+	defer job.mutLeaves.Unlock()
+
+	leaves := job.leaves
+	job.leaves = make([]core.KeyValueHolder, 0, len(leaves))
+
+	return leaves
+}
+
+func (job *syncJob) numBufferedLeaves() int {
+	job.mutLeaves.Lock()
+	defer job.mutLeaves.Unlock()
+
+	return len(job.leaves)
+}
+
+// syncItem is a trie node of a job that still has to be synced. The weight is the fraction of the key space of the
+// job's trie held under the trie node. Only one item at a time owns a hash, meaning that it is the one requesting its
+// trie node
+type syncItem struct {
+	hash        []byte
+	depth       int
+	weight      float64
+	job         *syncJob
+	index       uint64
+	isOwner     bool
+	requestTime time.Time
+}
+
+// syncItemsHeap orders the missing trie nodes so that the deepest ones are requested first, completing the started
+// subtrees before descending into new ones. Between nodes of the same depth, the first discovered one is requested first
+type syncItemsHeap []*syncItem
+
+// Len returns the number of items in the heap
+func (h syncItemsHeap) Len() int {
+	return len(h)
+}
+
+// Less returns true if the item at index i should be requested before the one at index j
+func (h syncItemsHeap) Less(i, j int) bool {
+	if h[i].depth != h[j].depth {
+		return h[i].depth > h[j].depth
+	}
+
+	return h[i].index < h[j].index
+}
+
+// Swap swaps the items at the given indexes
+func (h syncItemsHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+// Push adds an item at the end of the heap
+func (h *syncItemsHeap) Push(x interface{}) {
+	*h = append(*h, x.(*syncItem))
+}
+
+// Pop removes the last item of the heap
+func (h *syncItemsHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+
+	return item
+}
@@ -0,0 +1,601 @@
+package trie
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/keyValStorage"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+// the items of a job having more buffered leaves than this value are not processed until its leaves are consumed
+const maxBufferedLeavesPerJob = common.TrieLeavesChannelSyncCapacity
+
+// SyncProgress holds the progress of the tries synced through a parallel sync scheduler
+type SyncProgress struct {
+	NumSyncedNodes      uint64
+	NumSyncedBytes      uint64
+	NumPendingNodes     int
+	NumInFlightRequests int
+	NumActiveTries      int
+	// SyncedFraction is the estimated fraction of the first scheduled trie already synced, computed from the key space
+	// held under its synced trie nodes
+	SyncedFraction    float64
+	EstimatedTimeLeft time.Duration
+}
+
+type processedItem struct {
+	item       *syncItem
+	trieNode   node
+	missing    []*syncItem
+	doneWeight float64
+	err        error
+}
+
+type parallelSyncScheduler struct {
+	shardId                uint32
+	topic                  string
+	marshaller             marshal.Marshalizer
+	hasher                 hashing.Hasher
+	db                     common.TrieStorageInteractor
+	requestHandler         RequestHandler
+	interceptedNodesCacher storage.Cacher
+	trieSyncStatistics     common.SizeSyncStatisticsHandler
+	timeoutHandler         TimeoutHandler
+	maxInFlightRequests    int
+	numWorkers             int
+	checkNodesOnDisk       bool
+	waitTimeBetweenChecks  time.Duration
+	cancelFunc             func()
+
+	mutNewJobs sync.Mutex
+	newJobs    []*syncJob
+	isClosed   bool
+
+	// the fields below are only accessed from the processing loop
+	jobs       map[*syncJob]struct{}
+	pending    *syncItemsHeap
+	inFlight   map[string]*syncItem
+	owned      map[string]struct{}
+	deferred   map[string][]*syncItem
+	toCheck    []*syncItem
+	itemsIndex uint64
+
+	mutProgress sync.RWMutex
+	progress    SyncProgress
+	firstJob    *syncJob
+	startTime   time.Time
+}
+
+// NewParallelSyncScheduler creates a scheduler able to sync several tries at once, sharing between them a global budget
+// of requested trie nodes. The requested trie nodes of all the tries are batched in the same requests and the deepest
+// ones are requested first. The received trie nodes are processed by multiple workers. The maximum hardcap for missing
+// nodes of the argument is used as the maximum number of trie nodes requested at once, while the leaves channel of the
+// argument is not used, each created trie syncer being given its own
+func NewParallelSyncScheduler(arg ArgTrieSyncer) (*parallelSyncScheduler, error) {
+	err := checkParallelSyncArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	stsm, err := NewSyncTrieStorageManager(arg.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make(syncItemsHeap, 0)
+	s := &parallelSyncScheduler{
+		shardId:                arg.ShardId,
+		topic:                  arg.Topic,
+		marshaller:             arg.Marshalizer,
+		hasher:                 arg.Hasher,
+		db:                     stsm,
+		requestHandler:         arg.RequestHandler,
+		interceptedNodesCacher: arg.InterceptedNodes,
+		trieSyncStatistics:     arg.TrieSyncStatistics,
+		timeoutHandler:         arg.TimeoutHandler,
+		maxInFlightRequests:    arg.MaxHardCapForMissingNodes,
+		numWorkers:             arg.NumSyncWorkers,
+		checkNodesOnDisk:       arg.CheckNodesOnDisk,
+		waitTimeBetweenChecks:  time.Millisecond * 100,
+		newJobs:                make([]*syncJob, 0),
+		jobs:                   make(map[*syncJob]struct{}),
+		pending:                &pending,
+		inFlight:               make(map[string]*syncItem),
+		owned:                  make(map[string]struct{}),
+		deferred:               make(map[string][]*syncItem),
+		toCheck:                make([]*syncItem, 0),
+	}
+
+	var ctx context.Context
+	ctx, s.cancelFunc = context.WithCancel(context.Background())
+	go s.processLoop(ctx)
+
+	return s, nil
+}
+
+func checkParallelSyncArguments(arg ArgTrieSyncer) error {
+	err := checkArguments(arg)
+	if err != nil {
+		return err
+	}
+	if arg.NumSyncWorkers < 1 {
+		return fmt.Errorf("%w provided: %v", ErrInvalidNumSyncWorkers, arg.NumSyncWorkers)
+	}
+
+	return nil
+}
+
+// CreateTrieSyncer creates a trie syncer whose trie nodes are synced by this scheduler, together with the ones of all
+// the other created trie syncers. The leaves of the synced tries are written on the provided channel, if not nil
+func (s *parallelSyncScheduler) CreateTrieSyncer(leavesChan chan core.KeyValueHolder) TrieSyncer {
+	return &scheduledTrieSyncer{
+		scheduler:  s,
+		leavesChan: leavesChan,
+	}
+}
+
+func (s *parallelSyncScheduler) addJob(job *syncJob) {
+	s.mutNewJobs.Lock()
+	defer s.mutNewJobs.Unlock()
+
+	if s.isClosed {
+		job.finish(core.ErrContextClosing)
+		return
+	}
+
+	s.newJobs = append(s.newJobs, job)
+}
+
+func (s *parallelSyncScheduler) processLoop(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(s.waitTimeBetweenChecks):
+			s.processIteration()
+		case <-ctx.Done():
+			s.close()
+			return
+		}
+	}
+}
+
+func (s *parallelSyncScheduler) close() {
+	s.mutNewJobs.Lock()
+	s.isClosed = true
+	newJobs := s.newJobs
+	s.newJobs = nil
+	s.mutNewJobs.Unlock()
+
+	for _, job := range newJobs {
+		job.finish(core.ErrContextClosing)
+	}
+	for job := range s.jobs {
+		s.finishJob(job, core.ErrContextClosing)
+	}
+}
+
+func (s *parallelSyncScheduler) processIteration() {
+	start := time.Now()
+	defer func() {
+		s.trieSyncStatistics.AddProcessingTime(time.Since(start))
+		s.trieSyncStatistics.IncrementIteration()
+	}()
+
+	s.addNewJobs()
+	if len(s.jobs) == 0 {
+		return
+	}
+
+	if s.timeoutHandler.IsTimeout() {
+		for job := range s.jobs {
+			s.finishJob(job, ErrTrieSyncTimeout)
+		}
+		return
+	}
+
+	for job := range s.jobs {
+		if job.wasCancelled() {
+			s.finishJob(job, core.ErrContextClosing)
+		}
+	}
+
+	items := s.collectItemsToProcess()
+	processedItems := s.processItems(items)
+	for _, processed := range processedItems {
+		s.handleProcessedItem(processed)
+	}
+
+	s.requestMissingNodes()
+	s.updateProgress()
+}
+
+func (s *parallelSyncScheduler) addNewJobs() {
+	s.mutNewJobs.Lock()
+	newJobs := s.newJobs
+	s.newJobs = make([]*syncJob, 0)
+	s.mutNewJobs.Unlock()
+
+	for _, job := range newJobs {
+		s.jobs[job] = struct{}{}
+		job.numPending = 1
+		s.toCheck = append(s.toCheck, s.newSyncItem(job, job.rootHash, 0, 1))
+
+		s.mutProgress.Lock()
+		if s.firstJob == nil {
+			s.firstJob = job
+			s.startTime = time.Now()
+		}
+		s.mutProgress.Unlock()
+	}
+}
+
+func (s *parallelSyncScheduler) newSyncItem(job *syncJob, hash []byte, depth int, weight float64) *syncItem {
+	s.itemsIndex++
+
+	return &syncItem{
+		hash:   hash,
+		depth:  depth,
+		weight: weight,
+		job:    job,
+		index:  s.itemsIndex,
+	}
+}
+
+// collectItemsToProcess returns the received trie nodes together with the items that have to be checked locally
+func (s *parallelSyncScheduler) collectItemsToProcess() []*processedItem {
+	items := make([]*processedItem, 0, len(s.toCheck))
+	toCheck := s.toCheck
+	s.toCheck = make([]*syncItem, 0)
+	for _, item := range toCheck {
+		if item.job.isFinished {
+			continue
+		}
+		if isJobThrottled(item.job) {
+			s.toCheck = append(s.toCheck, item)
+			continue
+		}
+
+		items = append(items, &processedItem{item: item})
+	}
+
+	for hash, item := range s.inFlight {
+		if item.job.isFinished {
+			delete(s.inFlight, hash)
+			s.releaseHash(item.hash)
+			continue
+		}
+		if isJobThrottled(item.job) {
+			continue
+		}
+
+		n, err := s.getNodeFromCache(item.hash)
+		if err != nil {
+			continue
+		}
+
+		delete(s.inFlight, hash)
+		items = append(items, &processedItem{item: item, trieNode: n})
+	}
+
+	return items
+}
+
+func isJobThrottled(job *syncJob) bool {
+	return job.numBufferedLeaves() > maxBufferedLeavesPerJob
+}
+
+func (s *parallelSyncScheduler) processItems(items []*processedItem) []*processedItem {
+	if len(items) == 0 {
+		return items
+	}
+
+	itemsChan := make(chan *processedItem, len(items))
+	for _, item := range items {
+		itemsChan <- item
+	}
+	close(itemsChan)
+
+	wg := &sync.WaitGroup{}
+	numWorkers := core.MinInt(s.numWorkers, len(items))
+	wg.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		go func() {
+			for item := range itemsChan {
+				s.processItem(item)
+			}
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+
+	return items
+}
+
+type nodeToProcess struct {
+	trieNode node
+	depth    int
+	weight   float64
+}
+
+// processItem stores the trie node of the item together with all its descendants that are already available, collecting
+// the missing ones
+func (s *parallelSyncScheduler) processItem(processed *processedItem) {
+	item := processed.item
+	processed.missing = make([]*syncItem, 0)
+	if processed.trieNode == nil {
+		n, err := s.getNode(item.hash)
+		if err != nil {
+			processed.missing = append(processed.missing, item)
+			return
+		}
+		processed.trieNode = n
+	}
+
+	stack := []*nodeToProcess{{trieNode: processed.trieNode, depth: item.depth, weight: item.weight}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		err := s.storeTrieNode(current.trieNode, item.job)
+		if err != nil {
+			processed.err = err
+			return
+		}
+
+		missingChildrenHashes, children, err := current.trieNode.loadChildren(s.getNode)
+		if err != nil {
+			processed.err = err
+			return
+		}
+
+		childWeight, doneWeight := computeChildrenWeight(current, len(missingChildrenHashes)+len(children))
+		processed.doneWeight += doneWeight
+		for _, hash := range missingChildrenHashes {
+			processed.missing = append(processed.missing, &syncItem{
+				hash:   hash,
+				depth:  current.depth + 1,
+				weight: childWeight,
+				job:    item.job,
+			})
+		}
+		for _, child := range children {
+			stack = append(stack, &nodeToProcess{trieNode: child, depth: current.depth + 1, weight: childWeight})
+		}
+	}
+}
+
+// computeChildrenWeight splits the key space held by a trie node between its children, returning the weight of
+// each child and the weight of the key space that is already complete
+func computeChildrenWeight(parent *nodeToProcess, numChildren int) (float64, float64) {
+	switch parent.trieNode.(type) {
+	case *branchNode:
+		childWeight := parent.weight / nrOfChildren
+		return childWeight, childWeight * float64(nrOfChildren-numChildren)
+	case *extensionNode:
+		return parent.weight, 0
+	default:
+		return 0, parent.weight
+	}
+}
+
+func (s *parallelSyncScheduler) storeTrieNode(element node, job *syncJob) error {
+	numBytes, err := encodeNodeAndCommitToDB(element, s.db)
+	if err != nil {
+		return err
+	}
+	s.timeoutHandler.ResetWatchdog()
+
+	s.trieSyncStatistics.AddNumProcessed(1)
+	if numBytes > core.MaxBufferSizeToSendTrieNodes {
+		s.trieSyncStatistics.AddNumLarge(1)
+	}
+	s.trieSyncStatistics.AddNumBytesReceived(uint64(numBytes))
+	job.stats.updateStats(uint64(numBytes), element)
+
+	s.mutProgress.Lock()
+	s.progress.NumSyncedNodes++
+	s.progress.NumSyncedBytes += uint64(numBytes)
+	s.mutProgress.Unlock()
+
+	leafNodeElement, isLeaf := element.(*leafNode)
+	if isLeaf {
+		job.addLeaf(keyValStorage.NewKeyValStorage(leafNodeElement.Key, leafNodeElement.Value))
+	}
+
+	return nil
+}
+
+func (s *parallelSyncScheduler) handleProcessedItem(processed *processedItem) {
+	item := processed.item
+	job := item.job
+	if item.isOwner && processed.trieNode != nil {
+		s.releaseHash(item.hash)
+	}
+	if job.isFinished {
+		return
+	}
+	if processed.err != nil {
+		s.finishJob(job, processed.err)
+		return
+	}
+
+	job.numPending--
+	s.mutProgress.Lock()
+	job.doneWeight += processed.doneWeight
+	s.mutProgress.Unlock()
+
+	for _, missingItem := range processed.missing {
+		job.numPending++
+		s.addMissingItem(missingItem)
+	}
+
+	if job.numPending == 0 {
+		s.finishJob(job, nil)
+	}
+}
+
+// addMissingItem adds the item to the ones to be requested. If its trie node is already requested for another item,
+// the item is checked again after the trie node is processed
+func (s *parallelSyncScheduler) addMissingItem(item *syncItem) {
+	hash := string(item.hash)
+	_, isOwned := s.owned[hash]
+	if isOwned {
+		s.deferred[hash] = append(s.deferred[hash], item)
+		return
+	}
+
+	s.itemsIndex++
+	item.index = s.itemsIndex
+	item.isOwner = true
+	s.owned[hash] = struct{}{}
+	heap.Push(s.pending, item)
+}
+
+// releaseHash should only be called for the items added through addMissingItem
+func (s *parallelSyncScheduler) releaseHash(hash []byte) {
+	delete(s.owned, string(hash))
+
+	deferredItems, ok := s.deferred[string(hash)]
+	if !ok {
+		return
+	}
+
+	delete(s.deferred, string(hash))
+	s.toCheck = append(s.toCheck, deferredItems...)
+}
+
+func (s *parallelSyncScheduler) finishJob(job *syncJob, err error) {
+	delete(s.jobs, job)
+	s.trieSyncStatistics.SetNumMissing(job.rootHash, 0)
+	job.finish(err)
+}
+
+func (s *parallelSyncScheduler) requestMissingNodes() {
+	now := time.Now()
+	hashes := make([][]byte, 0, maxNumRequestedNodesPerBatch)
+	for hash, item := range s.inFlight {
+		if item.job.isFinished {
+			delete(s.inFlight, hash)
+			s.releaseHash(item.hash)
+			continue
+		}
+		if now.Sub(item.requestTime).Nanoseconds() > deltaReRequest {
+			hashes = append(hashes, item.hash)
+			item.requestTime = now
+		}
+	}
+
+	throttledItems := make([]*syncItem, 0)
+	for len(s.inFlight) < s.maxInFlightRequests && s.pending.Len() > 0 {
+		item := heap.Pop(s.pending).(*syncItem)
+		if item.job.isFinished {
+			s.releaseHash(item.hash)
+			continue
+		}
+		if isJobThrottled(item.job) {
+			throttledItems = append(throttledItems, item)
+			continue
+		}
+
+		item.requestTime = now
+		s.inFlight[string(item.hash)] = item
+		if s.interceptedNodesCacher.Has(item.hash) {
+			// already received, it will be processed in the next iteration
+			continue
+		}
+
+		hashes = append(hashes, item.hash)
+	}
+	for _, item := range throttledItems {
+		heap.Push(s.pending, item)
+	}
+
+	s.request(hashes)
+}
+
+// request sends the requests in batches, the trie nodes of different tries being requested together
+func (s *parallelSyncScheduler) request(hashes [][]byte) {
+	for len(hashes) > 0 {
+		batchSize := core.MinInt(len(hashes), maxNumRequestedNodesPerBatch)
+		s.requestHandler.RequestTrieNodes(s.shardId, hashes[:batchSize], s.topic)
+		hashes = hashes[batchSize:]
+	}
+}
+
+func (s *parallelSyncScheduler) updateProgress() {
+	numPendingNodes := 0
+	for job := range s.jobs {
+		numPendingNodes += job.numPending
+		s.trieSyncStatistics.SetNumMissing(job.rootHash, job.numPending)
+	}
+
+	s.mutProgress.Lock()
+	s.progress.NumPendingNodes = numPendingNodes
+	s.progress.NumInFlightRequests = len(s.inFlight)
+	s.progress.NumActiveTries = len(s.jobs)
+	s.mutProgress.Unlock()
+}
+
+// Progress returns the progress of the tries synced by this scheduler. The estimated time left is computed from the
+// progress of the first scheduled trie, as its leaves usually lead to the other synced tries
+func (s *parallelSyncScheduler) Progress() SyncProgress {
+	s.mutProgress.RLock()
+	defer s.mutProgress.RUnlock()
+
+	progress := s.progress
+	if s.firstJob == nil {
+		return progress
+	}
+
+	progress.SyncedFraction = s.firstJob.doneWeight
+	if progress.SyncedFraction > 1 {
+		progress.SyncedFraction = 1
+	}
+	if progress.SyncedFraction > 0 {
+		elapsed := time.Since(s.startTime)
+		progress.EstimatedTimeLeft = time.Duration(float64(elapsed) * (1 - progress.SyncedFraction) / progress.SyncedFraction)
+	}
+
+	return progress
+}
+
+func (s *parallelSyncScheduler) getNode(hash []byte) (node, error) {
+	if s.checkNodesOnDisk {
+		return getNodeFromCacheOrStorage(
+			hash,
+			s.interceptedNodesCacher,
+			s.db,
+			s.marshaller,
+			s.hasher,
+		)
+	}
+	return s.getNodeFromCache(hash)
+}
+
+func (s *parallelSyncScheduler) getNodeFromCache(hash []byte) (node, error) {
+	return getNodeFromCache(
+		hash,
+		s.interceptedNodesCacher,
+		s.marshaller,
+		s.hasher,
+	)
+}
+
+// Close stops the scheduler, the tries still syncing being stopped with an error
+func (s *parallelSyncScheduler) Close() error {
+	s.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *parallelSyncScheduler) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,285 @@
+package trie
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSourceTrie(numKeysValues int, keyPrefix string) (common.Trie, []byte) {
+	tr, _ := createInMemoryTrie()
+	for i := 0; i < numKeysValues; i++ {
+		keyVal := hasherMock.Compute(fmt.Sprintf("%s%d", keyPrefix, i))
+		_ = tr.Update(keyVal, keyVal)
+	}
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	return tr, rootHash
+}
+
+func checkSyncedTrie(t *testing.T, db common.StorageManager, rootHash []byte, numKeysValues int, keyPrefix string) {
+	tsm, _ := db.(*trieStorageManager)
+	persister, _ := tsm.mainStorer.(storage.Persister)
+	tr, _ := createInMemoryTrieFromDB(persister)
+	tr, _ = tr.Recreate(holders.NewDefaultRootHashesHolder(rootHash))
+	require.False(t, check.IfNil(tr))
+
+	for i := 0; i < numKeysValues; i++ {
+		keyVal := hasherMock.Compute(fmt.Sprintf("%s%d", keyPrefix, i))
+		val, _, err := tr.Get(keyVal)
+		require.Nil(t, err)
+		require.Equal(t, keyVal, val)
+	}
+}
+
+func TestNewParallelSyncScheduler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil request handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgument(time.Minute)
+		arg.RequestHandler = nil
+		scheduler, err := NewParallelSyncScheduler(arg)
+		assert.True(t, check.IfNil(scheduler))
+		assert.Equal(t, ErrNilRequestHandler, err)
+	})
+	t.Run("invalid number of workers should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgument(time.Minute)
+		arg.NumSyncWorkers = 0
+		scheduler, err := NewParallelSyncScheduler(arg)
+		assert.True(t, check.IfNil(scheduler))
+		assert.True(t, errors.Is(err, ErrInvalidNumSyncWorkers))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		scheduler, err := NewParallelSyncScheduler(createMockArgument(time.Minute))
+		assert.False(t, check.IfNil(scheduler))
+		assert.Nil(t, err)
+		assert.Nil(t, scheduler.Close())
+	})
+}
+
+func TestParallelSyncScheduler_StartSyncing(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty root hash should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		scheduler, _ := NewParallelSyncScheduler(createMockArgument(time.Minute))
+		defer func() {
+			_ = scheduler.Close()
+		}()
+
+		trieSyncer := scheduler.CreateTrieSyncer(nil)
+		assert.Nil(t, trieSyncer.StartSyncing(nil, context.Background()))
+		assert.Nil(t, trieSyncer.StartSyncing(common.EmptyTrieHash, context.Background()))
+	})
+	t.Run("nil context should error", func(t *testing.T) {
+		t.Parallel()
+
+		scheduler, _ := NewParallelSyncScheduler(createMockArgument(time.Minute))
+		defer func() {
+			_ = scheduler.Close()
+		}()
+
+		err := scheduler.CreateTrieSyncer(nil).StartSyncing([]byte("root hash"), nil)
+		assert.Equal(t, ErrNilContext, err)
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, rootHash := createSourceTrie(10, "")
+		scheduler, _ := NewParallelSyncScheduler(createMockArgument(time.Minute))
+		defer func() {
+			_ = scheduler.Close()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		err := scheduler.CreateTrieSyncer(nil).StartSyncing(rootHash, ctx)
+		assert.Equal(t, core.ErrContextClosing, err)
+	})
+	t.Run("closed scheduler should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, rootHash := createSourceTrie(10, "")
+		scheduler, _ := NewParallelSyncScheduler(createMockArgument(time.Minute))
+		_ = scheduler.Close()
+
+		err := scheduler.CreateTrieSyncer(nil).StartSyncing(rootHash, context.Background())
+		assert.Equal(t, core.ErrContextClosing, err)
+	})
+	t.Run("no trie nodes received should timeout", func(t *testing.T) {
+		t.Parallel()
+
+		_, rootHash := createSourceTrie(10, "")
+		scheduler, _ := NewParallelSyncScheduler(createMockArgument(time.Second))
+		defer func() {
+			_ = scheduler.Close()
+		}()
+
+		err := scheduler.CreateTrieSyncer(nil).StartSyncing(rootHash, context.Background())
+		assert.Equal(t, ErrTrieSyncTimeout, err)
+	})
+}
+
+func TestParallelSyncScheduler_SyncSeveralTries(t *testing.T) {
+	t.Parallel()
+
+	numMainTrieLeaves := 100
+	numDataTrieLeaves := 50
+	mainTrie, mainRootHash := createSourceTrie(numMainTrieLeaves, "account")
+	dataTrie, dataRootHash := createSourceTrie(numDataTrieLeaves, "data")
+
+	maxInFlightRequests := 5
+	arg := createMockArgument(time.Minute)
+	arg.MaxHardCapForMissingNodes = maxInFlightRequests
+	mainTrieResolver := createRequesterResolver(mainTrie, arg.InterceptedNodes, nil)
+	dataTrieResolver := createRequesterResolver(dataTrie, arg.InterceptedNodes, nil)
+
+	mutRequests := sync.Mutex{}
+	numMixedRequests := 0
+	arg.RequestHandler = &testscommon.RequestHandlerStub{
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			assert.LessOrEqual(t, len(hashes), maxInFlightRequests)
+
+			hasMainTrieNodes, hasDataTrieNodes := false, false
+			for _, hash := range hashes {
+				_, err := mainTrie.GetSerializedNode(hash)
+				hasMainTrieNodes = hasMainTrieNodes || err == nil
+				_, err = dataTrie.GetSerializedNode(hash)
+				hasDataTrieNodes = hasDataTrieNodes || err == nil
+			}
+
+			mutRequests.Lock()
+			if hasMainTrieNodes && hasDataTrieNodes {
+				numMixedRequests++
+			}
+			mutRequests.Unlock()
+
+			mainTrieResolver.RequestTrieNodes(destShardID, hashes, topic)
+			dataTrieResolver.RequestTrieNodes(destShardID, hashes, topic)
+		},
+	}
+
+	scheduler, err := NewParallelSyncScheduler(arg)
+	require.Nil(t, err)
+	defer func() {
+		_ = scheduler.Close()
+	}()
+
+	leavesChan := make(chan core.KeyValueHolder, numMainTrieLeaves)
+	mainTrieSyncer := scheduler.CreateTrieSyncer(leavesChan)
+	dataTrieSyncer := scheduler.CreateTrieSyncer(nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		assert.Nil(t, mainTrieSyncer.StartSyncing(mainRootHash, ctx))
+		wg.Done()
+	}()
+	go func() {
+		assert.Nil(t, dataTrieSyncer.StartSyncing(dataRootHash, ctx))
+		wg.Done()
+	}()
+	wg.Wait()
+
+	checkSyncedTrie(t, arg.DB, mainRootHash, numMainTrieLeaves, "account")
+	checkSyncedTrie(t, arg.DB, dataRootHash, numDataTrieLeaves, "data")
+
+	assert.Equal(t, numMainTrieLeaves, len(leavesChan))
+	assert.Equal(t, uint64(numMainTrieLeaves), mainTrieSyncer.NumLeaves())
+	assert.Equal(t, uint64(numDataTrieLeaves), dataTrieSyncer.NumLeaves())
+	assert.True(t, dataTrieSyncer.NumTrieNodes() > dataTrieSyncer.NumLeaves())
+	assert.True(t, mainTrieSyncer.Duration() > 0)
+
+	mutRequests.Lock()
+	assert.True(t, numMixedRequests > 0)
+	mutRequests.Unlock()
+
+	progress := scheduler.Progress()
+	assert.Equal(t, mainTrieSyncer.NumTrieNodes()+dataTrieSyncer.NumTrieNodes(), progress.NumSyncedNodes)
+	assert.Equal(t, mainTrieSyncer.NumBytes()+dataTrieSyncer.NumBytes(), progress.NumSyncedBytes)
+	assert.InDelta(t, 1, progress.SyncedFraction, 1e-9)
+	assert.Equal(t, time.Duration(0), progress.EstimatedTimeLeft)
+}
+
+func TestParallelTrieSyncer_StartSyncing(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 100
+	trSource, rootHash := createSourceTrie(numKeysValues, "")
+	arg := createMockArgument(time.Minute)
+	arg.RequestHandler = createRequesterResolver(trSource, arg.InterceptedNodes, nil)
+	arg.LeavesChan = make(chan core.KeyValueHolder, numKeysValues)
+
+	trieSyncer, err := NewParallelTrieSyncer(arg)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	err = trieSyncer.StartSyncing(rootHash, ctx)
+	require.Nil(t, err)
+
+	checkSyncedTrie(t, arg.DB, rootHash, numKeysValues, "")
+	assert.Equal(t, numKeysValues, len(arg.LeavesChan))
+	assert.Equal(t, uint64(numKeysValues), trieSyncer.NumLeaves())
+	assert.True(t, trieSyncer.NumTrieNodes() > trieSyncer.NumLeaves())
+	assert.True(t, trieSyncer.NumBytes() > 0)
+	assert.True(t, trieSyncer.Duration() > 0)
+}
+
+func TestSyncItemsHeap_DeepestItemsFirst(t *testing.T) {
+	t.Parallel()
+
+	h := make(syncItemsHeap, 0)
+	heap.Push(&h, &syncItem{hash: []byte("a"), depth: 1, index: 1})
+	heap.Push(&h, &syncItem{hash: []byte("b"), depth: 3, index: 2})
+	heap.Push(&h, &syncItem{hash: []byte("c"), depth: 2, index: 3})
+	heap.Push(&h, &syncItem{hash: []byte("d"), depth: 3, index: 4})
+
+	order := ""
+	for h.Len() > 0 {
+		order += string(heap.Pop(&h).(*syncItem).hash)
+	}
+	assert.Equal(t, "bdca", order)
+}
+
+func TestComputeChildrenWeight(t *testing.T) {
+	t.Parallel()
+
+	childWeight, doneWeight := computeChildrenWeight(&nodeToProcess{trieNode: &branchNode{}, weight: 1}, 4)
+	assert.Equal(t, 1.0/nrOfChildren, childWeight)
+	assert.InDelta(t, float64(nrOfChildren-4)/nrOfChildren, doneWeight, 1e-9)
+
+	childWeight, doneWeight = computeChildrenWeight(&nodeToProcess{trieNode: &extensionNode{}, weight: 0.5}, 1)
+	assert.Equal(t, 0.5, childWeight)
+	assert.Equal(t, float64(0), doneWeight)
+
+	childWeight, doneWeight = computeChildrenWeight(&nodeToProcess{trieNode: &leafNode{}, weight: 0.25}, 0)
+	assert.Equal(t, float64(0), childWeight)
+	assert.Equal(t, 0.25, doneWeight)
+}
@@ -0,0 +1,149 @@
+package trie
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type scheduledTrieSyncer struct {
+	baseSyncTrie
+	scheduler    *parallelSyncScheduler
+	leavesChan   chan core.KeyValueHolder
+	mutOperation sync.Mutex
+}
+
+// StartSyncing adds the trie to the sync scheduler and waits for it to be completely synced. All concurrent calls
+// will be serialized
+func (sts *scheduledTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
+	if common.IsEmptyTrie(rootHash) {
+		return nil
+	}
+	if ctx == nil {
+		return ErrNilContext
+	}
+
+	sts.mutOperation.Lock()
+	defer sts.mutOperation.Unlock()
+
+	job := newSyncJob(rootHash, &sts.baseSyncTrie, sts.leavesChan != nil)
+	sts.scheduler.addJob(job)
+
+	for {
+		select {
+		case <-job.leavesAvailable:
+			err := sts.writeLeavesOnChannel(job, ctx)
+			if err != nil {
+				job.cancel()
+				return err
+			}
+		case err := <-job.done:
+			if err != nil {
+				return err
+			}
+
+			return sts.writeLeavesOnChannel(job, ctx)
+		case <-ctx.Done():
+			job.cancel()
+			return core.ErrContextClosing
+		}
+	}
+}
+
+// writeLeavesOnChannel is called from the syncing go routine, so the workers of the scheduler are not blocked
+// while the leaves are consumed
+func (sts *scheduledTrieSyncer) writeLeavesOnChannel(job *syncJob, ctx context.Context) error {
+	for _, leaf := range job.popLeaves() {
+		select {
+		case sts.leavesChan <- leaf:
+		case <-ctx.Done():
+			return core.ErrContextClosing
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sts *scheduledTrieSyncer) IsInterfaceNil() bool {
+	return sts == nil
+}
+
+type parallelTrieSyncer struct {
+	arg          ArgTrieSyncer
+	mutOperation sync.RWMutex
+	lastSyncer   TrieSyncer
+}
+
+// NewParallelTrieSyncer creates a new instance of trieSyncer that syncs the trie through its own parallel sync scheduler
+func NewParallelTrieSyncer(arg ArgTrieSyncer) (*parallelTrieSyncer, error) {
+	err := checkParallelSyncArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parallelTrieSyncer{
+		arg:        arg,
+		lastSyncer: &scheduledTrieSyncer{},
+	}, nil
+}
+
+// StartSyncing completes the trie, asking for missing trie nodes on the network
+func (pts *parallelTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
+	if common.IsEmptyTrie(rootHash) {
+		return nil
+	}
+	if ctx == nil {
+		return ErrNilContext
+	}
+
+	scheduler, err := NewParallelSyncScheduler(pts.arg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = scheduler.Close()
+	}()
+
+	trieSyncer := scheduler.CreateTrieSyncer(pts.arg.LeavesChan)
+	pts.mutOperation.Lock()
+	pts.lastSyncer = trieSyncer
+	pts.mutOperation.Unlock()
+
+	return trieSyncer.StartSyncing(rootHash, ctx)
+}
+
+// NumLeaves returns the total number of leaves of the last synced trie
+func (pts *parallelTrieSyncer) NumLeaves() uint64 {
+	return pts.getLastSyncer().NumLeaves()
+}
+
+// NumBytes returns the total number of bytes of the last synced trie
+func (pts *parallelTrieSyncer) NumBytes() uint64 {
+	return pts.getLastSyncer().NumBytes()
+}
+
+// NumTrieNodes returns the total number of trie nodes of the last synced trie
+func (pts *parallelTrieSyncer) NumTrieNodes() uint64 {
+	return pts.getLastSyncer().NumTrieNodes()
+}
+
+// Duration returns the sync duration of the last synced trie
+func (pts *parallelTrieSyncer) Duration() time.Duration {
+	return pts.getLastSyncer().Duration()
+}
+
+func (pts *parallelTrieSyncer) getLastSyncer() TrieSyncer {
+	pts.mutOperation.RLock()
+	defer pts.mutOperation.RUnlock()
+
+	return pts.lastSyncer
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pts *parallelTrieSyncer) IsInterfaceNil() bool {
+	return pts == nil
+}
@@ -608,31 +608,47 @@ func (tr *patriciaMerkleTrie) VerifyProof(rootHash []byte, key []byte, proof [][
 }
 
 func (tr *patriciaMerkleTrie) verifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
+	ln, err := tr.getProvenLeaf(rootHash, key, proof)
+	if err != nil {
+		return false, err
+	}
+
+	return ln != nil, nil
+}
+
+// getProvenLeaf walks the proof from the root hash towards the given key and returns the leaf node holding the key.
+// A nil leaf node is returned if the proof does not lead to the key
+func (tr *patriciaMerkleTrie) getProvenLeaf(rootHash []byte, key []byte, proof [][]byte) (*leafNode, error) {
 	wantHash := rootHash
 	key = keyBytesToHex(key)
 	for _, encodedNode := range proof {
 		if encodedNode == nil {
-			return false, nil
+			return nil, nil
 		}
 
 		hash := tr.hasher.Compute(string(encodedNode))
 		if !bytes.Equal(wantHash, hash) {
-			return false, nil
+			return nil, nil
 		}
 
 		n, errDecode := decodeNode(encodedNode, tr.marshalizer, tr.hasher)
 		if errDecode != nil {
-			return false, errDecode
+			return nil, errDecode
 		}
 
 		var proofVerified bool
 		proofVerified, wantHash, key = n.getNextHashAndKey(key)
 		if proofVerified {
-			return true, nil
+			ln, ok := n.(*leafNode)
+			if !ok {
+				return nil, nil
+			}
+
+			return ln, nil
 		}
 	}
 
-	return false, nil
+	return nil, nil
 }
 
 // GetStorageManager returns the storage manager for the trie
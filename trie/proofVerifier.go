@@ -1,6 +1,7 @@
 package trie
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
@@ -31,3 +32,33 @@ func NewMerkleProofVerifier(marshalizer marshal.Marshalizer, hasher hashing.Hash
 func (mpv *merkleProofVerifier) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	return mpv.trie.VerifyProof(rootHash, key, proof)
 }
+
+// VerifyProofAndGetLeafData verifies the given Merkle proof and returns the data held by the proven leaf. As for the
+// proof verification, the key is searched hashed first and then as provided, the returned key being the one found
+func (mpv *merkleProofVerifier) VerifyProofAndGetLeafData(rootHash []byte, key []byte, proof [][]byte) (core.TrieData, error) {
+	mpv.trie.mutOperation.RLock()
+	defer mpv.trie.mutOperation.RUnlock()
+
+	for _, searchedKey := range [][]byte{mpv.trie.hasher.Compute(string(key)), key} {
+		ln, err := mpv.trie.getProvenLeaf(rootHash, searchedKey, proof)
+		if err != nil {
+			return core.TrieData{}, err
+		}
+		if ln == nil {
+			continue
+		}
+
+		version, err := ln.getVersion()
+		if err != nil {
+			return core.TrieData{}, err
+		}
+
+		return core.TrieData{
+			Key:     searchedKey,
+			Value:   ln.Value,
+			Version: version,
+		}, nil
+	}
+
+	return core.TrieData{}, ErrProofNotVerified
+}
@@ -4,8 +4,10 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -47,3 +49,55 @@ func TestMerkleProofVerifier_VerifyProof(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, ok)
 }
+
+func TestMerkleProofVerifier_VerifyProofAndGetLeafData(t *testing.T) {
+	t.Parallel()
+
+	args := GetDefaultTrieStorageManagerParameters()
+	trieStorage, _ := NewTrieStorageManager(args)
+	tr, _ := NewTrie(trieStorage, args.Marshalizer, args.Hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update(args.Hasher.Compute("ddog"), []byte("cat"))
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	mpv, _ := NewMerkleProofVerifier(args.Marshalizer, args.Hasher)
+
+	t.Run("raw key should work", func(t *testing.T) {
+		t.Parallel()
+
+		proof, _, _ := tr.GetProof([]byte("dog"))
+		leafData, err := mpv.VerifyProofAndGetLeafData(rootHash, []byte("dog"), proof)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("dog"), leafData.Key)
+		assert.Equal(t, []byte("puppy"), leafData.Value)
+		assert.Equal(t, core.NotSpecified, leafData.Version)
+	})
+	t.Run("hashed key should work", func(t *testing.T) {
+		t.Parallel()
+
+		hashedKey := args.Hasher.Compute("ddog")
+		proof, _, _ := tr.GetProof(hashedKey)
+		leafData, err := mpv.VerifyProofAndGetLeafData(rootHash, []byte("ddog"), proof)
+		assert.Nil(t, err)
+		assert.Equal(t, hashedKey, leafData.Key)
+		assert.Equal(t, []byte("cat"), leafData.Value)
+	})
+	t.Run("proof for another key should error", func(t *testing.T) {
+		t.Parallel()
+
+		proof, _, _ := tr.GetProof([]byte("dog"))
+		leafData, err := mpv.VerifyProofAndGetLeafData(rootHash, []byte("doe"), proof)
+		assert.Equal(t, ErrProofNotVerified, err)
+		assert.Nil(t, leafData.Value)
+	})
+	t.Run("different root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		proof, _, _ := tr.GetProof([]byte("dog"))
+		leafData, err := mpv.VerifyProofAndGetLeafData([]byte("another root hash"), []byte("dog"), proof)
+		assert.Equal(t, ErrProofNotVerified, err)
+		assert.Nil(t, leafData.Value)
+	})
+}
@@ -57,6 +57,7 @@ type ArgTrieSyncer struct {
 	Topic                     string
 	TrieSyncStatistics        common.SizeSyncStatisticsHandler
 	MaxHardCapForMissingNodes int
+	NumSyncWorkers            int
 	CheckNodesOnDisk          bool
 	TimeoutHandler            TimeoutHandler
 	LeavesChan                chan core.KeyValueHolder
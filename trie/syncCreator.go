@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
 )
 
 const (
 	initialVersion = 1
 	secondVersion  = 2
 	thirdVersion   = 3
+	fourthVersion  = 4
 )
 
 // TrieSyncer synchronizes the trie, asking on the network for the missing nodes
@@ -22,6 +25,14 @@ type TrieSyncer interface {
 	IsInterfaceNil() bool
 }
 
+// TrieSyncScheduler syncs several tries at once, sharing the requested trie nodes between them
+type TrieSyncScheduler interface {
+	CreateTrieSyncer(leavesChan chan core.KeyValueHolder) TrieSyncer
+	Progress() SyncProgress
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // CreateTrieSyncer is the method factory to create the correct trie syncer implementation
 // TODO try to split this package (syncers should go in sync package, this file in the factory package)
 func CreateTrieSyncer(arg ArgTrieSyncer, trieSyncerVersion int) (TrieSyncer, error) {
@@ -32,6 +43,8 @@ func CreateTrieSyncer(arg ArgTrieSyncer, trieSyncerVersion int) (TrieSyncer, err
 		return NewDoubleListTrieSyncer(arg)
 	case thirdVersion:
 		return NewDepthFirstTrieSyncer(arg)
+	case fourthVersion:
+		return NewParallelTrieSyncer(arg)
 	default:
 		return nil, fmt.Errorf("%w, unknown value %d", ErrInvalidTrieSyncerVersion, trieSyncerVersion)
 	}
@@ -39,10 +52,16 @@ func CreateTrieSyncer(arg ArgTrieSyncer, trieSyncerVersion int) (TrieSyncer, err
 
 // CheckTrieSyncerVersion can check if the syncer version has a correct value
 func CheckTrieSyncerVersion(trieSyncerVersion int) error {
-	isCorrectVersion := trieSyncerVersion >= initialVersion && trieSyncerVersion <= thirdVersion
+	isCorrectVersion := trieSyncerVersion >= initialVersion && trieSyncerVersion <= fourthVersion
 	if isCorrectVersion {
 		return nil
 	}
 
 	return fmt.Errorf("%w, unknown value %d", ErrInvalidTrieSyncerVersion, trieSyncerVersion)
 }
+
+// IsParallelTrieSyncerVersion returns true if the syncer version uses a parallel sync scheduler, which can be shared
+// between all the synced tries
+func IsParallelTrieSyncerVersion(trieSyncerVersion int) bool {
+	return trieSyncerVersion == fourthVersion
+}
@@ -56,6 +56,18 @@ func TestNewTrieSync_ThirdVariantImplementation(t *testing.T) {
 	assert.True(t, isInstanceOk)
 }
 
+func TestNewTrieSync_FourthVariantImplementation(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument(time.Minute)
+	syncer, err := CreateTrieSyncer(arg, fourthVersion)
+
+	require.False(t, check.IfNil(syncer))
+	require.Nil(t, err)
+	_, isInstanceOk := syncer.(*parallelTrieSyncer)
+	assert.True(t, isInstanceOk)
+}
+
 func TestCheckTrieSyncerVersion(t *testing.T) {
 	t.Parallel()
 
@@ -71,6 +83,16 @@ func TestCheckTrieSyncerVersion(t *testing.T) {
 	err = CheckTrieSyncerVersion(thirdVersion)
 	assert.Nil(t, err)
 
-	err = CheckTrieSyncerVersion(4)
+	err = CheckTrieSyncerVersion(fourthVersion)
+	assert.Nil(t, err)
+
+	err = CheckTrieSyncerVersion(5)
 	assert.True(t, errors.Is(err, ErrInvalidTrieSyncerVersion))
 }
+
+func TestIsParallelTrieSyncerVersion(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, IsParallelTrieSyncerVersion(thirdVersion))
+	assert.True(t, IsParallelTrieSyncerVersion(fourthVersion))
+}
@@ -41,6 +41,7 @@ func createMockArgument(timeout time.Duration) ArgTrieSyncer {
 		TrieSyncStatistics:        statistics.NewTrieSyncStatistics(),
 		TimeoutHandler:            testscommon.NewTimeoutHandlerMock(timeout),
 		MaxHardCapForMissingNodes: 500,
+		NumSyncWorkers:            4,
 		LeavesChan:                make(chan core.KeyValueHolder, 100),
 	}
 }
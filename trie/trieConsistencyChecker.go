@@ -0,0 +1,240 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+)
+
+// ArgsTrieConsistencyChecker is the DTO used to create a new trie consistency checker
+type ArgsTrieConsistencyChecker struct {
+	Storer       common.BaseStorer
+	RepairSource common.BaseStorer
+	Marshaller   marshal.Marshalizer
+	Hasher       hashing.Hasher
+}
+
+// TrieNodeIssue describes a trie node that is missing from the storer or that is corrupt
+type TrieNodeIssue struct {
+	Hash   []byte
+	Reason string
+}
+
+// TrieCheckResult holds the issues found while checking a trie
+type TrieCheckResult struct {
+	MissingNodes  []TrieNodeIssue
+	CorruptNodes  []TrieNodeIssue
+	RepairedNodes [][]byte
+}
+
+// IsConsistent returns true if no missing or corrupt trie node was left behind
+func (result *TrieCheckResult) IsConsistent() bool {
+	return len(result.MissingNodes) == 0 && len(result.CorruptNodes) == 0
+}
+
+// TrieLeafHandler is called for each checked leaf with its full key, value and version
+type TrieLeafHandler func(key []byte, value []byte, version core.TrieNodeVersion) error
+
+type nodeToCheck struct {
+	hash   []byte
+	hexKey []byte
+	level  int
+}
+
+type trieConsistencyChecker struct {
+	storer       common.BaseStorer
+	repairSource common.BaseStorer
+	marshaller   marshal.Marshalizer
+	hasher       hashing.Hasher
+}
+
+// NewTrieConsistencyChecker creates a new trie consistency checker. The repair source is optional: if provided, the
+// missing or corrupt nodes are searched in it and, if found valid, written in the checked storer
+func NewTrieConsistencyChecker(args ArgsTrieConsistencyChecker) (*trieConsistencyChecker, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &trieConsistencyChecker{
+		storer:       args.Storer,
+		repairSource: args.RepairSource,
+		marshaller:   args.Marshaller,
+		hasher:       args.Hasher,
+	}, nil
+}
+
+// CheckTrie walks depth first the trie with the given root hash and checks that each node is present in the storer,
+// matches its hash and can be decoded. Unlike the trie iterators, the walk does not stop at the first missing or
+// corrupt node, the subtries under them being skipped, and the walked nodes are not kept in memory. The node sizes and
+// levels are added to the provided statistics, while each reached leaf is passed to the leaf handler. An error is
+// returned only if the check could not be completed
+func (tcc *trieConsistencyChecker) CheckTrie(
+	rootHash []byte,
+	stats common.TrieStatisticsHandler,
+	leafHandler TrieLeafHandler,
+) (*TrieCheckResult, error) {
+	if check.IfNil(stats) {
+		return nil, ErrNilTrieStatisticsHandler
+	}
+	if leafHandler == nil {
+		return nil, ErrNilTrieLeafHandler
+	}
+
+	result := &TrieCheckResult{}
+	nodes := []nodeToCheck{{hash: rootHash}}
+	for len(nodes) > 0 {
+		current := nodes[len(nodes)-1]
+		nodes = nodes[:len(nodes)-1]
+
+		n, encodedNode, err := tcc.getValidNode(current.hash, result)
+		if err != nil {
+			return nil, err
+		}
+		if check.IfNil(n) {
+			continue
+		}
+
+		size := uint64(len(encodedNode))
+		switch typedNode := n.(type) {
+		case *branchNode:
+			stats.AddBranchNode(current.level, size)
+			for i := len(typedNode.EncodedChildren) - 1; i >= 0; i-- {
+				if len(typedNode.EncodedChildren[i]) == 0 {
+					continue
+				}
+
+				nodes = append(nodes, nodeToCheck{
+					hash:   typedNode.EncodedChildren[i],
+					hexKey: concatKey(current.hexKey, []byte{byte(i)}),
+					level:  current.level + 1,
+				})
+			}
+		case *extensionNode:
+			stats.AddExtensionNode(current.level, size)
+			nodes = append(nodes, nodeToCheck{
+				hash:   typedNode.EncodedChild,
+				hexKey: concatKey(current.hexKey, typedNode.Key),
+				level:  current.level + 1,
+			})
+		case *leafNode:
+			stats.AddLeafNode(current.level, size, core.TrieNodeVersion(typedNode.Version))
+			key, errKey := getLeafKey(current.hexKey, typedNode)
+			if errKey != nil {
+				result.CorruptNodes = append(result.CorruptNodes, TrieNodeIssue{
+					Hash:   current.hash,
+					Reason: errKey.Error(),
+				})
+				continue
+			}
+
+			err = leafHandler(key, typedNode.Value, core.TrieNodeVersion(typedNode.Version))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// getValidNode returns the decoded node and its encoded form, or a nil node if it is missing or corrupt, case in which
+// the issue is added to the result
+func (tcc *trieConsistencyChecker) getValidNode(hash []byte, result *TrieCheckResult) (node, []byte, error) {
+	encodedNode, err := tcc.storer.Get(hash)
+	if err != nil {
+		return tcc.repairNode(hash, result, &result.MissingNodes, err.Error())
+	}
+
+	n, err := tcc.decodeNode(hash, encodedNode)
+	if err != nil {
+		return tcc.repairNode(hash, result, &result.CorruptNodes, err.Error())
+	}
+
+	return n, encodedNode, nil
+}
+
+func (tcc *trieConsistencyChecker) repairNode(
+	hash []byte,
+	result *TrieCheckResult,
+	issues *[]TrieNodeIssue,
+	reason string,
+) (node, []byte, error) {
+	issue := TrieNodeIssue{
+		Hash:   hash,
+		Reason: reason,
+	}
+	if check.IfNil(tcc.repairSource) {
+		*issues = append(*issues, issue)
+		return nil, nil, nil
+	}
+
+	encodedNode, err := tcc.repairSource.Get(hash)
+	if err != nil {
+		issue.Reason = fmt.Sprintf("%s, repair source: %s", reason, err.Error())
+		*issues = append(*issues, issue)
+		return nil, nil, nil
+	}
+
+	n, err := tcc.decodeNode(hash, encodedNode)
+	if err != nil {
+		issue.Reason = fmt.Sprintf("%s, repair source: %s", reason, err.Error())
+		*issues = append(*issues, issue)
+		return nil, nil, nil
+	}
+
+	err = tcc.storer.Put(hash, encodedNode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Debug("repaired trie node", "hash", hash, "reason", reason)
+	result.RepairedNodes = append(result.RepairedNodes, hash)
+
+	return n, encodedNode, nil
+}
+
+func (tcc *trieConsistencyChecker) decodeNode(hash []byte, encodedNode []byte) (node, error) {
+	computedHash := tcc.hasher.Compute(string(encodedNode))
+	if !bytes.Equal(computedHash, hash) {
+		return nil, fmt.Errorf("%w: computed hash %x", ErrNodeHashMismatch, computedHash)
+	}
+
+	return decodeNode(encodedNode, tcc.marshaller, tcc.hasher)
+}
+
+func getLeafKey(hexKeyPrefix []byte, ln *leafNode) ([]byte, error) {
+	hexKey := concatKey(hexKeyPrefix, ln.Key)
+	if len(hexKey) == 0 {
+		return nil, ErrInvalidNode
+	}
+
+	kb := keyBuilder.NewKeyBuilder()
+	kb.BuildKey(hexKey)
+
+	return kb.GetKey()
+}
+
+// concatKey returns a new slice, as the pending nodes share the key prefix of their parent
+func concatKey(prefix []byte, keyPart []byte) []byte {
+	key := make([]byte, 0, len(prefix)+len(keyPart))
+	key = append(key, prefix...)
+
+	return append(key, keyPart...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tcc *trieConsistencyChecker) IsInterfaceNil() bool {
+	return tcc == nil
+}
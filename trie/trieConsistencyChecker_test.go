@@ -0,0 +1,242 @@
+package trie_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/trie/statistics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type trieNodeRecord struct {
+	hash        []byte
+	encodedNode []byte
+	isLeaf      bool
+}
+
+// copyTrieNodes returns a storer holding the trie nodes and the walked nodes, in depth first order
+func copyTrieNodes(t *testing.T, tr common.Trie) (common.BaseStorer, []byte, []trieNodeRecord) {
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+	_, marshaller, hasher, _, _ := getDefaultTrieParameters()
+
+	storer := testscommon.NewMemDbMock()
+	records := make([]trieNodeRecord, 0)
+	err := trie.WalkTrieNodes(rootHash, tr.GetStorageManager(), marshaller, hasher, func(hash []byte, encodedNode []byte, leafValue []byte) error {
+		records = append(records, trieNodeRecord{hash: hash, encodedNode: encodedNode, isLeaf: leafValue != nil})
+		return storer.Put(hash, encodedNode)
+	})
+	require.Nil(t, err)
+
+	return storer, rootHash, records
+}
+
+func createMockArgsTrieConsistencyChecker(storer common.BaseStorer) trie.ArgsTrieConsistencyChecker {
+	_, marshaller, hasher, _, _ := getDefaultTrieParameters()
+
+	return trie.ArgsTrieConsistencyChecker{
+		Storer:     storer,
+		Marshaller: marshaller,
+		Hasher:     hasher,
+	}
+}
+
+func checkTrieCollectingLeaves(t *testing.T, args trie.ArgsTrieConsistencyChecker, rootHash []byte) (*trie.TrieCheckResult, map[string][]byte) {
+	tcc, err := trie.NewTrieConsistencyChecker(args)
+	require.Nil(t, err)
+
+	leaves := make(map[string][]byte)
+	result, err := tcc.CheckTrie(rootHash, statistics.NewTrieStatistics(), func(key []byte, value []byte, _ core.TrieNodeVersion) error {
+		leaves[string(key)] = value
+		return nil
+	})
+	require.Nil(t, err)
+
+	return result, leaves
+}
+
+func TestNewTrieConsistencyChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		tcc, err := trie.NewTrieConsistencyChecker(createMockArgsTrieConsistencyChecker(nil))
+		assert.Equal(t, trie.ErrNilDatabase, err)
+		assert.Nil(t, tcc)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieConsistencyChecker(testscommon.NewMemDbMock())
+		args.Marshaller = nil
+		tcc, err := trie.NewTrieConsistencyChecker(args)
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+		assert.Nil(t, tcc)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieConsistencyChecker(testscommon.NewMemDbMock())
+		args.Hasher = nil
+		tcc, err := trie.NewTrieConsistencyChecker(args)
+		assert.Equal(t, trie.ErrNilHasher, err)
+		assert.Nil(t, tcc)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tcc, err := trie.NewTrieConsistencyChecker(createMockArgsTrieConsistencyChecker(testscommon.NewMemDbMock()))
+		assert.Nil(t, err)
+		assert.False(t, tcc.IsInterfaceNil())
+	})
+}
+
+func TestTrieConsistencyChecker_CheckTrie(t *testing.T) {
+	t.Parallel()
+
+	numValues := 100
+
+	t.Run("nil statistics should error", func(t *testing.T) {
+		t.Parallel()
+
+		tcc, _ := trie.NewTrieConsistencyChecker(createMockArgsTrieConsistencyChecker(testscommon.NewMemDbMock()))
+		result, err := tcc.CheckTrie([]byte("root"), nil, func(_ []byte, _ []byte, _ core.TrieNodeVersion) error {
+			return nil
+		})
+		assert.Equal(t, trie.ErrNilTrieStatisticsHandler, err)
+		assert.Nil(t, result)
+	})
+	t.Run("nil leaf handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		tcc, _ := trie.NewTrieConsistencyChecker(createMockArgsTrieConsistencyChecker(testscommon.NewMemDbMock()))
+		result, err := tcc.CheckTrie([]byte("root"), statistics.NewTrieStatistics(), nil)
+		assert.Equal(t, trie.ErrNilTrieLeafHandler, err)
+		assert.Nil(t, result)
+	})
+	t.Run("leaf handler error should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(numValues)
+		storer, rootHash, _ := copyTrieNodes(t, tr)
+
+		expectedErr := errors.New("expected error")
+		tcc, _ := trie.NewTrieConsistencyChecker(createMockArgsTrieConsistencyChecker(storer))
+		result, err := tcc.CheckTrie(rootHash, statistics.NewTrieStatistics(), func(_ []byte, _ []byte, _ core.TrieNodeVersion) error {
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, result)
+	})
+	t.Run("consistent trie", func(t *testing.T) {
+		t.Parallel()
+
+		tr, values := initTrieMultipleValues(numValues)
+		storer, rootHash, records := copyTrieNodes(t, tr)
+
+		tcc, _ := trie.NewTrieConsistencyChecker(createMockArgsTrieConsistencyChecker(storer))
+		stats := statistics.NewTrieStatistics()
+		leaves := make(map[string][]byte)
+		result, err := tcc.CheckTrie(rootHash, stats, func(key []byte, value []byte, _ core.TrieNodeVersion) error {
+			leaves[string(key)] = value
+			return nil
+		})
+		require.Nil(t, err)
+
+		assert.True(t, result.IsConsistent())
+		assert.Empty(t, result.RepairedNodes)
+		require.Equal(t, numValues, len(leaves))
+		for _, value := range values {
+			assert.Equal(t, value, leaves[string(value)])
+		}
+		assert.Equal(t, uint64(len(records)), stats.GetTotalNumNodes())
+		assert.Equal(t, uint64(numValues), stats.GetNumLeafNodes())
+	})
+	t.Run("missing nodes should be reported and their subtries skipped", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(numValues)
+		storer, rootHash, records := copyTrieNodes(t, tr)
+		missingHash := records[1].hash
+		_ = storer.Remove(missingHash)
+
+		result, leaves := checkTrieCollectingLeaves(t, createMockArgsTrieConsistencyChecker(storer), rootHash)
+		assert.False(t, result.IsConsistent())
+		require.Len(t, result.MissingNodes, 1)
+		assert.Equal(t, missingHash, result.MissingNodes[0].Hash)
+		assert.Empty(t, result.CorruptNodes)
+		assert.True(t, len(leaves) > 0)
+		assert.True(t, len(leaves) < numValues)
+	})
+	t.Run("corrupt nodes should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(numValues)
+		storer, rootHash, records := copyTrieNodes(t, tr)
+		// the first leaf is walked before the last inner node, so it is not in its subtrie
+		var leafRecord, innerRecord trieNodeRecord
+		for _, record := range records[1:] {
+			if record.isLeaf && leafRecord.hash == nil {
+				leafRecord = record
+			}
+			if !record.isLeaf {
+				innerRecord = record
+			}
+		}
+		_ = storer.Put(leafRecord.hash, append([]byte("tampered"), leafRecord.encodedNode...))
+		_ = storer.Put(innerRecord.hash, []byte("not a trie node"))
+
+		result, leaves := checkTrieCollectingLeaves(t, createMockArgsTrieConsistencyChecker(storer), rootHash)
+		assert.False(t, result.IsConsistent())
+		assert.Empty(t, result.MissingNodes)
+		require.Len(t, result.CorruptNodes, 2)
+		for _, issue := range result.CorruptNodes {
+			assert.Contains(t, issue.Reason, trie.ErrNodeHashMismatch.Error())
+		}
+		assert.True(t, len(leaves) < numValues-1)
+	})
+	t.Run("missing and corrupt nodes should be repaired from the repair source", func(t *testing.T) {
+		t.Parallel()
+
+		tr, values := initTrieMultipleValues(numValues)
+		storer, rootHash, records := copyTrieNodes(t, tr)
+		repairSource, _, _ := copyTrieNodes(t, tr)
+		_ = storer.Remove(records[1].hash)
+		_ = storer.Put(records[len(records)-1].hash, []byte("corrupt"))
+
+		args := createMockArgsTrieConsistencyChecker(storer)
+		args.RepairSource = repairSource
+		result, leaves := checkTrieCollectingLeaves(t, args, rootHash)
+		assert.True(t, result.IsConsistent())
+		assert.Equal(t, [][]byte{records[1].hash, records[len(records)-1].hash}, result.RepairedNodes)
+		assert.Equal(t, numValues, len(leaves))
+		for _, value := range values {
+			assert.Equal(t, value, leaves[string(value)])
+		}
+
+		repairedNode, err := storer.Get(records[1].hash)
+		assert.Nil(t, err)
+		assert.Equal(t, records[1].encodedNode, repairedNode)
+	})
+	t.Run("node missing from the repair source too should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(numValues)
+		storer, rootHash, records := copyTrieNodes(t, tr)
+		_ = storer.Remove(records[1].hash)
+
+		args := createMockArgsTrieConsistencyChecker(storer)
+		args.RepairSource = testscommon.NewMemDbMock()
+		result, _ := checkTrieCollectingLeaves(t, args, rootHash)
+		assert.False(t, result.IsConsistent())
+		require.Len(t, result.MissingNodes, 1)
+		assert.Contains(t, result.MissingNodes[0].Reason, "repair source")
+		assert.Empty(t, result.RepairedNodes)
+	})
+}
@@ -0,0 +1,362 @@
+package trie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+var errStopDiff = errors.New("trie diff stopped")
+
+// ArgsTrieDiffer is the DTO used to create a new trie differ
+type ArgsTrieDiffer struct {
+	Storer     common.BaseStorer
+	Marshaller marshal.Marshalizer
+	Hasher     hashing.Hasher
+}
+
+// TrieLeavesDiffHandler is called for each key which differs between the two compared tries, in the order in which the
+// keys are found in the tries. The old leaf is nil for an added key, while the new leaf is nil for a removed one. The
+// walk stops when false is returned
+type TrieLeavesDiffHandler func(oldLeaf *core.TrieData, newLeaf *core.TrieData) (bool, error)
+
+type trieDiffer struct {
+	storer     common.BaseStorer
+	marshaller marshal.Marshalizer
+	hasher     hashing.Hasher
+}
+
+// diffCursor points in a trie to the start of a node or, for an extension node, after the first offset nibbles of its key
+type diffCursor struct {
+	hash   []byte
+	node   node
+	offset int
+}
+
+// diffSession holds the state of a single diff
+type diffSession struct {
+	*trieDiffer
+	startHexKey []byte
+	handler     TrieLeavesDiffHandler
+}
+
+// NewTrieDiffer creates a new trie differ, reading the trie nodes from the provided storer
+func NewTrieDiffer(args ArgsTrieDiffer) (*trieDiffer, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &trieDiffer{
+		storer:     args.Storer,
+		marshaller: args.Marshaller,
+		hasher:     args.Hasher,
+	}, nil
+}
+
+// Diff walks in lockstep the tries with the given root hashes, skipping the subtries with the same hash, and calls the
+// handler for each added, removed or modified key. If a start key is provided, only the keys found after it in the
+// trie order are reported, which allows resuming a diff from the last reported key
+func (td *trieDiffer) Diff(oldRootHash []byte, newRootHash []byte, startKey []byte, handler TrieLeavesDiffHandler) error {
+	if handler == nil {
+		return ErrNilTrieLeavesDiffHandler
+	}
+
+	oldCursor, err := td.newRootCursor(oldRootHash)
+	if err != nil {
+		return err
+	}
+	newCursor, err := td.newRootCursor(newRootHash)
+	if err != nil {
+		return err
+	}
+
+	session := &diffSession{
+		trieDiffer: td,
+		handler:    handler,
+	}
+	if len(startKey) > 0 {
+		session.startHexKey = keyBytesToHex(startKey)
+	}
+
+	err = session.diff(oldCursor, newCursor, nil)
+	if err == errStopDiff {
+		return nil
+	}
+
+	return err
+}
+
+func (td *trieDiffer) newRootCursor(rootHash []byte) (*diffCursor, error) {
+	if common.IsEmptyTrie(rootHash) {
+		return nil, nil
+	}
+
+	return td.loadNode(rootHash)
+}
+
+func (td *trieDiffer) loadNode(hash []byte) (*diffCursor, error) {
+	encodedNode, err := td.storer.Get(hash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for trie node %x", err, hash)
+	}
+
+	n, err := decodeNode(encodedNode, td.marshaller, td.hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	return &diffCursor{
+		hash: hash,
+		node: n,
+	}, nil
+}
+
+func (session *diffSession) diff(oldCursor *diffCursor, newCursor *diffCursor, hexPrefix []byte) error {
+	if oldCursor == nil && newCursor == nil {
+		return nil
+	}
+	if oldCursor != nil && newCursor != nil && len(oldCursor.hash) > 0 && bytes.Equal(oldCursor.hash, newCursor.hash) {
+		return nil
+	}
+	if !session.mayContainKeysAfterStart(hexPrefix) {
+		return nil
+	}
+	if !isInnerNode(oldCursor) || !isInnerNode(newCursor) {
+		return session.diffLeaves(oldCursor, newCursor, hexPrefix)
+	}
+
+	for i := byte(0); i < nrOfChildren; i++ {
+		oldChild, err := session.step(oldCursor, i)
+		if err != nil {
+			return err
+		}
+		newChild, err := session.step(newCursor, i)
+		if err != nil {
+			return err
+		}
+
+		err = session.diff(oldChild, newChild, concatKey(hexPrefix, []byte{i}))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isInnerNode(cursor *diffCursor) bool {
+	if cursor == nil {
+		return false
+	}
+
+	_, isLeaf := cursor.node.(*leafNode)
+	return !isLeaf
+}
+
+// step returns the cursor reached from the given one by following the given nibble, or nil if there is no such path
+func (session *diffSession) step(cursor *diffCursor, nibble byte) (*diffCursor, error) {
+	switch n := cursor.node.(type) {
+	case *branchNode:
+		if len(n.EncodedChildren[nibble]) == 0 {
+			return nil, nil
+		}
+
+		return session.loadNode(n.EncodedChildren[nibble])
+	case *extensionNode:
+		if n.Key[cursor.offset] != nibble {
+			return nil, nil
+		}
+		if cursor.offset+1 < len(n.Key) {
+			return &diffCursor{
+				node:   n,
+				offset: cursor.offset + 1,
+			}, nil
+		}
+
+		return session.loadNode(n.EncodedChild)
+	default:
+		return nil, ErrInvalidNode
+	}
+}
+
+type diffLeaf struct {
+	hexKey []byte
+	data   *core.TrieData
+}
+
+// diffLeaves compares the subtries of the given cursors, when at least one of them is a leaf or is missing: the leaves
+// of the other subtrie are streamed in order and merged with the single leaf
+func (session *diffSession) diffLeaves(oldCursor *diffCursor, newCursor *diffCursor, hexPrefix []byte) error {
+	singleCursor, streamedCursor := oldCursor, newCursor
+	isSingleOld := true
+	if isInnerNode(oldCursor) || newCursor == nil {
+		singleCursor, streamedCursor = newCursor, oldCursor
+		isSingleOld = false
+	}
+
+	emit := func(singleLeaf *diffLeaf, streamedLeaf *diffLeaf) error {
+		if isSingleOld {
+			return session.emit(singleLeaf, streamedLeaf)
+		}
+
+		return session.emit(streamedLeaf, singleLeaf)
+	}
+
+	var singleLeaf *diffLeaf
+	if singleCursor != nil {
+		var err error
+		singleLeaf, err = newDiffLeaf(hexPrefix, singleCursor.node.(*leafNode))
+		if err != nil {
+			return err
+		}
+	}
+
+	err := session.walkLeaves(streamedCursor, hexPrefix, func(leaf *diffLeaf) error {
+		if singleLeaf != nil {
+			cmp := bytes.Compare(singleLeaf.hexKey, leaf.hexKey)
+			if cmp == 0 {
+				pairedLeaf := singleLeaf
+				singleLeaf = nil
+				return emit(pairedLeaf, leaf)
+			}
+			if cmp < 0 {
+				err := emit(singleLeaf, nil)
+				singleLeaf = nil
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return emit(nil, leaf)
+	})
+	if err != nil {
+		return err
+	}
+	if singleLeaf != nil {
+		return emit(singleLeaf, nil)
+	}
+
+	return nil
+}
+
+func (session *diffSession) walkLeaves(cursor *diffCursor, hexPrefix []byte, handler func(leaf *diffLeaf) error) error {
+	if cursor == nil || !session.mayContainKeysAfterStart(hexPrefix) {
+		return nil
+	}
+
+	switch n := cursor.node.(type) {
+	case *leafNode:
+		leaf, err := newDiffLeaf(hexPrefix, n)
+		if err != nil {
+			return err
+		}
+
+		return handler(leaf)
+	case *extensionNode:
+		child, err := session.loadNode(n.EncodedChild)
+		if err != nil {
+			return err
+		}
+
+		return session.walkLeaves(child, concatKey(hexPrefix, n.Key[cursor.offset:]), handler)
+	case *branchNode:
+		for i, encodedChild := range n.EncodedChildren {
+			if len(encodedChild) == 0 {
+				continue
+			}
+
+			child, err := session.loadNode(encodedChild)
+			if err != nil {
+				return err
+			}
+
+			err = session.walkLeaves(child, concatKey(hexPrefix, []byte{byte(i)}), handler)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return ErrInvalidNode
+	}
+}
+
+func newDiffLeaf(hexPrefix []byte, ln *leafNode) (*diffLeaf, error) {
+	key, err := getLeafKey(hexPrefix, ln)
+	if err != nil {
+		return nil, err
+	}
+
+	return &diffLeaf{
+		hexKey: concatKey(hexPrefix, ln.Key),
+		data: &core.TrieData{
+			Key:     key,
+			Value:   ln.Value,
+			Version: core.TrieNodeVersion(ln.Version),
+		},
+	}, nil
+}
+
+func (session *diffSession) emit(oldLeaf *diffLeaf, newLeaf *diffLeaf) error {
+	leaf := newLeaf
+	if leaf == nil {
+		leaf = oldLeaf
+	}
+	if len(session.startHexKey) > 0 && bytes.Compare(leaf.hexKey, session.startHexKey) <= 0 {
+		return nil
+	}
+
+	var oldData, newData *core.TrieData
+	if oldLeaf != nil {
+		oldData = oldLeaf.data
+	}
+	if newLeaf != nil {
+		newData = newLeaf.data
+	}
+	if oldData != nil && newData != nil && bytes.Equal(oldData.Value, newData.Value) && oldData.Version == newData.Version {
+		return nil
+	}
+
+	shouldContinue, err := session.handler(oldData, newData)
+	if err != nil {
+		return err
+	}
+	if !shouldContinue {
+		return errStopDiff
+	}
+
+	return nil
+}
+
+// mayContainKeysAfterStart returns false if all the keys under the given path are placed before the start key
+func (session *diffSession) mayContainKeysAfterStart(hexPrefix []byte) bool {
+	if len(session.startHexKey) == 0 {
+		return true
+	}
+
+	length := len(hexPrefix)
+	if len(session.startHexKey) < length {
+		length = len(session.startHexKey)
+	}
+
+	return bytes.Compare(hexPrefix[:length], session.startHexKey[:length]) >= 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (td *trieDiffer) IsInterfaceNil() bool {
+	return td == nil
+}
@@ -0,0 +1,243 @@
+package trie_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingStorer struct {
+	common.BaseStorer
+	numGets int
+}
+
+func (cs *countingStorer) Get(key []byte) ([]byte, error) {
+	cs.numGets++
+	return cs.BaseStorer.Get(key)
+}
+
+type leafDiff struct {
+	oldValue []byte
+	newValue []byte
+}
+
+func createMockArgsTrieDiffer(storer common.BaseStorer) trie.ArgsTrieDiffer {
+	_, marshaller, hasher, _, _ := getDefaultTrieParameters()
+
+	return trie.ArgsTrieDiffer{
+		Storer:     storer,
+		Marshaller: marshaller,
+		Hasher:     hasher,
+	}
+}
+
+// createTriesForDiff commits a trie with numValues values, then modifies, removes and adds some values and commits it
+// again. It returns the trie, both root hashes and the expected diff
+func createTriesForDiff(t *testing.T, numValues int) (common.Trie, []byte, []byte, map[string]leafDiff) {
+	tr := emptyTrie()
+	for i := 0; i < numValues; i++ {
+		require.Nil(t, tr.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.Nil(t, tr.Commit())
+	oldRootHash, _ := tr.RootHash()
+
+	expectedDiff := make(map[string]leafDiff)
+	for i := 0; i < numValues; i += 7 {
+		key := fmt.Sprintf("key%d", i)
+		require.Nil(t, tr.Update([]byte(key), []byte("modified")))
+		expectedDiff[key] = leafDiff{oldValue: []byte(fmt.Sprintf("value%d", i)), newValue: []byte("modified")}
+	}
+	for i := 3; i < numValues; i += 11 {
+		key := fmt.Sprintf("key%d", i)
+		require.Nil(t, tr.Delete([]byte(key)))
+		expectedDiff[key] = leafDiff{oldValue: []byte(fmt.Sprintf("value%d", i))}
+	}
+	for i := numValues; i < numValues+5; i++ {
+		key := fmt.Sprintf("key%d", i)
+		require.Nil(t, tr.Update([]byte(key), []byte("added")))
+		expectedDiff[key] = leafDiff{newValue: []byte("added")}
+	}
+	require.Nil(t, tr.Commit())
+	newRootHash, _ := tr.RootHash()
+
+	return tr, oldRootHash, newRootHash, expectedDiff
+}
+
+func diffTries(t *testing.T, storer common.BaseStorer, oldRootHash []byte, newRootHash []byte, startKey []byte, limit int) ([]string, map[string]leafDiff) {
+	td, err := trie.NewTrieDiffer(createMockArgsTrieDiffer(storer))
+	require.Nil(t, err)
+
+	keys := make([]string, 0)
+	diff := make(map[string]leafDiff)
+	err = td.Diff(oldRootHash, newRootHash, startKey, func(oldLeaf *core.TrieData, newLeaf *core.TrieData) (bool, error) {
+		var key string
+		entry := leafDiff{}
+		if oldLeaf != nil {
+			key = string(oldLeaf.Key)
+			entry.oldValue = oldLeaf.Value
+		}
+		if newLeaf != nil {
+			key = string(newLeaf.Key)
+			entry.newValue = newLeaf.Value
+		}
+		keys = append(keys, key)
+		diff[key] = entry
+
+		return limit == 0 || len(keys) < limit, nil
+	})
+	require.Nil(t, err)
+
+	return keys, diff
+}
+
+func TestNewTrieDiffer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		td, err := trie.NewTrieDiffer(createMockArgsTrieDiffer(nil))
+		assert.Equal(t, trie.ErrNilDatabase, err)
+		assert.Nil(t, td)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieDiffer(testscommon.NewMemDbMock())
+		args.Marshaller = nil
+		td, err := trie.NewTrieDiffer(args)
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+		assert.Nil(t, td)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieDiffer(testscommon.NewMemDbMock())
+		args.Hasher = nil
+		td, err := trie.NewTrieDiffer(args)
+		assert.Equal(t, trie.ErrNilHasher, err)
+		assert.Nil(t, td)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		td, err := trie.NewTrieDiffer(createMockArgsTrieDiffer(testscommon.NewMemDbMock()))
+		assert.Nil(t, err)
+		assert.False(t, td.IsInterfaceNil())
+	})
+}
+
+func TestTrieDiffer_Diff(t *testing.T) {
+	t.Parallel()
+
+	numValues := 200
+
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		td, _ := trie.NewTrieDiffer(createMockArgsTrieDiffer(testscommon.NewMemDbMock()))
+		err := td.Diff(nil, nil, nil, nil)
+		assert.Equal(t, trie.ErrNilTrieLeavesDiffHandler, err)
+	})
+	t.Run("missing root node should error", func(t *testing.T) {
+		t.Parallel()
+
+		td, _ := trie.NewTrieDiffer(createMockArgsTrieDiffer(testscommon.NewMemDbMock()))
+		err := td.Diff([]byte("missing root hash"), nil, nil, func(_ *core.TrieData, _ *core.TrieData) (bool, error) {
+			return true, nil
+		})
+		assert.NotNil(t, err)
+	})
+	t.Run("handler error should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, oldRootHash, newRootHash, _ := createTriesForDiff(t, numValues)
+		expectedErr := errors.New("expected error")
+		td, _ := trie.NewTrieDiffer(createMockArgsTrieDiffer(tr.GetStorageManager()))
+		err := td.Diff(oldRootHash, newRootHash, nil, func(_ *core.TrieData, _ *core.TrieData) (bool, error) {
+			return false, expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("same root hash should not report changes", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _, newRootHash, _ := createTriesForDiff(t, numValues)
+		storer := &countingStorer{BaseStorer: tr.GetStorageManager()}
+		keys, _ := diffTries(t, storer, newRootHash, newRootHash, nil, 0)
+		assert.Empty(t, keys)
+		assert.Equal(t, 2, storer.numGets)
+	})
+	t.Run("should report the modified, removed and added keys", func(t *testing.T) {
+		t.Parallel()
+
+		tr, oldRootHash, newRootHash, expectedDiff := createTriesForDiff(t, numValues)
+		_, diff := diffTries(t, tr.GetStorageManager(), oldRootHash, newRootHash, nil, 0)
+		assert.Equal(t, expectedDiff, diff)
+
+		// the reverse diff swaps the old and the new values
+		_, reverseDiff := diffTries(t, tr.GetStorageManager(), newRootHash, oldRootHash, nil, 0)
+		require.Equal(t, len(expectedDiff), len(reverseDiff))
+		for key, entry := range expectedDiff {
+			assert.Equal(t, leafDiff{oldValue: entry.newValue, newValue: entry.oldValue}, reverseDiff[key])
+		}
+	})
+	t.Run("empty tries should report all the keys", func(t *testing.T) {
+		t.Parallel()
+
+		tr, oldRootHash, _, _ := createTriesForDiff(t, numValues)
+		keys, diff := diffTries(t, tr.GetStorageManager(), common.EmptyTrieHash, oldRootHash, nil, 0)
+		assert.Equal(t, numValues, len(keys))
+		assert.Equal(t, leafDiff{newValue: []byte("value5")}, diff["key5"])
+
+		keys, diff = diffTries(t, tr.GetStorageManager(), oldRootHash, nil, nil, 0)
+		assert.Equal(t, numValues, len(keys))
+		assert.Equal(t, leafDiff{oldValue: []byte("value5")}, diff["key5"])
+	})
+	t.Run("identical subtries should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie()
+		for i := 0; i < numValues; i++ {
+			_ = tr.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		}
+		_ = tr.Commit()
+		oldRootHash, _ := tr.RootHash()
+		_ = tr.Update([]byte("key5"), []byte("modified"))
+		_ = tr.Commit()
+		newRootHash, _ := tr.RootHash()
+
+		storer := &countingStorer{BaseStorer: tr.GetStorageManager()}
+		keys, _ := diffTries(t, storer, oldRootHash, newRootHash, nil, 0)
+		assert.Equal(t, []string{"key5"}, keys)
+		assert.True(t, storer.numGets < numValues/4)
+	})
+	t.Run("resuming from the last reported key should cover the whole diff", func(t *testing.T) {
+		t.Parallel()
+
+		tr, oldRootHash, newRootHash, expectedDiff := createTriesForDiff(t, numValues)
+		allKeys, _ := diffTries(t, tr.GetStorageManager(), oldRootHash, newRootHash, nil, 0)
+
+		pageSize := 4
+		pagedKeys := make([]string, 0)
+		var startKey []byte
+		for {
+			keys, _ := diffTries(t, tr.GetStorageManager(), oldRootHash, newRootHash, startKey, pageSize)
+			pagedKeys = append(pagedKeys, keys...)
+			if len(keys) < pageSize {
+				break
+			}
+			startKey = []byte(keys[len(keys)-1])
+		}
+
+		assert.Equal(t, allKeys, pagedKeys)
+		assert.Equal(t, len(expectedDiff), len(pagedKeys))
+	})
+}
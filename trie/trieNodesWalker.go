@@ -0,0 +1,76 @@
+package trie
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const trieNodesWalkerIdentifier = "trie nodes walker"
+
+// TrieNodeHandler is called for each walked trie node with its hash and its encoded form. For leaf nodes the leaf
+// value is provided as well, being nil for the other node types
+type TrieNodeHandler func(hash []byte, encodedNode []byte, leafValue []byte) error
+
+// WalkTrieNodes walks depth first all the nodes of the trie with the given root hash, reading them from the provided
+// storer, and calls the handler for each of them, parents before children. Unlike the trie iterators, the walked nodes
+// are not kept in memory, so it can be used on tries of any size
+func WalkTrieNodes(
+	rootHash []byte,
+	db common.BaseStorer,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+	handler TrieNodeHandler,
+) error {
+	if check.IfNil(db) {
+		return ErrNilDatabase
+	}
+	if check.IfNil(marshaller) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return ErrNilHasher
+	}
+	if handler == nil {
+		return ErrNilTrieNodeHandler
+	}
+
+	hashes := [][]byte{rootHash}
+	for len(hashes) > 0 {
+		hash := hashes[len(hashes)-1]
+		hashes = hashes[:len(hashes)-1]
+
+		encodedNode, err := db.Get(hash)
+		if err != nil {
+			return core.NewGetNodeFromDBErrWithKey(hash, err, trieNodesWalkerIdentifier)
+		}
+
+		n, err := decodeNode(encodedNode, marshaller, hasher)
+		if err != nil {
+			return err
+		}
+
+		var leafValue []byte
+		switch typedNode := n.(type) {
+		case *branchNode:
+			for i := len(typedNode.EncodedChildren) - 1; i >= 0; i-- {
+				if len(typedNode.EncodedChildren[i]) > 0 {
+					hashes = append(hashes, typedNode.EncodedChildren[i])
+				}
+			}
+		case *extensionNode:
+			hashes = append(hashes, typedNode.EncodedChild)
+		case *leafNode:
+			leafValue = typedNode.Value
+		}
+
+		err = handler(hash, encodedNode, leafValue)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
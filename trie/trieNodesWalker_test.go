@@ -0,0 +1,103 @@
+package trie_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalkTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	noopHandler := func(_ []byte, _ []byte, _ []byte) error {
+		return nil
+	}
+
+	t.Run("nil database should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := trie.WalkTrieNodes([]byte("root"), nil, &testscommon.MarshallerStub{}, &testscommon.HasherStub{}, noopHandler)
+		assert.Equal(t, trie.ErrNilDatabase, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := trie.WalkTrieNodes([]byte("root"), testscommon.NewMemDbMock(), nil, &testscommon.HasherStub{}, noopHandler)
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := trie.WalkTrieNodes([]byte("root"), testscommon.NewMemDbMock(), &testscommon.MarshallerStub{}, nil, noopHandler)
+		assert.Equal(t, trie.ErrNilHasher, err)
+	})
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := trie.WalkTrieNodes([]byte("root"), testscommon.NewMemDbMock(), &testscommon.MarshallerStub{}, &testscommon.HasherStub{}, nil)
+		assert.Equal(t, trie.ErrNilTrieNodeHandler, err)
+	})
+	t.Run("missing node should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(20)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		_, marshaller, hasher, _, _ := getDefaultTrieParameters()
+
+		err := trie.WalkTrieNodes(rootHash, testscommon.NewMemDbMock(), marshaller, hasher, noopHandler)
+		assert.NotNil(t, err)
+	})
+	t.Run("handler error should stop the walk", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(20)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		_, marshaller, hasher, _, _ := getDefaultTrieParameters()
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		err := trie.WalkTrieNodes(rootHash, tr.GetStorageManager(), marshaller, hasher, func(_ []byte, _ []byte, _ []byte) error {
+			numCalls++
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("should walk all nodes", func(t *testing.T) {
+		t.Parallel()
+
+		numValues := 100
+		tr, values := initTrieMultipleValues(numValues)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		_, marshaller, hasher, _, _ := getDefaultTrieParameters()
+
+		walkedHashes := make(map[string]struct{})
+		walkedValues := make(map[string]struct{})
+		err := trie.WalkTrieNodes(rootHash, tr.GetStorageManager(), marshaller, hasher, func(hash []byte, encodedNode []byte, leafValue []byte) error {
+			assert.Equal(t, hash, keccak.NewKeccak().Compute(string(encodedNode)))
+			walkedHashes[string(hash)] = struct{}{}
+			if leafValue != nil {
+				walkedValues[string(leafValue)] = struct{}{}
+			}
+
+			return nil
+		})
+		require.Nil(t, err)
+
+		_, isRootWalked := walkedHashes[string(rootHash)]
+		assert.True(t, isRootWalked)
+		require.Equal(t, numValues, len(walkedValues))
+		for _, value := range values {
+			_, found := walkedValues[string(value)]
+			assert.True(t, found)
+		}
+	})
+}
@@ -33,6 +33,7 @@ type ArgsNewAccountsDBSyncersContainerFactory struct {
 	NumConcurrentTrieSyncers  int
 	MaxHardCapForMissingNodes int
 	TrieSyncerVersion         int
+	NumParallelSyncWorkers    int
 	CheckNodesOnDisk          bool
 	AddressPubKeyConverter    core.PubkeyConverter
 	EnableEpochsHandler       common.EnableEpochsHandler
@@ -51,6 +52,7 @@ type accountDBSyncersContainerFactory struct {
 	numConcurrentTrieSyncers  int
 	maxHardCapForMissingNodes int
 	trieSyncerVersion         int
+	numParallelSyncWorkers    int
 	checkNodesOnDisk          bool
 	addressPubKeyConverter    core.PubkeyConverter
 	enableEpochsHandler       common.EnableEpochsHandler
@@ -105,6 +107,7 @@ func NewAccountsDBSContainerFactory(args ArgsNewAccountsDBSyncersContainerFactor
 		numConcurrentTrieSyncers:  args.NumConcurrentTrieSyncers,
 		maxHardCapForMissingNodes: args.MaxHardCapForMissingNodes,
 		trieSyncerVersion:         args.TrieSyncerVersion,
+		numParallelSyncWorkers:    args.NumParallelSyncWorkers,
 		checkNodesOnDisk:          args.CheckNodesOnDisk,
 		addressPubKeyConverter:    args.AddressPubKeyConverter,
 		enableEpochsHandler:       args.EnableEpochsHandler,
@@ -154,6 +157,7 @@ func (a *accountDBSyncersContainerFactory) createUserAccountsSyncer(shardId uint
 			MaxTrieLevelInMemory:              a.maxTrieLevelinMemory,
 			MaxHardCapForMissingNodes:         a.maxHardCapForMissingNodes,
 			TrieSyncerVersion:                 a.trieSyncerVersion,
+			NumSyncWorkers:                    a.numParallelSyncWorkers,
 			CheckNodesOnDisk:                  a.checkNodesOnDisk,
 			UserAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 			AppStatusHandler:                  disabled.NewAppStatusHandler(),
@@ -184,6 +188,7 @@ func (a *accountDBSyncersContainerFactory) createValidatorAccountsSyncer(shardId
 			MaxTrieLevelInMemory:              a.maxTrieLevelinMemory,
 			MaxHardCapForMissingNodes:         a.maxHardCapForMissingNodes,
 			TrieSyncerVersion:                 a.trieSyncerVersion,
+			NumSyncWorkers:                    a.numParallelSyncWorkers,
 			CheckNodesOnDisk:                  a.checkNodesOnDisk,
 			UserAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 			AppStatusHandler:                  disabled.NewAppStatusHandler(),
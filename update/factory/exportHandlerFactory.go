@@ -67,6 +67,7 @@ type ArgsExporter struct {
 	MaxHardCapForMissingNodes        int
 	NumConcurrentTrieSyncers         int
 	TrieSyncerVersion                int
+	NumParallelSyncWorkers           int
 	CheckNodesOnDisk                 bool
 	NodeOperationMode                common.NodeOperation
 }
@@ -106,6 +107,7 @@ type exportHandlerFactory struct {
 	maxHardCapForMissingNodes        int
 	numConcurrentTrieSyncers         int
 	trieSyncerVersion                int
+	numParallelSyncWorkers           int
 	checkNodesOnDisk                 bool
 	nodeOperationMode                common.NodeOperation
 }
@@ -263,6 +265,7 @@ func NewExportHandlerFactory(args ArgsExporter) (*exportHandlerFactory, error) {
 		maxHardCapForMissingNodes:        args.MaxHardCapForMissingNodes,
 		numConcurrentTrieSyncers:         args.NumConcurrentTrieSyncers,
 		trieSyncerVersion:                args.TrieSyncerVersion,
+		numParallelSyncWorkers:           args.NumParallelSyncWorkers,
 		checkNodesOnDisk:                 args.CheckNodesOnDisk,
 		statusCoreComponents:             args.StatusCoreComponents,
 		nodeOperationMode:                args.NodeOperationMode,
@@ -412,6 +415,7 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 		MaxHardCapForMissingNodes: e.maxHardCapForMissingNodes,
 		NumConcurrentTrieSyncers:  e.numConcurrentTrieSyncers,
 		TrieSyncerVersion:         e.trieSyncerVersion,
+		NumParallelSyncWorkers:    e.numParallelSyncWorkers,
 		CheckNodesOnDisk:          e.checkNodesOnDisk,
 		AddressPubKeyConverter:    e.coreComponents.AddressPubKeyConverter(),
 		EnableEpochsHandler:       e.coreComponents.EnableEpochsHandler(),